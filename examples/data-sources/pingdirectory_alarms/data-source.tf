@@ -0,0 +1,20 @@
+check "no_critical_alarms" {
+  data "pingdirectory_alarms" "critical" {
+    severities = ["critical"]
+    max_alerts = 0
+  }
+
+  assert {
+    condition     = length(data.pingdirectory_alarms.critical.alarms) == 0
+    error_message = "Critical alarms are raised: ${join(", ", data.pingdirectory_alarms.critical.alarms[*].condition)}"
+  }
+}
+
+data "pingdirectory_alarms" "recent" {
+  alert_severities = ["error", "fatal"]
+  max_alerts       = 20
+}
+
+output "recentAlertMessages" {
+  value = data.pingdirectory_alarms.recent.alerts[*].message
+}
@@ -0,0 +1,8 @@
+data "pingdirectory_config_object" "userRoot" {
+  collection_path = "backends"
+  name            = "userRoot"
+}
+
+output "userRootBaseDNs" {
+  value = jsondecode(data.pingdirectory_config_object.userRoot.properties).baseDN
+}
@@ -0,0 +1,8 @@
+data "pingdirectory_configuration_snapshot" "snapshot" {
+  exclude_types = ["license", "server_instance", "server_instance_listener"]
+}
+
+resource "local_file" "snapshot" {
+  filename = "${path.module}/configuration-snapshot.json"
+  content  = data.pingdirectory_configuration_snapshot.snapshot.json
+}
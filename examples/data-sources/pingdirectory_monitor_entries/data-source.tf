@@ -0,0 +1,10 @@
+data "pingdirectory_monitor_entries" "backends" {
+  object_class = "ds-backend-monitor-entry"
+}
+
+output "backendEntryCounts" {
+  value = {
+    for entry in data.pingdirectory_monitor_entries.backends.entries :
+    entry.attributes["ds-backend-id"] => tonumber(entry.attributes["ds-backend-entry-count"])
+  }
+}
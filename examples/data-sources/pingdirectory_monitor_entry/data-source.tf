@@ -0,0 +1,14 @@
+data "pingdirectory_monitor_entry" "userRootBackend" {
+  name = "userRoot Backend"
+
+  lifecycle {
+    postcondition {
+      condition     = tonumber(self.attributes["ds-backend-entry-count"]) > 0
+      error_message = "The userRoot backend must not be empty"
+    }
+  }
+}
+
+output "userRootEntryCount" {
+  value = tonumber(data.pingdirectory_monitor_entry.userRootBackend.attributes["ds-backend-entry-count"])
+}
@@ -0,0 +1,18 @@
+resource "pingdirectory_prometheus_monitor_attribute_metric" "entryCount" {
+  http_servlet_extension_name = "Prometheus Monitoring"
+  metric_name                 = "ping_backend_entry_count"
+  monitor_attribute_name      = "ds-backend-entry-count"
+  monitor_object_class_name   = "ds-backend-monitor-entry"
+  metric_type                 = "gauge"
+}
+
+check "metrics_published" {
+  data "pingdirectory_prometheus_metrics" "metrics" {
+    depends_on = [pingdirectory_prometheus_monitor_attribute_metric.entryCount]
+  }
+
+  assert {
+    condition     = contains(data.pingdirectory_prometheus_metrics.metrics.metric_names, pingdirectory_prometheus_monitor_attribute_metric.entryCount.metric_name)
+    error_message = "The ${pingdirectory_prometheus_monitor_attribute_metric.entryCount.metric_name} metric is not being published"
+  }
+}
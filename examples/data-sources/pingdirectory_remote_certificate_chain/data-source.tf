@@ -0,0 +1,22 @@
+variable "external_ldap_ca_fingerprint" {
+  type        = string
+  description = "Expected SHA-256 fingerprint of the external LDAP server's CA certificate, as colon-separated hex"
+}
+
+data "pingdirectory_remote_certificate_chain" "externalLdap" {
+  host      = "ldap.example.com"
+  port      = 389
+  start_tls = true
+}
+
+resource "pingdirectory_trusted_certificate" "externalLdapCa" {
+  name        = "External LDAP CA"
+  certificate = data.pingdirectory_remote_certificate_chain.externalLdap.last_certificate
+
+  lifecycle {
+    precondition {
+      condition     = data.pingdirectory_remote_certificate_chain.externalLdap.certificates[length(data.pingdirectory_remote_certificate_chain.externalLdap.certificates) - 1].sha256_fingerprint == var.external_ldap_ca_fingerprint
+      error_message = "The CA certificate presented by ldap.example.com does not have the expected fingerprint"
+    }
+  }
+}
@@ -0,0 +1,16 @@
+data "pingdirectory_schema_attribute_type" "employeeNumber" {
+  name = "employeeNumber"
+}
+
+resource "pingdirectory_local_db_index" "employeeNumber" {
+  backend_name = "userRoot"
+  attribute    = data.pingdirectory_schema_attribute_type.employeeNumber.names[0]
+  index_type   = ["equality"]
+
+  lifecycle {
+    precondition {
+      condition     = data.pingdirectory_schema_attribute_type.employeeNumber.equality_matching_rule != null
+      error_message = "An equality index requires an attribute type with an equality matching rule"
+    }
+  }
+}
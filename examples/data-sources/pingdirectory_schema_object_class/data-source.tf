@@ -0,0 +1,10 @@
+data "pingdirectory_schema_object_class" "inetOrgPerson" {
+  name = "inetOrgPerson"
+}
+
+output "inetOrgPersonAllowedAttributes" {
+  value = setunion(
+    data.pingdirectory_schema_object_class.inetOrgPerson.required_attributes,
+    data.pingdirectory_schema_object_class.inetOrgPerson.optional_attributes,
+  )
+}
@@ -0,0 +1,15 @@
+data "pingdirectory_topology" "topology" {
+}
+
+resource "pingdirectory_local_db_index" "employeeNumber" {
+  backend_name = "userRoot"
+  attribute    = "employeeNumber"
+  index_type   = ["equality"]
+
+  lifecycle {
+    precondition {
+      condition     = !data.pingdirectory_topology.topology.degraded
+      error_message = "The topology is degraded: ${join("; ", data.pingdirectory_topology.topology.degraded_reasons)}"
+    }
+  }
+}
@@ -0,0 +1,7 @@
+resource "pingdirectory_cipher_secret_key_rotation" "myCipherSecretKeyRotation" {
+  server_instance_name       = "ds1"
+  cipher_transformation_name = "AES/CBC/PKCS5Padding"
+  key_length_bits            = 128
+  # Change this value to rotate the key
+  rotation_trigger = "2025-01"
+}
@@ -0,0 +1,2 @@
+# The import ID is the collection path relative to /config, followed by the name of the config object
+terraform import pingdirectory_config_object.myConfigObject backends/myMemoryBackend
@@ -0,0 +1,11 @@
+# A Memory Backend, which the pingdirectory_backend resource can't create
+resource "pingdirectory_config_object" "memoryBackend" {
+  collection_path = "backends"
+  name            = "myMemoryBackend"
+  properties = jsonencode({
+    schemas   = ["urn:pingidentity:schemas:configuration:2.0:backend:memory"]
+    backendID = "myMemoryBackend"
+    baseDN    = ["dc=memory,dc=example,dc=com"]
+    enabled   = true
+  })
+}
@@ -0,0 +1,2 @@
+# The import ID is the DN of the group. All members of the group are imported, and the imported resource is authoritative.
+terraform import pingdirectory_group_membership.auditors "cn=Auditors,ou=Groups,dc=example,dc=com"
@@ -0,0 +1,18 @@
+# Add two administrators to a group, keeping the other members of the group
+resource "pingdirectory_group_membership" "admins" {
+  group_dn = "cn=Admins,ou=Groups,dc=example,dc=com"
+  members = [
+    "uid=jdoe,ou=People,dc=example,dc=com",
+    "uid=asmith,ou=People,dc=example,dc=com",
+  ]
+}
+
+# Manage all members of a group with the groupOfUniqueNames object class
+resource "pingdirectory_group_membership" "auditors" {
+  group_dn         = "cn=Auditors,ou=Groups,dc=example,dc=com"
+  member_attribute = "uniqueMember"
+  members = [
+    "uid=bwilson,ou=People,dc=example,dc=com",
+  ]
+  authoritative = true
+}
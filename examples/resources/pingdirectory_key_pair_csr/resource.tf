@@ -0,0 +1,38 @@
+variable "private_key_pem" {
+  type      = string
+  sensitive = true
+}
+
+variable "self_signed_certificate_pem" {
+  type = string
+}
+
+# Set to the certificate chain returned by the certificate authority for the CSR
+variable "signed_certificate_chain" {
+  type    = string
+  default = null
+}
+
+resource "pingdirectory_key_pair" "myKeyPair" {
+  name              = "MyKeyPair"
+  certificate_chain = var.self_signed_certificate_pem
+  private_key       = var.private_key_pem
+
+  # The certificate chain is updated by pingdirectory_key_pair_csr once the signed chain is applied
+  lifecycle {
+    ignore_changes = [certificate_chain]
+  }
+}
+
+resource "pingdirectory_key_pair_csr" "myKeyPairCsr" {
+  key_pair_name            = pingdirectory_key_pair.myKeyPair.name
+  private_key_pem_wo         = var.private_key_pem
+  private_key_pem_wo_version = 1
+  subject_dn                 = "cn=ds.example.com,o=Example Corp"
+  dns_names                  = ["ds.example.com"]
+  signed_certificate_chain   = var.signed_certificate_chain
+}
+
+output "certificate_request" {
+  value = pingdirectory_key_pair_csr.myKeyPairCsr.certificate_request
+}
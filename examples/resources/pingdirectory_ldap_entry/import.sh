@@ -0,0 +1,2 @@
+# The import ID is the DN of the entry. All user attributes of the entry are read on import.
+terraform import pingdirectory_ldap_entry.people "ou=People,dc=example,dc=com"
@@ -0,0 +1,31 @@
+resource "pingdirectory_ldap_entry" "people" {
+  dn             = "ou=People,dc=example,dc=com"
+  object_classes = ["top", "organizationalUnit"]
+  attributes = {
+    ou          = "People"
+    description = "User accounts"
+  }
+}
+
+variable "service_account_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "pingdirectory_ldap_entry" "serviceAccount" {
+  dn             = "uid=app-service,${pingdirectory_ldap_entry.people.dn}"
+  object_classes = ["top", "person", "organizationalPerson", "inetOrgPerson"]
+  attributes = {
+    uid = "app-service"
+    cn  = "Application Service Account"
+    sn  = "Service"
+  }
+  attribute_values = {
+    description = ["Used by the application", "Managed by Terraform"]
+  }
+  # The password is never stored in the Terraform state. Increment the version to set a new password.
+  sensitive_attributes_wo = {
+    userPassword = var.service_account_password
+  }
+  sensitive_attributes_wo_version = 1
+}
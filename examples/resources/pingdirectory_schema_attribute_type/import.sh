@@ -0,0 +1,2 @@
+# The import ID is the name or OID of the attribute type
+terraform import pingdirectory_schema_attribute_type.employeeCode exampleEmployeeCode
@@ -0,0 +1,10 @@
+resource "pingdirectory_schema_attribute_type" "employeeCode" {
+  definition = <<EOT
+( 1.3.6.1.4.1.32473.1.1.1
+  NAME 'exampleEmployeeCode'
+  DESC 'Example employee code'
+  EQUALITY caseIgnoreMatch
+  SYNTAX 1.3.6.1.4.1.1466.115.121.1.15
+  SINGLE-VALUE )
+EOT
+}
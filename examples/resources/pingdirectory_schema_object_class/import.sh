@@ -0,0 +1,2 @@
+# The import ID is the name or OID of the object class
+terraform import pingdirectory_schema_object_class.employee exampleEmployee
@@ -0,0 +1,9 @@
+resource "pingdirectory_schema_object_class" "employee" {
+  definition = <<EOT
+( 1.3.6.1.4.1.32473.1.2.1
+  NAME 'exampleEmployee'
+  SUP top
+  AUXILIARY
+  MAY ( employeeNumber $ description ) )
+EOT
+}
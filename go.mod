@@ -17,7 +17,9 @@ require (
 	github.com/hashicorp/terraform-plugin-go v0.29.0
 	github.com/hashicorp/terraform-plugin-log v0.10.0
 	github.com/hashicorp/terraform-plugin-testing v1.14.0
+	github.com/pavlo-v-chernykh/keystore-go/v4 v4.5.0
 	github.com/pingidentity/pingdirectory-go-client/v10300 v10300.0.0
+	software.sslmate.com/src/go-pkcs12 v0.7.3
 )
 
 require (
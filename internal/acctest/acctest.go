@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
@@ -64,6 +65,16 @@ func TestBasicAuthContext() context.Context {
 	return config.BasicAuthContext(ctx, os.Getenv("PINGDIRECTORY_PROVIDER_USERNAME"), os.Getenv("PINGDIRECTORY_PROVIDER_PASSWORD"))
 }
 
+// Provider configuration for checks that use APIs other than the Configuration API, such as the Directory REST API
+func TestProviderConfiguration() types.ProviderConfiguration {
+	return types.ProviderConfiguration{
+		HttpsHost:      os.Getenv("PINGDIRECTORY_PROVIDER_HTTPS_HOST"),
+		Username:       os.Getenv("PINGDIRECTORY_PROVIDER_USERNAME"),
+		Password:       os.Getenv("PINGDIRECTORY_PROVIDER_PASSWORD"),
+		ProductVersion: os.Getenv("PINGDIRECTORY_PROVIDER_PRODUCT_VERSION"),
+	}
+}
+
 // Convert a string slice to the format used in Terraform files
 func StringSliceToTerraformString(values []string) string {
 	var builder strings.Builder
@@ -124,6 +135,14 @@ func TestAttributesMatchStringSlice(resourceType string, resourceName *string, a
 	return nil
 }
 
+// Test if ordered string slice attributes match, including the order of the values
+func TestAttributesMatchStringList(resourceType string, resourceName *string, attributeName string, expected, found []string) error {
+	if !slices.Equal(expected, found) {
+		return mismatchedAttributeError(resourceType, resourceName, attributeName, StringSliceToTerraformString(expected), StringSliceToTerraformString(found))
+	}
+	return nil
+}
+
 func ExpectedDestroyError(resourceType, resourceName string) error {
 	return fmt.Errorf("%s '%s' still exists after tests. Expected it to be destroyed.", resourceType, resourceName)
 }
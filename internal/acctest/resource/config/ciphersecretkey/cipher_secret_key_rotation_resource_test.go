@@ -0,0 +1,102 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package ciphersecretkey_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+// Attributes to test with
+type cipherSecretKeyRotationTestModel struct {
+	serverInstanceName       string
+	cipherTransformationName string
+	keyLengthBits            int64
+	rotationTrigger          string
+}
+
+func TestAccCipherSecretKeyRotation(t *testing.T) {
+	// Find a key of the test server to rotate. The crypto manager only generates a key the first time data is
+	// encrypted with a cipher, so the test is skipped when there isn't one.
+	var initialKeyID string
+	model := cipherSecretKeyRotationTestModel{
+		rotationTrigger: "1",
+	}
+	// Only run for acceptance tests
+	if os.Getenv("TF_ACC") == "1" {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		globalConfig, _, err := testClient.GlobalConfigurationAPI.GetGlobalConfiguration(ctx).Execute()
+		if err != nil {
+			t.Fatal(err)
+		}
+		model.serverInstanceName = globalConfig.InstanceName
+		keys, _, err := testClient.CipherSecretKeyAPI.ListCipherSecretKeys(ctx, model.serverInstanceName).Execute()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, key := range keys.Resources {
+			if !key.GetIsCompromised() {
+				initialKeyID = key.KeyID
+				model.cipherTransformationName = key.GetCipherTransformationName()
+				model.keyLengthBits = key.KeyLengthBits
+				break
+			}
+		}
+		if initialKeyID == "" {
+			t.Skip("The test server has no Cipher Secret Key to rotate")
+		}
+	}
+	rotatedModel := model
+	rotatedModel.rotationTrigger = "2"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Creating the resource records the current key without rotating it
+				Config: testAccCipherSecretKeyRotationResource(model),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdirectory_cipher_secret_key_rotation.rotation", "key_id", initialKeyID),
+					resource.TestCheckNoResourceAttr("pingdirectory_cipher_secret_key_rotation.rotation", "previous_key_id"),
+				),
+			},
+			{
+				// Changing the trigger rotates the key
+				Config: testAccCipherSecretKeyRotationResource(rotatedModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdirectory_cipher_secret_key_rotation.rotation", "previous_key_id", initialKeyID),
+					resource.TestCheckResourceAttrWith("pingdirectory_cipher_secret_key_rotation.rotation", "key_id", func(value string) error {
+						if value == initialKeyID {
+							return fmt.Errorf("expected a new key, but the key is still %s", value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCipherSecretKeyRotationResource(model cipherSecretKeyRotationTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_cipher_secret_key_rotation" "rotation" {
+  server_instance_name       = "%[1]s"
+  cipher_transformation_name = "%[2]s"
+  key_length_bits            = %[3]d
+  rotation_trigger           = "%[4]s"
+}`, model.serverInstanceName,
+		model.cipherTransformationName,
+		model.keyLengthBits,
+		model.rotationTrigger)
+}
@@ -0,0 +1,189 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package configobject_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const resourceName = "testConfigObject"
+const locationName = "ConfigObjectLocation"
+
+func TestAccConfigObject(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: testAccCheckConfigObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Create a Location through the Configuration API collection
+				Config: testAccConfigObjectResource(`{ description = "Created as a config object" }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExpectedLocationDescription("Created as a config object"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("pingdirectory_config_object.%s", resourceName), "id", "locations/"+locationName),
+					resource.TestCheckResourceAttr(fmt.Sprintf("pingdirectory_config_object.%s", resourceName), "type", "location"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.pingdirectory_config_object.%s", resourceName), "type", "location"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.pingdirectory_location.%s", resourceName), "description", "Created as a config object"),
+				),
+			},
+			{
+				// Update the description
+				Config: testAccConfigObjectResource(`{ description = "Updated as a config object" }`),
+				Check:  testAccCheckExpectedLocationDescription("Updated as a config object"),
+			},
+			{
+				// Removing the property resets it to its default
+				Config: testAccConfigObjectResource(`{}`),
+				Check:  testAccCheckExpectedLocationDescription(""),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccConfigObjectResource(`{}`),
+				ResourceName:      "pingdirectory_config_object." + resourceName,
+				ImportStateId:     "locations/" + locationName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"properties",
+					"required_actions",
+					"notifications",
+				},
+			},
+		},
+	})
+}
+
+func testAccConfigObjectResource(properties string) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_config_object" "%[1]s" {
+  collection_path = "locations"
+  name            = "%[2]s"
+  properties      = jsonencode(%[3]s)
+}
+
+data "pingdirectory_config_object" "%[1]s" {
+  collection_path = "locations"
+  name            = "%[2]s"
+  depends_on = [
+    pingdirectory_config_object.%[1]s
+  ]
+}
+
+data "pingdirectory_location" "%[1]s" {
+  name = "%[2]s"
+  depends_on = [
+    pingdirectory_config_object.%[1]s
+  ]
+}`, resourceName, locationName, properties)
+}
+
+// Test that the location has the expected description on the PingDirectory server
+func testAccCheckExpectedLocationDescription(description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		name := locationName
+		locationResponse, _, err := testClient.LocationAPI.GetLocation(ctx, name).Execute()
+		if err != nil {
+			return err
+		}
+		return acctest.TestAttributesMatchStringPointer("location", &name, "description", description, locationResponse.Description)
+	}
+}
+
+// Test that the location created by the test is destroyed
+func testAccCheckConfigObjectDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.LocationAPI.GetLocation(ctx, locationName).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("location", locationName)
+	}
+	return nil
+}
+
+const passwordPolicyName = "ConfigObjectPasswordPolicy"
+
+// Reordering the values of an ordered property is a change, and the order is kept on the server
+func TestAccConfigObjectOrderedProperty(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: testAccCheckConfigObjectPasswordPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigObjectPasswordPolicyResource("Salted SHA-512", "Blowfish"),
+				Check:  testAccCheckExpectedStorageSchemes("Salted SHA-512", "Blowfish"),
+			},
+			{
+				// Reversing the storage schemes is planned as an update
+				Config: testAccConfigObjectPasswordPolicyResource("Blowfish", "Salted SHA-512"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pingdirectory_config_object."+resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testAccCheckExpectedStorageSchemes("Blowfish", "Salted SHA-512"),
+			},
+			{
+				// The order read back from the server matches the configured order
+				Config: testAccConfigObjectPasswordPolicyResource("Blowfish", "Salted SHA-512"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccConfigObjectPasswordPolicyResource(storageSchemes ...string) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_config_object" "%[1]s" {
+  collection_path = "password-policies"
+  name            = "%[2]s"
+  properties = jsonencode({
+    password-attribute              = "userPassword"
+    default-password-storage-scheme = %[3]s
+  })
+}`, resourceName, passwordPolicyName, acctest.StringSliceToTerraformString(storageSchemes))
+}
+
+// Test that the password policy has the expected storage schemes, in order, on the PingDirectory server
+func testAccCheckExpectedStorageSchemes(storageSchemes ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		name := passwordPolicyName
+		response, _, err := testClient.PasswordPolicyAPI.GetPasswordPolicy(ctx, name).Execute()
+		if err != nil {
+			return err
+		}
+		return acctest.TestAttributesMatchStringList("Password Policy", &name, "default-password-storage-scheme", storageSchemes, response.DefaultPasswordStorageScheme)
+	}
+}
+
+// Test that the password policy created by the test is destroyed
+func testAccCheckConfigObjectPasswordPolicyDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.PasswordPolicyAPI.GetPasswordPolicy(ctx, passwordPolicyName).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Password Policy", passwordPolicyName)
+	}
+	return nil
+}
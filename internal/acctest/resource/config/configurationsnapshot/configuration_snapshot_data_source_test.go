@@ -0,0 +1,46 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package configurationsnapshot_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+func TestAccConfigurationSnapshot(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationSnapshot(`["backend", "local_db_index", "global_configuration", "location"]`, `["location"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pingdirectory_configuration_snapshot.snapshot", "types.#", "3"),
+					resource.TestCheckTypeSetElemAttr("data.pingdirectory_configuration_snapshot.snapshot", "types.*", "local_db_index"),
+					resource.TestMatchResourceAttr("data.pingdirectory_configuration_snapshot.snapshot", "json", regexp.MustCompile(`"userRoot/uid": \{`)),
+					resource.TestMatchResourceAttr("data.pingdirectory_configuration_snapshot.snapshot", "json", regexp.MustCompile(`"global_configuration": \{`)),
+				),
+			},
+			{
+				Config:      testAccConfigurationSnapshot(`["not_a_config_type"]`, `[]`),
+				ExpectError: regexp.MustCompile("Unknown config object type"),
+			},
+		},
+	})
+}
+
+func testAccConfigurationSnapshot(includeTypes, excludeTypes string) string {
+	return `
+data "pingdirectory_configuration_snapshot" "snapshot" {
+  include_types = ` + includeTypes + `
+  exclude_types = ` + excludeTypes + `
+}`
+}
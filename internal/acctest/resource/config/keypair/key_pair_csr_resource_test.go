@@ -0,0 +1,185 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package keypair_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+// Create a PEM-encoded certificate for the public key, signed by the issuer key. The certificate is self-signed
+// when the issuer is nil.
+func testAccCreateCertificate(t *testing.T, subject string, publicKey any, issuer *x509.Certificate, issuerKey any, isCA bool) (*x509.Certificate, string) {
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: subject},
+		DNSNames:              []string{"ds.example.com"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().AddDate(1, 0, 0),
+		IsCA:                  isCA,
+		BasicConstraintsValid: isCA,
+	}
+	if issuer == nil {
+		issuer = template
+	}
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, issuer, publicKey, issuerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(certBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes}))
+}
+
+func TestAccKeyPairCsr(t *testing.T) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKeyBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKeyPem := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKeyBytes}))
+	_, selfSignedPem := testAccCreateCertificate(t, "CSR Key Pair", &privateKey.PublicKey, nil, privateKey, false)
+
+	// Sign a certificate for the same key with a test CA, as a certificate authority would for the CSR
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caCert, caPem := testAccCreateCertificate(t, "CSR Test CA", &caKey.PublicKey, nil, caKey, true)
+	_, signedPem := testAccCreateCertificate(t, "CSR Key Pair", &privateKey.PublicKey, caCert, caKey, false)
+
+	// A certificate for a different key can't be applied to the key pair
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, otherPem := testAccCreateCertificate(t, "CSR Key Pair", &otherKey.PublicKey, caCert, caKey, false)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckKeyPairDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyPairCsrResource(selfSignedPem, privateKeyPem, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdirectory_key_pair_csr.csr", "id", testIdKeyPair),
+					resource.TestCheckNoResourceAttr("pingdirectory_key_pair_csr.csr", "private_key_pem_wo"),
+					resource.TestCheckResourceAttr("pingdirectory_key_pair_csr.csr", "subject_dn", "CN=CSR Key Pair"),
+					resource.TestCheckResourceAttr("pingdirectory_key_pair_csr.csr", "dns_names.#", "1"),
+					resource.TestCheckResourceAttr("pingdirectory_key_pair_csr.csr", "dns_names.0", "ds.example.com"),
+					resource.TestCheckResourceAttrWith("pingdirectory_key_pair_csr.csr", "certificate_request", testAccCheckCertificateRequest("CN=CSR Key Pair")),
+				),
+			},
+			{
+				// Apply the certificate chain signed by the CA to the key pair
+				Config: testAccKeyPairCsrResource(selfSignedPem, privateKeyPem, signedPem+caPem),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("pingdirectory_key_pair_csr.csr", "certificate_chain", testAccCheckCertificateIssuer("CN=CSR Test CA")),
+				),
+			},
+			{
+				Config:      testAccKeyPairCsrResource(selfSignedPem, privateKeyPem, otherPem+caPem),
+				ExpectError: regexp.MustCompile("Invalid signed certificate chain"),
+			},
+		},
+	})
+}
+
+func testAccKeyPairCsrResource(certificateChain, privateKeyPem, signedCertificateChain string) string {
+	signedCertificateChainAttr := ""
+	if signedCertificateChain != "" {
+		signedCertificateChainAttr = fmt.Sprintf("signed_certificate_chain = <<EOT\n%sEOT\n", signedCertificateChain)
+	}
+	return fmt.Sprintf(`
+resource "pingdirectory_key_pair" "csr" {
+  name              = "%[1]s"
+  certificate_chain = <<EOT
+%[2]sEOT
+  private_key = <<EOT
+%[3]sEOT
+
+  # The certificate chain is managed by pingdirectory_key_pair_csr once the signed chain is applied
+  lifecycle {
+    ignore_changes = [certificate_chain]
+  }
+}
+
+resource "pingdirectory_key_pair_csr" "csr" {
+  key_pair_name              = pingdirectory_key_pair.csr.name
+  private_key_pem_wo_version = 1
+  private_key_pem_wo         = <<EOT
+%[3]sEOT
+  %[4]s
+}`, testIdKeyPair,
+		certificateChain,
+		privateKeyPem,
+		signedCertificateChainAttr)
+}
+
+// Check that a value is a valid PEM-encoded CSR with the expected subject
+func testAccCheckCertificateRequest(expectedSubject string) resource.CheckResourceAttrWithFunc {
+	return func(value string) error {
+		block, _ := pem.Decode([]byte(value))
+		if block == nil || block.Type != "CERTIFICATE REQUEST" {
+			return errors.New("expected a PEM-encoded certificate request")
+		}
+		csr, err := x509.ParseCertificateRequest(block.Bytes)
+		if err != nil {
+			return err
+		}
+		if err = csr.CheckSignature(); err != nil {
+			return err
+		}
+		if csr.Subject.String() != expectedSubject {
+			return fmt.Errorf("expected subject '%s', got '%s'", expectedSubject, csr.Subject.String())
+		}
+		return nil
+	}
+}
+
+// Check that the first certificate of a PEM-encoded chain has the expected issuer
+func testAccCheckCertificateIssuer(expectedIssuer string) resource.CheckResourceAttrWithFunc {
+	return func(value string) error {
+		block, _ := pem.Decode([]byte(strings.TrimSpace(value)))
+		if block == nil {
+			return errors.New("expected a PEM-encoded certificate chain")
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return err
+		}
+		if cert.Issuer.String() != expectedIssuer {
+			return fmt.Errorf("expected issuer '%s', got '%s'", expectedIssuer, cert.Issuer.String())
+		}
+		return nil
+	}
+}
@@ -3,15 +3,28 @@
 package keypair_test
 
 import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
 	"fmt"
+	"math/big"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	keystore "github.com/pavlo-v-chernykh/keystore-go/v4"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+	"software.sslmate.com/src/go-pkcs12"
 )
 
 const testIdKeyPair = "MyId"
@@ -44,6 +57,12 @@ func TestAccKeyPair(t *testing.T) {
 					testAccCheckExpectedKeyPairAttributes(initialResourceModel),
 					resource.TestCheckResourceAttr(fmt.Sprintf("data.pingdirectory_key_pair.%s", resourceName), "subject_dn", initialResourceModel.subjectDn),
 					resource.TestCheckResourceAttrSet("data.pingdirectory_key_pairs.list", "ids.0"),
+					resource.TestCheckResourceAttr("pingdirectory_key_pair."+resourceName, "certificate_details.subject", "CN=Directory Server,O=Ping Identity Key Pair"),
+					resource.TestCheckResourceAttr("pingdirectory_key_pair."+resourceName, "certificate_details.key_algorithm", "RSA"),
+					resource.TestCheckResourceAttr("pingdirectory_key_pair."+resourceName, "certificate_details.key_size", "2048"),
+					resource.TestCheckResourceAttr("pingdirectory_key_pair."+resourceName, "certificate_chain_complete", "true"),
+					resource.TestCheckResourceAttrPair("pingdirectory_key_pair."+resourceName, "certificate_details.sha256_fingerprint",
+						fmt.Sprintf("data.pingdirectory_key_pair.%s", resourceName), "certificate_details.sha256_fingerprint"),
 				),
 			},
 			{
@@ -97,6 +116,251 @@ data "pingdirectory_key_pairs" "list" {
 		resourceModel.subjectDn)
 }
 
+func TestAccKeyPairPkcs12(t *testing.T) {
+	resourceName := "pkcs12"
+	privateKey, certBytes := testAccKeyPairSelfSignedCertificate(t, "PKCS12 Key Pair")
+	cert, err := x509.ParseCertificate(certBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pfxData, err := pkcs12.Modern2023.Encode(privateKey, cert, nil, "keystorepassword")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkcs12Base64 := base64.StdEncoding.EncodeToString(pfxData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckKeyPairDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyPairKeystoreResource(resourceName, pkcs12Base64, "keystorepassword", "PKCS12", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdirectory_key_pair."+resourceName, "certificate_details.subject", "CN=PKCS12 Key Pair"),
+					resource.TestCheckResourceAttr("pingdirectory_key_pair."+resourceName, "certificate_chain",
+						string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes}))),
+					resource.TestCheckNoResourceAttr("pingdirectory_key_pair."+resourceName, "private_key"),
+					resource.TestCheckNoResourceAttr("pingdirectory_key_pair."+resourceName, "pkcs12_base64_wo"),
+					resource.TestCheckNoResourceAttr("pingdirectory_key_pair."+resourceName, "pkcs12_password_wo"),
+					resource.TestCheckResourceAttr("pingdirectory_key_pair."+resourceName, "pkcs12_wo_version", "1"),
+				),
+			},
+			{
+				// Test importing the resource. The write-only keystore values are never in state, so only the
+				// attributes that are never returned by PingDirectory differ.
+				Config:            testAccKeyPairKeystoreResource(resourceName, pkcs12Base64, "keystorepassword", "PKCS12", ""),
+				ResourceName:      "pingdirectory_key_pair." + resourceName,
+				ImportStateId:     testIdKeyPair,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"keystore_type",
+					"pkcs12_wo_version",
+				},
+			},
+			{
+				// A wrong password should be reported during plan
+				Config:      testAccKeyPairKeystoreResource(resourceName, pkcs12Base64, "wrongpassword", "PKCS12", ""),
+				ExpectError: regexp.MustCompile("Failed to read the keystore for the Key Pair"),
+			},
+		},
+	})
+}
+
+func TestAccKeyPairJks(t *testing.T) {
+	resourceName := "jks"
+	// The keystore has two private key entries, so the alias to import must be set
+	ks := keystore.New()
+	for _, alias := range []string{"first", "second"} {
+		privateKey, certBytes := testAccKeyPairSelfSignedCertificate(t, "JKS Key Pair "+alias)
+		privateKeyBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ks.SetPrivateKeyEntry(alias, keystore.PrivateKeyEntry{
+			CreationTime: time.Now(),
+			PrivateKey:   privateKeyBytes,
+			CertificateChain: []keystore.Certificate{
+				{
+					Type:    "X509",
+					Content: certBytes,
+				},
+			},
+		}, []byte("keystorepassword"))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	var jksData bytes.Buffer
+	err := ks.Store(&jksData, []byte("keystorepassword"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	jksBase64 := base64.StdEncoding.EncodeToString(jksData.Bytes())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckKeyPairDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Without key_alias, the entry to import is ambiguous
+				Config:      testAccKeyPairKeystoreResource(resourceName, jksBase64, "keystorepassword", "JKS", ""),
+				ExpectError: regexp.MustCompile("key_alias must be set to one of"),
+			},
+			{
+				Config: testAccKeyPairKeystoreResource(resourceName, jksBase64, "keystorepassword", "JKS", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdirectory_key_pair."+resourceName, "certificate_details.subject", "CN=JKS Key Pair second"),
+					resource.TestCheckNoResourceAttr("pingdirectory_key_pair."+resourceName, "private_key"),
+					resource.TestCheckNoResourceAttr("pingdirectory_key_pair."+resourceName, "pkcs12_base64_wo"),
+					resource.TestCheckNoResourceAttr("pingdirectory_key_pair."+resourceName, "pkcs12_password_wo"),
+				),
+			},
+			{
+				// Importing a different entry changes the private key, so the Key Pair is replaced
+				Config: testAccKeyPairKeystoreResource(resourceName, jksBase64, "keystorepassword", "JKS", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdirectory_key_pair."+resourceName, "certificate_details.subject", "CN=JKS Key Pair first"),
+				),
+			},
+		},
+	})
+}
+
+// Generate a private key and a self-signed certificate with the given common name
+func testAccKeyPairSelfSignedCertificate(t *testing.T, commonName string) (*rsa.PrivateKey, []byte) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().AddDate(1, 0, 0),
+	}
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return privateKey, certBytes
+}
+
+func testAccKeyPairKeystoreResource(resourceName, keystoreBase64, keystorePassword, keystoreType, keyAlias string) string {
+	keyAliasConfig := ""
+	if keyAlias != "" {
+		keyAliasConfig = fmt.Sprintf("key_alias          = \"%s\"", keyAlias)
+	}
+	return fmt.Sprintf(`
+resource "pingdirectory_key_pair" "%[1]s" {
+  name               = "%[2]s"
+  pkcs12_base64_wo   = "%[3]s"
+  pkcs12_password_wo = "%[4]s"
+  pkcs12_wo_version  = 1
+  keystore_type      = "%[5]s"
+  %[6]s
+}`, resourceName,
+		testIdKeyPair,
+		keystoreBase64,
+		keystorePassword,
+		keystoreType,
+		keyAliasConfig)
+}
+
+func TestAccKeyPairRenewal(t *testing.T) {
+	resourceName := "renewal"
+	var initialSerialNumber string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: testAccCheckKeyPairDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The certificate isn't due for renewal yet
+				Config: testAccKeyPairRenewalResource(resourceName, "1 d"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("pingdirectory_key_pair."+resourceName, "certificate_details.serial_number", func(value string) error {
+						initialSerialNumber = value
+						return nil
+					}),
+				),
+			},
+			{
+				// The certificate is valid for less than renew_before, so it is renewed on every apply
+				Config: testAccKeyPairRenewalResource(resourceName, "14 d"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdirectory_key_pair."+resourceName, "name", testIdKeyPair),
+					resource.TestCheckResourceAttrWith("pingdirectory_key_pair."+resourceName, "certificate_details.serial_number", func(value string) error {
+						if value == initialSerialNumber {
+							return fmt.Errorf("expected the certificate to be renewed, but the serial number is unchanged: %s", value)
+						}
+						return nil
+					}),
+					testAccCheckKeyPairRenewalRemoved,
+					testAccCheckKeyPairRenewalReference,
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccKeyPairRenewalResource(resourceName, renewBefore string) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_key_pair" "%[1]s" {
+  name                             = "%[2]s"
+  self_signed_certificate_validity = "7 d"
+  renew_before                     = "%[3]s"
+}
+
+resource "pingdirectory_access_token_validator" "%[1]s" {
+  name                = "%[2]s Validator"
+  type                = "jwt"
+  enabled             = false
+  encryption_key_pair = pingdirectory_key_pair.%[1]s.name
+}`, resourceName,
+		testIdKeyPair,
+		renewBefore)
+}
+
+// Test that the temporary key pair used to renew the certificate was removed
+func testAccCheckKeyPairRenewalRemoved(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.KeyPairAPI.GetKeyPair(ctx, testIdKeyPair+"-renewal").Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Key Pair", testIdKeyPair+"-renewal")
+	}
+	return nil
+}
+
+// Test that the config object referencing the renewed key pair was pointed back at it after renewal
+func testAccCheckKeyPairRenewalReference(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	response, _, err := testClient.AccessTokenValidatorAPI.GetAccessTokenValidator(ctx, testIdKeyPair+" Validator").Execute()
+	if err != nil {
+		return err
+	}
+	return acctest.TestAttributesMatchStringPointer("Access Token Validator", nil, "encryption-key-pair", testIdKeyPair,
+		response.JwtAccessTokenValidatorResponse.EncryptionKeyPair)
+}
+
 // Test that the expected attributes are set on the PingDirectory server
 func testAccCheckExpectedKeyPairAttributes(config keyPairTestModel) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
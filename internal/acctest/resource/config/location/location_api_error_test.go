@@ -0,0 +1,68 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package location_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+// Configuration API errors that name a property are attached to the matching attribute, and errors naming a
+// property with no matching attribute fall back to a plain error on the resource. Uses a local server returning
+// canned Configuration API errors, so no PingDirectory server is needed.
+func TestAccLocationApiErrorDetail(t *testing.T) {
+	var detail atomic.Value
+	detail.Store("The value 'bad' is not valid for property 'description'")
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"400","detail":"%s"}`, detail.Load())
+	}))
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// The error is reported on the description attribute
+				Config:      testAccLocationApiErrorResource(server.URL),
+				ExpectError: regexp.MustCompile(`in resource "pingdirectory_location" "error":\s+\d+:\s+description\s+=`),
+			},
+			{
+				PreConfig: func() {
+					detail.Store("The value 'bad' is not valid for property 'not-an-attribute'")
+				},
+				// The error is reported on the resource block
+				Config:      testAccLocationApiErrorResource(server.URL),
+				ExpectError: regexp.MustCompile(`in resource "pingdirectory_location" "error":\s+\d+:\s+resource "pingdirectory_location" "error"`),
+			},
+		},
+	})
+}
+
+func testAccLocationApiErrorResource(httpsHost string) string {
+	return fmt.Sprintf(`
+provider "pingdirectory" {
+  https_host             = "%[1]s"
+  username               = "cn=administrator"
+  password               = "2FederateM0re"
+  insecure_trust_all_tls = true
+  product_version        = "10.3.0.0"
+}
+
+resource "pingdirectory_location" "error" {
+  name        = "ErrorLocation"
+  description = "bad"
+}`, httpsHost)
+}
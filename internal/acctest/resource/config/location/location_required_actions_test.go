@@ -0,0 +1,119 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package location_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+// Each provider required_actions_policy is applied to the required actions returned when creating a config object.
+// Uses a local server returning canned Configuration API responses, so no PingDirectory server is needed.
+func TestAccLocationRequiredActionsPolicyWarn(t *testing.T) {
+	server := newRequiredActionsServer()
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLocationRequiredActionsResource(server.URL, "warn"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdirectory_location.first", "required_actions.#", "1"),
+					resource.TestCheckResourceAttr("pingdirectory_location.first", "required_actions.0.type", "componentRestart"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLocationRequiredActionsPolicyError(t *testing.T) {
+	server := newRequiredActionsServer()
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// The "error" policy fails creates as well as updates
+				Config:      testAccLocationRequiredActionsResource(server.URL, "error"),
+				ExpectError: regexp.MustCompile(`Configuration API RequiredAction`),
+			},
+		},
+	})
+}
+
+func TestAccLocationRequiredActionsPolicyCollect(t *testing.T) {
+	server := newRequiredActionsServer()
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// The actions are reported in a summary warning, so the apply succeeds
+				Config: testAccLocationRequiredActionsResource(server.URL, "collect"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdirectory_location.first", "required_actions.#", "1"),
+					resource.TestCheckResourceAttr("pingdirectory_location.second", "required_actions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// Start a local server that returns a required action in every Location response
+func newRequiredActionsServer() *httptest.Server {
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		// Locations are created with POST to the collection and read with GET on the object
+		name := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		if r.Method == http.MethodPost {
+			body, _ := io.ReadAll(r.Body)
+			name = regexp.MustCompile(`"locationName":"([^"]*)"`).FindStringSubmatch(string(body))[1]
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"schemas":["urn:pingidentity:schemas:configuration:2.0:location"],"id":"%s",`+
+			`"urn:pingidentity:schemas:configuration:messages:2.0":{"requiredActions":[`+
+			`{"type":"componentRestart","synopsis":"Restart the server for the change to take effect"}]}}`, name)
+	}))
+}
+
+func testAccLocationRequiredActionsResource(httpsHost, policy string) string {
+	return fmt.Sprintf(`
+provider "pingdirectory" {
+  https_host              = "%[1]s"
+  username                = "cn=administrator"
+  password                = "2FederateM0re"
+  insecure_trust_all_tls  = true
+  product_version         = "10.3.0.0"
+  required_actions_policy = "%[2]s"
+}
+
+resource "pingdirectory_location" "first" {
+  name = "FirstRequiredActionsLocation"
+}
+
+resource "pingdirectory_location" "second" {
+  name = "SecondRequiredActionsLocation"
+}`, httpsHost, policy)
+}
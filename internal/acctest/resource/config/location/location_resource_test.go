@@ -4,12 +4,14 @@ package location_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
 )
@@ -36,6 +38,18 @@ func TestAccLocation(t *testing.T) {
 					resource.TestCheckResourceAttr(fmt.Sprintf("data.pingdirectory_location.%s", resourceName), "id", locationName),
 					resource.TestCheckResourceAttr(fmt.Sprintf("data.pingdirectory_location.%s", resourceName), "description", locationDescription),
 					resource.TestCheckResourceAttrSet("data.pingdirectory_locations.list", "ids.0"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.pingdirectory_locations.list", "objects.*", map[string]string{
+						"id":   locationName,
+						"type": "location",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.pingdirectory_locations.full", "objects.*", map[string]string{
+						"id":          locationName,
+						"name":        locationName,
+						"description": locationDescription,
+					}),
+					resource.TestCheckResourceAttr("data.pingdirectory_locations.full", fmt.Sprintf("objects_by_id.%s.description", locationName), locationDescription),
+					resource.TestCheckResourceAttr("data.pingdirectory_locations.filtered", "ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.pingdirectory_locations.filtered", "ids.*", locationName),
 				),
 			},
 			{
@@ -85,6 +99,63 @@ func TestAccLocation(t *testing.T) {
 	})
 }
 
+func TestAccLocationMoveState(t *testing.T) {
+	resourceName := "MovedLocation"
+	locationDescription := "Home of Rayquaza"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		// moved blocks between resource types require Terraform 1.8+
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		CheckDestroy: testAccCheckLocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLocationResourceNoData(resourceName, locationName, locationDescription),
+			},
+			{
+				// Move the state to the default resource
+				Config: testAccMovedLocationResource(resourceName, locationName, locationDescription, "pingdirectory_location", "pingdirectory_default_location"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("pingdirectory_default_location.%s", resourceName), "description", locationDescription),
+					testAccCheckExpectedLocationAttributes(locationName, locationDescription),
+				),
+			},
+			{
+				// Move the state back so the location is deleted during destroy
+				Config: testAccMovedLocationResource(resourceName, locationName, locationDescription, "pingdirectory_default_location", "pingdirectory_location"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("pingdirectory_location.%s", resourceName), "description", locationDescription),
+					testAccCheckExpectedLocationAttributes(locationName, locationDescription),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLocationsInvalidFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// The filter syntax is checked before any request is sent
+				Config:      testAccLocationsFilter(`id eq Hoenn`),
+				ExpectError: regexp.MustCompile("String values must be enclosed in double quotes"),
+			},
+			{
+				Config:      testAccLocationsFilter(`(id eq \"Hoenn\"`),
+				ExpectError: regexp.MustCompile("Invalid SCIM filter"),
+			},
+		},
+	})
+}
+
 func testAccLocationResource(resourceName, locationName, description string) string {
 	return fmt.Sprintf(`
 resource "pingdirectory_location" "%[1]s" {
@@ -103,9 +174,34 @@ data "pingdirectory_locations" "list" {
   depends_on = [
     pingdirectory_location.%[1]s
   ]
+}
+
+data "pingdirectory_locations" "full" {
+  include_attributes = true
+  depends_on = [
+    pingdirectory_location.%[1]s
+  ]
+}
+
+data "pingdirectory_locations" "filtered" {
+  type       = "location"
+  name_regex = "^%[2]s$"
+  attribute_equals = {
+    description = "%[3]s"
+  }
+  depends_on = [
+    pingdirectory_location.%[1]s
+  ]
 }`, resourceName, locationName, description)
 }
 
+func testAccLocationsFilter(filter string) string {
+	return fmt.Sprintf(`
+data "pingdirectory_locations" "list" {
+  filter = "%s"
+}`, filter)
+}
+
 func testAccLocationResourceNoDescription(resourceName, locationName string) string {
 	return fmt.Sprintf(`
 resource "pingdirectory_location" "%[1]s" {
@@ -113,6 +209,27 @@ resource "pingdirectory_location" "%[1]s" {
 }`, resourceName, locationName)
 }
 
+func testAccLocationResourceNoData(resourceName, locationName, description string) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_location" "%[1]s" {
+  name        = "%[2]s"
+  description = "%[3]s"
+}`, resourceName, locationName, description)
+}
+
+func testAccMovedLocationResource(resourceName, locationName, description, fromType, toType string) string {
+	return fmt.Sprintf(`
+moved {
+  from = %[4]s.%[1]s
+  to   = %[5]s.%[1]s
+}
+
+resource "%[5]s" "%[1]s" {
+  name        = "%[2]s"
+  description = "%[3]s"
+}`, resourceName, locationName, description, fromType, toType)
+}
+
 // Test that any locations created by the test are destroyed
 func testAccCheckLocationDestroy(s *terraform.State) error {
 	testClient := acctest.TestClient()
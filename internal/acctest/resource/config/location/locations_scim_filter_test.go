@@ -0,0 +1,112 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package location_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+// Valid SCIM filters are sent to the server unchanged, and invalid filters are rejected before any request is sent.
+// Uses a local server returning an empty list of Locations, so no PingDirectory server is needed.
+func TestAccLocationsScimFilter(t *testing.T) {
+	var receivedFilter atomic.Value
+	receivedFilter.Store("")
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedFilter.Store(r.URL.Query().Get("filter"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"schemas":["urn:ietf:params:scim:api:messages:2.0:ListResponse"],"totalResults":0,"Resources":[]}`)
+	}))
+	defer server.Close()
+
+	validFilters := []struct {
+		name   string
+		filter string
+	}{
+		{"comparison", `name eq "Austin"`},
+		{"present", `description pr`},
+		{"and binds more tightly than or", `name eq "a" or name eq "b" and not (description pr)`},
+		{"grouping", `(name sw "A" or name ew "B") and description co "x"`},
+		{"case-insensitive keywords", `NAME Eq "a" AND Description PR`},
+		{"quoted string with escapes", `name eq "say \"hi\" \\ \n é"`},
+		{"value path", `emails[type eq "work" and value co "@example.com"]`},
+		{"sub-attribute", `meta.lastModified gt "2011-05-13T04:42:34Z"`},
+		{"schema URN prefix", `urn:pingidentity:schemas:configuration:2.0:location:name eq "a"`},
+		{"number", `count ge 10`},
+		{"boolean", `enabled eq true`},
+		{"null", `description eq null`},
+	}
+	invalidFilters := []struct {
+		name        string
+		filter      string
+		expectError string
+	}{
+		{"empty", ``, `position 1: unexpected end of filter`},
+		{"missing value", `name eq`, `position 8: expected a value`},
+		{"unquoted string", `name eq Austin`, `position 9: expected a value, found "Austin"`},
+		{"unterminated string", `name eq "Austin`, `position 9: unterminated string`},
+		{"invalid escape", `name eq "bad \q"`, `position 9: invalid string`},
+		{"unclosed group", `(name pr`, `position 9: expected ")"`},
+		{"not without group", `not name pr`, `position 5: expected "(" after "not"`},
+		{"trailing and", `name pr and`, `position 12: unexpected end of filter`},
+		{"unknown operator", `name xx "a"`, `position 6: expected an operator after "name"`},
+		{"nested value path", `emails[value[type pr]]`, `position 14: value paths can't be nested`},
+		{"unbalanced parenthesis", `name pr)`, `position 8: unexpected ")"`},
+		{"leading zero", `name eq 01`, `position 10: unexpected "1"`},
+	}
+
+	steps := []resource.TestStep{}
+	for _, valid := range validFilters {
+		steps = append(steps, resource.TestStep{
+			// Test that a valid filter is sent to the server unchanged
+			Config: testAccLocationsScimFilterDataSource(server.URL, valid.filter),
+			Check: func(s *terraform.State) error {
+				if received := receivedFilter.Load().(string); received != valid.filter {
+					return fmt.Errorf("%s: expected the server to receive filter %q, found %q", valid.name, valid.filter, received)
+				}
+				return nil
+			},
+		})
+	}
+	for _, invalid := range invalidFilters {
+		steps = append(steps, resource.TestStep{
+			// Test that an invalid filter is rejected with the position of the error
+			Config:      testAccLocationsScimFilterDataSource(server.URL, invalid.filter),
+			ExpectError: regexp.MustCompile(`Invalid SCIM filter[\s\S]*` + strings.ReplaceAll(regexp.QuoteMeta(invalid.expectError), " ", `\s+`)),
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: steps,
+	})
+}
+
+func testAccLocationsScimFilterDataSource(httpsHost, filter string) string {
+	return fmt.Sprintf(`
+provider "pingdirectory" {
+  https_host             = "%[1]s"
+  username               = "cn=administrator"
+  password               = "2FederateM0re"
+  insecure_trust_all_tls = true
+  product_version        = "10.3.0.0"
+}
+
+data "pingdirectory_locations" "filtered" {
+  filter = %[2]s
+}`, httpsHost, strconv.Quote(filter))
+}
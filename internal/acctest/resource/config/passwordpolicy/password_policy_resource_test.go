@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
@@ -35,7 +36,13 @@ func TestAccPasswordPolicy(t *testing.T) {
 	updatedResourceModel := passwordPolicyTestModel{
 		id:                           testIdPasswordPolicy,
 		passwordAttribute:            "userPassword",
-		defaultPasswordStorageScheme: []string{"Salted SHA-512"},
+		defaultPasswordStorageScheme: []string{"Salted SHA-512", "Blowfish"},
+		allowPreEncodedPasswords:     "true",
+	}
+	reorderedResourceModel := passwordPolicyTestModel{
+		id:                           testIdPasswordPolicy,
+		passwordAttribute:            "userPassword",
+		defaultPasswordStorageScheme: []string{"Blowfish", "Salted SHA-512"},
 		allowPreEncodedPasswords:     "true",
 	}
 
@@ -53,7 +60,7 @@ func TestAccPasswordPolicy(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckExpectedPasswordPolicyAttributes(initialResourceModel),
 					resource.TestCheckResourceAttr(fmt.Sprintf("data.pingdirectory_password_policy.%s", resourceName), "password_attribute", initialResourceModel.passwordAttribute),
-					resource.TestCheckTypeSetElemAttr(fmt.Sprintf("data.pingdirectory_password_policy.%s", resourceName), "default_password_storage_scheme.*", initialResourceModel.defaultPasswordStorageScheme[0]),
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.pingdirectory_password_policy.%s", resourceName), "default_password_storage_scheme.0", initialResourceModel.defaultPasswordStorageScheme[0]),
 					resource.TestCheckResourceAttrSet("data.pingdirectory_password_policies.list", "ids.0"),
 				),
 			},
@@ -62,6 +69,43 @@ func TestAccPasswordPolicy(t *testing.T) {
 				Config: testAccPasswordPolicyResource(resourceName, updatedResourceModel),
 				Check:  testAccCheckExpectedPasswordPolicyAttributes(updatedResourceModel),
 			},
+			{
+				// Test that reordering the storage schemes is planned as an update and changes the order on the server
+				Config: testAccPasswordPolicyResource(resourceName, reorderedResourceModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pingdirectory_password_policy."+resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExpectedPasswordPolicyAttributes(reorderedResourceModel),
+					resource.TestCheckResourceAttr("pingdirectory_password_policy."+resourceName, "default_password_storage_scheme.0", reorderedResourceModel.defaultPasswordStorageScheme[0]),
+					resource.TestCheckResourceAttr("pingdirectory_password_policy."+resourceName, "default_password_storage_scheme.1", reorderedResourceModel.defaultPasswordStorageScheme[1]),
+				),
+			},
+			{
+				// Test reverting the order
+				Config: testAccPasswordPolicyResource(resourceName, updatedResourceModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pingdirectory_password_policy."+resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExpectedPasswordPolicyAttributes(updatedResourceModel),
+					resource.TestCheckResourceAttr("pingdirectory_password_policy."+resourceName, "default_password_storage_scheme.0", updatedResourceModel.defaultPasswordStorageScheme[0]),
+					resource.TestCheckResourceAttr("pingdirectory_password_policy."+resourceName, "default_password_storage_scheme.1", updatedResourceModel.defaultPasswordStorageScheme[1]),
+				),
+			},
+			{
+				// Test that the order read back from the server matches the configured order, so there is no diff
+				Config: testAccPasswordPolicyResource(resourceName, updatedResourceModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
 			{
 				// Test importing the resource
 				Config:            testAccPasswordPolicyResource(resourceName, updatedResourceModel),
@@ -130,7 +174,7 @@ func testAccCheckExpectedPasswordPolicyAttributes(config passwordPolicyTestModel
 		if err != nil {
 			return err
 		}
-		err = acctest.TestAttributesMatchStringSlice(resourceType, &config.id, "default-password-storage-scheme",
+		err = acctest.TestAttributesMatchStringList(resourceType, &config.id, "default-password-storage-scheme",
 			config.defaultPasswordStorageScheme, response.DefaultPasswordStorageScheme)
 		if err != nil {
 			return err
@@ -11,11 +11,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
 )
 
 const testIdPasswordValidator = "MyId"
+const defaultPasswordPolicy = "Default Password Policy"
 
 // Attributes to test with. Add optional properties to test here if desired.
 type passwordValidatorTestModel struct {
@@ -89,6 +91,46 @@ func TestAccPasswordValidator(t *testing.T) {
 	})
 }
 
+func TestAccPasswordValidatorDetachOnDestroy(t *testing.T) {
+	resourceName := "detached"
+	resourceModel := passwordValidatorTestModel{
+		id:                testIdPasswordValidator,
+		minPasswordLength: 8,
+		maxPasswordLength: 100,
+		enabled:           true,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: testAccCheckPasswordValidatorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetachedPasswordValidatorResource(resourceName, resourceModel),
+			},
+			{
+				// Reference the validator from a password policy that Terraform doesn't manage, then remove
+				// the validator from the config. The reference should be removed before the delete.
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					op := client.NewOperation(client.ENUMOPERATION_ADD, "password-validator")
+					op.SetValue(resourceModel.id)
+					_, _, err := testClient.PasswordPolicyAPI.UpdatePasswordPolicy(ctx, defaultPasswordPolicy).
+						UpdateRequest(*client.NewUpdateRequest([]client.Operation{*op})).Execute()
+					if err != nil {
+						t.Fatalf("Failed to add password validator reference: %v", err)
+					}
+				},
+				Config: testAccNoPasswordValidatorResource(),
+				Check:  testAccCheckPasswordValidatorNotReferenced(resourceModel.id),
+			},
+		},
+	})
+}
+
 func testAccPasswordValidatorResource(resourceName string, resourceModel passwordValidatorTestModel) string {
 	return fmt.Sprintf(`
 resource "pingdirectory_password_validator" "%[1]s" {
@@ -117,6 +159,46 @@ data "pingdirectory_password_validators" "list" {
 		resourceModel.enabled)
 }
 
+func testAccDetachedPasswordValidatorResource(resourceName string, resourceModel passwordValidatorTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_password_validator" "%[1]s" {
+  type                = "length-based"
+  name                = "%[2]s"
+  min_password_length = %[3]d
+  max_password_length = %[4]d
+  enabled             = %[5]t
+  detach_on_destroy   = true
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.minPasswordLength,
+		resourceModel.maxPasswordLength,
+		resourceModel.enabled)
+}
+
+func testAccNoPasswordValidatorResource() string {
+	return `
+data "pingdirectory_password_validators" "list" {
+}`
+}
+
+// Test that the default password policy no longer references the password validator
+func testAccCheckPasswordValidatorNotReferenced(id string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.PasswordPolicyAPI.GetPasswordPolicy(ctx, defaultPasswordPolicy).Execute()
+		if err != nil {
+			return err
+		}
+		for _, validator := range response.PasswordValidator {
+			if validator == id {
+				return fmt.Errorf("Password Policy %s still references Password Validator %s", defaultPasswordPolicy, id)
+			}
+		}
+		return nil
+	}
+}
+
 // Test that the expected attributes are set on the PingDirectory server
 func testAccCheckExpectedPasswordValidatorAttributes(config passwordValidatorTestModel) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
@@ -108,6 +109,63 @@ func TestAccRootDnUser(t *testing.T) {
 	})
 }
 
+func TestAccRootDnUserWriteOnlyPassword(t *testing.T) {
+	resourceName := "writeonly"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		// Write-only attributes require Terraform 1.11+
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckRootDnUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRootDnUserWriteOnlyPasswordResource(resourceName, "2FederateM0re!", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(fmt.Sprintf("pingdirectory_root_dn_user.%s", resourceName), "password_wo"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("pingdirectory_root_dn_user.%s", resourceName), "password_wo_version", "1"),
+				),
+			},
+			{
+				// Changing the version sends the new password
+				Config: testAccRootDnUserWriteOnlyPasswordResource(resourceName, "2FederateM0re!!", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(fmt.Sprintf("pingdirectory_root_dn_user.%s", resourceName), "password_wo"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("pingdirectory_root_dn_user.%s", resourceName), "password_wo_version", "2"),
+				),
+			},
+			{
+				// Changing the password outside of Terraform should cause the configured password to be applied again
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					op := client.NewOperation(client.ENUMOPERATION_REPLACE, "password")
+					op.SetValue("ChangedOutOfBand1!")
+					_, _, err := testClient.RootDnUserAPI.UpdateRootDnUser(ctx, testIdRootDnUser).
+						UpdateRequest(*client.NewUpdateRequest([]client.Operation{*op})).Execute()
+					if err != nil {
+						t.Fatalf("Failed to update password: %v", err)
+					}
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRootDnUserWriteOnlyPasswordResource(resourceName, password string, version int64) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_root_dn_user" "%[1]s" {
+  name                = "%[2]s"
+  password_wo         = "%[3]s"
+  password_wo_version = %[4]d
+}`, resourceName, testIdRootDnUser, password, version)
+}
+
 func testAccRootDnUserResource(resourceName string, resourceModel rootDnUserTestModel) string {
 	return fmt.Sprintf(`
 resource "pingdirectory_root_dn_user" "%[1]s" {
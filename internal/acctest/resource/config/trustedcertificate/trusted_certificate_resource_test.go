@@ -79,7 +79,14 @@ O153rbh1O3sXFjeKFSvpi6BM4OBaTDwtlZL+ZtDjvLX5xY278udB140n+XYdJaW7
 				// Test basic resource.
 				// Add checks for computed properties here if desired.
 				Config: testAccTrustedCertificateResource(resourceName, initialResourceModel),
-				Check:  resource.TestCheckResourceAttrSet("data.pingdirectory_trusted_certificates.list", "ids.0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pingdirectory_trusted_certificates.list", "ids.0"),
+					resource.TestCheckResourceAttr("pingdirectory_trusted_certificate."+resourceName, "certificate_details.key_algorithm", "RSA"),
+					resource.TestCheckResourceAttr("pingdirectory_trusted_certificate."+resourceName, "certificate_details.key_size", "4096"),
+					resource.TestCheckResourceAttr("pingdirectory_trusted_certificate."+resourceName, "certificate_details.not_after", "2024-05-25T19:20:22Z"),
+					resource.TestCheckResourceAttrPair("pingdirectory_trusted_certificate."+resourceName, "certificate_details.sha256_fingerprint",
+						"data.pingdirectory_trusted_certificate."+resourceName, "certificate_details.sha256_fingerprint"),
+				),
 			},
 			{
 				// Test importing the resource
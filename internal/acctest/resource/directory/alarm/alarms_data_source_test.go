@@ -0,0 +1,48 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package alarm_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+func TestAccAlarmsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmsDataSource(`["critical"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pingdirectory_alarms.alarms", "alarms.#"),
+					resource.TestMatchResourceAttr("data.pingdirectory_alarms.alarms", "highest_severity", regexp.MustCompile("^(normal|critical)$")),
+					// The server generates an alert when it starts
+					resource.TestCheckTypeSetElemNestedAttrs("data.pingdirectory_alarms.alarms", "alerts.*", map[string]string{
+						"type": "server-started",
+					}),
+				),
+			},
+			{
+				Config:      testAccAlarmsDataSource(`["urgent"]`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+		},
+	})
+}
+
+func testAccAlarmsDataSource(severities string) string {
+	return `
+data "pingdirectory_alarms" "alarms" {
+  severities = ` + severities + `
+  max_alerts = 1000
+}`
+}
@@ -0,0 +1,205 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package groupmembership_test
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/directory"
+)
+
+const testDnGroup = "cn=tf-group-membership,dc=example,dc=com"
+const testDnRequiredMemberGroup = "cn=tf-group-membership-required,dc=example,dc=com"
+
+// Attributes to test with
+type groupMembershipTestModel struct {
+	members       []string
+	authoritative bool
+}
+
+// DN of a test member
+func testMemberDn(uid string) string {
+	return "uid=" + uid + ",dc=example,dc=com"
+}
+
+func TestAccGroupMembership(t *testing.T) {
+	resourceName := "pingdirectory_group_membership.membership"
+	initialResourceModel := groupMembershipTestModel{
+		members: []string{testMemberDn("user.a"), testMemberDn("user.b")},
+	}
+	updatedResourceModel := groupMembershipTestModel{
+		// DNs are compared case-insensitively
+		members: []string{testMemberDn("owner"), "UID=user.b, DC=example, DC=com", testMemberDn("user.c")},
+	}
+	releasedResourceModel := groupMembershipTestModel{
+		members: []string{"UID=user.b, DC=example, DC=com", testMemberDn("user.c")},
+	}
+	authoritativeResourceModel := groupMembershipTestModel{
+		members:       []string{testMemberDn("user.c")},
+		authoritative: true,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: testAccCheckGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The member added with the group entry is kept in non-authoritative mode
+				Config: testAccGroupMembershipResource(initialResourceModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "members.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "member_attribute", "member"),
+					testAccCheckGroupMembers(testDnGroup, testMemberDn("owner"), testMemberDn("user.a"), testMemberDn("user.b")),
+				),
+			},
+			{
+				Config: testAccGroupMembershipResource(updatedResourceModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr(resourceName, "members.*", "UID=user.b, DC=example, DC=com"),
+					testAccCheckGroupMembers(testDnGroup, testMemberDn("owner"), testMemberDn("user.b"), testMemberDn("user.c")),
+				),
+			},
+			{
+				// The group already had the owner before it was managed, so it isn't removed in non-authoritative mode
+				Config: testAccGroupMembershipResource(releasedResourceModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "members.#", "2"),
+					testAccCheckGroupMembers(testDnGroup, testMemberDn("owner"), testMemberDn("user.b"), testMemberDn("user.c")),
+				),
+			},
+			{
+				// All other members are removed in authoritative mode
+				Config: testAccGroupMembershipResource(authoritativeResourceModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "members.#", "1"),
+					testAccCheckGroupMembers(testDnGroup, testMemberDn("user.c")),
+				),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccGroupMembershipResource(authoritativeResourceModel),
+				ResourceName:      resourceName,
+				ImportStateId:     testDnGroup,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGroupMembershipRequiredMember(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRequiredMemberGroupResource(true),
+				Check:  testAccCheckGroupMembers(testDnRequiredMemberGroup, testMemberDn("user.a")),
+			},
+			{
+				// groupOfNames requires a member, so the last member is kept when the membership is destroyed
+				Config: testAccRequiredMemberGroupResource(false),
+				Check:  testAccCheckGroupMembers(testDnRequiredMemberGroup, testMemberDn("user.a")),
+			},
+		},
+	})
+}
+
+func testAccRequiredMemberGroupResource(withMembership bool) string {
+	membership := ""
+	if withMembership {
+		membership = fmt.Sprintf(`
+
+resource "pingdirectory_group_membership" "membership" {
+  group_dn      = pingdirectory_ldap_entry.group.dn
+  members       = ["%[1]s"]
+  authoritative = true
+}`, testMemberDn("user.a"))
+	}
+	return fmt.Sprintf(`
+resource "pingdirectory_ldap_entry" "group" {
+  dn             = "%[1]s"
+  object_classes = ["top", "groupOfNames"]
+  attributes = {
+    cn = "tf-group-membership-required"
+  }
+  attribute_values = {
+    member = ["%[2]s"]
+  }
+  # Members are managed by pingdirectory_group_membership
+  ignore_server_changes = ["member"]
+}%[3]s`, testDnRequiredMemberGroup,
+		testMemberDn("owner"),
+		membership)
+}
+
+func testAccGroupMembershipResource(resourceModel groupMembershipTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_ldap_entry" "group" {
+  dn             = "%[1]s"
+  object_classes = ["top", "groupOfEntries"]
+  attributes = {
+    cn = "tf-group-membership"
+  }
+  attribute_values = {
+    member = ["%[2]s"]
+  }
+  # Members are managed by pingdirectory_group_membership
+  ignore_server_changes = ["member"]
+}
+
+resource "pingdirectory_group_membership" "membership" {
+  group_dn      = pingdirectory_ldap_entry.group.dn
+  members       = %[3]s
+  authoritative = %[4]t
+}`, testDnGroup,
+		testMemberDn("owner"),
+		acctest.StringSliceToTerraformString(resourceModel.members),
+		resourceModel.authoritative)
+}
+
+// Check the members of a test group on the server
+func testAccCheckGroupMembers(groupDn string, expected ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		entry, _, err := directory.GetEntry(acctest.TestBasicAuthContext(), acctest.TestProviderConfiguration(), acctest.TestClient(), groupDn, []string{"member"})
+		if err != nil {
+			return err
+		}
+		var found []string
+		for _, value := range entry.Values("member") {
+			found = append(found, strings.ToLower(strings.ReplaceAll(value, " ", "")))
+		}
+		sort.Strings(found)
+		sort.Strings(expected)
+		if strings.Join(found, ";") != strings.Join(expected, ";") {
+			return fmt.Errorf("expected group members %v, found %v", expected, found)
+		}
+		return nil
+	}
+}
+
+// Test that the group created by the test is destroyed
+func testAccCheckGroupMembershipDestroy(s *terraform.State) error {
+	_, httpResp, err := directory.GetEntry(acctest.TestBasicAuthContext(), acctest.TestProviderConfiguration(), acctest.TestClient(), testDnGroup, nil)
+	if err == nil {
+		return acctest.ExpectedDestroyError("Group", testDnGroup)
+	}
+	if httpResp == nil || httpResp.StatusCode != 404 {
+		return err
+	}
+	return nil
+}
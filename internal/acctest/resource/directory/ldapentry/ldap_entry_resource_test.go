@@ -0,0 +1,194 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package ldapentry_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/directory"
+)
+
+const testDnLdapEntry = "ou=tf-ldap-entry,dc=example,dc=com"
+const testDnSensitiveLdapEntry = "uid=tf-ldap-entry-sensitive,dc=example,dc=com"
+
+// Attributes to test with
+type ldapEntryTestModel struct {
+	description      string
+	telephoneNumber  string
+	localities       []string
+	managedAttribute string
+}
+
+func TestAccLdapEntry(t *testing.T) {
+	resourceName := "pingdirectory_ldap_entry.entry"
+	initialResourceModel := ldapEntryTestModel{
+		description: "Created by Terraform",
+		localities:  []string{"Austin", "Denver"},
+	}
+	updatedResourceModel := ldapEntryTestModel{
+		description:      "Updated by Terraform",
+		telephoneNumber:  "+1 512 555 0100",
+		managedAttribute: "l",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: testAccCheckLdapEntryDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test basic resource
+				Config: testAccLdapEntryResource(initialResourceModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", testDnLdapEntry),
+					resource.TestCheckResourceAttr(resourceName, "attributes.description", initialResourceModel.description),
+					resource.TestCheckTypeSetElemAttr(resourceName, "attribute_values.l.*", "Austin"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "attribute_values.l.*", "Denver"),
+				),
+			},
+			{
+				// Test updating some fields. The localities are removed, since l is still managed.
+				Config: testAccLdapEntryResource(updatedResourceModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "attributes.description", updatedResourceModel.description),
+					resource.TestCheckResourceAttr(resourceName, "attributes.telephoneNumber", updatedResourceModel.telephoneNumber),
+					resource.TestCheckNoResourceAttr(resourceName, "attribute_values.l.#"),
+				),
+			},
+			{
+				// Test importing the resource. All user attributes of the entry are read on import.
+				Config:            testAccLdapEntryResource(updatedResourceModel),
+				ResourceName:      resourceName,
+				ImportStateId:     testDnLdapEntry,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"managed_attributes",
+				},
+			},
+		},
+	})
+}
+
+func TestAccLdapEntrySensitiveAttributes(t *testing.T) {
+	resourceName := "pingdirectory_ldap_entry.sensitive"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckSensitiveLdapEntryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSensitiveLdapEntryResource("2FederateM0re!", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "sensitive_attributes_wo_version", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "sensitive_attributes_wo"),
+					resource.TestCheckNoResourceAttr(resourceName, "attributes.userPassword"),
+					testAccCheckLdapEntryHasAttribute(testDnSensitiveLdapEntry, "userPassword"),
+				),
+			},
+			{
+				// Write a new password by changing the version
+				Config: testAccSensitiveLdapEntryResource("Upd4ted-Passw0rd!", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "sensitive_attributes_wo_version", "2"),
+					resource.TestCheckNoResourceAttr(resourceName, "sensitive_attributes_wo"),
+					testAccCheckLdapEntryHasAttribute(testDnSensitiveLdapEntry, "userPassword"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSensitiveLdapEntryResource(password string, version int) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_ldap_entry" "sensitive" {
+  dn             = "%[1]s"
+  object_classes = ["top", "person", "organizationalPerson", "inetOrgPerson"]
+  attributes = {
+    uid = "tf-ldap-entry-sensitive"
+    cn  = "Sensitive Entry"
+    sn  = "Sensitive"
+  }
+  sensitive_attributes_wo = {
+    userPassword = "%[2]s"
+  }
+  sensitive_attributes_wo_version = %[3]d
+}`, testDnSensitiveLdapEntry,
+		password,
+		version)
+}
+
+// Test that an entry on the server has a value for an attribute
+func testAccCheckLdapEntryHasAttribute(dn, attributeName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		entry, _, err := directory.GetEntry(acctest.TestBasicAuthContext(), acctest.TestProviderConfiguration(), acctest.TestClient(), dn, []string{attributeName})
+		if err != nil {
+			return err
+		}
+		if len(entry.Values(attributeName)) == 0 {
+			return fmt.Errorf("expected entry %s to have a value for %s", dn, attributeName)
+		}
+		return nil
+	}
+}
+
+func testAccLdapEntryResource(resourceModel ldapEntryTestModel) string {
+	attributes := fmt.Sprintf("    ou          = \"tf-ldap-entry\"\n    description = \"%s\"\n", resourceModel.description)
+	if resourceModel.telephoneNumber != "" {
+		attributes += fmt.Sprintf("    telephoneNumber = \"%s\"\n", resourceModel.telephoneNumber)
+	}
+	attributeValues := ""
+	if len(resourceModel.localities) > 0 {
+		attributeValues = fmt.Sprintf("  attribute_values = {\n    l = %s\n  }\n", acctest.StringSliceToTerraformString(resourceModel.localities))
+	}
+	managedAttributes := ""
+	if resourceModel.managedAttribute != "" {
+		managedAttributes = fmt.Sprintf("  managed_attributes = [\"%s\"]\n", resourceModel.managedAttribute)
+	}
+	return fmt.Sprintf(`
+resource "pingdirectory_ldap_entry" "entry" {
+  dn             = "%[1]s"
+  object_classes = ["top", "organizationalUnit"]
+  attributes = {
+%[2]s  }
+%[3]s%[4]s}`, testDnLdapEntry,
+		attributes,
+		attributeValues,
+		managedAttributes)
+}
+
+// Test that any entries created by the test are destroyed
+func testAccCheckLdapEntryDestroy(s *terraform.State) error {
+	return testAccCheckEntryDestroyed(testDnLdapEntry)
+}
+
+// Test that any entries created by the sensitive attributes test are destroyed
+func testAccCheckSensitiveLdapEntryDestroy(s *terraform.State) error {
+	return testAccCheckEntryDestroyed(testDnSensitiveLdapEntry)
+}
+
+func testAccCheckEntryDestroyed(dn string) error {
+	_, httpResp, err := directory.GetEntry(acctest.TestBasicAuthContext(), acctest.TestProviderConfiguration(), acctest.TestClient(), dn, nil)
+	if err == nil {
+		return acctest.ExpectedDestroyError("LDAP Entry", dn)
+	}
+	if httpResp == nil || httpResp.StatusCode != 404 {
+		return err
+	}
+	return nil
+}
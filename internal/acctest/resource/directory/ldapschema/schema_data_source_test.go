@@ -0,0 +1,63 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package ldapschema_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+func TestAccSchemaDataSources(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaDataSources("uid"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pingdirectory_schema_attribute_type.uid", "oid", "0.9.2342.19200300.100.1.1"),
+					resource.TestCheckResourceAttr("data.pingdirectory_schema_attribute_type.uid", "syntax", "1.3.6.1.4.1.1466.115.121.1.15"),
+					resource.TestCheckResourceAttr("data.pingdirectory_schema_attribute_type.uid", "equality_matching_rule", "caseIgnoreMatch"),
+					resource.TestCheckResourceAttr("data.pingdirectory_schema_attribute_type.uid", "single_value", "false"),
+					// The syntax and matching rules of cn are inherited from name
+					resource.TestCheckResourceAttr("data.pingdirectory_schema_attribute_type.cn", "superior_type", "name"),
+					resource.TestCheckResourceAttr("data.pingdirectory_schema_attribute_type.cn", "syntax", "1.3.6.1.4.1.1466.115.121.1.15"),
+					resource.TestCheckResourceAttr("data.pingdirectory_schema_object_class.inetOrgPerson", "oid", "2.16.840.1.113730.3.2.2"),
+					resource.TestCheckResourceAttr("data.pingdirectory_schema_object_class.inetOrgPerson", "kind", "STRUCTURAL"),
+					resource.TestCheckResourceAttr("data.pingdirectory_schema_object_class.inetOrgPerson", "superior_classes.0", "organizationalPerson"),
+					// Attributes inherited from person and top
+					resource.TestCheckTypeSetElemAttr("data.pingdirectory_schema_object_class.inetOrgPerson", "required_attributes.*", "sn"),
+					resource.TestCheckTypeSetElemAttr("data.pingdirectory_schema_object_class.inetOrgPerson", "required_attributes.*", "objectClass"),
+					resource.TestCheckTypeSetElemAttr("data.pingdirectory_schema_object_class.inetOrgPerson", "optional_attributes.*", "uid"),
+				),
+			},
+			{
+				Config:      testAccSchemaDataSources("attributeThatDoesNotExist"),
+				ExpectError: regexp.MustCompile("Schema Attribute Type not found"),
+			},
+		},
+	})
+}
+
+func testAccSchemaDataSources(attributeName string) string {
+	return `
+data "pingdirectory_schema_attribute_type" "uid" {
+  name = "` + attributeName + `"
+}
+
+data "pingdirectory_schema_attribute_type" "cn" {
+  name = "commonName"
+}
+
+data "pingdirectory_schema_object_class" "inetOrgPerson" {
+  name = "inetorgperson"
+}`
+}
@@ -0,0 +1,153 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package ldapschema_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/directory"
+)
+
+// OIDs below the example enterprise number reserved for documentation in RFC 5612
+const (
+	testOidAttributeType = "1.3.6.1.4.1.32473.1.1.1"
+	testOidObjectClass   = "1.3.6.1.4.1.32473.1.2.1"
+)
+
+// Definitions to test with
+type schemaResourceTestModel struct {
+	attributeType string
+	objectClass   string
+}
+
+func TestAccSchemaResources(t *testing.T) {
+	initialResourceModel := schemaResourceTestModel{
+		attributeType: "( " + testOidAttributeType + " NAME 'tfTestEmployeeCode' DESC 'Terraform test attribute' EQUALITY caseIgnoreMatch SYNTAX 1.3.6.1.4.1.1466.115.121.1.15 SINGLE-VALUE )",
+		objectClass:   "( " + testOidObjectClass + " NAME 'tfTestEmployee' SUP top AUXILIARY MAY tfTestEmployeeCode )",
+	}
+	updatedResourceModel := schemaResourceTestModel{
+		attributeType: "( " + testOidAttributeType + " NAME 'tfTestEmployeeCode' DESC 'Updated Terraform test attribute' EQUALITY caseIgnoreMatch SYNTAX 1.3.6.1.4.1.1466.115.121.1.15 SINGLE-VALUE )",
+		objectClass:   "( " + testOidObjectClass + " NAME 'tfTestEmployee' SUP top AUXILIARY MAY ( tfTestEmployeeCode $ description ) )",
+	}
+	// The same definitions with different whitespace and keyword and list order
+	reformattedResourceModel := schemaResourceTestModel{
+		attributeType: "(  " + testOidAttributeType + "\n  NAME 'tfTestEmployeeCode'\n  SYNTAX 1.3.6.1.4.1.1466.115.121.1.15\n  EQUALITY caseIgnoreMatch\n  DESC 'Updated Terraform test attribute'\n  SINGLE-VALUE\n)",
+		objectClass:   "( " + testOidObjectClass + " NAME 'tfTestEmployee' AUXILIARY SUP top MAY ( description $ tfTestEmployeeCode ) )",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: testAccCheckSchemaResourcesDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test basic resources
+				Config: testAccSchemaResources(initialResourceModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdirectory_schema_attribute_type.test", "oid", testOidAttributeType),
+					resource.TestCheckResourceAttr("pingdirectory_schema_attribute_type.test", "names.0", "tfTestEmployeeCode"),
+					resource.TestCheckResourceAttr("pingdirectory_schema_object_class.test", "id", testOidObjectClass),
+					resource.TestCheckResourceAttr("data.pingdirectory_schema_object_class.test", "kind", "AUXILIARY"),
+				),
+			},
+			{
+				// Test updating the definitions
+				Config: testAccSchemaResources(updatedResourceModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pingdirectory_schema_attribute_type.test", "description", "Updated Terraform test attribute"),
+					resource.TestCheckTypeSetElemAttr("data.pingdirectory_schema_object_class.test", "optional_attributes.*", "description"),
+				),
+			},
+			{
+				// Reformatting the definitions doesn't change anything
+				Config:   testAccSchemaResources(reformattedResourceModel),
+				PlanOnly: true,
+			},
+			{
+				// Test importing the resources
+				Config:            testAccSchemaResources(updatedResourceModel),
+				ResourceName:      "pingdirectory_schema_attribute_type.test",
+				ImportStateId:     "tfTestEmployeeCode",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The definition is read from the server as formatted by the server
+				ImportStateVerifyIgnore: []string{
+					"definition",
+				},
+			},
+			{
+				Config:            testAccSchemaResources(updatedResourceModel),
+				ResourceName:      "pingdirectory_schema_object_class.test",
+				ImportStateId:     testOidObjectClass,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"definition",
+				},
+			},
+			{
+				// Definitions are validated before they are sent to the server
+				Config:      testAccSchemaResources(schemaResourceTestModel{attributeType: "( " + testOidAttributeType + " NAME 'tfTestEmployeeCode' DESC 'No syntax' )", objectClass: updatedResourceModel.objectClass}),
+				ExpectError: regexp.MustCompile("an attribute type must have SUP or SYNTAX"),
+			},
+			{
+				Config:      testAccSchemaResources(schemaResourceTestModel{attributeType: updatedResourceModel.attributeType, objectClass: "( " + testOidObjectClass + " NAME tfTestEmployee SUP top AUXILIARY )"}),
+				ExpectError: regexp.MustCompile("NAME must be a quoted name"),
+			},
+		},
+	})
+}
+
+func testAccSchemaResources(resourceModel schemaResourceTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_schema_attribute_type" "test" {
+  definition = <<EOT
+%[1]s
+EOT
+}
+
+resource "pingdirectory_schema_object_class" "test" {
+  definition = <<EOT
+%[2]s
+EOT
+  depends_on = [pingdirectory_schema_attribute_type.test]
+}
+
+data "pingdirectory_schema_attribute_type" "test" {
+  name       = "tfTestEmployeeCode"
+  depends_on = [pingdirectory_schema_attribute_type.test]
+}
+
+data "pingdirectory_schema_object_class" "test" {
+  name       = "tfTestEmployee"
+  depends_on = [pingdirectory_schema_object_class.test]
+}`, resourceModel.attributeType,
+		resourceModel.objectClass)
+}
+
+// Test that the schema elements created by the test are removed
+func testAccCheckSchemaResourcesDestroy(s *terraform.State) error {
+	entry, _, err := directory.GetEntry(acctest.TestBasicAuthContext(), acctest.TestProviderConfiguration(), acctest.TestClient(), "cn=schema", []string{"attributeTypes", "objectClasses"})
+	if err != nil {
+		return err
+	}
+	for _, attributeName := range []string{"attributeTypes", "objectClasses"} {
+		for _, definition := range entry.Values(attributeName) {
+			if strings.Contains(definition, "( "+testOidAttributeType+" ") || strings.Contains(definition, "( "+testOidObjectClass+" ") {
+				return acctest.ExpectedDestroyError("Schema Definition", definition)
+			}
+		}
+	}
+	return nil
+}
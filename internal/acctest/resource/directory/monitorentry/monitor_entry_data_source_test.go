@@ -0,0 +1,61 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package monitorentry_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+func TestAccMonitorEntryDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorEntryDataSource(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pingdirectory_monitor_entry.by_name", "dn", "cn=userRoot Backend,cn=monitor"),
+					resource.TestCheckResourceAttr("data.pingdirectory_monitor_entry.by_name", "attributes.ds-backend-id", "userRoot"),
+					resource.TestCheckTypeSetElemAttr("data.pingdirectory_monitor_entry.by_name", "object_classes.*", "ds-backend-monitor-entry"),
+					resource.TestCheckResourceAttr("data.pingdirectory_monitor_entry.by_dn", "name", "General Monitor Entry"),
+					resource.TestCheckResourceAttrSet("data.pingdirectory_monitor_entry.by_dn", "attributes.startTime"),
+					resource.TestCheckTypeSetElemAttr("data.pingdirectory_monitor_entries.backends", "dns.*", "cn=userRoot Backend,cn=monitor"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.pingdirectory_monitor_entries.backends", "entries.*", map[string]string{
+						"name":                     "userRoot Backend",
+						"attributes.ds-backend-id": "userRoot",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccMonitorEntryDataSource() string {
+	return `
+data "pingdirectory_monitor_entry" "by_name" {
+  name = "userRoot Backend"
+
+  lifecycle {
+    postcondition {
+      condition     = tonumber(self.attributes["ds-backend-entry-count"]) >= 0
+      error_message = "The userRoot backend must report an entry count"
+    }
+  }
+}
+
+data "pingdirectory_monitor_entry" "by_dn" {
+  dn = "cn=General Monitor Entry,cn=monitor"
+}
+
+data "pingdirectory_monitor_entries" "backends" {
+  object_class = "ds-backend-monitor-entry"
+}`
+}
@@ -0,0 +1,43 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package prometheusmetrics_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+func TestAccPrometheusMetricsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrometheusMetricsDataSource("/metrics"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pingdirectory_prometheus_metrics.metrics", "metric_names.#"),
+					resource.TestCheckResourceAttrSet("data.pingdirectory_prometheus_metrics.metrics", "families.#"),
+				),
+			},
+			{
+				Config:      testAccPrometheusMetricsDataSource("metrics"),
+				ExpectError: regexp.MustCompile("must be an absolute path"),
+			},
+		},
+	})
+}
+
+func testAccPrometheusMetricsDataSource(path string) string {
+	return `
+data "pingdirectory_prometheus_metrics" "metrics" {
+  path = "` + path + `"
+}`
+}
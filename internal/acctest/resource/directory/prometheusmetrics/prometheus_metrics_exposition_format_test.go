@@ -0,0 +1,139 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package prometheusmetrics_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const testAccExpositionFormatMetrics = `# HELP http_requests_total Total requests with "quotes", a \\ backslash\nand a second line
+# TYPE http_requests_total counter
+http_requests_total{method="post",path="/a\"b\\c\nd"} 1027 1395066363000
+http_requests_total{method="get"} 3
+# A comment that isn't HELP or TYPE
+# TYPE request_duration_seconds histogram
+request_duration_seconds_bucket{le="0.5"} 24054
+request_duration_seconds_bucket{le="+Inf"} 33444
+request_duration_seconds_sum 53423
+request_duration_seconds_count 33444
+# TYPE rpc_duration_seconds summary
+rpc_duration_seconds{quantile="0.5"} NaN
+rpc_duration_seconds_sum 17560473
+rpc_duration_seconds_count 2693
+temperature +Inf
+pressure -Inf 1395066363000
+standalone_count 5
+`
+
+// The Prometheus text exposition format is parsed into metric families. Uses a local server returning canned
+// metrics, so no PingDirectory server is needed.
+func TestAccPrometheusMetricsExpositionFormat(t *testing.T) {
+	var metrics atomic.Value
+	metrics.Store(testAccExpositionFormatMetrics)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		fmt.Fprint(w, metrics.Load())
+	}))
+	defer server.Close()
+
+	dataSourceName := "data.pingdirectory_prometheus_metrics.metrics"
+	steps := []resource.TestStep{
+		{
+			Config: testAccPrometheusMetricsFakeServerDataSource(server.URL),
+			Check: resource.ComposeTestCheckFunc(
+				// Families are sorted by name
+				resource.TestCheckResourceAttr(dataSourceName, "families.#", "6"),
+				resource.TestCheckResourceAttr(dataSourceName, "families.0.name", "http_requests_total"),
+				resource.TestCheckResourceAttr(dataSourceName, "families.1.name", "pressure"),
+				resource.TestCheckResourceAttr(dataSourceName, "families.2.name", "request_duration_seconds"),
+				resource.TestCheckResourceAttr(dataSourceName, "families.3.name", "rpc_duration_seconds"),
+				resource.TestCheckResourceAttr(dataSourceName, "families.4.name", "standalone_count"),
+				resource.TestCheckResourceAttr(dataSourceName, "families.5.name", "temperature"),
+				// HELP text escapes
+				resource.TestCheckResourceAttr(dataSourceName, "families.0.type", "counter"),
+				resource.TestCheckResourceAttr(dataSourceName, "families.0.help", "Total requests with \"quotes\", a \\ backslash\nand a second line"),
+				// Label value escapes, and timestamps after the value are ignored
+				resource.TestCheckResourceAttr(dataSourceName, "families.0.samples.#", "2"),
+				resource.TestCheckResourceAttr(dataSourceName, "families.0.samples.0.labels.method", "post"),
+				resource.TestCheckResourceAttr(dataSourceName, "families.0.samples.0.labels.path", "/a\"b\\c\nd"),
+				resource.TestCheckResourceAttr(dataSourceName, "families.0.samples.0.value", "1027"),
+				resource.TestCheckResourceAttr(dataSourceName, "families.0.samples.1.value", "3"),
+				// Infinite values are null, with or without a timestamp
+				resource.TestCheckResourceAttr(dataSourceName, "families.1.type", "untyped"),
+				resource.TestCheckNoResourceAttr(dataSourceName, "families.1.samples.0.value"),
+				resource.TestCheckNoResourceAttr(dataSourceName, "families.5.samples.0.value"),
+				// Histogram samples are grouped into their family in the order they were exposed
+				resource.TestCheckResourceAttr(dataSourceName, "families.2.type", "histogram"),
+				resource.TestCheckResourceAttr(dataSourceName, "families.2.samples.#", "4"),
+				resource.TestCheckResourceAttr(dataSourceName, "families.2.samples.0.name", "request_duration_seconds_bucket"),
+				resource.TestCheckResourceAttr(dataSourceName, "families.2.samples.0.labels.le", "0.5"),
+				resource.TestCheckResourceAttr(dataSourceName, "families.2.samples.1.labels.le", "+Inf"),
+				resource.TestCheckResourceAttr(dataSourceName, "families.2.samples.2.name", "request_duration_seconds_sum"),
+				resource.TestCheckResourceAttr(dataSourceName, "families.2.samples.3.name", "request_duration_seconds_count"),
+				resource.TestCheckResourceAttr(dataSourceName, "families.2.samples.3.value", "33444"),
+				// Summary samples are grouped the same way, and NaN values are null
+				resource.TestCheckResourceAttr(dataSourceName, "families.3.type", "summary"),
+				resource.TestCheckResourceAttr(dataSourceName, "families.3.samples.#", "3"),
+				resource.TestCheckNoResourceAttr(dataSourceName, "families.3.samples.0.value"),
+				resource.TestCheckResourceAttr(dataSourceName, "families.3.samples.1.value", "17560473"),
+				// A suffix doesn't group samples into a family that wasn't declared
+				resource.TestCheckResourceAttr(dataSourceName, "families.4.samples.0.name", "standalone_count"),
+				resource.TestCheckResourceAttr(dataSourceName, "families.4.samples.0.value", "5"),
+			),
+		},
+	}
+
+	malformedLines := []struct {
+		name        string
+		line        string
+		expectError string
+	}{
+		{"missing value", `missing_value`, `line 2: missing value for metric "missing_value"`},
+		{"missing value after labels", `labeled{method="get"}`, `line 2: missing value for metric labeled`},
+		{"invalid value", `invalid_value abc`, `line 2: invalid value "abc" for metric invalid_value`},
+		{"unquoted label value", `unquoted{method=get} 1`, `line 2: metric unquoted: value of label method must be quoted`},
+		{"unterminated label value", `unterminated{method="get} 1`, `line 2: metric unterminated: unterminated value of label method`},
+		{"missing label name", `unnamed{="get"} 1`, `line 2: metric unnamed: invalid labels`},
+	}
+	for _, malformed := range malformedLines {
+		steps = append(steps, resource.TestStep{
+			// Test that a malformed line is reported with its line number
+			PreConfig: func() {
+				metrics.Store("valid_metric 1\n" + malformed.line + "\n")
+			},
+			Config:      testAccPrometheusMetricsFakeServerDataSource(server.URL),
+			ExpectError: regexp.MustCompile(`Failed to parse the Prometheus Metrics[\s\S]*` + regexp.MustCompile(` +`).ReplaceAllString(regexp.QuoteMeta(malformed.expectError), `\s+`)),
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: steps,
+	})
+}
+
+func testAccPrometheusMetricsFakeServerDataSource(httpsHost string) string {
+	return fmt.Sprintf(`
+provider "pingdirectory" {
+  https_host             = "%[1]s"
+  username               = "cn=administrator"
+  password               = "2FederateM0re"
+  insecure_trust_all_tls = true
+  product_version        = "10.3.0.0"
+}
+
+data "pingdirectory_prometheus_metrics" "metrics" {
+}`, httpsHost)
+}
@@ -0,0 +1,42 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package topology_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+func TestAccTopologyDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopologyDataSource(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pingdirectory_topology.topology", "local_instance_name"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.pingdirectory_topology.topology", "server_instances.*", map[string]string{
+						"type":      "directory-server-instance",
+						"local":     "true",
+						"reachable": "true",
+					}),
+					resource.TestCheckResourceAttrSet("data.pingdirectory_topology.topology", "degraded"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTopologyDataSource() string {
+	return `
+data "pingdirectory_topology" "topology" {
+}`
+}
@@ -0,0 +1,122 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package remotecertificatechain_test
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/version"
+)
+
+// A successful LDAP extended response to a StartTLS request with message ID 1
+var startTLSSuccessResponse = []byte{0x30, 0x0c, 0x02, 0x01, 0x01, 0x78, 0x07, 0x0a, 0x01, 0x00, 0x04, 0x00, 0x04, 0x00}
+
+func TestAccRemoteCertificateChainDataSource(t *testing.T) {
+	// Local TLS listener presenting a self-signed certificate
+	tlsServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer tlsServer.Close()
+	tlsHost, tlsPort, err := net.SplitHostPort(tlsServer.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Local LDAP listener that accepts a StartTLS request before the TLS handshake
+	ldapListener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ldapListener.Close()
+	go serveStartTLS(ldapListener, tlsServer.TLS.Certificates)
+	ldapHost, ldapPort, err := net.SplitHostPort(ldapListener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fingerprint := sha256.Sum256(tlsServer.Certificate().Raw)
+	var fingerprintPairs []string
+	encodedFingerprint := hex.EncodeToString(fingerprint[:])
+	for i := 0; i < len(encodedFingerprint); i += 2 {
+		fingerprintPairs = append(fingerprintPairs, encodedFingerprint[i:i+2])
+	}
+	expectedFingerprint := strings.Join(fingerprintPairs, ":")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRemoteCertificateChainDataSource(tlsHost, tlsPort, ldapHost, ldapPort),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pingdirectory_remote_certificate_chain.tls", "certificates.#", "1"),
+					resource.TestCheckResourceAttr("data.pingdirectory_remote_certificate_chain.tls", "certificates.0.sha256_fingerprint", expectedFingerprint),
+					resource.TestCheckResourceAttr("data.pingdirectory_remote_certificate_chain.tls", "chain_complete", "true"),
+					resource.TestCheckResourceAttrPair("data.pingdirectory_remote_certificate_chain.tls", "last_certificate",
+						"data.pingdirectory_remote_certificate_chain.tls", "certificate_chain"),
+					resource.TestCheckResourceAttr("data.pingdirectory_remote_certificate_chain.ldap", "certificates.0.sha256_fingerprint", expectedFingerprint),
+				),
+			},
+		},
+	})
+}
+
+// Accept connections, respond to a StartTLS request, and then do the TLS handshake
+func serveStartTLS(listener net.Listener, certificates []tls.Certificate) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer conn.Close()
+			// The StartTLS request is 31 bytes long
+			request := make([]byte, 31)
+			_, err := io.ReadFull(conn, request)
+			if err != nil {
+				return
+			}
+			_, err = conn.Write(startTLSSuccessResponse)
+			if err != nil {
+				return
+			}
+			tlsConn := tls.Server(conn, &tls.Config{Certificates: certificates})
+			_ = tlsConn.Handshake()
+		}()
+	}
+}
+
+func testAccRemoteCertificateChainDataSource(tlsHost, tlsPort, ldapHost, ldapPort string) string {
+	// The data source doesn't use the PingDirectory API, so the provider is configured with placeholder values
+	// rather than requiring a PingDirectory server
+	return fmt.Sprintf(`
+provider "pingdirectory" {
+  https_host      = "https://localhost:1443"
+  username        = "cn=placeholder"
+  password        = "placeholder"
+  product_version = "%[5]s"
+}
+
+data "pingdirectory_remote_certificate_chain" "tls" {
+  host = "%[1]s"
+  port = %[2]s
+}
+
+data "pingdirectory_remote_certificate_chain" "ldap" {
+  host      = "%[3]s"
+  port      = %[4]s
+  start_tls = true
+}`, tlsHost, tlsPort, ldapHost, ldapPort, version.PingDirectory10300)
+}
@@ -15,8 +15,8 @@ import (
 
 var _ resource.ConfigValidator = &ImpliesValidator{}
 
-// Create an ImpliesValidator indicating that the condition path being configured implies the implied path is configured
-func Implies(condition, implied path.Expression) resource.ConfigValidator {
+// Create an ImpliesValidator indicating that the condition path being configured implies at least one of the implied paths is configured
+func Implies(condition path.Expression, implied ...path.Expression) resource.ConfigValidator {
 	return ImpliesValidator{
 		Condition: condition,
 		Implied:   implied,
@@ -26,7 +26,7 @@ func Implies(condition, implied path.Expression) resource.ConfigValidator {
 // ImpliesValidator is the underlying struct implementing Implies.
 type ImpliesValidator struct {
 	Condition path.Expression
-	Implied   path.Expression
+	Implied   []path.Expression
 }
 
 func (v ImpliesValidator) Description(ctx context.Context) string {
@@ -34,7 +34,10 @@ func (v ImpliesValidator) Description(ctx context.Context) string {
 }
 
 func (v ImpliesValidator) MarkdownDescription(_ context.Context) string {
-	return fmt.Sprintf("If the \"%s\" attribute is configured, then the \"%s\" attribute must be configured", v.Condition, v.Implied)
+	if len(v.Implied) == 1 {
+		return fmt.Sprintf("If the \"%s\" attribute is configured, then the \"%s\" attribute must be configured", v.Condition, v.Implied[0])
+	}
+	return fmt.Sprintf("If the \"%s\" attribute is configured, then one of the %s attributes must be configured", v.Condition, pathExpressionSliceToReadableString(v.Implied))
 }
 
 func (v ImpliesValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -85,40 +88,42 @@ func (v ImpliesValidator) Validate(ctx context.Context, config tfsdk.Config) dia
 		return diags
 	}
 
-	// If we got here, the condition attribute was found, so the implied attribute must be present
-	impliedMatchedPaths, impliedDiags := config.PathMatches(ctx, v.Implied)
-
-	diags.Append(impliedDiags...)
-	if impliedDiags.HasError() {
-		return diags
-	}
-
-	for _, matchedPath := range impliedMatchedPaths {
-		getAttributeDiags := config.GetAttribute(ctx, matchedPath, &impliedValue)
+	// If we got here, the condition attribute was found, so one of the implied attributes must be present
+	for _, implied := range v.Implied {
+		impliedMatchedPaths, impliedDiags := config.PathMatches(ctx, implied)
 
-		diags.Append(getAttributeDiags...)
-		if getAttributeDiags.HasError() {
+		diags.Append(impliedDiags...)
+		if impliedDiags.HasError() {
 			return diags
 		}
 
-		// If value is unknown, it may be null or a value, so we cannot
-		// know if the validator should succeed or not. Collect the path
-		// path so we use it to skip the validation later and continue to
-		// collect all path matching diagnostics.
-		if impliedValue.IsUnknown() {
-			return diags
-		}
+		for _, matchedPath := range impliedMatchedPaths {
+			getAttributeDiags := config.GetAttribute(ctx, matchedPath, &impliedValue)
 
-		// If the condition is null, then try the next one
-		if impliedValue.IsNull() {
-			continue
-		}
+			diags.Append(getAttributeDiags...)
+			if getAttributeDiags.HasError() {
+				return diags
+			}
 
-		// Value is known and not null, it is configured, so this validator passes
-		return diags
+			// If value is unknown, it may be null or a value, so we cannot
+			// know if the validator should succeed or not. Collect the path
+			// path so we use it to skip the validation later and continue to
+			// collect all path matching diagnostics.
+			if impliedValue.IsUnknown() {
+				return diags
+			}
+
+			// If the condition is null, then try the next one
+			if impliedValue.IsNull() {
+				continue
+			}
+
+			// Value is known and not null, it is configured, so this validator passes
+			return diags
+		}
 	}
 
-	// If we got here, then the condition value is configured and the implied value is not, so
+	// If we got here, then the condition value is configured and none of the implied values are, so
 	// this validator should fail
 	diags.Append(diag.NewErrorDiagnostic(
 		"Missing Implied Attribute Configuration",
@@ -104,8 +104,23 @@ func AddStringOperationIfNecessary(ops *[]client.Operation, plan types.String, s
 	}
 }
 
+// Add write-only string operation if the version associated with the value has changed. Write-only values
+// are never stored in state, so the version is the only way to tell that a new value needs to be sent.
+func AddWriteOnlyStringOperationIfNecessary(ops *[]client.Operation, value types.String, planVersion types.Int64, stateVersion types.Int64, path string) {
+	// If the version is unknown or unchanged, there is nothing new to send
+	if planVersion.IsUnknown() || planVersion.Equal(stateVersion) {
+		return
+	}
+
+	if internaltypes.IsNonEmptyString(value) {
+		op := client.NewOperation(client.ENUMOPERATION_REPLACE, path)
+		op.SetValue(value.ValueString())
+		*ops = append(*ops, *op)
+	}
+}
+
 // Get a path to remove a value from a multi-valued attribute
-func removeMultiValuedAttributePath(attributePath string, toRemove string) string {
+func RemoveMultiValuedAttributePath(attributePath string, toRemove string) string {
 	// Remove paths for multivalued attributes are formatted like this:
 	// "[additional-tags eq \"five\"]"
 	return "[" + attributePath + " eq \"" + toRemove + "\"]"
@@ -134,13 +149,39 @@ func AddStringSetOperationsIfNecessary(ops *[]client.Operation, plan types.Set,
 		// Removes
 		for _, stateEl := range stateElements {
 			if !internaltypes.Contains(planElements, stateEl) {
-				op := client.NewOperation(client.ENUMOPERATION_REMOVE, removeMultiValuedAttributePath(path, stateEl.(types.String).ValueString()))
+				op := client.NewOperation(client.ENUMOPERATION_REMOVE, RemoveMultiValuedAttributePath(path, stateEl.(types.String).ValueString()))
 				*ops = append(*ops, *op)
 			}
 		}
 	}
 }
 
+// Add list operations if the plan doesn't match the state. The order of the values matters for lists, so any
+// change replaces all the values: the first value replaces the existing values and the rest are added in order.
+func AddStringListOperationsIfNecessary(ops *[]client.Operation, plan types.List, state types.List, path string) {
+	// If plan is unknown, then just take whatever's in the state - no operation needed
+	if plan.IsUnknown() {
+		return
+	}
+
+	if !plan.Equal(state) {
+		planElements := plan.Elements()
+		if len(planElements) == 0 {
+			*ops = append(*ops, *client.NewOperation(client.ENUMOPERATION_REMOVE, path))
+			return
+		}
+
+		op := client.NewOperation(client.ENUMOPERATION_REPLACE, path)
+		op.SetValue(planElements[0].(types.String).ValueString())
+		*ops = append(*ops, *op)
+		for _, planEl := range planElements[1:] {
+			op := client.NewOperation(client.ENUMOPERATION_ADD, path)
+			op.SetValue(planEl.(types.String).ValueString())
+			*ops = append(*ops, *op)
+		}
+	}
+}
+
 // Add int64 set operation if the plan doesn't match the state
 func AddInt64SetOperationsIfNecessary(ops *[]client.Operation, plan types.Set, state types.Set, path string) {
 	// If plan is unknown, then just take whatever's in the state - no operation needed
@@ -164,9 +205,19 @@ func AddInt64SetOperationsIfNecessary(ops *[]client.Operation, plan types.Set, s
 		// Removes
 		for _, stateEl := range stateElements {
 			if !internaltypes.Contains(planElements, stateEl.(types.Int64)) {
-				op := client.NewOperation(client.ENUMOPERATION_REMOVE, removeMultiValuedAttributePath(path, internaltypes.Int64ToString(stateEl.(types.Int64))))
+				op := client.NewOperation(client.ENUMOPERATION_REMOVE, RemoveMultiValuedAttributePath(path, internaltypes.Int64ToString(stateEl.(types.Int64))))
 				*ops = append(*ops, *op)
 			}
 		}
 	}
 }
+
+// Get the value set by a replace operation for the given path, if there is one
+func GetReplacedValue(ops []client.Operation, path string) (string, bool) {
+	for _, op := range ops {
+		if op.Op == client.ENUMOPERATION_REPLACE && op.Path == path && op.Value != nil {
+			return *op.Value, true
+		}
+	}
+	return "", false
+}
@@ -12,10 +12,12 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
@@ -33,6 +35,8 @@ import (
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/ciphersecretkey"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/cipherstreamprovider"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/clientconnectionpolicy"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/configobject"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/configurationsnapshot"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/conjurauthenticationmethod"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/connectioncriteria"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/connectionhandler"
@@ -139,6 +143,14 @@ import (
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/virtualattribute"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/webapplicationextension"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/workqueue"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/directory/alarm"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/directory/groupmembership"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/directory/ldapentry"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/directory/ldapschema"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/directory/monitorentry"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/directory/prometheusmetrics"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/directory/topology"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/tls/remotecertificatechain"
 	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/version"
 )
@@ -151,6 +163,7 @@ type pingdirectoryProviderModel struct {
 	InsecureTrustAllTls   types.Bool   `tfsdk:"insecure_trust_all_tls"`
 	CACertificatePEMFiles types.Set    `tfsdk:"ca_certificate_pem_files"`
 	ProductVersion        types.String `tfsdk:"product_version"`
+	RequiredActionsPolicy types.String `tfsdk:"required_actions_policy"`
 }
 
 // Ensure the implementation satisfies the expected interfaces
@@ -213,6 +226,13 @@ func (p *pingdirectoryProvider) Schema(ctx context.Context, req provider.SchemaR
 				Description: "Version of the PingDirectory server being configured. Default value can be set with the `PINGDIRECTORY_PROVIDER_PRODUCT_VERSION` environment variable.",
 				Optional:    true,
 			},
+			"required_actions_policy": schema.StringAttribute{
+				Description: "How to handle required actions (such as restarting the server or rebuilding an index) returned by the PingDirectory Configuration API when applying changes. Options are `warn`, which reports each action as a warning, `error`, which fails the apply for the affected resource, and `collect`, which reports a single summary warning listing every action by resource. The summary reported by the last resource applied is the complete list. Defaults to `warn`. Default value can be set with the `PINGDIRECTORY_PROVIDER_REQUIRED_ACTIONS_POLICY` environment variable.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(internaltypes.RequiredActionsPolicyWarn, internaltypes.RequiredActionsPolicyError, internaltypes.RequiredActionsPolicyCollect),
+				},
+			},
 		},
 	}
 }
@@ -338,6 +358,22 @@ func (p *pingdirectoryProvider) Configure(ctx context.Context, req provider.Conf
 		}
 	}
 
+	var requiredActionsPolicy string
+	if !config.RequiredActionsPolicy.IsUnknown() && !config.RequiredActionsPolicy.IsNull() {
+		requiredActionsPolicy = config.RequiredActionsPolicy.ValueString()
+	} else {
+		requiredActionsPolicy = os.Getenv("PINGDIRECTORY_PROVIDER_REQUIRED_ACTIONS_POLICY")
+	}
+
+	switch requiredActionsPolicy {
+	case "":
+		requiredActionsPolicy = internaltypes.RequiredActionsPolicyWarn
+	case internaltypes.RequiredActionsPolicyWarn, internaltypes.RequiredActionsPolicyError, internaltypes.RequiredActionsPolicyCollect:
+	default:
+		resp.Diagnostics.AddError("Invalid required_actions_policy",
+			"required_actions_policy must be one of \"warn\", \"error\", or \"collect\", got \""+requiredActionsPolicy+"\". Check the configuration and the PINGDIRECTORY_PROVIDER_REQUIRED_ACTIONS_POLICY environment variable.")
+	}
+
 	var caCertPool *x509.CertPool
 	if len(caCertPemFiles) == 0 {
 		tflog.Info(ctx, "No CA certs specified, using the host's root CA set")
@@ -365,10 +401,12 @@ func (p *pingdirectoryProvider) Configure(ctx context.Context, req provider.Conf
 	// type Configure methods.
 	var resourceConfig internaltypes.ResourceConfiguration
 	providerConfig := internaltypes.ProviderConfiguration{
-		HttpsHost:      httpsHost,
-		Username:       username,
-		Password:       password,
-		ProductVersion: productVersion,
+		HttpsHost:             httpsHost,
+		Username:              username,
+		Password:              password,
+		ProductVersion:        productVersion,
+		RequiredActionsPolicy: requiredActionsPolicy,
+		RequiredActions:       internaltypes.NewRequiredActionsCollector(),
 	}
 	resourceConfig.ProviderConfig = providerConfig
 	//#nosec G402
@@ -404,6 +442,7 @@ func (p *pingdirectoryProvider) DataSources(_ context.Context) []func() datasour
 		accesstokenvalidator.NewAccessTokenValidatorsDataSource,
 		accountstatusnotificationhandler.NewAccountStatusNotificationHandlerDataSource,
 		accountstatusnotificationhandler.NewAccountStatusNotificationHandlersDataSource,
+		alarm.NewAlarmsDataSource,
 		alarmmanager.NewAlarmManagerDataSource,
 		alerthandler.NewAlertHandlerDataSource,
 		alerthandler.NewAlertHandlersDataSource,
@@ -425,6 +464,8 @@ func (p *pingdirectoryProvider) DataSources(_ context.Context) []func() datasour
 		cipherstreamprovider.NewCipherStreamProvidersDataSource,
 		clientconnectionpolicy.NewClientConnectionPolicyDataSource,
 		clientconnectionpolicy.NewClientConnectionPoliciesDataSource,
+		configobject.NewConfigObjectDataSource,
+		configurationsnapshot.NewConfigurationSnapshotDataSource,
 		conjurauthenticationmethod.NewConjurAuthenticationMethodDataSource,
 		conjurauthenticationmethod.NewConjurAuthenticationMethodsDataSource,
 		connectioncriteria.NewConnectionCriteriaDataSource,
@@ -495,6 +536,8 @@ func (p *pingdirectoryProvider) DataSources(_ context.Context) []func() datasour
 		keypair.NewKeyPairsDataSource,
 		ldapcorrelationattributepair.NewLdapCorrelationAttributePairDataSource,
 		ldapcorrelationattributepair.NewLdapCorrelationAttributePairsDataSource,
+		ldapschema.NewSchemaAttributeTypeDataSource,
+		ldapschema.NewSchemaObjectClassDataSource,
 		ldapsdkdebuglogger.NewLdapSdkDebugLoggerDataSource,
 		license.NewLicenseDataSource,
 		localdbcompositeindex.NewLocalDbCompositeIndexDataSource,
@@ -523,6 +566,8 @@ func (p *pingdirectoryProvider) DataSources(_ context.Context) []func() datasour
 		macsecretkey.NewMacSecretKeysDataSource,
 		matchingrule.NewMatchingRuleDataSource,
 		matchingrule.NewMatchingRulesDataSource,
+		monitorentry.NewMonitorEntryDataSource,
+		monitorentry.NewMonitorEntriesDataSource,
 		monitoringendpoint.NewMonitoringEndpointDataSource,
 		monitoringendpoint.NewMonitoringEndpointsDataSource,
 		monitorprovider.NewMonitorProviderDataSource,
@@ -552,12 +597,14 @@ func (p *pingdirectoryProvider) DataSources(_ context.Context) []func() datasour
 		pluginroot.NewPluginRootDataSource,
 		postldifexporttaskprocessor.NewPostLdifExportTaskProcessorDataSource,
 		postldifexporttaskprocessor.NewPostLdifExportTaskProcessorsDataSource,
+		prometheusmetrics.NewPrometheusMetricsDataSource,
 		prometheusmonitorattributemetric.NewPrometheusMonitorAttributeMetricDataSource,
 		prometheusmonitorattributemetric.NewPrometheusMonitorAttributeMetricsDataSource,
 		recurringtask.NewRecurringTaskDataSource,
 		recurringtask.NewRecurringTasksDataSource,
 		recurringtaskchain.NewRecurringTaskChainDataSource,
 		recurringtaskchain.NewRecurringTaskChainsDataSource,
+		remotecertificatechain.NewRemoteCertificateChainDataSource,
 		replicationassurancepolicy.NewReplicationAssurancePolicyDataSource,
 		replicationassurancepolicy.NewReplicationAssurancePoliciesDataSource,
 		replicationdomain.NewReplicationDomainDataSource,
@@ -605,6 +652,7 @@ func (p *pingdirectoryProvider) DataSources(_ context.Context) []func() datasour
 		synchronizationprovider.NewSynchronizationProvidersDataSource,
 		tokenclaimvalidation.NewTokenClaimValidationDataSource,
 		tokenclaimvalidation.NewTokenClaimValidationsDataSource,
+		topology.NewTopologyDataSource,
 		topologyadminuser.NewTopologyAdminUserDataSource,
 		topologyadminuser.NewTopologyAdminUsersDataSource,
 		trustedcertificate.NewTrustedCertificateDataSource,
@@ -653,10 +701,12 @@ func (p *pingdirectoryProvider) Resources(_ context.Context) []func() resource.R
 		changesubscriptionhandler.NewChangeSubscriptionHandlerResource,
 		changesubscriptionhandler.NewDefaultChangeSubscriptionHandlerResource,
 		ciphersecretkey.NewCipherSecretKeyResource,
+		ciphersecretkey.NewCipherSecretKeyRotationResource,
 		cipherstreamprovider.NewCipherStreamProviderResource,
 		cipherstreamprovider.NewDefaultCipherStreamProviderResource,
 		clientconnectionpolicy.NewClientConnectionPolicyResource,
 		clientconnectionpolicy.NewDefaultClientConnectionPolicyResource,
+		configobject.NewConfigObjectResource,
 		conjurauthenticationmethod.NewConjurAuthenticationMethodResource,
 		conjurauthenticationmethod.NewDefaultConjurAuthenticationMethodResource,
 		connectioncriteria.NewConnectionCriteriaResource,
@@ -705,6 +755,7 @@ func (p *pingdirectoryProvider) Resources(_ context.Context) []func() resource.R
 		gaugedatasource.NewGaugeDataSourceResource,
 		globalconfiguration.NewGlobalConfigurationResource,
 		groupimplementation.NewGroupImplementationResource,
+		groupmembership.NewGroupMembershipResource,
 		httpconfiguration.NewHttpConfigurationResource,
 		httpservletcrossoriginpolicy.NewDefaultHttpServletCrossOriginPolicyResource,
 		httpservletcrossoriginpolicy.NewHttpServletCrossOriginPolicyResource,
@@ -723,8 +774,12 @@ func (p *pingdirectoryProvider) Resources(_ context.Context) []func() resource.R
 		keymanagerprovider.NewKeyManagerProviderResource,
 		keypair.NewDefaultKeyPairResource,
 		keypair.NewKeyPairResource,
+		keypair.NewKeyPairCsrResource,
 		ldapcorrelationattributepair.NewDefaultLdapCorrelationAttributePairResource,
 		ldapcorrelationattributepair.NewLdapCorrelationAttributePairResource,
+		ldapentry.NewLdapEntryResource,
+		ldapschema.NewSchemaAttributeTypeResource,
+		ldapschema.NewSchemaObjectClassResource,
 		ldapsdkdebuglogger.NewLdapSdkDebugLoggerResource,
 		license.NewLicenseResource,
 		localdbcompositeindex.NewDefaultLocalDbCompositeIndexResource,
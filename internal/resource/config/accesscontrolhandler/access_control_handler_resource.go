@@ -195,7 +195,7 @@ func (r *accessControlHandlerResource) Create(ctx context.Context, req resource.
 	readResponse, httpResp, err := r.apiClient.AccessControlHandlerAPI.GetAccessControlHandler(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig)).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Access Control Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Access Control Handler", err, httpResp)
 		return
 	}
 
@@ -219,7 +219,7 @@ func (r *accessControlHandlerResource) Create(ctx context.Context, req resource.
 
 		updateResponse, httpResp, err := r.apiClient.AccessControlHandlerAPI.UpdateAccessControlHandlerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Access Control Handler", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Access Control Handler", err, httpResp)
 			return
 		}
 
@@ -231,6 +231,8 @@ func (r *accessControlHandlerResource) Create(ctx context.Context, req resource.
 
 		// Read the response
 		readDseeCompatAccessControlHandlerResponse(ctx, updateResponse, &state, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Access Control Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -296,7 +298,7 @@ func (r *accessControlHandlerResource) Update(ctx context.Context, req resource.
 
 		updateResponse, httpResp, err := r.apiClient.AccessControlHandlerAPI.UpdateAccessControlHandlerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Access Control Handler", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Access Control Handler", err, httpResp)
 			return
 		}
 
@@ -308,6 +310,8 @@ func (r *accessControlHandlerResource) Update(ctx context.Context, req resource.
 
 		// Read the response
 		readDseeCompatAccessControlHandlerResponse(ctx, updateResponse, &state, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Access Control Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
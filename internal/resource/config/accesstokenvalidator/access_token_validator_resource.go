@@ -31,9 +31,11 @@ var (
 	_ resource.Resource                = &accessTokenValidatorResource{}
 	_ resource.ResourceWithConfigure   = &accessTokenValidatorResource{}
 	_ resource.ResourceWithImportState = &accessTokenValidatorResource{}
+	_ resource.ResourceWithMoveState   = &accessTokenValidatorResource{}
 	_ resource.Resource                = &defaultAccessTokenValidatorResource{}
 	_ resource.ResourceWithConfigure   = &defaultAccessTokenValidatorResource{}
 	_ resource.ResourceWithImportState = &defaultAccessTokenValidatorResource{}
+	_ resource.ResourceWithMoveState   = &defaultAccessTokenValidatorResource{}
 )
 
 // Create a Access Token Validator resource
@@ -106,6 +108,8 @@ type accessTokenValidatorResourceModel struct {
 	ScopeClaimName                    types.String `tfsdk:"scope_claim_name"`
 	ClientID                          types.String `tfsdk:"client_id"`
 	ClientSecret                      types.String `tfsdk:"client_secret"`
+	ClientSecretWo                    types.String `tfsdk:"client_secret_wo"`
+	ClientSecretWoVersion             types.Int64  `tfsdk:"client_secret_wo_version"`
 	ClientSecretPassphraseProvider    types.String `tfsdk:"client_secret_passphrase_provider"`
 	IncludeAudParameter               types.Bool   `tfsdk:"include_aud_parameter"`
 	AccessTokenManagerID              types.String `tfsdk:"access_token_manager_id"`
@@ -137,6 +141,8 @@ type defaultAccessTokenValidatorResourceModel struct {
 	ScopeClaimName                    types.String `tfsdk:"scope_claim_name"`
 	ClientID                          types.String `tfsdk:"client_id"`
 	ClientSecret                      types.String `tfsdk:"client_secret"`
+	ClientSecretWo                    types.String `tfsdk:"client_secret_wo"`
+	ClientSecretWoVersion             types.Int64  `tfsdk:"client_secret_wo_version"`
 	ClientSecretPassphraseProvider    types.String `tfsdk:"client_secret_passphrase_provider"`
 	IncludeAudParameter               types.Bool   `tfsdk:"include_aud_parameter"`
 	AccessTokenManagerID              types.String `tfsdk:"access_token_manager_id"`
@@ -341,6 +347,7 @@ func accessTokenValidatorSchema(ctx context.Context, req resource.SchemaRequest,
 		extensionClassAttr.PlanModifiers = append(extensionClassAttr.PlanModifiers, stringplanmodifier.RequiresReplace())
 		schemaDef.Attributes["extension_class"] = extensionClassAttr
 	}
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "client_secret")
 	config.AddCommonResourceSchema(&schemaDef, true)
 	resp.Schema = schemaDef
 }
@@ -512,6 +519,7 @@ func configValidatorsAccessTokenValidator() []resource.ConfigValidator {
 			[]string{"ping-federate"},
 			resourcevalidator.ExactlyOneOf(
 				path.MatchRoot("client_secret"),
+				path.MatchRoot("client_secret_wo"),
 				path.MatchRoot("client_secret_passphrase_provider"),
 			),
 		),
@@ -525,6 +533,11 @@ func configValidatorsAccessTokenValidator() []resource.ConfigValidator {
 			path.MatchRoot("type"),
 			[]string{"ping-federate"},
 		),
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("client_secret_wo"),
+			path.MatchRoot("type"),
+			[]string{"ping-federate"},
+		),
 		configvalidators.ImpliesOtherAttributeOneOfString(
 			path.MatchRoot("client_secret_passphrase_provider"),
 			path.MatchRoot("type"),
@@ -676,6 +689,9 @@ func addOptionalPingFederateAccessTokenValidatorFields(ctx context.Context, addR
 	if internaltypes.IsNonEmptyString(plan.ClientSecret) {
 		addRequest.ClientSecret = plan.ClientSecret.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.ClientSecretWo) {
+		addRequest.ClientSecret = plan.ClientSecretWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.ClientSecretPassphraseProvider) {
 		addRequest.ClientSecretPassphraseProvider = plan.ClientSecretPassphraseProvider.ValueStringPointer()
@@ -1093,12 +1109,18 @@ func (state *defaultAccessTokenValidatorResourceModel) setStateValuesNotReturned
 	if !expectedValues.ClientSecret.IsUnknown() {
 		state.ClientSecret = expectedValues.ClientSecret
 	}
+	if !expectedValues.ClientSecretWoVersion.IsUnknown() {
+		state.ClientSecretWoVersion = expectedValues.ClientSecretWoVersion
+	}
 }
 
 func (state *accessTokenValidatorResourceModel) setStateValuesNotReturnedByAPI(expectedValues *accessTokenValidatorResourceModel) {
 	if !expectedValues.ClientSecret.IsUnknown() {
 		state.ClientSecret = expectedValues.ClientSecret
 	}
+	if !expectedValues.ClientSecretWoVersion.IsUnknown() {
+		state.ClientSecretWoVersion = expectedValues.ClientSecretWoVersion
+	}
 }
 
 // Create any update operations necessary to make the state match the plan
@@ -1117,6 +1139,7 @@ func createAccessTokenValidatorOperations(plan accessTokenValidatorResourceModel
 	operations.AddStringOperationIfNecessary(&ops, plan.ScopeClaimName, state.ScopeClaimName, "scope-claim-name")
 	operations.AddStringOperationIfNecessary(&ops, plan.ClientID, state.ClientID, "client-id")
 	operations.AddStringOperationIfNecessary(&ops, plan.ClientSecret, state.ClientSecret, "client-secret")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.ClientSecretWo, plan.ClientSecretWoVersion, state.ClientSecretWoVersion, "client-secret")
 	operations.AddStringOperationIfNecessary(&ops, plan.ClientSecretPassphraseProvider, state.ClientSecretPassphraseProvider, "client-secret-passphrase-provider")
 	operations.AddBoolOperationIfNecessary(&ops, plan.IncludeAudParameter, state.IncludeAudParameter, "include-aud-parameter")
 	operations.AddStringOperationIfNecessary(&ops, plan.AccessTokenManagerID, state.AccessTokenManagerID, "access-token-manager-id")
@@ -1146,6 +1169,7 @@ func createAccessTokenValidatorOperationsDefault(plan defaultAccessTokenValidato
 	operations.AddStringOperationIfNecessary(&ops, plan.ScopeClaimName, state.ScopeClaimName, "scope-claim-name")
 	operations.AddStringOperationIfNecessary(&ops, plan.ClientID, state.ClientID, "client-id")
 	operations.AddStringOperationIfNecessary(&ops, plan.ClientSecret, state.ClientSecret, "client-secret")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.ClientSecretWo, plan.ClientSecretWoVersion, state.ClientSecretWoVersion, "client-secret")
 	operations.AddStringOperationIfNecessary(&ops, plan.ClientSecretPassphraseProvider, state.ClientSecretPassphraseProvider, "client-secret-passphrase-provider")
 	operations.AddBoolOperationIfNecessary(&ops, plan.IncludeAudParameter, state.IncludeAudParameter, "include-aud-parameter")
 	operations.AddStringOperationIfNecessary(&ops, plan.AccessTokenManagerID, state.AccessTokenManagerID, "access-token-manager-id")
@@ -1188,7 +1212,7 @@ func (r *accessTokenValidatorResource) CreatePingFederateAccessTokenValidator(ct
 
 	addResponse, httpResp, err := r.apiClient.AccessTokenValidatorAPI.AddAccessTokenValidatorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Access Token Validator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Access Token Validator", err, httpResp)
 		return nil, err
 	}
 
@@ -1226,7 +1250,7 @@ func (r *accessTokenValidatorResource) CreateJwtAccessTokenValidator(ctx context
 
 	addResponse, httpResp, err := r.apiClient.AccessTokenValidatorAPI.AddAccessTokenValidatorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Access Token Validator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Access Token Validator", err, httpResp)
 		return nil, err
 	}
 
@@ -1264,7 +1288,7 @@ func (r *accessTokenValidatorResource) CreateMockAccessTokenValidator(ctx contex
 
 	addResponse, httpResp, err := r.apiClient.AccessTokenValidatorAPI.AddAccessTokenValidatorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Access Token Validator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Access Token Validator", err, httpResp)
 		return nil, err
 	}
 
@@ -1304,7 +1328,7 @@ func (r *accessTokenValidatorResource) CreateThirdPartyAccessTokenValidator(ctx
 
 	addResponse, httpResp, err := r.apiClient.AccessTokenValidatorAPI.AddAccessTokenValidatorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Access Token Validator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Access Token Validator", err, httpResp)
 		return nil, err
 	}
 
@@ -1329,6 +1353,11 @@ func (r *accessTokenValidatorResource) Create(ctx context.Context, req resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("client_secret_wo"), &plan.ClientSecretWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	var state *accessTokenValidatorResourceModel
 	var err error
@@ -1359,6 +1388,8 @@ func (r *accessTokenValidatorResource) Create(ctx context.Context, req resource.
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Access Token Validator", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -1379,11 +1410,16 @@ func (r *defaultAccessTokenValidatorResource) Create(ctx context.Context, req re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("client_secret_wo"), &plan.ClientSecretWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	readResponse, httpResp, err := r.apiClient.AccessTokenValidatorAPI.GetAccessTokenValidator(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Access Token Validator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Access Token Validator", err, httpResp)
 		return
 	}
 
@@ -1421,7 +1457,7 @@ func (r *defaultAccessTokenValidatorResource) Create(ctx context.Context, req re
 
 		updateResponse, httpResp, err := r.apiClient.AccessTokenValidatorAPI.UpdateAccessTokenValidatorExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Access Token Validator", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Access Token Validator", err, httpResp)
 			return
 		}
 
@@ -1447,6 +1483,8 @@ func (r *defaultAccessTokenValidatorResource) Create(ctx context.Context, req re
 		if updateResponse.ThirdPartyAccessTokenValidatorResponse != nil {
 			readThirdPartyAccessTokenValidatorResponseDefault(ctx, updateResponse.ThirdPartyAccessTokenValidatorResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Access Token Validator", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -1545,6 +1583,11 @@ func (r *accessTokenValidatorResource) Update(ctx context.Context, req resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("client_secret_wo"), &plan.ClientSecretWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Get the current state to see how any attributes are changing
 	var state accessTokenValidatorResourceModel
@@ -1561,7 +1604,7 @@ func (r *accessTokenValidatorResource) Update(ctx context.Context, req resource.
 
 		updateResponse, httpResp, err := r.apiClient.AccessTokenValidatorAPI.UpdateAccessTokenValidatorExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Access Token Validator", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Access Token Validator", err, httpResp)
 			return
 		}
 
@@ -1584,6 +1627,8 @@ func (r *accessTokenValidatorResource) Update(ctx context.Context, req resource.
 		if updateResponse.ThirdPartyAccessTokenValidatorResponse != nil {
 			readThirdPartyAccessTokenValidatorResponse(ctx, updateResponse.ThirdPartyAccessTokenValidatorResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Access Token Validator", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -1604,6 +1649,11 @@ func (r *defaultAccessTokenValidatorResource) Update(ctx context.Context, req re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("client_secret_wo"), &plan.ClientSecretWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Get the current state to see how any attributes are changing
 	var state defaultAccessTokenValidatorResourceModel
@@ -1620,7 +1670,7 @@ func (r *defaultAccessTokenValidatorResource) Update(ctx context.Context, req re
 
 		updateResponse, httpResp, err := r.apiClient.AccessTokenValidatorAPI.UpdateAccessTokenValidatorExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Access Token Validator", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Access Token Validator", err, httpResp)
 			return
 		}
 
@@ -1646,6 +1696,8 @@ func (r *defaultAccessTokenValidatorResource) Update(ctx context.Context, req re
 		if updateResponse.ThirdPartyAccessTokenValidatorResponse != nil {
 			readThirdPartyAccessTokenValidatorResponseDefault(ctx, updateResponse.ThirdPartyAccessTokenValidatorResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Access Token Validator", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -1694,3 +1746,16 @@ func importAccessTokenValidator(ctx context.Context, req resource.ImportStateReq
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *accessTokenValidatorResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_access_token_validator"),
+	}
+}
+
+func (r *defaultAccessTokenValidatorResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_access_token_validator"),
+	}
+}
@@ -49,29 +49,21 @@ func (r *accessTokenValidatorsDataSource) Configure(_ context.Context, req datas
 }
 
 type accessTokenValidatorsDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *accessTokenValidatorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Access Token Validator objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Access Token Validator objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewAccessTokenValidatorDataSource(), "Access Token Validator")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *accessTokenValidatorsDataSource) Read(ctx context.Context, req datasour
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewAccessTokenValidatorDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.AccessTokenValidatorAPI.ListAccessTokenValidators(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.AccessTokenValidatorAPI.ListAccessTokenValidatorsExecute(listRequest)
@@ -106,41 +104,39 @@ func (r *accessTokenValidatorsDataSource) Read(ctx context.Context, req datasour
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object accessTokenValidatorDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.ExternalApiGatewayAccessTokenValidatorResponse != nil {
-			attributes["id"] = types.StringValue(response.ExternalApiGatewayAccessTokenValidatorResponse.Id)
-			attributes["type"] = types.StringValue("external-api-gateway")
+			object.Id = types.StringValue(response.ExternalApiGatewayAccessTokenValidatorResponse.Id)
+			object.Type = types.StringValue("external-api-gateway")
 		}
 		if response.MockAccessTokenValidatorResponse != nil {
-			attributes["id"] = types.StringValue(response.MockAccessTokenValidatorResponse.Id)
-			attributes["type"] = types.StringValue("mock")
+			readMockAccessTokenValidatorResponseDataSource(ctx, response.MockAccessTokenValidatorResponse, &object, &resp.Diagnostics)
 		}
 		if response.BindAccessTokenValidatorResponse != nil {
-			attributes["id"] = types.StringValue(response.BindAccessTokenValidatorResponse.Id)
-			attributes["type"] = types.StringValue("bind")
+			readBindAccessTokenValidatorResponseDataSource(ctx, response.BindAccessTokenValidatorResponse, &object, &resp.Diagnostics)
 		}
 		if response.PingFederateAccessTokenValidatorResponse != nil {
-			attributes["id"] = types.StringValue(response.PingFederateAccessTokenValidatorResponse.Id)
-			attributes["type"] = types.StringValue("ping-federate")
+			readPingFederateAccessTokenValidatorResponseDataSource(ctx, response.PingFederateAccessTokenValidatorResponse, &object, &resp.Diagnostics)
 		}
 		if response.JwtAccessTokenValidatorResponse != nil {
-			attributes["id"] = types.StringValue(response.JwtAccessTokenValidatorResponse.Id)
-			attributes["type"] = types.StringValue("jwt")
+			readJwtAccessTokenValidatorResponseDataSource(ctx, response.JwtAccessTokenValidatorResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyAccessTokenValidatorResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyAccessTokenValidatorResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyAccessTokenValidatorResponseDataSource(ctx, response.ThirdPartyAccessTokenValidatorResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -30,9 +30,11 @@ var (
 	_ resource.Resource                = &accountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithConfigure   = &accountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithImportState = &accountStatusNotificationHandlerResource{}
+	_ resource.ResourceWithMoveState   = &accountStatusNotificationHandlerResource{}
 	_ resource.Resource                = &defaultAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithConfigure   = &defaultAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithImportState = &defaultAccountStatusNotificationHandlerResource{}
+	_ resource.ResourceWithMoveState   = &defaultAccountStatusNotificationHandlerResource{}
 )
 
 // Create a Account Status Notification Handler resource
@@ -1214,7 +1216,7 @@ func (r *accountStatusNotificationHandlerResource) CreateSmtpAccountStatusNotifi
 
 	addResponse, httpResp, err := r.apiClient.AccountStatusNotificationHandlerAPI.AddAccountStatusNotificationHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Account Status Notification Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Account Status Notification Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1249,7 +1251,7 @@ func (r *accountStatusNotificationHandlerResource) CreateGroovyScriptedAccountSt
 
 	addResponse, httpResp, err := r.apiClient.AccountStatusNotificationHandlerAPI.AddAccountStatusNotificationHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Account Status Notification Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Account Status Notification Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1286,7 +1288,7 @@ func (r *accountStatusNotificationHandlerResource) CreateAdminAlertAccountStatus
 
 	addResponse, httpResp, err := r.apiClient.AccountStatusNotificationHandlerAPI.AddAccountStatusNotificationHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Account Status Notification Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Account Status Notification Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1323,7 +1325,7 @@ func (r *accountStatusNotificationHandlerResource) CreateErrorLogAccountStatusNo
 
 	addResponse, httpResp, err := r.apiClient.AccountStatusNotificationHandlerAPI.AddAccountStatusNotificationHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Account Status Notification Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Account Status Notification Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1357,7 +1359,7 @@ func (r *accountStatusNotificationHandlerResource) CreateMultiPartEmailAccountSt
 
 	addResponse, httpResp, err := r.apiClient.AccountStatusNotificationHandlerAPI.AddAccountStatusNotificationHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Account Status Notification Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Account Status Notification Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1392,7 +1394,7 @@ func (r *accountStatusNotificationHandlerResource) CreateThirdPartyAccountStatus
 
 	addResponse, httpResp, err := r.apiClient.AccountStatusNotificationHandlerAPI.AddAccountStatusNotificationHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Account Status Notification Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Account Status Notification Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1457,6 +1459,8 @@ func (r *accountStatusNotificationHandlerResource) Create(ctx context.Context, r
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Account Status Notification Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -1481,7 +1485,7 @@ func (r *defaultAccountStatusNotificationHandlerResource) Create(ctx context.Con
 	readResponse, httpResp, err := r.apiClient.AccountStatusNotificationHandlerAPI.GetAccountStatusNotificationHandler(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Account Status Notification Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Account Status Notification Handler", err, httpResp)
 		return
 	}
 
@@ -1522,7 +1526,7 @@ func (r *defaultAccountStatusNotificationHandlerResource) Create(ctx context.Con
 
 		updateResponse, httpResp, err := r.apiClient.AccountStatusNotificationHandlerAPI.UpdateAccountStatusNotificationHandlerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Account Status Notification Handler", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Account Status Notification Handler", err, httpResp)
 			return
 		}
 
@@ -1551,6 +1555,8 @@ func (r *defaultAccountStatusNotificationHandlerResource) Create(ctx context.Con
 		if updateResponse.ThirdPartyAccountStatusNotificationHandlerResponse != nil {
 			readThirdPartyAccountStatusNotificationHandlerResponse(ctx, updateResponse.ThirdPartyAccountStatusNotificationHandlerResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Account Status Notification Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -1659,7 +1665,7 @@ func updateAccountStatusNotificationHandler(ctx context.Context, req resource.Up
 
 		updateResponse, httpResp, err := apiClient.AccountStatusNotificationHandlerAPI.UpdateAccountStatusNotificationHandlerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Account Status Notification Handler", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Account Status Notification Handler", err, httpResp)
 			return
 		}
 
@@ -1688,6 +1694,8 @@ func updateAccountStatusNotificationHandler(ctx context.Context, req resource.Up
 		if updateResponse.ThirdPartyAccountStatusNotificationHandlerResponse != nil {
 			readThirdPartyAccountStatusNotificationHandlerResponse(ctx, updateResponse.ThirdPartyAccountStatusNotificationHandlerResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Account Status Notification Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -1735,3 +1743,16 @@ func importAccountStatusNotificationHandler(ctx context.Context, req resource.Im
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *accountStatusNotificationHandlerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_account_status_notification_handler"),
+	}
+}
+
+func (r *defaultAccountStatusNotificationHandlerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_account_status_notification_handler"),
+	}
+}
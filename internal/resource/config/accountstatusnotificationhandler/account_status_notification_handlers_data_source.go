@@ -49,29 +49,21 @@ func (r *accountStatusNotificationHandlersDataSource) Configure(_ context.Contex
 }
 
 type accountStatusNotificationHandlersDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *accountStatusNotificationHandlersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Account Status Notification Handler objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Account Status Notification Handler objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewAccountStatusNotificationHandlerDataSource(), "Account Status Notification Handler")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *accountStatusNotificationHandlersDataSource) Read(ctx context.Context,
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewAccountStatusNotificationHandlerDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.AccountStatusNotificationHandlerAPI.ListAccountStatusNotificationHandlers(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.AccountStatusNotificationHandlerAPI.ListAccountStatusNotificationHandlersExecute(listRequest)
@@ -106,41 +104,38 @@ func (r *accountStatusNotificationHandlersDataSource) Read(ctx context.Context,
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object accountStatusNotificationHandlerDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.SmtpAccountStatusNotificationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.SmtpAccountStatusNotificationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("smtp")
+			readSmtpAccountStatusNotificationHandlerResponseDataSource(ctx, response.SmtpAccountStatusNotificationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.GroovyScriptedAccountStatusNotificationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.GroovyScriptedAccountStatusNotificationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("groovy-scripted")
+			readGroovyScriptedAccountStatusNotificationHandlerResponseDataSource(ctx, response.GroovyScriptedAccountStatusNotificationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.AdminAlertAccountStatusNotificationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.AdminAlertAccountStatusNotificationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("admin-alert")
+			readAdminAlertAccountStatusNotificationHandlerResponseDataSource(ctx, response.AdminAlertAccountStatusNotificationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.ErrorLogAccountStatusNotificationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.ErrorLogAccountStatusNotificationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("error-log")
+			readErrorLogAccountStatusNotificationHandlerResponseDataSource(ctx, response.ErrorLogAccountStatusNotificationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.MultiPartEmailAccountStatusNotificationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.MultiPartEmailAccountStatusNotificationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("multi-part-email")
+			readMultiPartEmailAccountStatusNotificationHandlerResponseDataSource(ctx, response.MultiPartEmailAccountStatusNotificationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyAccountStatusNotificationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyAccountStatusNotificationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyAccountStatusNotificationHandlerResponseDataSource(ctx, response.ThirdPartyAccountStatusNotificationHandlerResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -156,7 +156,7 @@ func (r *alarmManagerResource) Create(ctx context.Context, req resource.CreateRe
 	readResponse, httpResp, err := r.apiClient.AlarmManagerAPI.GetAlarmManager(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig)).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Alarm Manager", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Alarm Manager", err, httpResp)
 		return
 	}
 
@@ -180,7 +180,7 @@ func (r *alarmManagerResource) Create(ctx context.Context, req resource.CreateRe
 
 		updateResponse, httpResp, err := r.apiClient.AlarmManagerAPI.UpdateAlarmManagerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Alarm Manager", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Alarm Manager", err, httpResp)
 			return
 		}
 
@@ -192,6 +192,8 @@ func (r *alarmManagerResource) Create(ctx context.Context, req resource.CreateRe
 
 		// Read the response
 		readAlarmManagerResponse(ctx, updateResponse, &state, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Alarm Manager", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -257,7 +259,7 @@ func (r *alarmManagerResource) Update(ctx context.Context, req resource.UpdateRe
 
 		updateResponse, httpResp, err := r.apiClient.AlarmManagerAPI.UpdateAlarmManagerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Alarm Manager", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Alarm Manager", err, httpResp)
 			return
 		}
 
@@ -269,6 +271,8 @@ func (r *alarmManagerResource) Update(ctx context.Context, req resource.UpdateRe
 
 		// Read the response
 		readAlarmManagerResponse(ctx, updateResponse, &state, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Alarm Manager", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
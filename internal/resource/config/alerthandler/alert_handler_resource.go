@@ -31,9 +31,11 @@ var (
 	_ resource.Resource                = &alertHandlerResource{}
 	_ resource.ResourceWithConfigure   = &alertHandlerResource{}
 	_ resource.ResourceWithImportState = &alertHandlerResource{}
+	_ resource.ResourceWithMoveState   = &alertHandlerResource{}
 	_ resource.Resource                = &defaultAlertHandlerResource{}
 	_ resource.ResourceWithConfigure   = &defaultAlertHandlerResource{}
 	_ resource.ResourceWithImportState = &defaultAlertHandlerResource{}
+	_ resource.ResourceWithMoveState   = &defaultAlertHandlerResource{}
 )
 
 // Create a Alert Handler resource
@@ -101,6 +103,8 @@ type alertHandlerResourceModel struct {
 	HttpProxyExternalServer           types.String `tfsdk:"http_proxy_external_server"`
 	TwilioAccountSID                  types.String `tfsdk:"twilio_account_sid"`
 	TwilioAuthToken                   types.String `tfsdk:"twilio_auth_token"`
+	TwilioAuthTokenWo                 types.String `tfsdk:"twilio_auth_token_wo"`
+	TwilioAuthTokenWoVersion          types.Int64  `tfsdk:"twilio_auth_token_wo_version"`
 	TwilioAuthTokenPassphraseProvider types.String `tfsdk:"twilio_auth_token_passphrase_provider"`
 	SenderPhoneNumber                 types.Set    `tfsdk:"sender_phone_number"`
 	RecipientPhoneNumber              types.Set    `tfsdk:"recipient_phone_number"`
@@ -136,6 +140,8 @@ type defaultAlertHandlerResourceModel struct {
 	HttpProxyExternalServer           types.String `tfsdk:"http_proxy_external_server"`
 	TwilioAccountSID                  types.String `tfsdk:"twilio_account_sid"`
 	TwilioAuthToken                   types.String `tfsdk:"twilio_auth_token"`
+	TwilioAuthTokenWo                 types.String `tfsdk:"twilio_auth_token_wo"`
+	TwilioAuthTokenWoVersion          types.Int64  `tfsdk:"twilio_auth_token_wo_version"`
 	TwilioAuthTokenPassphraseProvider types.String `tfsdk:"twilio_auth_token_passphrase_provider"`
 	SenderPhoneNumber                 types.Set    `tfsdk:"sender_phone_number"`
 	RecipientPhoneNumber              types.Set    `tfsdk:"recipient_phone_number"`
@@ -369,6 +375,7 @@ func alertHandlerSchema(ctx context.Context, req resource.SchemaRequest, resp *r
 		extensionClassAttr.PlanModifiers = append(extensionClassAttr.PlanModifiers, stringplanmodifier.RequiresReplace())
 		schemaDef.Attributes["extension_class"] = extensionClassAttr
 	}
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "twilio_auth_token")
 	config.AddCommonResourceSchema(&schemaDef, true)
 	resp.Schema = schemaDef
 }
@@ -638,6 +645,7 @@ func configValidatorsAlertHandler() []resource.ConfigValidator {
 			[]string{"twilio"},
 			resourcevalidator.ExactlyOneOf(
 				path.MatchRoot("twilio_auth_token"),
+				path.MatchRoot("twilio_auth_token_wo"),
 				path.MatchRoot("twilio_auth_token_passphrase_provider"),
 			),
 		),
@@ -706,6 +714,11 @@ func configValidatorsAlertHandler() []resource.ConfigValidator {
 			path.MatchRoot("type"),
 			[]string{"twilio"},
 		),
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("twilio_auth_token_wo"),
+			path.MatchRoot("type"),
+			[]string{"twilio"},
+		),
 		configvalidators.ImpliesOtherAttributeOneOfString(
 			path.MatchRoot("twilio_auth_token_passphrase_provider"),
 			path.MatchRoot("type"),
@@ -1057,6 +1070,9 @@ func addOptionalTwilioAlertHandlerFields(ctx context.Context, addRequest *client
 	if internaltypes.IsNonEmptyString(plan.TwilioAuthToken) {
 		addRequest.TwilioAuthToken = plan.TwilioAuthToken.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.TwilioAuthTokenWo) {
+		addRequest.TwilioAuthToken = plan.TwilioAuthTokenWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.TwilioAuthTokenPassphraseProvider) {
 		addRequest.TwilioAuthTokenPassphraseProvider = plan.TwilioAuthTokenPassphraseProvider.ValueStringPointer()
@@ -1780,12 +1796,18 @@ func (state *defaultAlertHandlerResourceModel) setStateValuesNotReturnedByAPI(ex
 	if !expectedValues.TwilioAuthToken.IsUnknown() {
 		state.TwilioAuthToken = expectedValues.TwilioAuthToken
 	}
+	if !expectedValues.TwilioAuthTokenWoVersion.IsUnknown() {
+		state.TwilioAuthTokenWoVersion = expectedValues.TwilioAuthTokenWoVersion
+	}
 }
 
 func (state *alertHandlerResourceModel) setStateValuesNotReturnedByAPI(expectedValues *alertHandlerResourceModel) {
 	if !expectedValues.TwilioAuthToken.IsUnknown() {
 		state.TwilioAuthToken = expectedValues.TwilioAuthToken
 	}
+	if !expectedValues.TwilioAuthTokenWoVersion.IsUnknown() {
+		state.TwilioAuthTokenWoVersion = expectedValues.TwilioAuthTokenWoVersion
+	}
 }
 
 // Create any update operations necessary to make the state match the plan
@@ -1799,6 +1821,7 @@ func createAlertHandlerOperations(plan alertHandlerResourceModel, state alertHan
 	operations.AddStringOperationIfNecessary(&ops, plan.HttpProxyExternalServer, state.HttpProxyExternalServer, "http-proxy-external-server")
 	operations.AddStringOperationIfNecessary(&ops, plan.TwilioAccountSID, state.TwilioAccountSID, "twilio-account-sid")
 	operations.AddStringOperationIfNecessary(&ops, plan.TwilioAuthToken, state.TwilioAuthToken, "twilio-auth-token")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.TwilioAuthTokenWo, plan.TwilioAuthTokenWoVersion, state.TwilioAuthTokenWoVersion, "twilio-auth-token")
 	operations.AddStringOperationIfNecessary(&ops, plan.TwilioAuthTokenPassphraseProvider, state.TwilioAuthTokenPassphraseProvider, "twilio-auth-token-passphrase-provider")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.SenderPhoneNumber, state.SenderPhoneNumber, "sender-phone-number")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.RecipientPhoneNumber, state.RecipientPhoneNumber, "recipient-phone-number")
@@ -1832,6 +1855,7 @@ func createAlertHandlerOperationsDefault(plan defaultAlertHandlerResourceModel,
 	operations.AddStringOperationIfNecessary(&ops, plan.HttpProxyExternalServer, state.HttpProxyExternalServer, "http-proxy-external-server")
 	operations.AddStringOperationIfNecessary(&ops, plan.TwilioAccountSID, state.TwilioAccountSID, "twilio-account-sid")
 	operations.AddStringOperationIfNecessary(&ops, plan.TwilioAuthToken, state.TwilioAuthToken, "twilio-auth-token")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.TwilioAuthTokenWo, plan.TwilioAuthTokenWoVersion, state.TwilioAuthTokenWoVersion, "twilio-auth-token")
 	operations.AddStringOperationIfNecessary(&ops, plan.TwilioAuthTokenPassphraseProvider, state.TwilioAuthTokenPassphraseProvider, "twilio-auth-token-passphrase-provider")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.SenderPhoneNumber, state.SenderPhoneNumber, "sender-phone-number")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.RecipientPhoneNumber, state.RecipientPhoneNumber, "recipient-phone-number")
@@ -1882,7 +1906,7 @@ func (r *alertHandlerResource) CreateSmtpAlertHandler(ctx context.Context, req r
 
 	addResponse, httpResp, err := r.apiClient.AlertHandlerAPI.AddAlertHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Alert Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Alert Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1920,7 +1944,7 @@ func (r *alertHandlerResource) CreateJmxAlertHandler(ctx context.Context, req re
 
 	addResponse, httpResp, err := r.apiClient.AlertHandlerAPI.AddAlertHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Alert Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Alert Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1959,7 +1983,7 @@ func (r *alertHandlerResource) CreateGroovyScriptedAlertHandler(ctx context.Cont
 
 	addResponse, httpResp, err := r.apiClient.AlertHandlerAPI.AddAlertHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Alert Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Alert Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1998,7 +2022,7 @@ func (r *alertHandlerResource) CreateSnmpAlertHandler(ctx context.Context, req r
 
 	addResponse, httpResp, err := r.apiClient.AlertHandlerAPI.AddAlertHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Alert Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Alert Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -2043,7 +2067,7 @@ func (r *alertHandlerResource) CreateTwilioAlertHandler(ctx context.Context, req
 
 	addResponse, httpResp, err := r.apiClient.AlertHandlerAPI.AddAlertHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Alert Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Alert Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -2081,7 +2105,7 @@ func (r *alertHandlerResource) CreateErrorLogAlertHandler(ctx context.Context, r
 
 	addResponse, httpResp, err := r.apiClient.AlertHandlerAPI.AddAlertHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Alert Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Alert Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -2119,7 +2143,7 @@ func (r *alertHandlerResource) CreateSnmpSubAgentAlertHandler(ctx context.Contex
 
 	addResponse, httpResp, err := r.apiClient.AlertHandlerAPI.AddAlertHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Alert Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Alert Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -2158,7 +2182,7 @@ func (r *alertHandlerResource) CreateExecAlertHandler(ctx context.Context, req r
 
 	addResponse, httpResp, err := r.apiClient.AlertHandlerAPI.AddAlertHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Alert Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Alert Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -2197,7 +2221,7 @@ func (r *alertHandlerResource) CreateThirdPartyAlertHandler(ctx context.Context,
 
 	addResponse, httpResp, err := r.apiClient.AlertHandlerAPI.AddAlertHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Alert Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Alert Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -2222,6 +2246,11 @@ func (r *alertHandlerResource) Create(ctx context.Context, req resource.CreateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("twilio_auth_token_wo"), &plan.TwilioAuthTokenWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	var state *alertHandlerResourceModel
 	var err error
@@ -2282,6 +2311,8 @@ func (r *alertHandlerResource) Create(ctx context.Context, req resource.CreateRe
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Alert Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -2302,11 +2333,16 @@ func (r *defaultAlertHandlerResource) Create(ctx context.Context, req resource.C
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("twilio_auth_token_wo"), &plan.TwilioAuthTokenWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	readResponse, httpResp, err := r.apiClient.AlertHandlerAPI.GetAlertHandler(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Alert Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Alert Handler", err, httpResp)
 		return
 	}
 
@@ -2362,7 +2398,7 @@ func (r *defaultAlertHandlerResource) Create(ctx context.Context, req resource.C
 
 		updateResponse, httpResp, err := r.apiClient.AlertHandlerAPI.UpdateAlertHandlerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Alert Handler", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Alert Handler", err, httpResp)
 			return
 		}
 
@@ -2406,6 +2442,8 @@ func (r *defaultAlertHandlerResource) Create(ctx context.Context, req resource.C
 		if updateResponse.ThirdPartyAlertHandlerResponse != nil {
 			readThirdPartyAlertHandlerResponseDefault(ctx, updateResponse.ThirdPartyAlertHandlerResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Alert Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -2522,6 +2560,11 @@ func (r *alertHandlerResource) Update(ctx context.Context, req resource.UpdateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("twilio_auth_token_wo"), &plan.TwilioAuthTokenWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Get the current state to see how any attributes are changing
 	var state alertHandlerResourceModel
@@ -2538,7 +2581,7 @@ func (r *alertHandlerResource) Update(ctx context.Context, req resource.UpdateRe
 
 		updateResponse, httpResp, err := r.apiClient.AlertHandlerAPI.UpdateAlertHandlerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Alert Handler", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Alert Handler", err, httpResp)
 			return
 		}
 
@@ -2576,6 +2619,8 @@ func (r *alertHandlerResource) Update(ctx context.Context, req resource.UpdateRe
 		if updateResponse.ThirdPartyAlertHandlerResponse != nil {
 			readThirdPartyAlertHandlerResponse(ctx, updateResponse.ThirdPartyAlertHandlerResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Alert Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -2596,6 +2641,11 @@ func (r *defaultAlertHandlerResource) Update(ctx context.Context, req resource.U
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("twilio_auth_token_wo"), &plan.TwilioAuthTokenWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Get the current state to see how any attributes are changing
 	var state defaultAlertHandlerResourceModel
@@ -2612,7 +2662,7 @@ func (r *defaultAlertHandlerResource) Update(ctx context.Context, req resource.U
 
 		updateResponse, httpResp, err := r.apiClient.AlertHandlerAPI.UpdateAlertHandlerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Alert Handler", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Alert Handler", err, httpResp)
 			return
 		}
 
@@ -2656,6 +2706,8 @@ func (r *defaultAlertHandlerResource) Update(ctx context.Context, req resource.U
 		if updateResponse.ThirdPartyAlertHandlerResponse != nil {
 			readThirdPartyAlertHandlerResponseDefault(ctx, updateResponse.ThirdPartyAlertHandlerResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Alert Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -2704,3 +2756,16 @@ func importAlertHandler(ctx context.Context, req resource.ImportStateRequest, re
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *alertHandlerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_alert_handler"),
+	}
+}
+
+func (r *defaultAlertHandlerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_alert_handler"),
+	}
+}
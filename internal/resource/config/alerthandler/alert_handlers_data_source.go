@@ -49,29 +49,21 @@ func (r *alertHandlersDataSource) Configure(_ context.Context, req datasource.Co
 }
 
 type alertHandlersDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *alertHandlersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Alert Handler objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Alert Handler objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewAlertHandlerDataSource(), "Alert Handler")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *alertHandlersDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewAlertHandlerDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.AlertHandlerAPI.ListAlertHandlers(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.AlertHandlerAPI.ListAlertHandlersExecute(listRequest)
@@ -106,61 +104,53 @@ func (r *alertHandlersDataSource) Read(ctx context.Context, req datasource.ReadR
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object alertHandlerDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.OutputAlertHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.OutputAlertHandlerResponse.Id)
-			attributes["type"] = types.StringValue("output")
+			readOutputAlertHandlerResponseDataSource(ctx, response.OutputAlertHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.SmtpAlertHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.SmtpAlertHandlerResponse.Id)
-			attributes["type"] = types.StringValue("smtp")
+			readSmtpAlertHandlerResponseDataSource(ctx, response.SmtpAlertHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.JmxAlertHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.JmxAlertHandlerResponse.Id)
-			attributes["type"] = types.StringValue("jmx")
+			readJmxAlertHandlerResponseDataSource(ctx, response.JmxAlertHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.GroovyScriptedAlertHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.GroovyScriptedAlertHandlerResponse.Id)
-			attributes["type"] = types.StringValue("groovy-scripted")
+			readGroovyScriptedAlertHandlerResponseDataSource(ctx, response.GroovyScriptedAlertHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.CustomAlertHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.CustomAlertHandlerResponse.Id)
-			attributes["type"] = types.StringValue("custom")
+			readCustomAlertHandlerResponseDataSource(ctx, response.CustomAlertHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.SnmpAlertHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.SnmpAlertHandlerResponse.Id)
-			attributes["type"] = types.StringValue("snmp")
+			readSnmpAlertHandlerResponseDataSource(ctx, response.SnmpAlertHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.TwilioAlertHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.TwilioAlertHandlerResponse.Id)
-			attributes["type"] = types.StringValue("twilio")
+			readTwilioAlertHandlerResponseDataSource(ctx, response.TwilioAlertHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.ErrorLogAlertHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.ErrorLogAlertHandlerResponse.Id)
-			attributes["type"] = types.StringValue("error-log")
+			readErrorLogAlertHandlerResponseDataSource(ctx, response.ErrorLogAlertHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.SnmpSubAgentAlertHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.SnmpSubAgentAlertHandlerResponse.Id)
-			attributes["type"] = types.StringValue("snmp-sub-agent")
+			readSnmpSubAgentAlertHandlerResponseDataSource(ctx, response.SnmpSubAgentAlertHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.ExecAlertHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.ExecAlertHandlerResponse.Id)
-			attributes["type"] = types.StringValue("exec")
+			readExecAlertHandlerResponseDataSource(ctx, response.ExecAlertHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyAlertHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyAlertHandlerResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyAlertHandlerResponseDataSource(ctx, response.ThirdPartyAlertHandlerResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
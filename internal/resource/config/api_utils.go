@@ -7,9 +7,13 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"regexp"
+	"strings"
+	"unicode"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
@@ -36,17 +40,28 @@ type pingDirectoryError struct {
 	Detail  string   `json:"detail"`
 }
 
+// Schema used to check whether a property named in a Config API error has a matching attribute.
+// Implemented by the Schema field of tfsdk.Plan, tfsdk.State, and tfsdk.Config.
+type AttributeSchema interface {
+	TypeAtPath(ctx context.Context, path path.Path) (attr.Type, diag.Diagnostics)
+}
+
 // Report an HTTP error as a warning
 func ReportHttpErrorAsWarning(ctx context.Context, diagnostics *diag.Diagnostics, errorSummary string, err error, httpResp *http.Response) {
-	reportHttpResponse(ctx, diagnostics, errorSummary, err, httpResp, true)
+	reportHttpResponse(ctx, nil, diagnostics, errorSummary, err, httpResp, true)
 }
 
 // Report an HTTP error
 func ReportHttpError(ctx context.Context, diagnostics *diag.Diagnostics, errorSummary string, err error, httpResp *http.Response) {
-	reportHttpResponse(ctx, diagnostics, errorSummary, err, httpResp, false)
+	reportHttpResponse(ctx, nil, diagnostics, errorSummary, err, httpResp, false)
+}
+
+// Report an HTTP error, attaching it to any attributes of the given schema that are named in the error detail
+func ReportHttpErrorForSchema(ctx context.Context, schema AttributeSchema, diagnostics *diag.Diagnostics, errorSummary string, err error, httpResp *http.Response) {
+	reportHttpResponse(ctx, schema, diagnostics, errorSummary, err, httpResp, false)
 }
 
-func reportHttpResponse(ctx context.Context, diagnostics *diag.Diagnostics, errorSummary string, err error, httpResp *http.Response, isWarning bool) {
+func reportHttpResponse(ctx context.Context, schema AttributeSchema, diagnostics *diag.Diagnostics, errorSummary string, err error, httpResp *http.Response, isWarning bool) {
 	httpErrorPrinted := false
 	var internalError error
 	if httpResp != nil {
@@ -56,11 +71,7 @@ func reportHttpResponse(ctx context.Context, diagnostics *diag.Diagnostics, erro
 			var pdError pingDirectoryError
 			internalError = json.Unmarshal(body, &pdError)
 			if internalError == nil {
-				if isWarning {
-					diagnostics.AddWarning(errorSummary, err.Error()+" - Detail: "+pdError.Detail)
-				} else {
-					diagnostics.AddError(errorSummary, err.Error()+" - Detail: "+pdError.Detail)
-				}
+				reportDetail(ctx, schema, diagnostics, errorSummary, err.Error()+" - Detail: "+pdError.Detail, pdError.Detail, isWarning)
 				httpErrorPrinted = true
 			}
 		}
@@ -77,6 +88,78 @@ func reportHttpResponse(ctx context.Context, diagnostics *diag.Diagnostics, erro
 	}
 }
 
+// Matches property names in Config API error messages, such as "The value 'x' is not valid for property 'lookthrough-limit'"
+var propertyInDetailRegex = regexp.MustCompile(`(?i)\bproperty\s+['"]([A-Za-z][A-Za-z0-9-]*)['"]`)
+
+// Report the detail of a Config API error. If the detail names any properties that have a matching attribute in
+// the schema, the diagnostic is attached to those attributes so that Terraform can point at the offending
+// configuration. Properties without an attribute, or a nil schema, result in a plain diagnostic.
+func reportDetail(ctx context.Context, schema AttributeSchema, diagnostics *diag.Diagnostics, errorSummary, errorDetail, pdDetail string, isWarning bool) {
+	var attributePaths []path.Path
+	if schema != nil {
+		for _, attributePath := range PropertyAttributePaths(pdDetail) {
+			_, diags := schema.TypeAtPath(ctx, attributePath)
+			if !diags.HasError() {
+				attributePaths = append(attributePaths, attributePath)
+			}
+		}
+	}
+	if len(attributePaths) == 0 {
+		if isWarning {
+			diagnostics.AddWarning(errorSummary, errorDetail)
+		} else {
+			diagnostics.AddError(errorSummary, errorDetail)
+		}
+		return
+	}
+
+	for _, attributePath := range attributePaths {
+		if isWarning {
+			diagnostics.AddAttributeWarning(attributePath, errorSummary, errorDetail)
+		} else {
+			diagnostics.AddAttributeError(attributePath, errorSummary, errorDetail)
+		}
+	}
+}
+
+// Get the attribute paths for any properties named in a Config API error detail
+func PropertyAttributePaths(detail string) []path.Path {
+	var attributePaths []path.Path
+	seen := map[string]bool{}
+	for _, match := range propertyInDetailRegex.FindAllStringSubmatch(detail, -1) {
+		attributeName := PropertyToAttributeName(match[1])
+		if !seen[attributeName] {
+			seen[attributeName] = true
+			attributePaths = append(attributePaths, path.Root(attributeName))
+		}
+	}
+	return attributePaths
+}
+
+// Convert a Config API property name, either hyphenated ("lookthrough-limit") or in the camel case used
+// in JSON ("lookthroughLimit"), to the corresponding schema attribute name ("lookthrough_limit"). Runs of
+// capitals are treated as acronyms, so "bindDNPattern" becomes "bind_dn_pattern".
+func PropertyToAttributeName(property string) string {
+	var attributeName strings.Builder
+	runes := []rune(property)
+	for i, r := range runes {
+		switch {
+		case r == '-':
+			attributeName.WriteRune('_')
+		case unicode.IsUpper(r):
+			previousIsUpper := i > 0 && unicode.IsUpper(runes[i-1])
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if i > 0 && runes[i-1] != '-' && (!previousIsUpper || nextIsLower) {
+				attributeName.WriteRune('_')
+			}
+			attributeName.WriteRune(unicode.ToLower(r))
+		default:
+			attributeName.WriteRune(r)
+		}
+	}
+	return attributeName.String()
+}
+
 // Write out messages from the Config API response to tflog
 func logMessages(ctx context.Context, messages *client.MetaUrnPingidentitySchemasConfigurationMessages20, diagnostics *diag.Diagnostics) {
 	if messages == nil {
@@ -91,8 +174,9 @@ func logMessages(ctx context.Context, messages *client.MetaUrnPingidentitySchema
 	for _, action := range messages.RequiredActions {
 		actionJson, err := action.MarshalJSON()
 		if err == nil {
+			// Required actions are reported as diagnostics based on the provider required_actions_policy,
+			// see HandleRequiredActions
 			tflog.Warn(ctx, "Configuration API RequiredAction: "+string(actionJson))
-			diagnostics.AddWarning("Configuration API RequiredAction", string(actionJson))
 		}
 	}
 }
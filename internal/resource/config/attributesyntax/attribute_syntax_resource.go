@@ -434,7 +434,7 @@ func (r *attributeSyntaxResource) Create(ctx context.Context, req resource.Creat
 	readResponse, httpResp, err := r.apiClient.AttributeSyntaxAPI.GetAttributeSyntax(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Attribute Syntax", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Attribute Syntax", err, httpResp)
 		return
 	}
 
@@ -502,7 +502,7 @@ func (r *attributeSyntaxResource) Create(ctx context.Context, req resource.Creat
 
 		updateResponse, httpResp, err := r.apiClient.AttributeSyntaxAPI.UpdateAttributeSyntaxExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Attribute Syntax", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Attribute Syntax", err, httpResp)
 			return
 		}
 
@@ -558,6 +558,8 @@ func (r *attributeSyntaxResource) Create(ctx context.Context, req resource.Creat
 		if updateResponse.NameAndOptionalUidAttributeSyntaxResponse != nil {
 			readNameAndOptionalUidAttributeSyntaxResponse(ctx, updateResponse.NameAndOptionalUidAttributeSyntaxResponse, &state, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Attribute Syntax", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -667,7 +669,7 @@ func (r *attributeSyntaxResource) Update(ctx context.Context, req resource.Updat
 
 		updateResponse, httpResp, err := r.apiClient.AttributeSyntaxAPI.UpdateAttributeSyntaxExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Attribute Syntax", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Attribute Syntax", err, httpResp)
 			return
 		}
 
@@ -723,6 +725,8 @@ func (r *attributeSyntaxResource) Update(ctx context.Context, req resource.Updat
 		if updateResponse.NameAndOptionalUidAttributeSyntaxResponse != nil {
 			readNameAndOptionalUidAttributeSyntaxResponse(ctx, updateResponse.NameAndOptionalUidAttributeSyntaxResponse, &state, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Attribute Syntax", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -49,29 +49,21 @@ func (r *attributeSyntaxesDataSource) Configure(_ context.Context, req datasourc
 }
 
 type attributeSyntaxesDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *attributeSyntaxesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Attribute Syntax objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Attribute Syntax objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewAttributeSyntaxDataSource(), "Attribute Syntax")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *attributeSyntaxesDataSource) Read(ctx context.Context, req datasource.R
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewAttributeSyntaxDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.AttributeSyntaxAPI.ListAttributeSyntaxes(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.AttributeSyntaxAPI.ListAttributeSyntaxesExecute(listRequest)
@@ -106,77 +104,65 @@ func (r *attributeSyntaxesDataSource) Read(ctx context.Context, req datasource.R
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object attributeSyntaxDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.AttributeTypeDescriptionAttributeSyntaxResponse != nil {
-			attributes["id"] = types.StringValue(response.AttributeTypeDescriptionAttributeSyntaxResponse.Id)
-			attributes["type"] = types.StringValue("attribute-type-description")
+			readAttributeTypeDescriptionAttributeSyntaxResponseDataSource(ctx, response.AttributeTypeDescriptionAttributeSyntaxResponse, &object, &resp.Diagnostics)
 		}
 		if response.DirectoryStringAttributeSyntaxResponse != nil {
-			attributes["id"] = types.StringValue(response.DirectoryStringAttributeSyntaxResponse.Id)
-			attributes["type"] = types.StringValue("directory-string")
+			readDirectoryStringAttributeSyntaxResponseDataSource(ctx, response.DirectoryStringAttributeSyntaxResponse, &object, &resp.Diagnostics)
 		}
 		if response.TelephoneNumberAttributeSyntaxResponse != nil {
-			attributes["id"] = types.StringValue(response.TelephoneNumberAttributeSyntaxResponse.Id)
-			attributes["type"] = types.StringValue("telephone-number")
+			readTelephoneNumberAttributeSyntaxResponseDataSource(ctx, response.TelephoneNumberAttributeSyntaxResponse, &object, &resp.Diagnostics)
 		}
 		if response.DistinguishedNameAttributeSyntaxResponse != nil {
-			attributes["id"] = types.StringValue(response.DistinguishedNameAttributeSyntaxResponse.Id)
-			attributes["type"] = types.StringValue("distinguished-name")
+			readDistinguishedNameAttributeSyntaxResponseDataSource(ctx, response.DistinguishedNameAttributeSyntaxResponse, &object, &resp.Diagnostics)
 		}
 		if response.GeneralizedTimeAttributeSyntaxResponse != nil {
-			attributes["id"] = types.StringValue(response.GeneralizedTimeAttributeSyntaxResponse.Id)
-			attributes["type"] = types.StringValue("generalized-time")
+			readGeneralizedTimeAttributeSyntaxResponseDataSource(ctx, response.GeneralizedTimeAttributeSyntaxResponse, &object, &resp.Diagnostics)
 		}
 		if response.IntegerAttributeSyntaxResponse != nil {
-			attributes["id"] = types.StringValue(response.IntegerAttributeSyntaxResponse.Id)
-			attributes["type"] = types.StringValue("integer")
+			readIntegerAttributeSyntaxResponseDataSource(ctx, response.IntegerAttributeSyntaxResponse, &object, &resp.Diagnostics)
 		}
 		if response.UuidAttributeSyntaxResponse != nil {
-			attributes["id"] = types.StringValue(response.UuidAttributeSyntaxResponse.Id)
-			attributes["type"] = types.StringValue("uuid")
+			readUuidAttributeSyntaxResponseDataSource(ctx, response.UuidAttributeSyntaxResponse, &object, &resp.Diagnostics)
 		}
 		if response.GenericAttributeSyntaxResponse != nil {
-			attributes["id"] = types.StringValue(response.GenericAttributeSyntaxResponse.Id)
-			attributes["type"] = types.StringValue("generic")
+			readGenericAttributeSyntaxResponseDataSource(ctx, response.GenericAttributeSyntaxResponse, &object, &resp.Diagnostics)
 		}
 		if response.JsonObjectAttributeSyntaxResponse != nil {
-			attributes["id"] = types.StringValue(response.JsonObjectAttributeSyntaxResponse.Id)
-			attributes["type"] = types.StringValue("json-object")
+			readJsonObjectAttributeSyntaxResponseDataSource(ctx, response.JsonObjectAttributeSyntaxResponse, &object, &resp.Diagnostics)
 		}
 		if response.UserPasswordAttributeSyntaxResponse != nil {
-			attributes["id"] = types.StringValue(response.UserPasswordAttributeSyntaxResponse.Id)
-			attributes["type"] = types.StringValue("user-password")
+			readUserPasswordAttributeSyntaxResponseDataSource(ctx, response.UserPasswordAttributeSyntaxResponse, &object, &resp.Diagnostics)
 		}
 		if response.BooleanAttributeSyntaxResponse != nil {
-			attributes["id"] = types.StringValue(response.BooleanAttributeSyntaxResponse.Id)
-			attributes["type"] = types.StringValue("boolean")
+			readBooleanAttributeSyntaxResponseDataSource(ctx, response.BooleanAttributeSyntaxResponse, &object, &resp.Diagnostics)
 		}
 		if response.HexStringAttributeSyntaxResponse != nil {
-			attributes["id"] = types.StringValue(response.HexStringAttributeSyntaxResponse.Id)
-			attributes["type"] = types.StringValue("hex-string")
+			readHexStringAttributeSyntaxResponseDataSource(ctx, response.HexStringAttributeSyntaxResponse, &object, &resp.Diagnostics)
 		}
 		if response.BitStringAttributeSyntaxResponse != nil {
-			attributes["id"] = types.StringValue(response.BitStringAttributeSyntaxResponse.Id)
-			attributes["type"] = types.StringValue("bit-string")
+			readBitStringAttributeSyntaxResponseDataSource(ctx, response.BitStringAttributeSyntaxResponse, &object, &resp.Diagnostics)
 		}
 		if response.LdapUrlAttributeSyntaxResponse != nil {
-			attributes["id"] = types.StringValue(response.LdapUrlAttributeSyntaxResponse.Id)
-			attributes["type"] = types.StringValue("ldap-url")
+			readLdapUrlAttributeSyntaxResponseDataSource(ctx, response.LdapUrlAttributeSyntaxResponse, &object, &resp.Diagnostics)
 		}
 		if response.NameAndOptionalUidAttributeSyntaxResponse != nil {
-			attributes["id"] = types.StringValue(response.NameAndOptionalUidAttributeSyntaxResponse.Id)
-			attributes["type"] = types.StringValue("name-and-optional-uid")
+			readNameAndOptionalUidAttributeSyntaxResponseDataSource(ctx, response.NameAndOptionalUidAttributeSyntaxResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
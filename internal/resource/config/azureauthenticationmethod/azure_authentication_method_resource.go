@@ -5,6 +5,7 @@ package azureauthenticationmethod
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -27,9 +28,11 @@ var (
 	_ resource.Resource                = &azureAuthenticationMethodResource{}
 	_ resource.ResourceWithConfigure   = &azureAuthenticationMethodResource{}
 	_ resource.ResourceWithImportState = &azureAuthenticationMethodResource{}
+	_ resource.ResourceWithMoveState   = &azureAuthenticationMethodResource{}
 	_ resource.Resource                = &defaultAzureAuthenticationMethodResource{}
 	_ resource.ResourceWithConfigure   = &defaultAzureAuthenticationMethodResource{}
 	_ resource.ResourceWithImportState = &defaultAzureAuthenticationMethodResource{}
+	_ resource.ResourceWithMoveState   = &defaultAzureAuthenticationMethodResource{}
 )
 
 // Create a Azure Authentication Method resource
@@ -84,17 +87,21 @@ func (r *defaultAzureAuthenticationMethodResource) Configure(_ context.Context,
 }
 
 type azureAuthenticationMethodResourceModel struct {
-	Id              types.String `tfsdk:"id"`
-	Name            types.String `tfsdk:"name"`
-	Notifications   types.Set    `tfsdk:"notifications"`
-	RequiredActions types.Set    `tfsdk:"required_actions"`
-	Type            types.String `tfsdk:"type"`
-	TenantID        types.String `tfsdk:"tenant_id"`
-	ClientID        types.String `tfsdk:"client_id"`
-	Username        types.String `tfsdk:"username"`
-	Password        types.String `tfsdk:"password"`
-	ClientSecret    types.String `tfsdk:"client_secret"`
-	Description     types.String `tfsdk:"description"`
+	Id                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Notifications         types.Set    `tfsdk:"notifications"`
+	RequiredActions       types.Set    `tfsdk:"required_actions"`
+	Type                  types.String `tfsdk:"type"`
+	TenantID              types.String `tfsdk:"tenant_id"`
+	ClientID              types.String `tfsdk:"client_id"`
+	Username              types.String `tfsdk:"username"`
+	Password              types.String `tfsdk:"password"`
+	PasswordWo            types.String `tfsdk:"password_wo"`
+	PasswordWoVersion     types.Int64  `tfsdk:"password_wo_version"`
+	ClientSecret          types.String `tfsdk:"client_secret"`
+	ClientSecretWo        types.String `tfsdk:"client_secret_wo"`
+	ClientSecretWoVersion types.Int64  `tfsdk:"client_secret_wo_version"`
+	Description           types.String `tfsdk:"description"`
 }
 
 // GetSchema defines the schema for the resource.
@@ -162,6 +169,8 @@ func azureAuthenticationMethodSchema(ctx context.Context, req resource.SchemaReq
 		// Add any default properties and set optional properties to computed where necessary
 		config.SetAttributesToOptionalAndComputedAndRemoveDefaults(&schemaDef, []string{"type"})
 	}
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "password")
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "client_secret")
 	config.AddCommonResourceSchema(&schemaDef, true)
 	resp.Schema = schemaDef
 }
@@ -174,6 +183,11 @@ func configValidatorsAzureAuthenticationMethod() []resource.ConfigValidator {
 			path.MatchRoot("type"),
 			[]string{"client-secret"},
 		),
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("client_secret_wo"),
+			path.MatchRoot("type"),
+			[]string{"client-secret"},
+		),
 		configvalidators.ImpliesOtherAttributeOneOfString(
 			path.MatchRoot("username"),
 			path.MatchRoot("type"),
@@ -184,15 +198,36 @@ func configValidatorsAzureAuthenticationMethod() []resource.ConfigValidator {
 			path.MatchRoot("type"),
 			[]string{"username-password"},
 		),
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("password_wo"),
+			path.MatchRoot("type"),
+			[]string{"username-password"},
+		),
 		configvalidators.ValueImpliesAttributeRequired(
 			path.MatchRoot("type"),
 			"client-secret",
-			[]path.Expression{path.MatchRoot("tenant_id"), path.MatchRoot("client_id"), path.MatchRoot("client_secret")},
+			[]path.Expression{path.MatchRoot("tenant_id"), path.MatchRoot("client_id")},
+		),
+		configvalidators.ImpliesOtherValidator(
+			path.MatchRoot("type"),
+			[]string{"client-secret"},
+			resourcevalidator.ExactlyOneOf(
+				path.MatchRoot("client_secret"),
+				path.MatchRoot("client_secret_wo"),
+			),
 		),
 		configvalidators.ValueImpliesAttributeRequired(
 			path.MatchRoot("type"),
 			"username-password",
-			[]path.Expression{path.MatchRoot("tenant_id"), path.MatchRoot("client_id"), path.MatchRoot("username"), path.MatchRoot("password")},
+			[]path.Expression{path.MatchRoot("tenant_id"), path.MatchRoot("client_id"), path.MatchRoot("username")},
+		),
+		configvalidators.ImpliesOtherValidator(
+			path.MatchRoot("type"),
+			[]string{"username-password"},
+			resourcevalidator.ExactlyOneOf(
+				path.MatchRoot("password"),
+				path.MatchRoot("password_wo"),
+			),
 		),
 	}
 }
@@ -301,9 +336,15 @@ func (state *azureAuthenticationMethodResourceModel) setStateValuesNotReturnedBy
 	if !expectedValues.ClientSecret.IsUnknown() {
 		state.ClientSecret = expectedValues.ClientSecret
 	}
+	if !expectedValues.ClientSecretWoVersion.IsUnknown() {
+		state.ClientSecretWoVersion = expectedValues.ClientSecretWoVersion
+	}
 	if !expectedValues.Password.IsUnknown() {
 		state.Password = expectedValues.Password
 	}
+	if !expectedValues.PasswordWoVersion.IsUnknown() {
+		state.PasswordWoVersion = expectedValues.PasswordWoVersion
+	}
 }
 
 // Create any update operations necessary to make the state match the plan
@@ -313,7 +354,9 @@ func createAzureAuthenticationMethodOperations(plan azureAuthenticationMethodRes
 	operations.AddStringOperationIfNecessary(&ops, plan.ClientID, state.ClientID, "client-id")
 	operations.AddStringOperationIfNecessary(&ops, plan.Username, state.Username, "username")
 	operations.AddStringOperationIfNecessary(&ops, plan.Password, state.Password, "password")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.PasswordWo, plan.PasswordWoVersion, state.PasswordWoVersion, "password")
 	operations.AddStringOperationIfNecessary(&ops, plan.ClientSecret, state.ClientSecret, "client-secret")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.ClientSecretWo, plan.ClientSecretWoVersion, state.ClientSecretWoVersion, "client-secret")
 	operations.AddStringOperationIfNecessary(&ops, plan.Description, state.Description, "description")
 	return ops
 }
@@ -335,7 +378,7 @@ func (r *azureAuthenticationMethodResource) CreateDefaultAzureAuthenticationMeth
 
 	addResponse, httpResp, err := r.apiClient.AzureAuthenticationMethodAPI.AddAzureAuthenticationMethodExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Azure Authentication Method", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Azure Authentication Method", err, httpResp)
 		return nil, err
 	}
 
@@ -353,10 +396,14 @@ func (r *azureAuthenticationMethodResource) CreateDefaultAzureAuthenticationMeth
 
 // Create a client-secret azure-authentication-method
 func (r *azureAuthenticationMethodResource) CreateClientSecretAzureAuthenticationMethod(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse, plan azureAuthenticationMethodResourceModel) (*azureAuthenticationMethodResourceModel, error) {
+	clientSecret := plan.ClientSecret.ValueString()
+	if internaltypes.IsNonEmptyString(plan.ClientSecretWo) {
+		clientSecret = plan.ClientSecretWo.ValueString()
+	}
 	addRequest := client.NewAddClientSecretAzureAuthenticationMethodRequest([]client.EnumclientSecretAzureAuthenticationMethodSchemaUrn{client.ENUMCLIENTSECRETAZUREAUTHENTICATIONMETHODSCHEMAURN_URNPINGIDENTITYSCHEMASCONFIGURATION2_0AZURE_AUTHENTICATION_METHODCLIENT_SECRET},
 		plan.TenantID.ValueString(),
 		plan.ClientID.ValueString(),
-		plan.ClientSecret.ValueString(),
+		clientSecret,
 		plan.Name.ValueString())
 	addOptionalClientSecretAzureAuthenticationMethodFields(ctx, addRequest, plan)
 	// Log request JSON
@@ -371,7 +418,7 @@ func (r *azureAuthenticationMethodResource) CreateClientSecretAzureAuthenticatio
 
 	addResponse, httpResp, err := r.apiClient.AzureAuthenticationMethodAPI.AddAzureAuthenticationMethodExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Azure Authentication Method", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Azure Authentication Method", err, httpResp)
 		return nil, err
 	}
 
@@ -389,11 +436,15 @@ func (r *azureAuthenticationMethodResource) CreateClientSecretAzureAuthenticatio
 
 // Create a username-password azure-authentication-method
 func (r *azureAuthenticationMethodResource) CreateUsernamePasswordAzureAuthenticationMethod(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse, plan azureAuthenticationMethodResourceModel) (*azureAuthenticationMethodResourceModel, error) {
+	password := plan.Password.ValueString()
+	if internaltypes.IsNonEmptyString(plan.PasswordWo) {
+		password = plan.PasswordWo.ValueString()
+	}
 	addRequest := client.NewAddUsernamePasswordAzureAuthenticationMethodRequest([]client.EnumusernamePasswordAzureAuthenticationMethodSchemaUrn{client.ENUMUSERNAMEPASSWORDAZUREAUTHENTICATIONMETHODSCHEMAURN_URNPINGIDENTITYSCHEMASCONFIGURATION2_0AZURE_AUTHENTICATION_METHODUSERNAME_PASSWORD},
 		plan.TenantID.ValueString(),
 		plan.ClientID.ValueString(),
 		plan.Username.ValueString(),
-		plan.Password.ValueString(),
+		password,
 		plan.Name.ValueString())
 	addOptionalUsernamePasswordAzureAuthenticationMethodFields(ctx, addRequest, plan)
 	// Log request JSON
@@ -408,7 +459,7 @@ func (r *azureAuthenticationMethodResource) CreateUsernamePasswordAzureAuthentic
 
 	addResponse, httpResp, err := r.apiClient.AzureAuthenticationMethodAPI.AddAzureAuthenticationMethodExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Azure Authentication Method", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Azure Authentication Method", err, httpResp)
 		return nil, err
 	}
 
@@ -433,6 +484,12 @@ func (r *azureAuthenticationMethodResource) Create(ctx context.Context, req reso
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("client_secret_wo"), &plan.ClientSecretWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	var state *azureAuthenticationMethodResourceModel
 	var err error
@@ -457,6 +514,8 @@ func (r *azureAuthenticationMethodResource) Create(ctx context.Context, req reso
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Azure Authentication Method", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -477,11 +536,17 @@ func (r *defaultAzureAuthenticationMethodResource) Create(ctx context.Context, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("client_secret_wo"), &plan.ClientSecretWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	readResponse, httpResp, err := r.apiClient.AzureAuthenticationMethodAPI.GetAzureAuthenticationMethod(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Azure Authentication Method", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Azure Authentication Method", err, httpResp)
 		return
 	}
 
@@ -513,7 +578,7 @@ func (r *defaultAzureAuthenticationMethodResource) Create(ctx context.Context, r
 
 		updateResponse, httpResp, err := r.apiClient.AzureAuthenticationMethodAPI.UpdateAzureAuthenticationMethodExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Azure Authentication Method", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Azure Authentication Method", err, httpResp)
 			return
 		}
 
@@ -533,6 +598,8 @@ func (r *defaultAzureAuthenticationMethodResource) Create(ctx context.Context, r
 		if updateResponse.UsernamePasswordAzureAuthenticationMethodResponse != nil {
 			readUsernamePasswordAzureAuthenticationMethodResponse(ctx, updateResponse.UsernamePasswordAzureAuthenticationMethodResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Azure Authentication Method", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -617,6 +684,12 @@ func updateAzureAuthenticationMethod(ctx context.Context, req resource.UpdateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("client_secret_wo"), &plan.ClientSecretWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Get the current state to see how any attributes are changing
 	var state azureAuthenticationMethodResourceModel
@@ -633,7 +706,7 @@ func updateAzureAuthenticationMethod(ctx context.Context, req resource.UpdateReq
 
 		updateResponse, httpResp, err := apiClient.AzureAuthenticationMethodAPI.UpdateAzureAuthenticationMethodExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Azure Authentication Method", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Azure Authentication Method", err, httpResp)
 			return
 		}
 
@@ -653,6 +726,8 @@ func updateAzureAuthenticationMethod(ctx context.Context, req resource.UpdateReq
 		if updateResponse.UsernamePasswordAzureAuthenticationMethodResponse != nil {
 			readUsernamePasswordAzureAuthenticationMethodResponse(ctx, updateResponse.UsernamePasswordAzureAuthenticationMethodResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Azure Authentication Method", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -701,3 +776,16 @@ func importAzureAuthenticationMethod(ctx context.Context, req resource.ImportSta
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *azureAuthenticationMethodResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_azure_authentication_method"),
+	}
+}
+
+func (r *defaultAzureAuthenticationMethodResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_azure_authentication_method"),
+	}
+}
@@ -32,9 +32,11 @@ var (
 	_ resource.Resource                = &backendResource{}
 	_ resource.ResourceWithConfigure   = &backendResource{}
 	_ resource.ResourceWithImportState = &backendResource{}
+	_ resource.ResourceWithMoveState   = &backendResource{}
 	_ resource.Resource                = &defaultBackendResource{}
 	_ resource.ResourceWithConfigure   = &defaultBackendResource{}
 	_ resource.ResourceWithImportState = &defaultBackendResource{}
+	_ resource.ResourceWithMoveState   = &defaultBackendResource{}
 )
 
 // Create a Backend resource
@@ -261,6 +263,8 @@ type defaultBackendResourceModel struct {
 	TrustStoreFile                              types.String `tfsdk:"trust_store_file"`
 	TrustStoreType                              types.String `tfsdk:"trust_store_type"`
 	TrustStorePin                               types.String `tfsdk:"trust_store_pin"`
+	TrustStorePinWo                             types.String `tfsdk:"trust_store_pin_wo"`
+	TrustStorePinWoVersion                      types.Int64  `tfsdk:"trust_store_pin_wo_version"`
 	TrustStorePinFile                           types.String `tfsdk:"trust_store_pin_file"`
 	TrustStorePinPassphraseProvider             types.String `tfsdk:"trust_store_pin_passphrase_provider"`
 	BaseDN                                      types.Set    `tfsdk:"base_dn"`
@@ -868,6 +872,9 @@ func backendSchema(ctx context.Context, req resource.SchemaRequest, resp *resour
 		backendIdAttr.PlanModifiers = append(backendIdAttr.PlanModifiers, stringplanmodifier.RequiresReplace())
 		schemaDef.Attributes["backend_id"] = backendIdAttr
 	}
+	if isDefault {
+		config.AddWriteOnlyAttributeSchema(&schemaDef, "trust_store_pin")
+	}
 	config.AddCommonResourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -1498,6 +1505,11 @@ func (r defaultBackendResource) ConfigValidators(ctx context.Context) []resource
 			path.MatchRoot("type"),
 			[]string{"trust-store"},
 		),
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("trust_store_pin_wo"),
+			path.MatchRoot("type"),
+			[]string{"trust-store"},
+		),
 		configvalidators.ImpliesOtherAttributeOneOfString(
 			path.MatchRoot("trust_store_pin_file"),
 			path.MatchRoot("type"),
@@ -2594,6 +2606,9 @@ func (state *defaultBackendResourceModel) setStateValuesNotReturnedByAPI(expecte
 	if !expectedValues.TrustStorePin.IsUnknown() {
 		state.TrustStorePin = expectedValues.TrustStorePin
 	}
+	if !expectedValues.TrustStorePinWoVersion.IsUnknown() {
+		state.TrustStorePinWoVersion = expectedValues.TrustStorePinWoVersion
+	}
 }
 
 // Create any update operations necessary to make the state match the plan
@@ -2766,6 +2781,7 @@ func createBackendOperationsDefault(plan defaultBackendResourceModel, state defa
 	operations.AddStringOperationIfNecessary(&ops, plan.TrustStoreFile, state.TrustStoreFile, "trust-store-file")
 	operations.AddStringOperationIfNecessary(&ops, plan.TrustStoreType, state.TrustStoreType, "trust-store-type")
 	operations.AddStringOperationIfNecessary(&ops, plan.TrustStorePin, state.TrustStorePin, "trust-store-pin")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.TrustStorePinWo, plan.TrustStorePinWoVersion, state.TrustStorePinWoVersion, "trust-store-pin")
 	operations.AddStringOperationIfNecessary(&ops, plan.TrustStorePinFile, state.TrustStorePinFile, "trust-store-pin-file")
 	operations.AddStringOperationIfNecessary(&ops, plan.TrustStorePinPassphraseProvider, state.TrustStorePinPassphraseProvider, "trust-store-pin-passphrase-provider")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.BaseDN, state.BaseDN, "base-dn")
@@ -2808,7 +2824,7 @@ func (r *backendResource) CreateLocalDbBackend(ctx context.Context, req resource
 
 	addResponse, httpResp, err := r.apiClient.BackendAPI.AddBackendExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Backend", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Backend", err, httpResp)
 		return nil, err
 	}
 
@@ -2839,6 +2855,8 @@ func (r *backendResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Backend", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -2859,11 +2877,16 @@ func (r *defaultBackendResource) Create(ctx context.Context, req resource.Create
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("trust_store_pin_wo"), &plan.TrustStorePinWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	readResponse, httpResp, err := r.apiClient.BackendAPI.GetBackend(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.BackendID.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Backend", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Backend", err, httpResp)
 		return
 	}
 
@@ -2928,7 +2951,7 @@ func (r *defaultBackendResource) Create(ctx context.Context, req resource.Create
 
 		updateResponse, httpResp, err := r.apiClient.BackendAPI.UpdateBackendExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Backend", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Backend", err, httpResp)
 			return
 		}
 
@@ -2981,6 +3004,8 @@ func (r *defaultBackendResource) Create(ctx context.Context, req resource.Create
 		if updateResponse.MetricsBackendResponse != nil {
 			readMetricsBackendResponseDefault(ctx, updateResponse.MetricsBackendResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Backend", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -3122,7 +3147,7 @@ func (r *backendResource) Update(ctx context.Context, req resource.UpdateRequest
 
 		updateResponse, httpResp, err := r.apiClient.BackendAPI.UpdateBackendExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Backend", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Backend", err, httpResp)
 			return
 		}
 
@@ -3136,6 +3161,8 @@ func (r *backendResource) Update(ctx context.Context, req resource.UpdateRequest
 		if updateResponse.LocalDbBackendResponse != nil {
 			readLocalDbBackendResponse(ctx, updateResponse.LocalDbBackendResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Backend", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -3155,6 +3182,11 @@ func (r *defaultBackendResource) Update(ctx context.Context, req resource.Update
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("trust_store_pin_wo"), &plan.TrustStorePinWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Get the current state to see how any attributes are changing
 	var state defaultBackendResourceModel
@@ -3171,7 +3203,7 @@ func (r *defaultBackendResource) Update(ctx context.Context, req resource.Update
 
 		updateResponse, httpResp, err := r.apiClient.BackendAPI.UpdateBackendExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Backend", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Backend", err, httpResp)
 			return
 		}
 
@@ -3224,6 +3256,8 @@ func (r *defaultBackendResource) Update(ctx context.Context, req resource.Update
 		if updateResponse.MetricsBackendResponse != nil {
 			readMetricsBackendResponseDefault(ctx, updateResponse.MetricsBackendResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Backend", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -3271,3 +3305,16 @@ func importBackend(ctx context.Context, req resource.ImportStateRequest, resp *r
 	// Retrieve import ID and save to backend_id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("backend_id"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *backendResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_backend"),
+	}
+}
+
+func (r *defaultBackendResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_backend"),
+	}
+}
@@ -49,29 +49,21 @@ func (r *backendsDataSource) Configure(_ context.Context, req datasource.Configu
 }
 
 type backendsDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *backendsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Backend objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Backend objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewBackendDataSource(), "Backend")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *backendsDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewBackendDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.BackendAPI.ListBackends(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.BackendAPI.ListBackendsExecute(listRequest)
@@ -106,89 +104,78 @@ func (r *backendsDataSource) Read(ctx context.Context, req datasource.ReadReques
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object backendDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.SchemaBackendResponse != nil {
-			attributes["id"] = types.StringValue(response.SchemaBackendResponse.Id)
-			attributes["type"] = types.StringValue("schema")
+			readSchemaBackendResponseDataSource(ctx, response.SchemaBackendResponse, &object, &resp.Diagnostics)
 		}
 		if response.BackupBackendResponse != nil {
-			attributes["id"] = types.StringValue(response.BackupBackendResponse.Id)
-			attributes["type"] = types.StringValue("backup")
+			readBackupBackendResponseDataSource(ctx, response.BackupBackendResponse, &object, &resp.Diagnostics)
 		}
 		if response.MemoryBackendResponse != nil {
-			attributes["id"] = types.StringValue(response.MemoryBackendResponse.Id)
-			attributes["type"] = types.StringValue("memory")
+			object.Id = types.StringValue(response.MemoryBackendResponse.Id)
+			object.Type = types.StringValue("memory")
 		}
 		if response.EncryptionSettingsBackendResponse != nil {
-			attributes["id"] = types.StringValue(response.EncryptionSettingsBackendResponse.Id)
-			attributes["type"] = types.StringValue("encryption-settings")
+			readEncryptionSettingsBackendResponseDataSource(ctx, response.EncryptionSettingsBackendResponse, &object, &resp.Diagnostics)
 		}
 		if response.LdifBackendResponse != nil {
-			attributes["id"] = types.StringValue(response.LdifBackendResponse.Id)
-			attributes["type"] = types.StringValue("ldif")
+			readLdifBackendResponseDataSource(ctx, response.LdifBackendResponse, &object, &resp.Diagnostics)
 		}
 		if response.TrustStoreBackendResponse != nil {
-			attributes["id"] = types.StringValue(response.TrustStoreBackendResponse.Id)
-			attributes["type"] = types.StringValue("trust-store")
+			readTrustStoreBackendResponseDataSource(ctx, response.TrustStoreBackendResponse, &object, &resp.Diagnostics)
 		}
 		if response.CustomBackendResponse != nil {
-			attributes["id"] = types.StringValue(response.CustomBackendResponse.Id)
-			attributes["type"] = types.StringValue("custom")
+			readCustomBackendResponseDataSource(ctx, response.CustomBackendResponse, &object, &resp.Diagnostics)
 		}
 		if response.ChangelogBackendResponse != nil {
-			attributes["id"] = types.StringValue(response.ChangelogBackendResponse.Id)
-			attributes["type"] = types.StringValue("changelog")
+			readChangelogBackendResponseDataSource(ctx, response.ChangelogBackendResponse, &object, &resp.Diagnostics)
 		}
 		if response.MonitorBackendResponse != nil {
-			attributes["id"] = types.StringValue(response.MonitorBackendResponse.Id)
-			attributes["type"] = types.StringValue("monitor")
+			readMonitorBackendResponseDataSource(ctx, response.MonitorBackendResponse, &object, &resp.Diagnostics)
 		}
 		if response.LocalDbBackendResponse != nil {
-			attributes["id"] = types.StringValue(response.LocalDbBackendResponse.Id)
-			attributes["type"] = types.StringValue("local-db")
+			readLocalDbBackendResponseDataSource(ctx, response.LocalDbBackendResponse, &object, &resp.Diagnostics)
 		}
 		if response.MirroredLdifBackendResponse != nil {
-			attributes["id"] = types.StringValue(response.MirroredLdifBackendResponse.Id)
-			attributes["type"] = types.StringValue("mirrored-ldif")
+			object.Id = types.StringValue(response.MirroredLdifBackendResponse.Id)
+			object.Type = types.StringValue("mirrored-ldif")
 		}
 		if response.ConfigFileHandlerBackendResponse != nil {
-			attributes["id"] = types.StringValue(response.ConfigFileHandlerBackendResponse.Id)
-			attributes["type"] = types.StringValue("config-file-handler")
+			readConfigFileHandlerBackendResponseDataSource(ctx, response.ConfigFileHandlerBackendResponse, &object, &resp.Diagnostics)
 		}
 		if response.TaskBackendResponse != nil {
-			attributes["id"] = types.StringValue(response.TaskBackendResponse.Id)
-			attributes["type"] = types.StringValue("task")
+			readTaskBackendResponseDataSource(ctx, response.TaskBackendResponse, &object, &resp.Diagnostics)
 		}
 		if response.AlertBackendResponse != nil {
-			attributes["id"] = types.StringValue(response.AlertBackendResponse.Id)
-			attributes["type"] = types.StringValue("alert")
+			readAlertBackendResponseDataSource(ctx, response.AlertBackendResponse, &object, &resp.Diagnostics)
 		}
 		if response.AlarmBackendResponse != nil {
-			attributes["id"] = types.StringValue(response.AlarmBackendResponse.Id)
-			attributes["type"] = types.StringValue("alarm")
+			readAlarmBackendResponseDataSource(ctx, response.AlarmBackendResponse, &object, &resp.Diagnostics)
 		}
 		if response.MetricsBackendResponse != nil {
-			attributes["id"] = types.StringValue(response.MetricsBackendResponse.Id)
-			attributes["type"] = types.StringValue("metrics")
+			readMetricsBackendResponseDataSource(ctx, response.MetricsBackendResponse, &object, &resp.Diagnostics)
 		}
 		if response.LargeAttributeBackendResponse != nil {
-			attributes["id"] = types.StringValue(response.LargeAttributeBackendResponse.Id)
-			attributes["type"] = types.StringValue("large-attribute")
+			object.Id = types.StringValue(response.LargeAttributeBackendResponse.Id)
+			object.Type = types.StringValue("large-attribute")
 		}
 		if response.CannedResponseBackendResponse != nil {
-			attributes["id"] = types.StringValue(response.CannedResponseBackendResponse.Id)
-			attributes["type"] = types.StringValue("canned-response")
+			object.Id = types.StringValue(response.CannedResponseBackendResponse.Id)
+			object.Type = types.StringValue("canned-response")
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
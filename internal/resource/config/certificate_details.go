@@ -0,0 +1,325 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package config
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Number of days before a certificate expires at which a warning is shown during plan, when not configured
+const defaultExpirationWarningDays = 30
+
+// Get attrtype map for the parsed details of an X.509 certificate
+func getCertificateDetailsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"subject":                   types.StringType,
+		"issuer":                    types.StringType,
+		"serial_number":             types.StringType,
+		"subject_alternative_names": types.ListType{ElemType: types.StringType},
+		"key_algorithm":             types.StringType,
+		"key_size":                  types.Int64Type,
+		"not_before":                types.StringType,
+		"not_after":                 types.StringType,
+		"sha1_fingerprint":          types.StringType,
+		"sha256_fingerprint":        types.StringType,
+	}
+}
+
+func GetCertificateDetailsObjectType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: getCertificateDetailsAttrTypes(),
+	}
+}
+
+// Descriptions of the parsed certificate detail attributes, shared by the resource and data source schemas
+var certificateDetailsDescriptions = map[string]string{
+	"subject":                   "The subject DN of the certificate.",
+	"issuer":                    "The issuer DN of the certificate.",
+	"serial_number":             "The serial number of the certificate, as colon-separated hex.",
+	"subject_alternative_names": "The DNS names, IP addresses, email addresses, and URIs in the subject alternative name extension.",
+	"key_algorithm":             "The algorithm of the certificate's public key, such as RSA, ECDSA, or Ed25519.",
+	"key_size":                  "The size of the certificate's public key in bits.",
+	"not_before":                "The time the certificate becomes valid, in RFC 3339 format.",
+	"not_after":                 "The time the certificate expires, in RFC 3339 format.",
+	"sha1_fingerprint":          "The SHA-1 fingerprint of the DER-encoded certificate, as colon-separated hex.",
+	"sha256_fingerprint":        "The SHA-256 fingerprint of the DER-encoded certificate, as colon-separated hex.",
+}
+
+// Get the schema attributes describing a parsed certificate, for use in a nested data source attribute
+func GetCertificateDetailsDataSourceAttributes() map[string]datasourceschema.Attribute {
+	attributes := map[string]datasourceschema.Attribute{}
+	for name, description := range certificateDetailsDescriptions {
+		switch name {
+		case "subject_alternative_names":
+			attributes[name] = datasourceschema.ListAttribute{
+				Description: description,
+				ElementType: types.StringType,
+				Computed:    true,
+			}
+		case "key_size":
+			attributes[name] = datasourceschema.Int64Attribute{
+				Description: description,
+				Computed:    true,
+			}
+		default:
+			attributes[name] = datasourceschema.StringAttribute{
+				Description: description,
+				Computed:    true,
+			}
+		}
+	}
+	return attributes
+}
+
+func getCertificateDetailsResourceAttributes() map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{}
+	for name, description := range certificateDetailsDescriptions {
+		switch name {
+		case "subject_alternative_names":
+			attributes[name] = schema.ListAttribute{
+				Description: description,
+				ElementType: types.StringType,
+				Computed:    true,
+			}
+		case "key_size":
+			attributes[name] = schema.Int64Attribute{
+				Description: description,
+				Computed:    true,
+			}
+		default:
+			attributes[name] = schema.StringAttribute{
+				Description: description,
+				Computed:    true,
+			}
+		}
+	}
+	return attributes
+}
+
+// Add computed attributes with the parsed details of the PEM-encoded certificate in the given attribute, along with
+// the expiration_warning_days attribute that controls the plan-time expiration warning. The chain completeness
+// attribute is only added for attributes that can hold a full certificate chain.
+func AddCertificateDetailsSchema(s *schema.Schema, certificateAttributeName string, addChainCompleteAttribute bool) {
+	s.Attributes["certificate_details"] = schema.SingleNestedAttribute{
+		Description: "Details parsed from the first certificate in `" + certificateAttributeName + "`.",
+		Attributes:  getCertificateDetailsResourceAttributes(),
+		Computed:    true,
+	}
+	if addChainCompleteAttribute {
+		s.Attributes["certificate_chain_complete"] = schema.BoolAttribute{
+			Description: "Whether each certificate in `" + certificateAttributeName + "` is signed by the next one, ending with a self-signed root certificate.",
+			Computed:    true,
+		}
+	}
+	s.Attributes["expiration_warning_days"] = schema.Int64Attribute{
+		Description: fmt.Sprintf("Number of days before a certificate in `%s` expires at which Terraform shows a warning during plan. Defaults to %d. Set to 0 to only warn about expired certificates.", certificateAttributeName, defaultExpirationWarningDays),
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+	}
+}
+
+// Add computed attributes with the parsed details of the PEM-encoded certificate in the given attribute
+func AddCertificateDetailsDataSourceSchema(s *datasourceschema.Schema, certificateAttributeName string, addChainCompleteAttribute bool) {
+	s.Attributes["certificate_details"] = datasourceschema.SingleNestedAttribute{
+		Description: "Details parsed from the first certificate in `" + certificateAttributeName + "`.",
+		Attributes:  GetCertificateDetailsDataSourceAttributes(),
+		Computed:    true,
+	}
+	if addChainCompleteAttribute {
+		s.Attributes["certificate_chain_complete"] = datasourceschema.BoolAttribute{
+			Description: "Whether each certificate in `" + certificateAttributeName + "` is signed by the next one, ending with a self-signed root certificate.",
+			Computed:    true,
+		}
+	}
+}
+
+// Parse all certificates from a PEM-encoded value. Blocks that aren't certificates are ignored.
+func ParsePEMCertificates(certificatePem string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := []byte(certificatePem)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("no PEM-encoded certificates found")
+	}
+	return certs, nil
+}
+
+// Format bytes as lowercase colon-separated hex, like 0a:1b:2c
+func colonSeparatedHex(value []byte) string {
+	encoded := hex.EncodeToString(value)
+	var pairs []string
+	for i := 0; i < len(encoded); i += 2 {
+		pairs = append(pairs, encoded[i:i+2])
+	}
+	return strings.Join(pairs, ":")
+}
+
+// Get the size in bits of a certificate's public key, or nil if the key type isn't recognized
+func publicKeySize(cert *x509.Certificate) *int64 {
+	var size int64
+	switch publicKey := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		size = int64(publicKey.N.BitLen())
+	case *ecdsa.PublicKey:
+		size = int64(publicKey.Curve.Params().BitSize)
+	case ed25519.PublicKey:
+		size = int64(len(publicKey) * 8)
+	default:
+		return nil
+	}
+	return &size
+}
+
+// Get an object with the parsed details of a certificate
+func CertificateDetailsObject(cert *x509.Certificate) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var subjectAlternativeNames []string
+	subjectAlternativeNames = append(subjectAlternativeNames, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		subjectAlternativeNames = append(subjectAlternativeNames, ip.String())
+	}
+	subjectAlternativeNames = append(subjectAlternativeNames, cert.EmailAddresses...)
+	for _, uri := range cert.URIs {
+		subjectAlternativeNames = append(subjectAlternativeNames, uri.String())
+	}
+	sanList, listDiags := types.ListValueFrom(context.Background(), types.StringType, subjectAlternativeNames)
+	diags.Append(listDiags...)
+
+	keySize := types.Int64Null()
+	if size := publicKeySize(cert); size != nil {
+		keySize = types.Int64Value(*size)
+	}
+	sha1Sum := sha1.Sum(cert.Raw)
+	sha256Sum := sha256.Sum256(cert.Raw)
+
+	details, objDiags := types.ObjectValue(getCertificateDetailsAttrTypes(), map[string]attr.Value{
+		"subject":                   types.StringValue(cert.Subject.String()),
+		"issuer":                    types.StringValue(cert.Issuer.String()),
+		"serial_number":             types.StringValue(colonSeparatedHex(cert.SerialNumber.Bytes())),
+		"subject_alternative_names": sanList,
+		"key_algorithm":             types.StringValue(cert.PublicKeyAlgorithm.String()),
+		"key_size":                  keySize,
+		"not_before":                types.StringValue(cert.NotBefore.UTC().Format(time.RFC3339)),
+		"not_after":                 types.StringValue(cert.NotAfter.UTC().Format(time.RFC3339)),
+		"sha1_fingerprint":          types.StringValue(colonSeparatedHex(sha1Sum[:])),
+		"sha256_fingerprint":        types.StringValue(colonSeparatedHex(sha256Sum[:])),
+	})
+	diags.Append(objDiags...)
+	return details, diags
+}
+
+// Determine if each certificate is signed by the next one, with the last being a self-signed root certificate
+func CertificateChainComplete(certs []*x509.Certificate) bool {
+	if len(certs) == 0 {
+		return false
+	}
+	for i := 0; i < len(certs)-1; i++ {
+		if certs[i].CheckSignatureFrom(certs[i+1]) != nil {
+			return false
+		}
+	}
+	root := certs[len(certs)-1]
+	return bytes.Equal(root.RawIssuer, root.RawSubject) &&
+		root.CheckSignature(root.SignatureAlgorithm, root.RawTBSCertificate, root.Signature) == nil
+}
+
+// Read the parsed details of the first certificate in a PEM-encoded value, along with whether the certificates form a
+// complete chain. Null values are returned when there is no certificate to parse.
+func ReadCertificateDetails(ctx context.Context, certificatePem *string, diagnostics *diag.Diagnostics) (types.Object, types.Bool) {
+	if certificatePem == nil || *certificatePem == "" {
+		return types.ObjectNull(getCertificateDetailsAttrTypes()), types.BoolNull()
+	}
+	certs, err := ParsePEMCertificates(*certificatePem)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to parse certificate: "+err.Error())
+		return types.ObjectNull(getCertificateDetailsAttrTypes()), types.BoolNull()
+	}
+	details, diags := CertificateDetailsObject(certs[0])
+	diagnostics.Append(diags...)
+	return details, types.BoolValue(CertificateChainComplete(certs))
+}
+
+// Set the parsed certificate details in the plan when the certificate is known, and warn about any certificate that
+// has expired or expires within the configured expiration_warning_days. When the certificate won't be known until
+// apply, the details are left unknown.
+func PlanCertificateDetails(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, certificateAttributeName string, addChainCompleteAttribute bool) {
+	// Nothing to do when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var certificatePem types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root(certificateAttributeName), &certificatePem)...)
+	if resp.Diagnostics.HasError() || certificatePem.IsUnknown() {
+		return
+	}
+
+	details, chainComplete := ReadCertificateDetails(ctx, certificatePem.ValueStringPointer(), &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("certificate_details"), details)...)
+	if addChainCompleteAttribute {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("certificate_chain_complete"), chainComplete)...)
+	}
+	if details.IsNull() {
+		return
+	}
+
+	var warningDays types.Int64
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("expiration_warning_days"), &warningDays)...)
+	warningPeriod := time.Duration(defaultExpirationWarningDays) * 24 * time.Hour
+	if internaltypes.IsDefined(warningDays) {
+		warningPeriod = time.Duration(warningDays.ValueInt64()) * 24 * time.Hour
+	}
+	certs, _ := ParsePEMCertificates(certificatePem.ValueString())
+	now := time.Now()
+	for _, cert := range certs {
+		notAfter := cert.NotAfter.UTC().Format(time.RFC3339)
+		if now.After(cert.NotAfter) {
+			resp.Diagnostics.AddAttributeWarning(path.Root(certificateAttributeName), "Certificate has expired",
+				"The certificate with subject '"+cert.Subject.String()+"' expired at "+notAfter+".")
+		} else if now.Add(warningPeriod).After(cert.NotAfter) {
+			resp.Diagnostics.AddAttributeWarning(path.Root(certificateAttributeName), "Certificate expires soon",
+				fmt.Sprintf("The certificate with subject '%s' expires at %s, which is within %d days.", cert.Subject.String(), notAfter, int64(warningPeriod.Hours()/24)))
+		}
+	}
+}
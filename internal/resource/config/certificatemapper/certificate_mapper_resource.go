@@ -29,9 +29,11 @@ var (
 	_ resource.Resource                = &certificateMapperResource{}
 	_ resource.ResourceWithConfigure   = &certificateMapperResource{}
 	_ resource.ResourceWithImportState = &certificateMapperResource{}
+	_ resource.ResourceWithMoveState   = &certificateMapperResource{}
 	_ resource.Resource                = &defaultCertificateMapperResource{}
 	_ resource.ResourceWithConfigure   = &defaultCertificateMapperResource{}
 	_ resource.ResourceWithImportState = &defaultCertificateMapperResource{}
+	_ resource.ResourceWithMoveState   = &defaultCertificateMapperResource{}
 )
 
 // Create a Certificate Mapper resource
@@ -593,7 +595,7 @@ func (r *certificateMapperResource) CreateSubjectEqualsDnCertificateMapper(ctx c
 
 	addResponse, httpResp, err := r.apiClient.CertificateMapperAPI.AddCertificateMapperExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Certificate Mapper", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Certificate Mapper", err, httpResp)
 		return nil, err
 	}
 
@@ -627,7 +629,7 @@ func (r *certificateMapperResource) CreateSubjectDnToUserAttributeCertificateMap
 
 	addResponse, httpResp, err := r.apiClient.CertificateMapperAPI.AddCertificateMapperExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Certificate Mapper", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Certificate Mapper", err, httpResp)
 		return nil, err
 	}
 
@@ -662,7 +664,7 @@ func (r *certificateMapperResource) CreateGroovyScriptedCertificateMapper(ctx co
 
 	addResponse, httpResp, err := r.apiClient.CertificateMapperAPI.AddCertificateMapperExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Certificate Mapper", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Certificate Mapper", err, httpResp)
 		return nil, err
 	}
 
@@ -699,7 +701,7 @@ func (r *certificateMapperResource) CreateSubjectAttributeToUserAttributeCertifi
 
 	addResponse, httpResp, err := r.apiClient.CertificateMapperAPI.AddCertificateMapperExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Certificate Mapper", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Certificate Mapper", err, httpResp)
 		return nil, err
 	}
 
@@ -739,7 +741,7 @@ func (r *certificateMapperResource) CreateFingerprintCertificateMapper(ctx conte
 
 	addResponse, httpResp, err := r.apiClient.CertificateMapperAPI.AddCertificateMapperExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Certificate Mapper", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Certificate Mapper", err, httpResp)
 		return nil, err
 	}
 
@@ -774,7 +776,7 @@ func (r *certificateMapperResource) CreateThirdPartyCertificateMapper(ctx contex
 
 	addResponse, httpResp, err := r.apiClient.CertificateMapperAPI.AddCertificateMapperExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Certificate Mapper", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Certificate Mapper", err, httpResp)
 		return nil, err
 	}
 
@@ -839,6 +841,8 @@ func (r *certificateMapperResource) Create(ctx context.Context, req resource.Cre
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Certificate Mapper", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -863,7 +867,7 @@ func (r *defaultCertificateMapperResource) Create(ctx context.Context, req resou
 	readResponse, httpResp, err := r.apiClient.CertificateMapperAPI.GetCertificateMapper(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Certificate Mapper", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Certificate Mapper", err, httpResp)
 		return
 	}
 
@@ -904,7 +908,7 @@ func (r *defaultCertificateMapperResource) Create(ctx context.Context, req resou
 
 		updateResponse, httpResp, err := r.apiClient.CertificateMapperAPI.UpdateCertificateMapperExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Certificate Mapper", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Certificate Mapper", err, httpResp)
 			return
 		}
 
@@ -933,6 +937,8 @@ func (r *defaultCertificateMapperResource) Create(ctx context.Context, req resou
 		if updateResponse.ThirdPartyCertificateMapperResponse != nil {
 			readThirdPartyCertificateMapperResponse(ctx, updateResponse.ThirdPartyCertificateMapperResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Certificate Mapper", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -1041,7 +1047,7 @@ func updateCertificateMapper(ctx context.Context, req resource.UpdateRequest, re
 
 		updateResponse, httpResp, err := apiClient.CertificateMapperAPI.UpdateCertificateMapperExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Certificate Mapper", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Certificate Mapper", err, httpResp)
 			return
 		}
 
@@ -1070,6 +1076,8 @@ func updateCertificateMapper(ctx context.Context, req resource.UpdateRequest, re
 		if updateResponse.ThirdPartyCertificateMapperResponse != nil {
 			readThirdPartyCertificateMapperResponse(ctx, updateResponse.ThirdPartyCertificateMapperResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Certificate Mapper", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -1117,3 +1125,16 @@ func importCertificateMapper(ctx context.Context, req resource.ImportStateReques
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *certificateMapperResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_certificate_mapper"),
+	}
+}
+
+func (r *defaultCertificateMapperResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_certificate_mapper"),
+	}
+}
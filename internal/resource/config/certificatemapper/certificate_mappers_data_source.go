@@ -49,29 +49,21 @@ func (r *certificateMappersDataSource) Configure(_ context.Context, req datasour
 }
 
 type certificateMappersDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *certificateMappersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Certificate Mapper objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Certificate Mapper objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewCertificateMapperDataSource(), "Certificate Mapper")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *certificateMappersDataSource) Read(ctx context.Context, req datasource.
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewCertificateMapperDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.CertificateMapperAPI.ListCertificateMappers(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.CertificateMapperAPI.ListCertificateMappersExecute(listRequest)
@@ -106,41 +104,38 @@ func (r *certificateMappersDataSource) Read(ctx context.Context, req datasource.
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object certificateMapperDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.SubjectEqualsDnCertificateMapperResponse != nil {
-			attributes["id"] = types.StringValue(response.SubjectEqualsDnCertificateMapperResponse.Id)
-			attributes["type"] = types.StringValue("subject-equals-dn")
+			readSubjectEqualsDnCertificateMapperResponseDataSource(ctx, response.SubjectEqualsDnCertificateMapperResponse, &object, &resp.Diagnostics)
 		}
 		if response.SubjectDnToUserAttributeCertificateMapperResponse != nil {
-			attributes["id"] = types.StringValue(response.SubjectDnToUserAttributeCertificateMapperResponse.Id)
-			attributes["type"] = types.StringValue("subject-dn-to-user-attribute")
+			readSubjectDnToUserAttributeCertificateMapperResponseDataSource(ctx, response.SubjectDnToUserAttributeCertificateMapperResponse, &object, &resp.Diagnostics)
 		}
 		if response.GroovyScriptedCertificateMapperResponse != nil {
-			attributes["id"] = types.StringValue(response.GroovyScriptedCertificateMapperResponse.Id)
-			attributes["type"] = types.StringValue("groovy-scripted")
+			readGroovyScriptedCertificateMapperResponseDataSource(ctx, response.GroovyScriptedCertificateMapperResponse, &object, &resp.Diagnostics)
 		}
 		if response.SubjectAttributeToUserAttributeCertificateMapperResponse != nil {
-			attributes["id"] = types.StringValue(response.SubjectAttributeToUserAttributeCertificateMapperResponse.Id)
-			attributes["type"] = types.StringValue("subject-attribute-to-user-attribute")
+			readSubjectAttributeToUserAttributeCertificateMapperResponseDataSource(ctx, response.SubjectAttributeToUserAttributeCertificateMapperResponse, &object, &resp.Diagnostics)
 		}
 		if response.FingerprintCertificateMapperResponse != nil {
-			attributes["id"] = types.StringValue(response.FingerprintCertificateMapperResponse.Id)
-			attributes["type"] = types.StringValue("fingerprint")
+			readFingerprintCertificateMapperResponseDataSource(ctx, response.FingerprintCertificateMapperResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyCertificateMapperResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyCertificateMapperResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyCertificateMapperResponseDataSource(ctx, response.ThirdPartyCertificateMapperResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -27,9 +27,11 @@ var (
 	_ resource.Resource                = &changeSubscriptionResource{}
 	_ resource.ResourceWithConfigure   = &changeSubscriptionResource{}
 	_ resource.ResourceWithImportState = &changeSubscriptionResource{}
+	_ resource.ResourceWithMoveState   = &changeSubscriptionResource{}
 	_ resource.Resource                = &defaultChangeSubscriptionResource{}
 	_ resource.ResourceWithConfigure   = &defaultChangeSubscriptionResource{}
 	_ resource.ResourceWithImportState = &defaultChangeSubscriptionResource{}
+	_ resource.ResourceWithMoveState   = &defaultChangeSubscriptionResource{}
 )
 
 // Create a Change Subscription resource
@@ -238,7 +240,7 @@ func (r *changeSubscriptionResource) CreateChangeSubscription(ctx context.Contex
 
 	addResponse, httpResp, err := r.apiClient.ChangeSubscriptionAPI.AddChangeSubscriptionExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Change Subscription", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Change Subscription", err, httpResp)
 		return nil, err
 	}
 
@@ -269,6 +271,8 @@ func (r *changeSubscriptionResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Change Subscription", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -293,7 +297,7 @@ func (r *defaultChangeSubscriptionResource) Create(ctx context.Context, req reso
 	readResponse, httpResp, err := r.apiClient.ChangeSubscriptionAPI.GetChangeSubscription(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Change Subscription", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Change Subscription", err, httpResp)
 		return
 	}
 
@@ -317,7 +321,7 @@ func (r *defaultChangeSubscriptionResource) Create(ctx context.Context, req reso
 
 		updateResponse, httpResp, err := r.apiClient.ChangeSubscriptionAPI.UpdateChangeSubscriptionExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Change Subscription", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Change Subscription", err, httpResp)
 			return
 		}
 
@@ -329,6 +333,8 @@ func (r *defaultChangeSubscriptionResource) Create(ctx context.Context, req reso
 
 		// Read the response
 		readChangeSubscriptionResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Change Subscription", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -420,7 +426,7 @@ func updateChangeSubscription(ctx context.Context, req resource.UpdateRequest, r
 
 		updateResponse, httpResp, err := apiClient.ChangeSubscriptionAPI.UpdateChangeSubscriptionExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Change Subscription", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Change Subscription", err, httpResp)
 			return
 		}
 
@@ -432,6 +438,8 @@ func updateChangeSubscription(ctx context.Context, req resource.UpdateRequest, r
 
 		// Read the response
 		readChangeSubscriptionResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Change Subscription", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -479,3 +487,16 @@ func importChangeSubscription(ctx context.Context, req resource.ImportStateReque
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *changeSubscriptionResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_change_subscription"),
+	}
+}
+
+func (r *defaultChangeSubscriptionResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_change_subscription"),
+	}
+}
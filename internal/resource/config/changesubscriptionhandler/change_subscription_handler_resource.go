@@ -28,9 +28,11 @@ var (
 	_ resource.Resource                = &changeSubscriptionHandlerResource{}
 	_ resource.ResourceWithConfigure   = &changeSubscriptionHandlerResource{}
 	_ resource.ResourceWithImportState = &changeSubscriptionHandlerResource{}
+	_ resource.ResourceWithMoveState   = &changeSubscriptionHandlerResource{}
 	_ resource.Resource                = &defaultChangeSubscriptionHandlerResource{}
 	_ resource.ResourceWithConfigure   = &defaultChangeSubscriptionHandlerResource{}
 	_ resource.ResourceWithImportState = &defaultChangeSubscriptionHandlerResource{}
+	_ resource.ResourceWithMoveState   = &defaultChangeSubscriptionHandlerResource{}
 )
 
 // Create a Change Subscription Handler resource
@@ -428,7 +430,7 @@ func (r *changeSubscriptionHandlerResource) CreateGroovyScriptedChangeSubscripti
 
 	addResponse, httpResp, err := r.apiClient.ChangeSubscriptionHandlerAPI.AddChangeSubscriptionHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Change Subscription Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Change Subscription Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -462,7 +464,7 @@ func (r *changeSubscriptionHandlerResource) CreateLoggingChangeSubscriptionHandl
 
 	addResponse, httpResp, err := r.apiClient.ChangeSubscriptionHandlerAPI.AddChangeSubscriptionHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Change Subscription Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Change Subscription Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -497,7 +499,7 @@ func (r *changeSubscriptionHandlerResource) CreateThirdPartyChangeSubscriptionHa
 
 	addResponse, httpResp, err := r.apiClient.ChangeSubscriptionHandlerAPI.AddChangeSubscriptionHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Change Subscription Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Change Subscription Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -544,6 +546,8 @@ func (r *changeSubscriptionHandlerResource) Create(ctx context.Context, req reso
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Change Subscription Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -568,7 +572,7 @@ func (r *defaultChangeSubscriptionHandlerResource) Create(ctx context.Context, r
 	readResponse, httpResp, err := r.apiClient.ChangeSubscriptionHandlerAPI.GetChangeSubscriptionHandler(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Change Subscription Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Change Subscription Handler", err, httpResp)
 		return
 	}
 
@@ -600,7 +604,7 @@ func (r *defaultChangeSubscriptionHandlerResource) Create(ctx context.Context, r
 
 		updateResponse, httpResp, err := r.apiClient.ChangeSubscriptionHandlerAPI.UpdateChangeSubscriptionHandlerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Change Subscription Handler", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Change Subscription Handler", err, httpResp)
 			return
 		}
 
@@ -620,6 +624,8 @@ func (r *defaultChangeSubscriptionHandlerResource) Create(ctx context.Context, r
 		if updateResponse.ThirdPartyChangeSubscriptionHandlerResponse != nil {
 			readThirdPartyChangeSubscriptionHandlerResponse(ctx, updateResponse.ThirdPartyChangeSubscriptionHandlerResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Change Subscription Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -719,7 +725,7 @@ func updateChangeSubscriptionHandler(ctx context.Context, req resource.UpdateReq
 
 		updateResponse, httpResp, err := apiClient.ChangeSubscriptionHandlerAPI.UpdateChangeSubscriptionHandlerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Change Subscription Handler", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Change Subscription Handler", err, httpResp)
 			return
 		}
 
@@ -739,6 +745,8 @@ func updateChangeSubscriptionHandler(ctx context.Context, req resource.UpdateReq
 		if updateResponse.ThirdPartyChangeSubscriptionHandlerResponse != nil {
 			readThirdPartyChangeSubscriptionHandlerResponse(ctx, updateResponse.ThirdPartyChangeSubscriptionHandlerResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Change Subscription Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -786,3 +794,16 @@ func importChangeSubscriptionHandler(ctx context.Context, req resource.ImportSta
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *changeSubscriptionHandlerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_change_subscription_handler"),
+	}
+}
+
+func (r *defaultChangeSubscriptionHandlerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_change_subscription_handler"),
+	}
+}
@@ -203,7 +203,7 @@ func (r *cipherSecretKeyResource) Create(ctx context.Context, req resource.Creat
 	readResponse, httpResp, err := r.apiClient.CipherSecretKeyAPI.GetCipherSecretKey(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString(), plan.ServerInstanceName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Cipher Secret Key", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Cipher Secret Key", err, httpResp)
 		return
 	}
 
@@ -227,7 +227,7 @@ func (r *cipherSecretKeyResource) Create(ctx context.Context, req resource.Creat
 
 		updateResponse, httpResp, err := r.apiClient.CipherSecretKeyAPI.UpdateCipherSecretKeyExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Cipher Secret Key", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Cipher Secret Key", err, httpResp)
 			return
 		}
 
@@ -239,6 +239,8 @@ func (r *cipherSecretKeyResource) Create(ctx context.Context, req resource.Creat
 
 		// Read the response
 		readCipherSecretKeyResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Cipher Secret Key", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -305,7 +307,7 @@ func (r *cipherSecretKeyResource) Update(ctx context.Context, req resource.Updat
 
 		updateResponse, httpResp, err := r.apiClient.CipherSecretKeyAPI.UpdateCipherSecretKeyExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Cipher Secret Key", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Cipher Secret Key", err, httpResp)
 			return
 		}
 
@@ -317,6 +319,8 @@ func (r *cipherSecretKeyResource) Update(ctx context.Context, req resource.Updat
 
 		// Read the response
 		readCipherSecretKeyResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Cipher Secret Key", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -0,0 +1,417 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package ciphersecretkey
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/operations"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &cipherSecretKeyRotationResource{}
+	_ resource.ResourceWithConfigure  = &cipherSecretKeyRotationResource{}
+	_ resource.ResourceWithModifyPlan = &cipherSecretKeyRotationResource{}
+)
+
+// How often to check whether the crypto manager has generated and distributed a new key
+const rotationPollInterval = 2 * time.Second
+
+// Create a Cipher Secret Key Rotation resource
+func NewCipherSecretKeyRotationResource() resource.Resource {
+	return &cipherSecretKeyRotationResource{}
+}
+
+// cipherSecretKeyRotationResource is the resource implementation.
+type cipherSecretKeyRotationResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the resource type name.
+func (r *cipherSecretKeyRotationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cipher_secret_key_rotation"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cipherSecretKeyRotationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+type cipherSecretKeyRotationResourceModel struct {
+	Id                       types.String `tfsdk:"id"`
+	ServerInstanceName       types.String `tfsdk:"server_instance_name"`
+	CipherTransformationName types.String `tfsdk:"cipher_transformation_name"`
+	KeyLengthBits            types.Int64  `tfsdk:"key_length_bits"`
+	RotationTrigger          types.String `tfsdk:"rotation_trigger"`
+	WaitForDistribution      types.Bool   `tfsdk:"wait_for_distribution"`
+	TimeoutSeconds           types.Int64  `tfsdk:"timeout_seconds"`
+	KeyID                    types.String `tfsdk:"key_id"`
+	KeyName                  types.String `tfsdk:"key_name"`
+	PreviousKeyID            types.String `tfsdk:"previous_key_id"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *cipherSecretKeyRotationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rotates a Cipher Secret Key on purpose through an apply. Changing `rotation_trigger` marks the current key as compromised, waits for the crypto manager to generate and distribute a new key, and reports the new `key_id`. Creating this resource records the current key without rotating it, and destroying it leaves the keys unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The name of the Server Instance.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_instance_name": schema.StringAttribute{
+				Description: "Name of the Server Instance whose Cipher Secret Key is rotated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cipher_transformation_name": schema.StringAttribute{
+				Description: "Only rotate the key used with this cipher transformation, such as `AES/CBC/PKCS5Padding`. Required along with `key_length_bits` when the Server Instance has more than one key that isn't compromised.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key_length_bits": schema.Int64Attribute{
+				Description: "Only rotate the key with this length in bits.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_trigger": schema.StringAttribute{
+				Description: "Any value, such as a date or a version number. Changing it rotates the key during the next apply.",
+				Optional:    true,
+			},
+			"wait_for_distribution": schema.BoolAttribute{
+				Description: "Whether to wait until the new key is encrypted for every Server Instance in the topology before the rotation is complete. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Description: "Maximum number of seconds to wait for the crypto manager to generate and distribute a new key. Defaults to 300.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(300),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"key_id": schema.StringAttribute{
+				Description: "The unique system-generated identifier of the current key.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_name": schema.StringAttribute{
+				Description: "Name of the current Cipher Secret Key config object.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_key_id": schema.StringAttribute{
+				Description: "The identifier of the key that was marked as compromised by the most recent rotation. Null until the first rotation.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Rotate the key when the rotation trigger changes. The new key isn't known until apply.
+func (r *cipherSecretKeyRotationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only existing resources are rotated, and there is nothing to do when the resource is being destroyed
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state cipherSecretKeyRotationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.RotationTrigger.Equal(state.RotationTrigger) {
+		return
+	}
+	for _, attributeName := range []string{"key_id", "key_name", "previous_key_id"} {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attributeName), types.StringUnknown())...)
+	}
+}
+
+// Determine whether a key is used with the configured cipher transformation and key length
+func (model *cipherSecretKeyRotationResourceModel) matches(key client.CipherSecretKeyResponse) bool {
+	if internaltypes.IsDefined(model.CipherTransformationName) && !strings.EqualFold(key.GetCipherTransformationName(), model.CipherTransformationName.ValueString()) {
+		return false
+	}
+	return !internaltypes.IsDefined(model.KeyLengthBits) || key.KeyLengthBits == model.KeyLengthBits.ValueInt64()
+}
+
+// Get the keys matching the configured cipher transformation and key length that aren't compromised
+func (model *cipherSecretKeyRotationResourceModel) activeKeys(keys []client.CipherSecretKeyResponse) []client.CipherSecretKeyResponse {
+	var active []client.CipherSecretKeyResponse
+	for _, key := range keys {
+		if !key.GetIsCompromised() && model.matches(key) {
+			active = append(active, key)
+		}
+	}
+	return active
+}
+
+// Describe keys for error messages, such as "abc123 (AES/CBC/PKCS5Padding, 128 bits)"
+func describeKeys(keys []client.CipherSecretKeyResponse) string {
+	var descriptions []string
+	for _, key := range keys {
+		descriptions = append(descriptions, fmt.Sprintf("%s (%s, %d bits)", key.KeyID, key.GetCipherTransformationName(), key.KeyLengthBits))
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+// List the Cipher Secret Keys of the Server Instance
+func (r *cipherSecretKeyRotationResource) listKeys(ctx context.Context, serverInstanceName string, diagnostics *diag.Diagnostics) []client.CipherSecretKeyResponse {
+	listResponse, httpResp, err := r.apiClient.CipherSecretKeyAPI.ListCipherSecretKeys(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig), serverInstanceName).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diagnostics, "An error occurred while listing the Cipher Secret Keys", err, httpResp)
+		return nil
+	}
+	return listResponse.Resources
+}
+
+// Get the single key that isn't compromised and matches the configured cipher transformation and key length
+func (model *cipherSecretKeyRotationResourceModel) currentKey(keys []client.CipherSecretKeyResponse, diagnostics *diag.Diagnostics) *client.CipherSecretKeyResponse {
+	active := model.activeKeys(keys)
+	switch len(active) {
+	case 0:
+		diagnostics.AddError("No current Cipher Secret Key found",
+			"Server Instance '"+model.ServerInstanceName.ValueString()+"' has no Cipher Secret Key that isn't compromised and matches cipher_transformation_name and key_length_bits. The crypto manager generates a key the first time data is encrypted with a cipher.")
+		return nil
+	case 1:
+		return &active[0]
+	default:
+		diagnostics.AddError("More than one current Cipher Secret Key found",
+			"Server Instance '"+model.ServerInstanceName.ValueString()+"' has more than one Cipher Secret Key that isn't compromised: "+describeKeys(active)+". Set cipher_transformation_name and key_length_bits to select the key to rotate.")
+		return nil
+	}
+}
+
+// Count the Server Instances in the topology, which each get a copy of a distributed key
+func (r *cipherSecretKeyRotationResource) countServerInstances(ctx context.Context, diagnostics *diag.Diagnostics) int {
+	listResponse, httpResp, err := r.apiClient.ServerInstanceAPI.ListServerInstances(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diagnostics, "An error occurred while listing the Server Instances", err, httpResp)
+		return 0
+	}
+	return len(listResponse.Resources)
+}
+
+// Mark the current key as compromised, then wait for the crypto manager to generate a new key with the same cipher
+// transformation and key length, and optionally for the new key to be distributed to every Server Instance. When an
+// earlier rotation already marked the recorded key as compromised, only the wait is repeated, so that a retried
+// apply doesn't rotate the key twice.
+func (r *cipherSecretKeyRotationResource) rotate(ctx context.Context, plan *cipherSecretKeyRotationResourceModel, recordedKeyID string, diagnostics *diag.Diagnostics) {
+	keys := r.listKeys(ctx, plan.ServerInstanceName.ValueString(), diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+	recordedKeyIndex := slices.IndexFunc(keys, func(key client.CipherSecretKeyResponse) bool {
+		return key.KeyID == recordedKeyID
+	})
+	var current *client.CipherSecretKeyResponse
+	if recordedKeyIndex >= 0 && keys[recordedKeyIndex].GetIsCompromised() {
+		current = &keys[recordedKeyIndex]
+	} else {
+		current = plan.currentKey(keys, diagnostics)
+		if diagnostics.HasError() {
+			return
+		}
+		r.markCompromised(ctx, plan.ServerInstanceName.ValueString(), *current, diagnostics)
+		if diagnostics.HasError() {
+			return
+		}
+	}
+
+	serverInstanceCount := 0
+	if plan.WaitForDistribution.ValueBool() {
+		serverInstanceCount = r.countServerInstances(ctx, diagnostics)
+		if diagnostics.HasError() {
+			return
+		}
+	}
+
+	deadline := time.Now().Add(time.Duration(plan.TimeoutSeconds.ValueInt64()) * time.Second)
+	for {
+		for _, key := range r.listKeys(ctx, plan.ServerInstanceName.ValueString(), diagnostics) {
+			if key.GetIsCompromised() || key.KeyID == current.KeyID || key.KeyLengthBits != current.KeyLengthBits ||
+				!strings.EqualFold(key.GetCipherTransformationName(), current.GetCipherTransformationName()) {
+				continue
+			}
+			if len(key.SymmetricKey) < serverInstanceCount {
+				tflog.Debug(ctx, fmt.Sprintf("New Cipher Secret Key %s is distributed to %d of %d Server Instances", key.KeyID, len(key.SymmetricKey), serverInstanceCount))
+				continue
+			}
+			plan.PreviousKeyID = types.StringValue(current.KeyID)
+			plan.KeyID = types.StringValue(key.KeyID)
+			plan.KeyName = types.StringValue(key.Id)
+			return
+		}
+		if diagnostics.HasError() {
+			return
+		}
+
+		if time.Now().After(deadline) {
+			diagnostics.AddError("Timed out waiting for a new Cipher Secret Key",
+				fmt.Sprintf("Cipher Secret Key %s was marked as compromised, but the crypto manager didn't generate and distribute a new key within %d seconds. Apply again to keep waiting for the new key.", current.KeyID, plan.TimeoutSeconds.ValueInt64()))
+			return
+		}
+		select {
+		case <-ctx.Done():
+			diagnostics.AddError("Timed out waiting for a new Cipher Secret Key", ctx.Err().Error())
+			return
+		case <-time.After(rotationPollInterval):
+		}
+	}
+}
+
+// Mark a key as compromised, which triggers the crypto manager to generate a new key
+func (r *cipherSecretKeyRotationResource) markCompromised(ctx context.Context, serverInstanceName string, key client.CipherSecretKeyResponse, diagnostics *diag.Diagnostics) {
+	op := client.NewOperation(client.ENUMOPERATION_REPLACE, "is-compromised")
+	op.SetValue("true")
+	ops := []client.Operation{*op}
+	// Log operations
+	operations.LogUpdateOperations(ctx, ops)
+	updateRequest := r.apiClient.CipherSecretKeyAPI.UpdateCipherSecretKey(config.ProviderBasicAuthContext(ctx, r.providerConfig), key.Id, serverInstanceName)
+	updateRequest = updateRequest.UpdateRequest(*client.NewUpdateRequest(ops))
+	_, httpResp, err := r.apiClient.CipherSecretKeyAPI.UpdateCipherSecretKeyExecute(updateRequest)
+	if err != nil {
+		config.ReportHttpError(ctx, diagnostics, "An error occurred while marking the Cipher Secret Key as compromised", err, httpResp)
+		return
+	}
+	tflog.Info(ctx, "Marked Cipher Secret Key "+key.KeyID+" as compromised")
+}
+
+// Create a new resource. The current key is recorded without rotating it.
+func (r *cipherSecretKeyRotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan cipherSecretKeyRotationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = plan.ServerInstanceName
+	plan.PreviousKeyID = types.StringNull()
+	keys := r.listKeys(ctx, plan.ServerInstanceName.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	current := plan.currentKey(keys, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.KeyID = types.StringValue(current.KeyID)
+	plan.KeyName = types.StringValue(current.Id)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information
+func (r *cipherSecretKeyRotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state cipherSecretKeyRotationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the recorded key while it exists, even when it was marked as compromised, so that an interrupted rotation
+	// only waits for the new key when it is applied again. When the recorded key was removed, record the current key
+	// if there is a single one.
+	keys := r.listKeys(ctx, state.ServerInstanceName.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	active := state.activeKeys(keys)
+	if len(active) == 1 && !slices.ContainsFunc(keys, func(key client.CipherSecretKeyResponse) bool {
+		return key.KeyID == state.KeyID.ValueString()
+	}) {
+		state.KeyID = types.StringValue(active[0].KeyID)
+		state.KeyName = types.StringValue(active[0].Id)
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update a resource, rotating the key when the rotation trigger changes
+func (r *cipherSecretKeyRotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan cipherSecretKeyRotationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the current state to find the key recorded before the rotation
+	var state cipherSecretKeyRotationResourceModel
+	req.State.Get(ctx, &state)
+
+	if plan.KeyID.IsUnknown() {
+		r.rotate(ctx, &plan, state.KeyID.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			// Keep the prior state, with the old trigger and the recorded key, so the rotation is still pending and
+			// the next apply waits for the new key instead of marking another key as compromised
+			resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the resource from the Terraform state. The Cipher Secret Keys are left unchanged.
+func (r *cipherSecretKeyRotationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No implementation necessary
+}
@@ -31,9 +31,11 @@ var (
 	_ resource.Resource                = &cipherStreamProviderResource{}
 	_ resource.ResourceWithConfigure   = &cipherStreamProviderResource{}
 	_ resource.ResourceWithImportState = &cipherStreamProviderResource{}
+	_ resource.ResourceWithMoveState   = &cipherStreamProviderResource{}
 	_ resource.Resource                = &defaultCipherStreamProviderResource{}
 	_ resource.ResourceWithConfigure   = &defaultCipherStreamProviderResource{}
 	_ resource.ResourceWithImportState = &defaultCipherStreamProviderResource{}
+	_ resource.ResourceWithMoveState   = &defaultCipherStreamProviderResource{}
 )
 
 // Create a Cipher Stream Provider resource
@@ -103,10 +105,14 @@ type cipherStreamProviderResourceModel struct {
 	VaultEncryptionMetadataFile     types.String `tfsdk:"vault_encryption_metadata_file"`
 	TrustStoreFile                  types.String `tfsdk:"trust_store_file"`
 	TrustStorePin                   types.String `tfsdk:"trust_store_pin"`
+	TrustStorePinWo                 types.String `tfsdk:"trust_store_pin_wo"`
+	TrustStorePinWoVersion          types.Int64  `tfsdk:"trust_store_pin_wo_version"`
 	TrustStoreType                  types.String `tfsdk:"trust_store_type"`
 	Pkcs11ProviderClass             types.String `tfsdk:"pkcs11_provider_class"`
 	Pkcs11ProviderConfigurationFile types.String `tfsdk:"pkcs11_provider_configuration_file"`
 	KeyStorePin                     types.String `tfsdk:"key_store_pin"`
+	KeyStorePinWo                   types.String `tfsdk:"key_store_pin_wo"`
+	KeyStorePinWoVersion            types.Int64  `tfsdk:"key_store_pin_wo_version"`
 	KeyStorePinFile                 types.String `tfsdk:"key_store_pin_file"`
 	KeyStorePinEnvironmentVariable  types.String `tfsdk:"key_store_pin_environment_variable"`
 	Pkcs11KeyStoreType              types.String `tfsdk:"pkcs11_key_store_type"`
@@ -129,6 +135,8 @@ type cipherStreamProviderResourceModel struct {
 	AwsExternalServer               types.String `tfsdk:"aws_external_server"`
 	AwsAccessKeyID                  types.String `tfsdk:"aws_access_key_id"`
 	AwsSecretAccessKey              types.String `tfsdk:"aws_secret_access_key"`
+	AwsSecretAccessKeyWo            types.String `tfsdk:"aws_secret_access_key_wo"`
+	AwsSecretAccessKeyWoVersion     types.Int64  `tfsdk:"aws_secret_access_key_wo_version"`
 	AwsRegionName                   types.String `tfsdk:"aws_region_name"`
 	KmsEncryptionKeyArn             types.String `tfsdk:"kms_encryption_key_arn"`
 	IterationCount                  types.Int64  `tfsdk:"iteration_count"`
@@ -405,6 +413,9 @@ func cipherStreamProviderSchema(ctx context.Context, req resource.SchemaRequest,
 		extensionClassAttr.PlanModifiers = append(extensionClassAttr.PlanModifiers, stringplanmodifier.RequiresReplace())
 		schemaDef.Attributes["extension_class"] = extensionClassAttr
 	}
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "trust_store_pin")
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "key_store_pin")
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "aws_secret_access_key")
 	config.AddCommonResourceSchema(&schemaDef, true)
 	resp.Schema = schemaDef
 }
@@ -643,6 +654,7 @@ func configValidatorsCipherStreamProvider() []resource.ConfigValidator {
 			configvalidators.Implies(
 				path.MatchRoot("aws_access_key_id"),
 				path.MatchRoot("aws_secret_access_key"),
+				path.MatchRoot("aws_secret_access_key_wo"),
 			),
 		),
 		configvalidators.ImpliesOtherValidator(
@@ -673,6 +685,11 @@ func configValidatorsCipherStreamProvider() []resource.ConfigValidator {
 			path.MatchRoot("type"),
 			[]string{"amazon-key-management-service"},
 		),
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("aws_secret_access_key_wo"),
+			path.MatchRoot("type"),
+			[]string{"amazon-key-management-service"},
+		),
 		configvalidators.ImpliesOtherAttributeOneOfString(
 			path.MatchRoot("aws_region_name"),
 			path.MatchRoot("type"),
@@ -768,6 +785,11 @@ func configValidatorsCipherStreamProvider() []resource.ConfigValidator {
 			path.MatchRoot("type"),
 			[]string{"pkcs11"},
 		),
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("key_store_pin_wo"),
+			path.MatchRoot("type"),
+			[]string{"pkcs11"},
+		),
 		configvalidators.ImpliesOtherAttributeOneOfString(
 			path.MatchRoot("key_store_pin_file"),
 			path.MatchRoot("type"),
@@ -833,6 +855,11 @@ func configValidatorsCipherStreamProvider() []resource.ConfigValidator {
 			path.MatchRoot("type"),
 			[]string{"vault"},
 		),
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("trust_store_pin_wo"),
+			path.MatchRoot("type"),
+			[]string{"vault"},
+		),
 		configvalidators.ImpliesOtherAttributeOneOfString(
 			path.MatchRoot("trust_store_type"),
 			path.MatchRoot("type"),
@@ -919,6 +946,9 @@ func addOptionalAmazonKeyManagementServiceCipherStreamProviderFields(ctx context
 	if internaltypes.IsNonEmptyString(plan.AwsSecretAccessKey) {
 		addRequest.AwsSecretAccessKey = plan.AwsSecretAccessKey.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.AwsSecretAccessKeyWo) {
+		addRequest.AwsSecretAccessKey = plan.AwsSecretAccessKeyWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.AwsRegionName) {
 		addRequest.AwsRegionName = plan.AwsRegionName.ValueStringPointer()
@@ -1029,6 +1059,9 @@ func addOptionalPkcs11CipherStreamProviderFields(ctx context.Context, addRequest
 	if internaltypes.IsNonEmptyString(plan.KeyStorePin) {
 		addRequest.KeyStorePin = plan.KeyStorePin.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.KeyStorePinWo) {
+		addRequest.KeyStorePin = plan.KeyStorePinWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.KeyStorePinFile) {
 		addRequest.KeyStorePinFile = plan.KeyStorePinFile.ValueStringPointer()
@@ -1085,6 +1118,9 @@ func addOptionalVaultCipherStreamProviderFields(ctx context.Context, addRequest
 	if internaltypes.IsNonEmptyString(plan.TrustStorePin) {
 		addRequest.TrustStorePin = plan.TrustStorePin.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.TrustStorePinWo) {
+		addRequest.TrustStorePin = plan.TrustStorePinWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.TrustStoreType) {
 		addRequest.TrustStoreType = plan.TrustStoreType.ValueStringPointer()
@@ -1385,12 +1421,21 @@ func (state *cipherStreamProviderResourceModel) setStateValuesNotReturnedByAPI(e
 	if !expectedValues.AwsSecretAccessKey.IsUnknown() {
 		state.AwsSecretAccessKey = expectedValues.AwsSecretAccessKey
 	}
+	if !expectedValues.AwsSecretAccessKeyWoVersion.IsUnknown() {
+		state.AwsSecretAccessKeyWoVersion = expectedValues.AwsSecretAccessKeyWoVersion
+	}
 	if !expectedValues.KeyStorePin.IsUnknown() {
 		state.KeyStorePin = expectedValues.KeyStorePin
 	}
+	if !expectedValues.KeyStorePinWoVersion.IsUnknown() {
+		state.KeyStorePinWoVersion = expectedValues.KeyStorePinWoVersion
+	}
 	if !expectedValues.TrustStorePin.IsUnknown() {
 		state.TrustStorePin = expectedValues.TrustStorePin
 	}
+	if !expectedValues.TrustStorePinWoVersion.IsUnknown() {
+		state.TrustStorePinWoVersion = expectedValues.TrustStorePinWoVersion
+	}
 }
 
 // Create any update operations necessary to make the state match the plan
@@ -1406,10 +1451,12 @@ func createCipherStreamProviderOperations(plan cipherStreamProviderResourceModel
 	operations.AddStringOperationIfNecessary(&ops, plan.VaultEncryptionMetadataFile, state.VaultEncryptionMetadataFile, "vault-encryption-metadata-file")
 	operations.AddStringOperationIfNecessary(&ops, plan.TrustStoreFile, state.TrustStoreFile, "trust-store-file")
 	operations.AddStringOperationIfNecessary(&ops, plan.TrustStorePin, state.TrustStorePin, "trust-store-pin")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.TrustStorePinWo, plan.TrustStorePinWoVersion, state.TrustStorePinWoVersion, "trust-store-pin")
 	operations.AddStringOperationIfNecessary(&ops, plan.TrustStoreType, state.TrustStoreType, "trust-store-type")
 	operations.AddStringOperationIfNecessary(&ops, plan.Pkcs11ProviderClass, state.Pkcs11ProviderClass, "pkcs11-provider-class")
 	operations.AddStringOperationIfNecessary(&ops, plan.Pkcs11ProviderConfigurationFile, state.Pkcs11ProviderConfigurationFile, "pkcs11-provider-configuration-file")
 	operations.AddStringOperationIfNecessary(&ops, plan.KeyStorePin, state.KeyStorePin, "key-store-pin")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.KeyStorePinWo, plan.KeyStorePinWoVersion, state.KeyStorePinWoVersion, "key-store-pin")
 	operations.AddStringOperationIfNecessary(&ops, plan.KeyStorePinFile, state.KeyStorePinFile, "key-store-pin-file")
 	operations.AddStringOperationIfNecessary(&ops, plan.KeyStorePinEnvironmentVariable, state.KeyStorePinEnvironmentVariable, "key-store-pin-environment-variable")
 	operations.AddStringOperationIfNecessary(&ops, plan.Pkcs11KeyStoreType, state.Pkcs11KeyStoreType, "pkcs11-key-store-type")
@@ -1432,6 +1479,7 @@ func createCipherStreamProviderOperations(plan cipherStreamProviderResourceModel
 	operations.AddStringOperationIfNecessary(&ops, plan.AwsExternalServer, state.AwsExternalServer, "aws-external-server")
 	operations.AddStringOperationIfNecessary(&ops, plan.AwsAccessKeyID, state.AwsAccessKeyID, "aws-access-key-id")
 	operations.AddStringOperationIfNecessary(&ops, plan.AwsSecretAccessKey, state.AwsSecretAccessKey, "aws-secret-access-key")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.AwsSecretAccessKeyWo, plan.AwsSecretAccessKeyWoVersion, state.AwsSecretAccessKeyWoVersion, "aws-secret-access-key")
 	operations.AddStringOperationIfNecessary(&ops, plan.AwsRegionName, state.AwsRegionName, "aws-region-name")
 	operations.AddStringOperationIfNecessary(&ops, plan.KmsEncryptionKeyArn, state.KmsEncryptionKeyArn, "kms-encryption-key-arn")
 	operations.AddInt64OperationIfNecessary(&ops, plan.IterationCount, state.IterationCount, "iteration-count")
@@ -1459,7 +1507,7 @@ func (r *cipherStreamProviderResource) CreateAmazonKeyManagementServiceCipherStr
 
 	addResponse, httpResp, err := r.apiClient.CipherStreamProviderAPI.AddCipherStreamProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Cipher Stream Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Cipher Stream Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -1496,7 +1544,7 @@ func (r *cipherStreamProviderResource) CreateAmazonSecretsManagerCipherStreamPro
 
 	addResponse, httpResp, err := r.apiClient.CipherStreamProviderAPI.AddCipherStreamProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Cipher Stream Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Cipher Stream Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -1533,7 +1581,7 @@ func (r *cipherStreamProviderResource) CreateAzureKeyVaultCipherStreamProvider(c
 
 	addResponse, httpResp, err := r.apiClient.CipherStreamProviderAPI.AddCipherStreamProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Cipher Stream Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Cipher Stream Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -1568,7 +1616,7 @@ func (r *cipherStreamProviderResource) CreateFileBasedCipherStreamProvider(ctx c
 
 	addResponse, httpResp, err := r.apiClient.CipherStreamProviderAPI.AddCipherStreamProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Cipher Stream Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Cipher Stream Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -1602,7 +1650,7 @@ func (r *cipherStreamProviderResource) CreateWaitForPassphraseCipherStreamProvid
 
 	addResponse, httpResp, err := r.apiClient.CipherStreamProviderAPI.AddCipherStreamProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Cipher Stream Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Cipher Stream Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -1638,7 +1686,7 @@ func (r *cipherStreamProviderResource) CreateConjurCipherStreamProvider(ctx cont
 
 	addResponse, httpResp, err := r.apiClient.CipherStreamProviderAPI.AddCipherStreamProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Cipher Stream Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Cipher Stream Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -1673,7 +1721,7 @@ func (r *cipherStreamProviderResource) CreatePkcs11CipherStreamProvider(ctx cont
 
 	addResponse, httpResp, err := r.apiClient.CipherStreamProviderAPI.AddCipherStreamProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Cipher Stream Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Cipher Stream Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -1709,7 +1757,7 @@ func (r *cipherStreamProviderResource) CreateVaultCipherStreamProvider(ctx conte
 
 	addResponse, httpResp, err := r.apiClient.CipherStreamProviderAPI.AddCipherStreamProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Cipher Stream Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Cipher Stream Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -1744,7 +1792,7 @@ func (r *cipherStreamProviderResource) CreateThirdPartyCipherStreamProvider(ctx
 
 	addResponse, httpResp, err := r.apiClient.CipherStreamProviderAPI.AddCipherStreamProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Cipher Stream Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Cipher Stream Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -1769,6 +1817,13 @@ func (r *cipherStreamProviderResource) Create(ctx context.Context, req resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("trust_store_pin_wo"), &plan.TrustStorePinWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("key_store_pin_wo"), &plan.KeyStorePinWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("aws_secret_access_key_wo"), &plan.AwsSecretAccessKeyWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	var state *cipherStreamProviderResourceModel
 	var err error
@@ -1829,6 +1884,8 @@ func (r *cipherStreamProviderResource) Create(ctx context.Context, req resource.
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Cipher Stream Provider", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -1849,11 +1906,18 @@ func (r *defaultCipherStreamProviderResource) Create(ctx context.Context, req re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("trust_store_pin_wo"), &plan.TrustStorePinWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("key_store_pin_wo"), &plan.KeyStorePinWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("aws_secret_access_key_wo"), &plan.AwsSecretAccessKeyWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	readResponse, httpResp, err := r.apiClient.CipherStreamProviderAPI.GetCipherStreamProvider(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Cipher Stream Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Cipher Stream Provider", err, httpResp)
 		return
 	}
 
@@ -1903,7 +1967,7 @@ func (r *defaultCipherStreamProviderResource) Create(ctx context.Context, req re
 
 		updateResponse, httpResp, err := r.apiClient.CipherStreamProviderAPI.UpdateCipherStreamProviderExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Cipher Stream Provider", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Cipher Stream Provider", err, httpResp)
 			return
 		}
 
@@ -1941,6 +2005,8 @@ func (r *defaultCipherStreamProviderResource) Create(ctx context.Context, req re
 		if updateResponse.ThirdPartyCipherStreamProviderResponse != nil {
 			readThirdPartyCipherStreamProviderResponse(ctx, updateResponse.ThirdPartyCipherStreamProviderResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Cipher Stream Provider", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -2043,6 +2109,13 @@ func updateCipherStreamProvider(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("trust_store_pin_wo"), &plan.TrustStorePinWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("key_store_pin_wo"), &plan.KeyStorePinWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("aws_secret_access_key_wo"), &plan.AwsSecretAccessKeyWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Get the current state to see how any attributes are changing
 	var state cipherStreamProviderResourceModel
@@ -2059,7 +2132,7 @@ func updateCipherStreamProvider(ctx context.Context, req resource.UpdateRequest,
 
 		updateResponse, httpResp, err := apiClient.CipherStreamProviderAPI.UpdateCipherStreamProviderExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Cipher Stream Provider", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Cipher Stream Provider", err, httpResp)
 			return
 		}
 
@@ -2097,6 +2170,8 @@ func updateCipherStreamProvider(ctx context.Context, req resource.UpdateRequest,
 		if updateResponse.ThirdPartyCipherStreamProviderResponse != nil {
 			readThirdPartyCipherStreamProviderResponse(ctx, updateResponse.ThirdPartyCipherStreamProviderResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Cipher Stream Provider", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -2145,3 +2220,16 @@ func importCipherStreamProvider(ctx context.Context, req resource.ImportStateReq
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *cipherStreamProviderResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_cipher_stream_provider"),
+	}
+}
+
+func (r *defaultCipherStreamProviderResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_cipher_stream_provider"),
+	}
+}
@@ -49,29 +49,21 @@ func (r *cipherStreamProvidersDataSource) Configure(_ context.Context, req datas
 }
 
 type cipherStreamProvidersDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *cipherStreamProvidersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Cipher Stream Provider objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Cipher Stream Provider objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewCipherStreamProviderDataSource(), "Cipher Stream Provider")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *cipherStreamProvidersDataSource) Read(ctx context.Context, req datasour
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewCipherStreamProviderDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.CipherStreamProviderAPI.ListCipherStreamProviders(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.CipherStreamProviderAPI.ListCipherStreamProvidersExecute(listRequest)
@@ -106,57 +104,51 @@ func (r *cipherStreamProvidersDataSource) Read(ctx context.Context, req datasour
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object cipherStreamProviderDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.AmazonKeyManagementServiceCipherStreamProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.AmazonKeyManagementServiceCipherStreamProviderResponse.Id)
-			attributes["type"] = types.StringValue("amazon-key-management-service")
+			readAmazonKeyManagementServiceCipherStreamProviderResponseDataSource(ctx, response.AmazonKeyManagementServiceCipherStreamProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.DefaultCipherStreamProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.DefaultCipherStreamProviderResponse.Id)
-			attributes["type"] = types.StringValue("default")
+			object.Id = types.StringValue(response.DefaultCipherStreamProviderResponse.Id)
+			object.Type = types.StringValue("default")
 		}
 		if response.AmazonSecretsManagerCipherStreamProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.AmazonSecretsManagerCipherStreamProviderResponse.Id)
-			attributes["type"] = types.StringValue("amazon-secrets-manager")
+			readAmazonSecretsManagerCipherStreamProviderResponseDataSource(ctx, response.AmazonSecretsManagerCipherStreamProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.AzureKeyVaultCipherStreamProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.AzureKeyVaultCipherStreamProviderResponse.Id)
-			attributes["type"] = types.StringValue("azure-key-vault")
+			readAzureKeyVaultCipherStreamProviderResponseDataSource(ctx, response.AzureKeyVaultCipherStreamProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.FileBasedCipherStreamProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.FileBasedCipherStreamProviderResponse.Id)
-			attributes["type"] = types.StringValue("file-based")
+			readFileBasedCipherStreamProviderResponseDataSource(ctx, response.FileBasedCipherStreamProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.WaitForPassphraseCipherStreamProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.WaitForPassphraseCipherStreamProviderResponse.Id)
-			attributes["type"] = types.StringValue("wait-for-passphrase")
+			readWaitForPassphraseCipherStreamProviderResponseDataSource(ctx, response.WaitForPassphraseCipherStreamProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.ConjurCipherStreamProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.ConjurCipherStreamProviderResponse.Id)
-			attributes["type"] = types.StringValue("conjur")
+			readConjurCipherStreamProviderResponseDataSource(ctx, response.ConjurCipherStreamProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.Pkcs11CipherStreamProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.Pkcs11CipherStreamProviderResponse.Id)
-			attributes["type"] = types.StringValue("pkcs11")
+			readPkcs11CipherStreamProviderResponseDataSource(ctx, response.Pkcs11CipherStreamProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.VaultCipherStreamProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.VaultCipherStreamProviderResponse.Id)
-			attributes["type"] = types.StringValue("vault")
+			readVaultCipherStreamProviderResponseDataSource(ctx, response.VaultCipherStreamProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyCipherStreamProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyCipherStreamProviderResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyCipherStreamProviderResponseDataSource(ctx, response.ThirdPartyCipherStreamProviderResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -32,9 +32,11 @@ var (
 	_ resource.Resource                = &clientConnectionPolicyResource{}
 	_ resource.ResourceWithConfigure   = &clientConnectionPolicyResource{}
 	_ resource.ResourceWithImportState = &clientConnectionPolicyResource{}
+	_ resource.ResourceWithMoveState   = &clientConnectionPolicyResource{}
 	_ resource.Resource                = &defaultClientConnectionPolicyResource{}
 	_ resource.ResourceWithConfigure   = &defaultClientConnectionPolicyResource{}
 	_ resource.ResourceWithImportState = &defaultClientConnectionPolicyResource{}
+	_ resource.ResourceWithMoveState   = &defaultClientConnectionPolicyResource{}
 )
 
 // Create a Client Connection Policy resource
@@ -825,7 +827,7 @@ func (r *clientConnectionPolicyResource) CreateClientConnectionPolicy(ctx contex
 
 	addResponse, httpResp, err := r.apiClient.ClientConnectionPolicyAPI.AddClientConnectionPolicyExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Client Connection Policy", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Client Connection Policy", err, httpResp)
 		return nil, err
 	}
 
@@ -856,6 +858,8 @@ func (r *clientConnectionPolicyResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Client Connection Policy", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -880,7 +884,7 @@ func (r *defaultClientConnectionPolicyResource) Create(ctx context.Context, req
 	readResponse, httpResp, err := r.apiClient.ClientConnectionPolicyAPI.GetClientConnectionPolicy(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.PolicyID.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Client Connection Policy", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Client Connection Policy", err, httpResp)
 		return
 	}
 
@@ -904,7 +908,7 @@ func (r *defaultClientConnectionPolicyResource) Create(ctx context.Context, req
 
 		updateResponse, httpResp, err := r.apiClient.ClientConnectionPolicyAPI.UpdateClientConnectionPolicyExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Client Connection Policy", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Client Connection Policy", err, httpResp)
 			return
 		}
 
@@ -916,6 +920,8 @@ func (r *defaultClientConnectionPolicyResource) Create(ctx context.Context, req
 
 		// Read the response
 		readClientConnectionPolicyResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Client Connection Policy", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -1007,7 +1013,7 @@ func updateClientConnectionPolicy(ctx context.Context, req resource.UpdateReques
 
 		updateResponse, httpResp, err := apiClient.ClientConnectionPolicyAPI.UpdateClientConnectionPolicyExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Client Connection Policy", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Client Connection Policy", err, httpResp)
 			return
 		}
 
@@ -1019,6 +1025,8 @@ func updateClientConnectionPolicy(ctx context.Context, req resource.UpdateReques
 
 		// Read the response
 		readClientConnectionPolicyResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Client Connection Policy", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -1066,3 +1074,16 @@ func importClientConnectionPolicy(ctx context.Context, req resource.ImportStateR
 	// Retrieve import ID and save to policy_id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("policy_id"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *clientConnectionPolicyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_client_connection_policy"),
+	}
+}
+
+func (r *defaultClientConnectionPolicyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_client_connection_policy"),
+	}
+}
@@ -3,16 +3,21 @@
 package config
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
 	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
@@ -90,11 +95,52 @@ func AddCommonDataSourceSchema(s *datasourceschema.Schema, addNameAttribute bool
 	}
 }
 
+// Add a write-only variant of a sensitive attribute, named with a "_wo" suffix, along with a "_wo_version" attribute.
+// Write-only values are never stored in the plan or state, so changing the version is what triggers sending a new value.
+func AddWriteOnlyAttributeSchema(s *schema.Schema, attributeName string) {
+	writeOnlyName := attributeName + "_wo"
+	versionName := writeOnlyName + "_version"
+	s.Attributes[writeOnlyName] = schema.StringAttribute{
+		Description: "Write-only alternative to `" + attributeName + "`. This value is sent to PingDirectory but is never stored in the Terraform plan or state. Change `" + versionName + "` to apply a new value. Requires Terraform 1.11 or later.",
+		Optional:    true,
+		Sensitive:   true,
+		WriteOnly:   true,
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(path.MatchRoot(attributeName)),
+			stringvalidator.AlsoRequires(path.MatchRoot(versionName)),
+		},
+	}
+	s.Attributes[versionName] = schema.Int64Attribute{
+		Description: "Version of the `" + writeOnlyName + "` value. The write-only value is only sent to PingDirectory when this version changes.",
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRoot(writeOnlyName)),
+		},
+	}
+}
+
+// Add the detach_on_destroy attribute for resources that can remove references to their config object before deleting it.
+// Default resources never delete their config object, so the attribute has no effect there.
+func AddDetachOnDestroySchema(s *schema.Schema, objectDescription string, isDefault bool) {
+	description := "Set to true to remove references to this " + objectDescription + " from other config objects before deleting it on destroy. Without this, deleting a " + objectDescription + " that is still referenced fails, and the referencing config objects are listed in the error."
+	if isDefault {
+		description = "Has no effect, since the " + objectDescription + " is not deleted when this resource is destroyed."
+	}
+	s.Attributes["detach_on_destroy"] = schema.BoolAttribute{
+		Description: description,
+		Optional:    true,
+	}
+}
+
 func SetAttributesToOptionalAndComputedAndRemoveDefaults(s *schema.Schema, exemptAttributes []string) {
 	for key, attribute := range s.Attributes {
 		// If more attribute types are used by this provider, this method will need to be updated
 		if !internaltypes.StringSliceContains(exemptAttributes, key) {
 			stringAttr, ok := attribute.(schema.StringAttribute)
+			// Write-only attributes can't be computed
+			if ok && stringAttr.WriteOnly {
+				continue
+			}
 			if ok {
 				stringAttr.Required = false
 				stringAttr.Optional = true
@@ -114,6 +160,16 @@ func SetAttributesToOptionalAndComputedAndRemoveDefaults(s *schema.Schema, exemp
 				s.Attributes[key] = setAttr
 				continue
 			}
+			listAttr, ok := attribute.(schema.ListAttribute)
+			if ok {
+				listAttr.Required = false
+				listAttr.Optional = true
+				listAttr.Computed = true
+				listAttr.Default = nil
+				listAttr.PlanModifiers = append(listAttr.PlanModifiers, listplanmodifier.UseStateForUnknown())
+				s.Attributes[key] = listAttr
+				continue
+			}
 			boolAttr, ok := attribute.(schema.BoolAttribute)
 			if ok {
 				boolAttr.Required = false
@@ -0,0 +1,231 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package configobject
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+)
+
+// Keys of Config API objects that aren't config properties
+const (
+	idKey       = "id"
+	schemasKey  = "schemas"
+	metaKey     = "meta"
+	messagesKey = "urn:pingidentity:schemas:configuration:messages:2.0"
+)
+
+// Matches collection paths like "backends" or "backends/userRoot/local-db-indexes"
+var collectionPathRegex = regexp.MustCompile(`^[a-z0-9-]+(/[^/]+/[a-z0-9-]+)*$`)
+
+// Get the Config API path for a collection, escaping the names of any parent objects
+func collectionUrlPath(collectionPath string) string {
+	segments := strings.Split(collectionPath, "/")
+	for i := range segments {
+		segments[i] = url.PathEscape(segments[i])
+	}
+	return "/config/" + strings.Join(segments, "/")
+}
+
+// Get the Config API path for a config object
+func objectUrlPath(collectionPath, name string) string {
+	return collectionUrlPath(collectionPath) + "/" + url.PathEscape(name)
+}
+
+// Parse a JSON object string. Null and empty strings are treated as an empty object.
+func parseProperties(properties types.String, diagnostics *diag.Diagnostics) map[string]any {
+	result := map[string]any{}
+	if properties.IsNull() || properties.IsUnknown() || properties.ValueString() == "" {
+		return result
+	}
+	if err := json.Unmarshal([]byte(properties.ValueString()), &result); err != nil {
+		diagnostics.AddError("Invalid properties JSON", "The properties must be a JSON object: "+err.Error())
+	}
+	return result
+}
+
+// Get the config properties of a Config API object, leaving out the id, metadata, and messages
+func configProperties(object map[string]any) map[string]any {
+	properties := map[string]any{}
+	for key, value := range object {
+		if key != idKey && key != metaKey && key != messagesKey {
+			properties[key] = value
+		}
+	}
+	return properties
+}
+
+// Get the object type from the schemas of a Config API object, such as "local-db" for
+// "urn:pingidentity:schemas:configuration:2.0:backend:local-db"
+func objectType(object map[string]any) types.String {
+	schemas, _ := object[schemasKey].([]any)
+	for _, schema := range schemas {
+		if urn, ok := schema.(string); ok && strings.HasPrefix(urn, "urn:pingidentity:schemas:configuration:2.0:") {
+			return types.StringValue(urn[strings.LastIndex(urn, ":")+1:])
+		}
+	}
+	return types.StringNull()
+}
+
+// Read the messages returned with a Config API object
+func readObjectMessages(ctx context.Context, object map[string]any, diagnostics *diag.Diagnostics) (types.Set, types.Set) {
+	var messages *client.MetaUrnPingidentitySchemasConfigurationMessages20
+	if rawMessages, ok := object[messagesKey]; ok {
+		messagesJson, err := json.Marshal(rawMessages)
+		if err == nil {
+			messages = &client.MetaUrnPingidentitySchemasConfigurationMessages20{}
+			if err = json.Unmarshal(messagesJson, messages); err != nil {
+				messages = nil
+			}
+		}
+	}
+	return config.ReadMessages(ctx, messages, diagnostics)
+}
+
+// Get a normalized form of a property value for comparison. Config API values are returned as strings, numbers,
+// booleans, or arrays of those. Scalars are compared by their string form, and a single-element array is equivalent
+// to its only element. Array elements are compared in order, since the order matters for some properties, such as
+// the default password storage schemes of a Password Policy.
+func normalizeValue(value any) any {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []any:
+		if len(v) == 1 {
+			return normalizeValue(v[0])
+		}
+		normalized := make([]string, len(v))
+		for i, element := range v {
+			normalized[i] = fmt.Sprint(normalizeValue(element))
+		}
+		return strings.Join(normalized, "\x00")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func valuesEqual(a, b any) bool {
+	return normalizeValue(a) == normalizeValue(b)
+}
+
+// Get the values of a property as strings for Config API operations. Empty strings are treated as equivalent
+// to no value, as they are for the other resources.
+func operationValues(value any) []string {
+	var values []string
+	switch v := value.(type) {
+	case nil:
+	case []any:
+		for _, element := range v {
+			values = append(values, operationValues(element)...)
+		}
+	default:
+		if s := fmt.Sprint(v); s != "" {
+			values = append(values, s)
+		}
+	}
+	return values
+}
+
+// Create the operations needed to change the configured properties from the state values to the plan values.
+// Properties that are no longer configured are reset to their default values.
+func createOperations(plan, state map[string]any) []client.Operation {
+	var ops []client.Operation
+	keys := make([]string, 0, len(plan)+len(state))
+	for key := range plan {
+		keys = append(keys, key)
+	}
+	for key := range state {
+		if _, ok := plan[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if key == idKey || key == schemasKey {
+			continue
+		}
+		planValue, configured := plan[key]
+		if configured && valuesEqual(planValue, state[key]) {
+			continue
+		}
+		path := config.PropertyJsonNameToConfigName(key)
+		values := operationValues(planValue)
+		if len(values) == 0 {
+			ops = append(ops, *client.NewOperation(client.ENUMOPERATION_REMOVE, path))
+			continue
+		}
+		// Replace with the first value and add the rest, so the property is never left empty
+		op := client.NewOperation(client.ENUMOPERATION_REPLACE, path)
+		op.SetValue(values[0])
+		ops = append(ops, *op)
+		for _, value := range values[1:] {
+			op := client.NewOperation(client.ENUMOPERATION_ADD, path)
+			op.SetValue(value)
+			ops = append(ops, *op)
+		}
+	}
+	return ops
+}
+
+// Get the configured properties as read from the server. The configured JSON string is kept when the server values
+// are equivalent, so that formatting differences don't show up as changes. Properties in ignoreServerChanges keep
+// their configured values, since the server may not return them as configured.
+func readConfiguredProperties(configured types.String, serverProperties map[string]any, ignoreServerChanges []string, diagnostics *diag.Diagnostics) types.String {
+	if configured.IsNull() || configured.IsUnknown() {
+		return configured
+	}
+	configuredProperties := parseProperties(configured, diagnostics)
+	readProperties := map[string]any{}
+	changed := false
+	for key, configuredValue := range configuredProperties {
+		serverValue, found := serverProperties[key]
+		if key == idKey || slices.Contains(ignoreServerChanges, key) {
+			readProperties[key] = configuredValue
+			continue
+		}
+		if !found {
+			// The Config API leaves out properties with no value
+			if len(operationValues(configuredValue)) == 0 {
+				readProperties[key] = configuredValue
+			} else {
+				changed = true
+			}
+			continue
+		}
+		readProperties[key] = serverValue
+		if !valuesEqual(configuredValue, serverValue) {
+			changed = true
+		}
+	}
+	if !changed {
+		return configured
+	}
+	readJson, err := json.Marshal(readProperties)
+	if err != nil {
+		diagnostics.AddError("Failed to encode properties JSON", err.Error())
+		return configured
+	}
+	return types.StringValue(string(readJson))
+}
+
+// Encode all properties of a config object as a JSON string with sorted keys
+func propertiesJson(properties map[string]any, diagnostics *diag.Diagnostics) types.String {
+	propertiesJson, err := json.Marshal(properties)
+	if err != nil {
+		diagnostics.AddError("Failed to encode properties JSON", err.Error())
+		return types.StringNull()
+	}
+	return types.StringValue(string(propertiesJson))
+}
@@ -0,0 +1,110 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package configobject
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &configObjectDataSource{}
+	_ datasource.DataSourceWithConfigure = &configObjectDataSource{}
+)
+
+// Create a Config Object data source
+func NewConfigObjectDataSource() datasource.DataSource {
+	return &configObjectDataSource{}
+}
+
+// configObjectDataSource is the datasource implementation.
+type configObjectDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the data source type name.
+func (r *configObjectDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_object"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *configObjectDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+type configObjectDataSourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	CollectionPath types.String `tfsdk:"collection_path"`
+	Type           types.String `tfsdk:"type"`
+	Properties     types.String `tfsdk:"properties"`
+}
+
+// GetSchema defines the schema for the datasource.
+func (r *configObjectDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	schemaDef := schema.Schema{
+		Description: "Describes any config object through the Configuration API, including types and properties that the typed data sources don't support.",
+		Attributes: map[string]schema.Attribute{
+			"collection_path": schema.StringAttribute{
+				Description: "Path of the Configuration API collection containing the config object, relative to `/config`. For example, `backends` or `backends/userRoot/local-db-indexes`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(collectionPathRegex, "must be a Configuration API collection path such as \"backends\" or \"backends/userRoot/local-db-indexes\""),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the config object, taken from its schemas. For example, `local-db` for a Local DB Backend.",
+				Computed:    true,
+			},
+			"properties": schema.StringAttribute{
+				Description: "JSON object with all properties of the config object as returned by the Configuration API. Use `jsondecode` to read individual properties.",
+				Computed:    true,
+			},
+		},
+	}
+	config.AddCommonDataSourceSchema(&schemaDef, true)
+	resp.Schema = schemaDef
+}
+
+// Read resource information
+func (r *configObjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current state
+	var state configObjectDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResponse, httpResp, err := sendObjectRequest(ctx, r.providerConfig, r.apiClient, http.MethodGet, objectUrlPath(state.CollectionPath.ValueString(), state.Name.ValueString()), nil)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Config Object", err, httpResp)
+		return
+	}
+
+	// Read the response into the state
+	state.Id = types.StringValue(state.CollectionPath.ValueString() + "/" + state.Name.ValueString())
+	state.Type = objectType(readResponse)
+	state.Properties = propertiesJson(configProperties(readResponse), &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
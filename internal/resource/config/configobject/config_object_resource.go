@@ -0,0 +1,295 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package configobject
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/operations"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &configObjectResource{}
+	_ resource.ResourceWithConfigure   = &configObjectResource{}
+	_ resource.ResourceWithImportState = &configObjectResource{}
+)
+
+// Create a Config Object resource
+func NewConfigObjectResource() resource.Resource {
+	return &configObjectResource{}
+}
+
+// configObjectResource is the resource implementation.
+type configObjectResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the resource type name.
+func (r *configObjectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_object"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *configObjectResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+type configObjectResourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Notifications       types.Set    `tfsdk:"notifications"`
+	RequiredActions     types.Set    `tfsdk:"required_actions"`
+	CollectionPath      types.String `tfsdk:"collection_path"`
+	Type                types.String `tfsdk:"type"`
+	Properties          types.String `tfsdk:"properties"`
+	IgnoreServerChanges types.Set    `tfsdk:"ignore_server_changes"`
+	ServerProperties    types.String `tfsdk:"server_properties"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *configObjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	schemaDef := schema.Schema{
+		Description: "Manages any config object through the Configuration API, including types and properties that the typed resources don't support. Prefer the typed resources where they are available.",
+		Attributes: map[string]schema.Attribute{
+			"collection_path": schema.StringAttribute{
+				Description: "Path of the Configuration API collection containing the config object, relative to `/config`. For example, `backends` or `backends/userRoot/local-db-indexes`.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(collectionPathRegex, "must be a Configuration API collection path such as \"backends\" or \"backends/userRoot/local-db-indexes\""),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the config object, taken from its schemas. For example, `local-db` for a Local DB Backend.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"properties": schema.StringAttribute{
+				Description: "JSON object with the config properties to manage, using the property names from Configuration API JSON, such as `jsonencode({ schemas = [\"urn:pingidentity:schemas:configuration:2.0:backend:local-db\"], backendID = \"myBackend\", baseDN = [\"dc=example,dc=com\"], enabled = true })`. Include `schemas` when creating a config object that has a type. Changing `schemas` replaces the config object. Properties removed from this attribute are reset to their default values.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(schemasChanged, "Changing schemas replaces the config object.", "Changing `schemas` replaces the config object."),
+				},
+			},
+			"ignore_server_changes": schema.SetAttribute{
+				Description: "Properties whose values read back from the server are not compared with the configured values, such as passwords that the server returns in encoded form. Multi-valued properties are compared in order, so properties whose values the server returns in a different order than configured also belong here.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"server_properties": schema.StringAttribute{
+				Description: "JSON object with all properties of the config object as returned by the Configuration API, including properties not managed by this resource.",
+				Computed:    true,
+			},
+		},
+	}
+	config.AddCommonResourceSchema(&schemaDef, true)
+	resp.Schema = schemaDef
+}
+
+// Require replacement when the schemas of the config object change, since the Configuration API can't change them
+func schemasChanged(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+	var diags diag.Diagnostics
+	planSchemas, planHasSchemas := parseProperties(req.PlanValue, &diags)[schemasKey]
+	stateSchemas, stateHasSchemas := parseProperties(req.StateValue, &diags)[schemasKey]
+	if diags.HasError() {
+		return
+	}
+	resp.RequiresReplace = planHasSchemas != stateHasSchemas || !valuesEqual(planSchemas, stateSchemas)
+}
+
+// Read a Config API object into the model struct
+func readConfigObjectResponse(ctx context.Context, object map[string]any, state *configObjectResourceModel, expectedValues *configObjectResourceModel, diagnostics *diag.Diagnostics) {
+	id, _ := object[idKey].(string)
+	state.Name = types.StringValue(id)
+	state.Id = types.StringValue(state.CollectionPath.ValueString() + "/" + id)
+	state.Type = objectType(object)
+	serverProperties := configProperties(object)
+	var ignoreServerChanges []string
+	if internaltypes.IsDefined(expectedValues.IgnoreServerChanges) {
+		diagnostics.Append(expectedValues.IgnoreServerChanges.ElementsAs(ctx, &ignoreServerChanges, false)...)
+	}
+	state.Properties = readConfiguredProperties(expectedValues.Properties, serverProperties, ignoreServerChanges, diagnostics)
+	state.ServerProperties = propertiesJson(serverProperties, diagnostics)
+	state.Notifications, state.RequiredActions = readObjectMessages(ctx, object, diagnostics)
+}
+
+// Send a request for a single config object and parse the response
+func sendObjectRequest(ctx context.Context, providerConfig internaltypes.ProviderConfiguration, apiClient *client.APIClient, method, urlPath string, body any) (map[string]any, *http.Response, error) {
+	responseBody, httpResp, err := config.SendRawRequest(ctx, providerConfig, apiClient, method, urlPath, body)
+	if err != nil {
+		return nil, httpResp, err
+	}
+	var object map[string]any
+	err = json.Unmarshal(responseBody, &object)
+	return object, httpResp, err
+}
+
+// Create a new resource
+func (r *configObjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan configObjectResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addRequest := parseProperties(plan.Properties, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	addRequest[idKey] = plan.Name.ValueString()
+
+	addResponse, httpResp, err := sendObjectRequest(ctx, r.providerConfig, r.apiClient, http.MethodPost, collectionUrlPath(plan.CollectionPath.ValueString()), addRequest)
+	if err != nil {
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Config Object", err, httpResp)
+		return
+	}
+
+	// Read the response into the state
+	state := plan
+	readConfigObjectResponse(ctx, addResponse, &state, &plan, &resp.Diagnostics)
+	// Differences between the configured and returned values are reported on the next refresh
+	state.Properties = plan.Properties
+
+	config.HandleRequiredActions(ctx, r.providerConfig, "Config Object", state.Id, state.RequiredActions, &resp.Diagnostics)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information
+func (r *configObjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state configObjectResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResponse, httpResp, err := sendObjectRequest(ctx, r.providerConfig, r.apiClient, http.MethodGet, objectUrlPath(state.CollectionPath.ValueString(), state.Name.ValueString()), nil)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.ReportHttpErrorAsWarning(ctx, &resp.Diagnostics, "An error occurred while getting the Config Object", err, httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Config Object", err, httpResp)
+		}
+		return
+	}
+
+	// Read the response into the state
+	readConfigObjectResponse(ctx, readResponse, &state, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update a resource
+func (r *configObjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan configObjectResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the current state to see how any properties are changing
+	var state configObjectResourceModel
+	req.State.Get(ctx, &state)
+	planProperties := parseProperties(plan.Properties, &resp.Diagnostics)
+	stateProperties := parseProperties(state.Properties, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Determine what update operations are necessary
+	ops := createOperations(planProperties, stateProperties)
+	if len(ops) > 0 {
+		// Log operations
+		operations.LogUpdateOperations(ctx, ops)
+
+		updateResponse, httpResp, err := sendObjectRequest(ctx, r.providerConfig, r.apiClient, http.MethodPatch, objectUrlPath(plan.CollectionPath.ValueString(), plan.Name.ValueString()), client.NewUpdateRequest(ops))
+		if err != nil {
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Config Object", err, httpResp)
+			return
+		}
+
+		// Read the response
+		readConfigObjectResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Config Object", state.Id, state.RequiredActions, &resp.Diagnostics)
+	} else {
+		tflog.Warn(ctx, "No configuration API operations created for update")
+	}
+	// Differences between the configured and returned values are reported on the next refresh
+	state.Properties = plan.Properties
+	state.IgnoreServerChanges = plan.IgnoreServerChanges
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *configObjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state configObjectResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, httpResp, err := config.SendRawRequest(ctx, r.providerConfig, r.apiClient, http.MethodDelete, objectUrlPath(state.CollectionPath.ValueString(), state.Name.ValueString()), nil)
+	if err != nil && (httpResp == nil || httpResp.StatusCode != 404) {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting the Config Object", err, httpResp)
+		return
+	}
+}
+
+func (r *configObjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The name is the last segment of the import ID, and the rest is the collection path
+	separator := strings.LastIndex(req.ID, "/")
+	if separator <= 0 || separator == len(req.ID)-1 {
+		resp.Diagnostics.AddError("Invalid import id for resource", "Expected [collection-path]/[name], like backends/userRoot. Got: "+req.ID)
+		return
+	}
+	// Set the required attributes to read the resource
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("collection_path"), req.ID[:separator])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID[separator+1:])...)
+}
@@ -0,0 +1,132 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package configurationsnapshot
+
+import "github.com/pingidentity/terraform-provider-pingdirectory/internal/version"
+
+// Every type of config object that the Configuration API can list or read, with the path of its collection.
+// Child types are read under each object of the parent type named by the placeholder in their path.
+var configTypes = []configType{
+	{name: "access_control_handler", path: "/access-control-handler", singleton: true},
+	{name: "access_token_validator", path: "/access-token-validators"},
+	{name: "account_status_notification_handler", path: "/account-status-notification-handlers"},
+	{name: "alarm_manager", path: "/alarm-manager", singleton: true},
+	{name: "alert_handler", path: "/alert-handlers"},
+	{name: "attribute_syntax", path: "/attribute-syntaxes"},
+	{name: "azure_authentication_method", path: "/azure-authentication-methods"},
+	{name: "backend", path: "/backends"},
+	{name: "certificate_mapper", path: "/certificate-mappers"},
+	{name: "change_subscription", path: "/change-subscriptions"},
+	{name: "change_subscription_handler", path: "/change-subscription-handlers"},
+	{name: "cipher_secret_key", path: "/server-instances/{server-instance-name}/cipher-secret-keys"},
+	{name: "cipher_stream_provider", path: "/cipher-stream-providers"},
+	{name: "client_connection_policy", path: "/client-connection-policies"},
+	{name: "conjur_authentication_method", path: "/conjur-authentication-methods"},
+	{name: "connection_criteria", path: "/connection-criteria"},
+	{name: "connection_handler", path: "/connection-handlers"},
+	{name: "consent_definition", path: "/consent-definitions"},
+	{name: "consent_definition_localization", path: "/consent-definitions/{consent-definition-name}/consent-definition-localizations"},
+	{name: "consent_service", path: "/consent-service", singleton: true},
+	{name: "constructed_attribute", path: "/constructed-attributes"},
+	{name: "correlated_ldap_data_view", path: "/scim-resource-types/{scim-resource-type-name}/correlated-ldap-data-views"},
+	{name: "crypto_manager", path: "/crypto-manager", singleton: true},
+	{name: "custom_logged_stats", path: "/plugin-root/plugins/{plugin-name}/custom-logged-stats"},
+	{name: "data_security_auditor", path: "/data-security-auditors"},
+	{name: "debug_target", path: "/log-publishers/{log-publisher-name}/debug-targets"},
+	{name: "delegated_admin_attribute", path: "/rest-resource-types/{rest-resource-type-name}/delegated-admin-attributes"},
+	{name: "delegated_admin_attribute_category", path: "/delegated-admin-attribute-categories"},
+	{name: "delegated_admin_correlated_rest_resource", path: "/rest-resource-types/{rest-resource-type-name}/delegated-admin-correlated-rest-resources"},
+	{name: "delegated_admin_resource_rights", path: "/delegated-admin-rights/{delegated-admin-rights-name}/delegated-admin-resource-rights"},
+	{name: "delegated_admin_rights", path: "/delegated-admin-rights"},
+	{name: "dn_map", path: "/dn-maps"},
+	{name: "entry_cache", path: "/entry-caches"},
+	{name: "entry_counter_plugin_criteria", path: "/plugin-root/plugins/{plugin-name}/entry-counter-criteria"},
+	{name: "extended_operation_handler", path: "/extended-operation-handlers"},
+	{name: "external_server", path: "/external-servers"},
+	{name: "failure_lockout_action", path: "/failure-lockout-actions"},
+	{name: "gauge", path: "/gauges"},
+	{name: "gauge_data_source", path: "/gauge-data-sources"},
+	{name: "global_configuration", path: "/global-configuration", singleton: true},
+	{name: "group_implementation", path: "/group-implementations"},
+	{name: "http_configuration", path: "/http-configuration", singleton: true},
+	{name: "http_servlet_cross_origin_policy", path: "/http-servlet-cross-origin-policies"},
+	{name: "http_servlet_extension", path: "/http-servlet-extensions"},
+	{name: "id_token_validator", path: "/id-token-validators"},
+	{name: "identity_mapper", path: "/identity-mappers"},
+	{name: "inter_server_authentication_info", path: "/server-instances/{server-instance-name}/server-instance-listeners/{server-instance-listener-name}/inter-server-authentication-info"},
+	{name: "json_attribute_constraints", path: "/json-attribute-constraints"},
+	{name: "json_field_constraints", path: "/json-attribute-constraints/{json-attribute-constraints-name}/json-field-constraints"},
+	{name: "key_manager_provider", path: "/key-manager-providers"},
+	{name: "key_pair", path: "/key-pairs"},
+	{name: "ldap_correlation_attribute_pair", path: "/scim-resource-types/{scim-resource-type-name}/correlated-ldap-data-views/{correlated-ldap-data-view-name}/ldap-correlation-attribute-pairs"},
+	{name: "ldap_sdk_debug_logger", path: "/ldap-sdk-debug-logger", singleton: true},
+	{name: "license", path: "/license", singleton: true},
+	{name: "local_db_composite_index", path: "/backends/{backend-name}/local-db-composite-indexes"},
+	{name: "local_db_index", path: "/backends/{backend-name}/local-db-indexes"},
+	{name: "local_db_vlv_index", path: "/backends/{backend-name}/local-db-vlv-indexes"},
+	{name: "location", path: "/locations"},
+	{name: "log_field_behavior", path: "/log-field-behaviors"},
+	{name: "log_field_mapping", path: "/log-field-mappings"},
+	{name: "log_field_syntax", path: "/log-field-syntaxes"},
+	{name: "log_file_rotation_listener", path: "/log-file-rotation-listeners"},
+	{name: "log_publisher", path: "/log-publishers"},
+	{name: "log_publisher_message_exclusion_policy", path: "/log-publisher-message-exclusion-policies"},
+	{name: "log_retention_policy", path: "/log-retention-policies"},
+	{name: "log_rotation_policy", path: "/log-rotation-policies"},
+	{name: "mac_secret_key", path: "/server-instances/{server-instance-name}/mac-secret-keys"},
+	{name: "matching_rule", path: "/matching-rules"},
+	{name: "monitor_provider", path: "/monitor-providers"},
+	{name: "monitoring_endpoint", path: "/monitoring-endpoints"},
+	{name: "notification_manager", path: "/notification-managers"},
+	{name: "oauth_token_handler", path: "/oauth-token-handlers"},
+	{name: "obscured_value", path: "/obscured-values"},
+	{name: "otp_delivery_mechanism", path: "/otp-delivery-mechanisms"},
+	{name: "pass_through_authentication_handler", path: "/pass-through-authentication-handlers"},
+	{name: "passphrase_provider", path: "/passphrase-providers"},
+	{name: "password_generator", path: "/password-generators"},
+	{name: "password_policy", path: "/password-policies"},
+	{name: "password_storage_scheme", path: "/password-storage-schemes"},
+	{name: "password_validator", path: "/password-validators"},
+	{name: "plugin", path: "/plugin-root/plugins"},
+	{name: "plugin_root", path: "/plugin-root", singleton: true},
+	{name: "post_ldif_export_task_processor", path: "/post-ldif-export-task-processors", minimumVersion: version.PingDirectory10000},
+	{name: "prometheus_monitor_attribute_metric", path: "/http-servlet-extensions/{http-servlet-extension-name}/prometheus-monitor-attribute-metrics"},
+	{name: "recurring_task", path: "/recurring-tasks"},
+	{name: "recurring_task_chain", path: "/recurring-task-chains"},
+	{name: "replication_assurance_policy", path: "/replication-assurance-policies"},
+	{name: "replication_domain", path: "/synchronization-providers/{synchronization-provider-name}/replication-domains"},
+	{name: "replication_server", path: "/synchronization-providers/{synchronization-provider-name}/replication-server", singleton: true},
+	{name: "request_criteria", path: "/request-criteria"},
+	{name: "rest_resource_type", path: "/rest-resource-types"},
+	{name: "result_code_map", path: "/result-code-maps"},
+	{name: "result_criteria", path: "/result-criteria"},
+	{name: "root_dn", path: "/root-dn", singleton: true},
+	{name: "root_dn_user", path: "/root-dn/root-dn-users"},
+	{name: "root_dse_backend", path: "/root-dse-backend", singleton: true},
+	{name: "sasl_mechanism_handler", path: "/sasl-mechanism-handlers"},
+	{name: "scim_attribute", path: "/scim-schemas/{scim-schema-name}/scim-attributes"},
+	{name: "scim_attribute_mapping", path: "/scim-resource-types/{scim-resource-type-name}/scim-attribute-mappings"},
+	{name: "scim_resource_type", path: "/scim-resource-types"},
+	{name: "scim_schema", path: "/scim-schemas"},
+	{name: "scim_subattribute", path: "/scim-schemas/{scim-schema-name}/scim-attributes/{scim-attribute-name}/scim-subattributes"},
+	{name: "search_entry_criteria", path: "/search-entry-criteria"},
+	{name: "search_reference_criteria", path: "/search-reference-criteria"},
+	{name: "sensitive_attribute", path: "/sensitive-attributes"},
+	{name: "server_group", path: "/server-groups"},
+	{name: "server_instance", path: "/server-instances"},
+	{name: "server_instance_listener", path: "/server-instances/{server-instance-name}/server-instance-listeners"},
+	{name: "soft_delete_policy", path: "/soft-delete-policies"},
+	{name: "synchronization_provider", path: "/synchronization-providers"},
+	{name: "token_claim_validation", path: "/id-token-validators/{id-token-validator-name}/token-claim-validations"},
+	{name: "topology_admin_user", path: "/topology-admin-users"},
+	{name: "trust_manager_provider", path: "/trust-manager-providers"},
+	{name: "trusted_certificate", path: "/trusted-certificates"},
+	{name: "uncached_attribute_criteria", path: "/uncached-attribute-criteria"},
+	{name: "uncached_entry_criteria", path: "/uncached-entry-criteria"},
+	{name: "vault_authentication_method", path: "/vault-authentication-methods"},
+	{name: "velocity_context_provider", path: "/http-servlet-extensions/{http-servlet-extension-name}/velocity-context-providers"},
+	{name: "velocity_template_loader", path: "/http-servlet-extensions/{http-servlet-extension-name}/velocity-template-loaders"},
+	{name: "virtual_attribute", path: "/virtual-attributes"},
+	{name: "web_application_extension", path: "/web-application-extensions"},
+	{name: "work_queue", path: "/work-queue", singleton: true},
+}
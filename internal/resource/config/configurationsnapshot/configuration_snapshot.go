@@ -0,0 +1,295 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package configurationsnapshot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/version"
+)
+
+// Value used in place of sensitive property values
+const redactedValue = "REDACTED"
+
+// Keys of Config API objects that aren't config properties
+const (
+	idKey        = "id"
+	metaKey      = "meta"
+	messagesKey  = "urn:pingidentity:schemas:configuration:messages:2.0"
+	resourcesKey = "Resources"
+)
+
+// Matches the boundaries between words of a camelCase name
+var wordBoundaryRegex = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+// Last words of property names holding sensitive values, such as "password" in "bindPassword"
+var sensitiveWords = map[string]bool{
+	"password":   true,
+	"passphrase": true,
+	"pin":        true,
+	"secret":     true,
+	"token":      true,
+	"key":        true,
+}
+
+// Sensitive properties whose names don't end with one of the sensitive words
+var sensitiveProperties = map[string]bool{
+	"obscuredValue": true,
+	"vaultSecretId": true,
+}
+
+// A type of config object that can be read through the Configuration API
+type configType struct {
+	// The snake_case name used for the type in the snapshot, such as "local_db_index"
+	name string
+	// The Config API path of the type's collection, or of the object for singletons, relative to /config. Child
+	// types include a placeholder for the parent object, such as "/backends/{backend-name}/local-db-indexes".
+	path string
+	// Whether the type is a singleton that is read rather than listed
+	singleton bool
+	// The first PingDirectory version supporting the type, if it isn't supported by every version of the provider
+	minimumVersion string
+}
+
+// A config object read from the Config API
+type configObject struct {
+	// The Config API path of the object
+	path string
+	// The name of the object, prefixed by the names of any parent objects
+	id string
+}
+
+// Matches the placeholder for the last parent object in a path, such as "/{backend-name}" in
+// "/backends/{backend-name}/local-db-indexes"
+var parentPlaceholderRegex = regexp.MustCompile(`/\{[a-z-]+\}(/[^{}]+)$`)
+
+// Get the collection path of the parent type and the path of this type relative to a parent object. Returns an
+// empty parent path for top-level types.
+func (t configType) parentPath() (string, string) {
+	match := parentPlaceholderRegex.FindStringSubmatchIndex(t.path)
+	if match == nil {
+		return "", t.path
+	}
+	return t.path[:match[0]], t.path[match[2]:match[3]]
+}
+
+// Get the number of parent objects in the path of this type
+func (t configType) depth() int {
+	return strings.Count(t.path, "{")
+}
+
+// Check whether the type is supported by the configured PingDirectory version
+func (t configType) supported(productVersion string) (bool, error) {
+	if t.minimumVersion == "" {
+		return true, nil
+	}
+	compare, err := version.Compare(productVersion, t.minimumVersion)
+	return compare >= 0, err
+}
+
+// Find the type whose collection holds the parent objects of a type
+func immediateParent(configType configType, typesByPath map[string]configType) (configType, bool) {
+	parentPath, _ := configType.parentPath()
+	if parentPath == "" {
+		return configType, false
+	}
+	parent, ok := typesByPath[parentPath]
+	return parent, ok
+}
+
+// Read the configuration of every selected type
+func readSnapshot(ctx context.Context, providerConfig internaltypes.ProviderConfiguration, apiClient *client.APIClient, configTypes []configType, selected map[string]bool) (map[string]any, int, error) {
+	typesByPath := map[string]configType{}
+	for _, configType := range configTypes {
+		if !configType.singleton {
+			typesByPath[configType.path] = configType
+		}
+	}
+
+	// Parent types need to be listed even when they aren't selected, to find their child objects
+	needed := map[string]bool{}
+	for _, configType := range configTypes {
+		if !selected[configType.name] {
+			continue
+		}
+		for current, ok := configType, true; ok; current, ok = immediateParent(current, typesByPath) {
+			needed[current.name] = true
+		}
+	}
+
+	// List parent types before their children, and leave out types the server version doesn't support
+	ordered := make([]configType, 0, len(configTypes))
+	for _, configType := range configTypes {
+		if !needed[configType.name] {
+			continue
+		}
+		supported, err := configType.supported(providerConfig.ProductVersion)
+		if err != nil {
+			return nil, 0, err
+		}
+		if supported {
+			ordered = append(ordered, configType)
+		}
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].depth() < ordered[j].depth()
+	})
+
+	snapshot := map[string]any{}
+	objectCount := 0
+	objectsByType := map[string][]configObject{}
+	for _, configType := range ordered {
+		// Top-level types are read once. Child types are read under each parent object, and only exist under some
+		// variants of their parent type, such as the replication server under the replication synchronization
+		// provider, so a missing child collection or singleton is expected. A missing top-level type isn't.
+		collections := []configObject{{path: "/config"}}
+		relativePath := configType.path
+		isChild := configType.depth() > 0
+		if isChild {
+			parent, ok := immediateParent(configType, typesByPath)
+			if !ok {
+				return nil, 0, fmt.Errorf("no parent type found for the config object type %s", configType.name)
+			}
+			collections = objectsByType[parent.name]
+			_, relativePath = configType.parentPath()
+		}
+
+		objects := map[string]any{}
+		for _, collection := range collections {
+			objectPath := collection.path + relativePath
+			if configType.singleton {
+				object, err := readConfigObject(ctx, providerConfig, apiClient, objectPath)
+				if err != nil {
+					return nil, 0, err
+				}
+				if object == nil && !isChild {
+					return nil, 0, notFoundError(objectPath)
+				}
+				if object == nil {
+					continue
+				}
+				if !isChild {
+					if selected[configType.name] {
+						snapshot[configType.name] = normalizeObject(object)
+						objectCount++
+					}
+					break
+				}
+				objects[collection.id] = normalizeObject(object)
+				continue
+			}
+
+			resources, err := listConfigObjects(ctx, providerConfig, apiClient, objectPath)
+			if err != nil {
+				return nil, 0, err
+			}
+			if resources == nil && !isChild {
+				return nil, 0, notFoundError(objectPath)
+			}
+			for _, resource := range resources {
+				name, _ := resource[idKey].(string)
+				id := name
+				if collection.id != "" {
+					id = collection.id + "/" + name
+				}
+				objectsByType[configType.name] = append(objectsByType[configType.name], configObject{
+					path: objectPath + "/" + url.PathEscape(name),
+					id:   id,
+				})
+				objects[id] = normalizeObject(resource)
+			}
+		}
+		if selected[configType.name] && len(objects) > 0 {
+			snapshot[configType.name] = objects
+			objectCount += len(objects)
+		}
+	}
+	return snapshot, objectCount, nil
+}
+
+// Error for a top-level config object type that the server unexpectedly doesn't have
+func notFoundError(path string) error {
+	return fmt.Errorf("the Configuration API returned 404 Not Found for %s, which should exist on every supported PingDirectory version", path)
+}
+
+// Read a single config object. Returns nil if the object doesn't exist.
+func readConfigObject(ctx context.Context, providerConfig internaltypes.ProviderConfiguration, apiClient *client.APIClient, path string) (map[string]any, error) {
+	responseBody, httpResp, err := config.SendRawRequest(ctx, providerConfig, apiClient, http.MethodGet, path, nil)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var object map[string]any
+	if err := json.Unmarshal(responseBody, &object); err != nil {
+		return nil, fmt.Errorf("failed to parse the response from %s: %w", path, err)
+	}
+	return object, nil
+}
+
+// List the config objects in a collection. Returns nil if the collection doesn't exist.
+func listConfigObjects(ctx context.Context, providerConfig internaltypes.ProviderConfiguration, apiClient *client.APIClient, path string) ([]map[string]any, error) {
+	response, err := readConfigObject(ctx, providerConfig, apiClient, path)
+	if err != nil || response == nil {
+		return nil, err
+	}
+	rawResources, _ := response[resourcesKey].([]any)
+	resources := make([]map[string]any, 0, len(rawResources))
+	for _, rawResource := range rawResources {
+		if resource, ok := rawResource.(map[string]any); ok {
+			resources = append(resources, resource)
+		}
+	}
+	return resources, nil
+}
+
+// Check whether a property holds a sensitive value
+func isSensitiveProperty(name string) bool {
+	if sensitiveProperties[name] {
+		return true
+	}
+	words := strings.Split(wordBoundaryRegex.ReplaceAllString(name, "$1 $2"), " ")
+	return sensitiveWords[strings.ToLower(words[len(words)-1])]
+}
+
+// Get the properties of a config object in a normalized form: metadata is removed, multi-valued properties are
+// sorted, and sensitive values are redacted
+func normalizeObject(object map[string]any) map[string]any {
+	normalized := map[string]any{}
+	for key, value := range object {
+		switch {
+		case key == idKey || key == metaKey || key == messagesKey:
+			continue
+		case isSensitiveProperty(key):
+			normalized[key] = redactedValue
+		default:
+			normalized[key] = normalizeValue(value)
+		}
+	}
+	return normalized
+}
+
+// Sort the values of multi-valued properties, so that the order returned by the server doesn't matter
+func normalizeValue(value any) any {
+	values, ok := value.([]any)
+	if !ok {
+		return value
+	}
+	sorted := make([]any, len(values))
+	copy(sorted, values)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return fmt.Sprint(sorted[i]) < fmt.Sprint(sorted[j])
+	})
+	return sorted
+}
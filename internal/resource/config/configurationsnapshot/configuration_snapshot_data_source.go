@@ -0,0 +1,165 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package configurationsnapshot
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &configurationSnapshotDataSource{}
+	_ datasource.DataSourceWithConfigure = &configurationSnapshotDataSource{}
+)
+
+// Create a Configuration Snapshot data source
+func NewConfigurationSnapshotDataSource() datasource.DataSource {
+	return &configurationSnapshotDataSource{}
+}
+
+// configurationSnapshotDataSource is the datasource implementation.
+type configurationSnapshotDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the data source type name.
+func (r *configurationSnapshotDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_configuration_snapshot"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *configurationSnapshotDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+type configurationSnapshotDataSourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	IncludeTypes types.Set    `tfsdk:"include_types"`
+	ExcludeTypes types.Set    `tfsdk:"exclude_types"`
+	Types        types.Set    `tfsdk:"types"`
+	ObjectCount  types.Int64  `tfsdk:"object_count"`
+	Json         types.String `tfsdk:"json"`
+}
+
+// GetSchema defines the schema for the datasource.
+func (r *configurationSnapshotDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	schemaDef := schema.Schema{
+		Description: "Reads every config object that the Configuration API can list into a single normalized JSON document, for comparing the configuration of different servers or environments.",
+		Attributes: map[string]schema.Attribute{
+			"include_types": schema.SetAttribute{
+				Description: "Types of config objects to include, named as in the resource names of this provider, such as `backend` or `local_db_index`. Defaults to every type.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"exclude_types": schema.SetAttribute{
+				Description: "Types of config objects to leave out, named as in the resource names of this provider, such as `license` or `server_instance`.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"types": schema.SetAttribute{
+				Description: "Types of config objects included in the snapshot.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"object_count": schema.Int64Attribute{
+				Description: "Number of config objects included in the snapshot.",
+				Computed:    true,
+			},
+			"json": schema.StringAttribute{
+				Description: "JSON document with the properties of every config object, keyed by type and then by object name. Names of child objects are prefixed with the names of their parents, such as `userRoot/uid` for a Local DB Index. Singleton objects such as `global_configuration` hold their properties directly. Object keys are sorted, multi-valued properties are sorted, and sensitive values are replaced with `" + redactedValue + "`.",
+				Computed:    true,
+			},
+		},
+	}
+	config.AddCommonDataSourceSchema(&schemaDef, false)
+	resp.Schema = schemaDef
+}
+
+// Check that every type in a filter is a known type
+func validateTypeNames(names []string, knownNames map[string]bool, attributeName string, diagnostics *diag.Diagnostics) {
+	for _, name := range names {
+		if !knownNames[name] {
+			diagnostics.AddAttributeError(path.Root(attributeName), "Unknown config object type",
+				"\""+name+"\" is not a config object type that the Configuration API can list")
+		}
+	}
+}
+
+// Read resource information
+func (r *configurationSnapshotDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current state
+	var state configurationSnapshotDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	knownNames := map[string]bool{}
+	for _, configType := range configTypes {
+		knownNames[configType.name] = true
+	}
+	includeTypes := internaltypes.GetStringSetValues(ctx, state.IncludeTypes, &resp.Diagnostics)
+	excludeTypes := internaltypes.GetStringSetValues(ctx, state.ExcludeTypes, &resp.Diagnostics)
+	validateTypeNames(includeTypes, knownNames, "include_types", &resp.Diagnostics)
+	validateTypeNames(excludeTypes, knownNames, "exclude_types", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	selected := map[string]bool{}
+	if len(includeTypes) == 0 {
+		selected = knownNames
+	}
+	for _, name := range includeTypes {
+		selected[name] = true
+	}
+	for _, name := range excludeTypes {
+		delete(selected, name)
+	}
+
+	snapshot, objectCount, err := readSnapshot(ctx, r.providerConfig, r.apiClient, configTypes, selected)
+	if err != nil {
+		resp.Diagnostics.AddError("An error occurred while reading the Configuration Snapshot", err.Error())
+		return
+	}
+	// Maps are encoded with sorted keys
+	snapshotJson, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to encode the Configuration Snapshot", err.Error())
+		return
+	}
+
+	// Read the response into the state
+	snapshotTypes := make([]string, 0, len(snapshot))
+	for name := range snapshot {
+		snapshotTypes = append(snapshotTypes, name)
+	}
+	sort.Strings(snapshotTypes)
+	state.Id = types.StringValue("id")
+	state.Types = internaltypes.GetStringSet(snapshotTypes)
+	state.ObjectCount = types.Int64Value(int64(objectCount))
+	state.Json = types.StringValue(string(snapshotJson) + "\n")
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -27,9 +27,11 @@ var (
 	_ resource.Resource                = &conjurAuthenticationMethodResource{}
 	_ resource.ResourceWithConfigure   = &conjurAuthenticationMethodResource{}
 	_ resource.ResourceWithImportState = &conjurAuthenticationMethodResource{}
+	_ resource.ResourceWithMoveState   = &conjurAuthenticationMethodResource{}
 	_ resource.Resource                = &defaultConjurAuthenticationMethodResource{}
 	_ resource.ResourceWithConfigure   = &defaultConjurAuthenticationMethodResource{}
 	_ resource.ResourceWithImportState = &defaultConjurAuthenticationMethodResource{}
+	_ resource.ResourceWithMoveState   = &defaultConjurAuthenticationMethodResource{}
 )
 
 // Create a Conjur Authentication Method resource
@@ -84,15 +86,19 @@ func (r *defaultConjurAuthenticationMethodResource) Configure(_ context.Context,
 }
 
 type conjurAuthenticationMethodResourceModel struct {
-	Id              types.String `tfsdk:"id"`
-	Name            types.String `tfsdk:"name"`
-	Notifications   types.Set    `tfsdk:"notifications"`
-	RequiredActions types.Set    `tfsdk:"required_actions"`
-	Type            types.String `tfsdk:"type"`
-	Username        types.String `tfsdk:"username"`
-	Password        types.String `tfsdk:"password"`
-	ApiKey          types.String `tfsdk:"api_key"`
-	Description     types.String `tfsdk:"description"`
+	Id                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Notifications     types.Set    `tfsdk:"notifications"`
+	RequiredActions   types.Set    `tfsdk:"required_actions"`
+	Type              types.String `tfsdk:"type"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWo        types.String `tfsdk:"password_wo"`
+	PasswordWoVersion types.Int64  `tfsdk:"password_wo_version"`
+	ApiKey            types.String `tfsdk:"api_key"`
+	ApiKeyWo          types.String `tfsdk:"api_key_wo"`
+	ApiKeyWoVersion   types.Int64  `tfsdk:"api_key_wo_version"`
+	Description       types.String `tfsdk:"description"`
 }
 
 // GetSchema defines the schema for the resource.
@@ -149,6 +155,8 @@ func conjurAuthenticationMethodSchema(ctx context.Context, req resource.SchemaRe
 		// Add any default properties and set optional properties to computed where necessary
 		config.SetAttributesToOptionalAndComputedAndRemoveDefaults(&schemaDef, []string{"type"})
 	}
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "password")
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "api_key")
 	config.AddCommonResourceSchema(&schemaDef, true)
 	resp.Schema = schemaDef
 }
@@ -159,10 +167,16 @@ func addOptionalApiKeyConjurAuthenticationMethodFields(ctx context.Context, addR
 	if internaltypes.IsNonEmptyString(plan.Password) {
 		addRequest.Password = plan.Password.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.PasswordWo) {
+		addRequest.Password = plan.PasswordWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.ApiKey) {
 		addRequest.ApiKey = plan.ApiKey.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.ApiKeyWo) {
+		addRequest.ApiKey = plan.ApiKeyWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.Description) {
 		addRequest.Description = plan.Description.ValueStringPointer()
@@ -201,9 +215,15 @@ func (state *conjurAuthenticationMethodResourceModel) setStateValuesNotReturnedB
 	if !expectedValues.Password.IsUnknown() {
 		state.Password = expectedValues.Password
 	}
+	if !expectedValues.PasswordWoVersion.IsUnknown() {
+		state.PasswordWoVersion = expectedValues.PasswordWoVersion
+	}
 	if !expectedValues.ApiKey.IsUnknown() {
 		state.ApiKey = expectedValues.ApiKey
 	}
+	if !expectedValues.ApiKeyWoVersion.IsUnknown() {
+		state.ApiKeyWoVersion = expectedValues.ApiKeyWoVersion
+	}
 }
 
 // Create any update operations necessary to make the state match the plan
@@ -211,7 +231,9 @@ func createConjurAuthenticationMethodOperations(plan conjurAuthenticationMethodR
 	var ops []client.Operation
 	operations.AddStringOperationIfNecessary(&ops, plan.Username, state.Username, "username")
 	operations.AddStringOperationIfNecessary(&ops, plan.Password, state.Password, "password")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.PasswordWo, plan.PasswordWoVersion, state.PasswordWoVersion, "password")
 	operations.AddStringOperationIfNecessary(&ops, plan.ApiKey, state.ApiKey, "api-key")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.ApiKeyWo, plan.ApiKeyWoVersion, state.ApiKeyWoVersion, "api-key")
 	operations.AddStringOperationIfNecessary(&ops, plan.Description, state.Description, "description")
 	return ops
 }
@@ -233,7 +255,7 @@ func (r *conjurAuthenticationMethodResource) CreateApiKeyConjurAuthenticationMet
 
 	addResponse, httpResp, err := r.apiClient.ConjurAuthenticationMethodAPI.AddConjurAuthenticationMethodExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Conjur Authentication Method", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Conjur Authentication Method", err, httpResp)
 		return nil, err
 	}
 
@@ -258,6 +280,12 @@ func (r *conjurAuthenticationMethodResource) Create(ctx context.Context, req res
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_key_wo"), &plan.ApiKeyWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	state, err := r.CreateApiKeyConjurAuthenticationMethod(ctx, req, resp, plan)
 	if err != nil {
@@ -266,6 +294,8 @@ func (r *conjurAuthenticationMethodResource) Create(ctx context.Context, req res
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Conjur Authentication Method", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -286,11 +316,17 @@ func (r *defaultConjurAuthenticationMethodResource) Create(ctx context.Context,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_key_wo"), &plan.ApiKeyWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	readResponse, httpResp, err := r.apiClient.ConjurAuthenticationMethodAPI.GetConjurAuthenticationMethod(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Conjur Authentication Method", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Conjur Authentication Method", err, httpResp)
 		return
 	}
 
@@ -314,7 +350,7 @@ func (r *defaultConjurAuthenticationMethodResource) Create(ctx context.Context,
 
 		updateResponse, httpResp, err := r.apiClient.ConjurAuthenticationMethodAPI.UpdateConjurAuthenticationMethodExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Conjur Authentication Method", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Conjur Authentication Method", err, httpResp)
 			return
 		}
 
@@ -326,6 +362,8 @@ func (r *defaultConjurAuthenticationMethodResource) Create(ctx context.Context,
 
 		// Read the response
 		readApiKeyConjurAuthenticationMethodResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Conjur Authentication Method", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -402,6 +440,12 @@ func updateConjurAuthenticationMethod(ctx context.Context, req resource.UpdateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_key_wo"), &plan.ApiKeyWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Get the current state to see how any attributes are changing
 	var state conjurAuthenticationMethodResourceModel
@@ -418,7 +462,7 @@ func updateConjurAuthenticationMethod(ctx context.Context, req resource.UpdateRe
 
 		updateResponse, httpResp, err := apiClient.ConjurAuthenticationMethodAPI.UpdateConjurAuthenticationMethodExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Conjur Authentication Method", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Conjur Authentication Method", err, httpResp)
 			return
 		}
 
@@ -430,6 +474,8 @@ func updateConjurAuthenticationMethod(ctx context.Context, req resource.UpdateRe
 
 		// Read the response
 		readApiKeyConjurAuthenticationMethodResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Conjur Authentication Method", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -478,3 +524,16 @@ func importConjurAuthenticationMethod(ctx context.Context, req resource.ImportSt
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *conjurAuthenticationMethodResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_conjur_authentication_method"),
+	}
+}
+
+func (r *defaultConjurAuthenticationMethodResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_conjur_authentication_method"),
+	}
+}
@@ -54,10 +54,10 @@ type connectionCriteriaDataSourceModel struct {
 	Type                             types.String `tfsdk:"type"`
 	ExtensionClass                   types.String `tfsdk:"extension_class"`
 	ExtensionArgument                types.Set    `tfsdk:"extension_argument"`
-	AllIncludedConnectionCriteria    types.Set    `tfsdk:"all_included_connection_criteria"`
-	AnyIncludedConnectionCriteria    types.Set    `tfsdk:"any_included_connection_criteria"`
-	NotAllIncludedConnectionCriteria types.Set    `tfsdk:"not_all_included_connection_criteria"`
-	NoneIncludedConnectionCriteria   types.Set    `tfsdk:"none_included_connection_criteria"`
+	AllIncludedConnectionCriteria    types.List   `tfsdk:"all_included_connection_criteria"`
+	AnyIncludedConnectionCriteria    types.List   `tfsdk:"any_included_connection_criteria"`
+	NotAllIncludedConnectionCriteria types.List   `tfsdk:"not_all_included_connection_criteria"`
+	NoneIncludedConnectionCriteria   types.List   `tfsdk:"none_included_connection_criteria"`
 	IncludedClientAddress            types.Set    `tfsdk:"included_client_address"`
 	ExcludedClientAddress            types.Set    `tfsdk:"excluded_client_address"`
 	IncludedConnectionHandler        types.Set    `tfsdk:"included_connection_handler"`
@@ -110,28 +110,28 @@ func (r *connectionCriteriaDataSource) Schema(ctx context.Context, req datasourc
 				Computed:    true,
 				ElementType: types.StringType,
 			},
-			"all_included_connection_criteria": schema.SetAttribute{
+			"all_included_connection_criteria": schema.ListAttribute{
 				Description: "Specifies a connection criteria object that must match the associated client connection in order to match the aggregate connection criteria. If one or more all-included connection criteria objects are provided, then a client connection must match all of them in order to match the aggregate connection criteria.",
 				Required:    false,
 				Optional:    false,
 				Computed:    true,
 				ElementType: types.StringType,
 			},
-			"any_included_connection_criteria": schema.SetAttribute{
+			"any_included_connection_criteria": schema.ListAttribute{
 				Description: "Specifies a connection criteria object that may match the associated client connection in order to match the aggregate connection criteria. If one or more any-included connection criteria objects are provided, then a client connection must match at least one of them in order to match the aggregate connection criteria.",
 				Required:    false,
 				Optional:    false,
 				Computed:    true,
 				ElementType: types.StringType,
 			},
-			"not_all_included_connection_criteria": schema.SetAttribute{
+			"not_all_included_connection_criteria": schema.ListAttribute{
 				Description: "Specifies a connection criteria object that should not match the associated client connection in order to match the aggregate connection criteria. If one or more not-all-included connection criteria objects are provided, then a client connection must not match all of them (that is, it may match zero or more of them, but it must not match all of them) in order to match the aggregate connection criteria.",
 				Required:    false,
 				Optional:    false,
 				Computed:    true,
 				ElementType: types.StringType,
 			},
-			"none_included_connection_criteria": schema.SetAttribute{
+			"none_included_connection_criteria": schema.ListAttribute{
 				Description: "Specifies a connection criteria object that must not match the associated client connection in order to match the aggregate connection criteria. If one or more none-included connection criteria objects are provided, then a client connection must not match any of them in order to match the aggregate connection criteria.",
 				Required:    false,
 				Optional:    false,
@@ -368,10 +368,10 @@ func readAggregateConnectionCriteriaResponseDataSource(ctx context.Context, r *c
 	state.Type = types.StringValue("aggregate")
 	state.Id = types.StringValue(r.Id)
 	state.Name = types.StringValue(r.Id)
-	state.AllIncludedConnectionCriteria = internaltypes.GetStringSet(r.AllIncludedConnectionCriteria)
-	state.AnyIncludedConnectionCriteria = internaltypes.GetStringSet(r.AnyIncludedConnectionCriteria)
-	state.NotAllIncludedConnectionCriteria = internaltypes.GetStringSet(r.NotAllIncludedConnectionCriteria)
-	state.NoneIncludedConnectionCriteria = internaltypes.GetStringSet(r.NoneIncludedConnectionCriteria)
+	state.AllIncludedConnectionCriteria = internaltypes.GetStringList(r.AllIncludedConnectionCriteria)
+	state.AnyIncludedConnectionCriteria = internaltypes.GetStringList(r.AnyIncludedConnectionCriteria)
+	state.NotAllIncludedConnectionCriteria = internaltypes.GetStringList(r.NotAllIncludedConnectionCriteria)
+	state.NoneIncludedConnectionCriteria = internaltypes.GetStringList(r.NoneIncludedConnectionCriteria)
 	state.Description = internaltypes.StringTypeOrNil(r.Description, false)
 }
 
@@ -28,9 +28,11 @@ var (
 	_ resource.Resource                = &connectionCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &connectionCriteriaResource{}
 	_ resource.ResourceWithImportState = &connectionCriteriaResource{}
+	_ resource.ResourceWithMoveState   = &connectionCriteriaResource{}
 	_ resource.Resource                = &defaultConnectionCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &defaultConnectionCriteriaResource{}
 	_ resource.ResourceWithImportState = &defaultConnectionCriteriaResource{}
+	_ resource.ResourceWithMoveState   = &defaultConnectionCriteriaResource{}
 )
 
 // Create a Connection Criteria resource
@@ -92,10 +94,10 @@ type connectionCriteriaResourceModel struct {
 	Type                             types.String `tfsdk:"type"`
 	ExtensionClass                   types.String `tfsdk:"extension_class"`
 	ExtensionArgument                types.Set    `tfsdk:"extension_argument"`
-	AllIncludedConnectionCriteria    types.Set    `tfsdk:"all_included_connection_criteria"`
-	AnyIncludedConnectionCriteria    types.Set    `tfsdk:"any_included_connection_criteria"`
-	NotAllIncludedConnectionCriteria types.Set    `tfsdk:"not_all_included_connection_criteria"`
-	NoneIncludedConnectionCriteria   types.Set    `tfsdk:"none_included_connection_criteria"`
+	AllIncludedConnectionCriteria    types.List   `tfsdk:"all_included_connection_criteria"`
+	AnyIncludedConnectionCriteria    types.List   `tfsdk:"any_included_connection_criteria"`
+	NotAllIncludedConnectionCriteria types.List   `tfsdk:"not_all_included_connection_criteria"`
+	NoneIncludedConnectionCriteria   types.List   `tfsdk:"none_included_connection_criteria"`
 	IncludedClientAddress            types.Set    `tfsdk:"included_client_address"`
 	ExcludedClientAddress            types.Set    `tfsdk:"excluded_client_address"`
 	IncludedConnectionHandler        types.Set    `tfsdk:"included_connection_handler"`
@@ -158,32 +160,32 @@ func connectionCriteriaSchema(ctx context.Context, req resource.SchemaRequest, r
 				Default:     internaltypes.EmptySetDefault(types.StringType),
 				ElementType: types.StringType,
 			},
-			"all_included_connection_criteria": schema.SetAttribute{
+			"all_included_connection_criteria": schema.ListAttribute{
 				Description: "Specifies a connection criteria object that must match the associated client connection in order to match the aggregate connection criteria. If one or more all-included connection criteria objects are provided, then a client connection must match all of them in order to match the aggregate connection criteria.",
 				Optional:    true,
 				Computed:    true,
-				Default:     internaltypes.EmptySetDefault(types.StringType),
+				Default:     internaltypes.EmptyListDefault(types.StringType),
 				ElementType: types.StringType,
 			},
-			"any_included_connection_criteria": schema.SetAttribute{
+			"any_included_connection_criteria": schema.ListAttribute{
 				Description: "Specifies a connection criteria object that may match the associated client connection in order to match the aggregate connection criteria. If one or more any-included connection criteria objects are provided, then a client connection must match at least one of them in order to match the aggregate connection criteria.",
 				Optional:    true,
 				Computed:    true,
-				Default:     internaltypes.EmptySetDefault(types.StringType),
+				Default:     internaltypes.EmptyListDefault(types.StringType),
 				ElementType: types.StringType,
 			},
-			"not_all_included_connection_criteria": schema.SetAttribute{
+			"not_all_included_connection_criteria": schema.ListAttribute{
 				Description: "Specifies a connection criteria object that should not match the associated client connection in order to match the aggregate connection criteria. If one or more not-all-included connection criteria objects are provided, then a client connection must not match all of them (that is, it may match zero or more of them, but it must not match all of them) in order to match the aggregate connection criteria.",
 				Optional:    true,
 				Computed:    true,
-				Default:     internaltypes.EmptySetDefault(types.StringType),
+				Default:     internaltypes.EmptyListDefault(types.StringType),
 				ElementType: types.StringType,
 			},
-			"none_included_connection_criteria": schema.SetAttribute{
+			"none_included_connection_criteria": schema.ListAttribute{
 				Description: "Specifies a connection criteria object that must not match the associated client connection in order to match the aggregate connection criteria. If one or more none-included connection criteria objects are provided, then a client connection must not match any of them in order to match the aggregate connection criteria.",
 				Optional:    true,
 				Computed:    true,
-				Default:     internaltypes.EmptySetDefault(types.StringType),
+				Default:     internaltypes.EmptyListDefault(types.StringType),
 				ElementType: types.StringType,
 			},
 			"included_client_address": schema.SetAttribute{
@@ -863,13 +865,13 @@ func populateConnectionCriteriaUnknownValues(model *connectionCriteriaResourceMo
 		model.ExcludedUserSASLMechanism, _ = types.SetValue(types.StringType, []attr.Value{})
 	}
 	if model.AnyIncludedConnectionCriteria.IsUnknown() || model.AnyIncludedConnectionCriteria.IsNull() {
-		model.AnyIncludedConnectionCriteria, _ = types.SetValue(types.StringType, []attr.Value{})
+		model.AnyIncludedConnectionCriteria, _ = types.ListValue(types.StringType, []attr.Value{})
 	}
 	if model.ExcludedConnectionHandler.IsUnknown() || model.ExcludedConnectionHandler.IsNull() {
 		model.ExcludedConnectionHandler, _ = types.SetValue(types.StringType, []attr.Value{})
 	}
 	if model.NotAllIncludedConnectionCriteria.IsUnknown() || model.NotAllIncludedConnectionCriteria.IsNull() {
-		model.NotAllIncludedConnectionCriteria, _ = types.SetValue(types.StringType, []attr.Value{})
+		model.NotAllIncludedConnectionCriteria, _ = types.ListValue(types.StringType, []attr.Value{})
 	}
 	if model.AllIncludedUserFilter.IsUnknown() || model.AllIncludedUserFilter.IsNull() {
 		model.AllIncludedUserFilter, _ = types.SetValue(types.StringType, []attr.Value{})
@@ -914,7 +916,7 @@ func populateConnectionCriteriaUnknownValues(model *connectionCriteriaResourceMo
 		model.NoneIncludedUserFilter, _ = types.SetValue(types.StringType, []attr.Value{})
 	}
 	if model.AllIncludedConnectionCriteria.IsUnknown() || model.AllIncludedConnectionCriteria.IsNull() {
-		model.AllIncludedConnectionCriteria, _ = types.SetValue(types.StringType, []attr.Value{})
+		model.AllIncludedConnectionCriteria, _ = types.ListValue(types.StringType, []attr.Value{})
 	}
 	if model.ExcludedProtocol.IsUnknown() || model.ExcludedProtocol.IsNull() {
 		model.ExcludedProtocol, _ = types.SetValue(types.StringType, []attr.Value{})
@@ -923,7 +925,7 @@ func populateConnectionCriteriaUnknownValues(model *connectionCriteriaResourceMo
 		model.AnyIncludedUserFilter, _ = types.SetValue(types.StringType, []attr.Value{})
 	}
 	if model.NoneIncludedConnectionCriteria.IsUnknown() || model.NoneIncludedConnectionCriteria.IsNull() {
-		model.NoneIncludedConnectionCriteria, _ = types.SetValue(types.StringType, []attr.Value{})
+		model.NoneIncludedConnectionCriteria, _ = types.ListValue(types.StringType, []attr.Value{})
 	}
 	if model.NoneIncludedUserGroupDN.IsUnknown() || model.NoneIncludedUserGroupDN.IsNull() {
 		model.NoneIncludedUserGroupDN, _ = types.SetValue(types.StringType, []attr.Value{})
@@ -993,10 +995,10 @@ func readAggregateConnectionCriteriaResponse(ctx context.Context, r *client.Aggr
 	state.Type = types.StringValue("aggregate")
 	state.Id = types.StringValue(r.Id)
 	state.Name = types.StringValue(r.Id)
-	state.AllIncludedConnectionCriteria = internaltypes.GetStringSet(r.AllIncludedConnectionCriteria)
-	state.AnyIncludedConnectionCriteria = internaltypes.GetStringSet(r.AnyIncludedConnectionCriteria)
-	state.NotAllIncludedConnectionCriteria = internaltypes.GetStringSet(r.NotAllIncludedConnectionCriteria)
-	state.NoneIncludedConnectionCriteria = internaltypes.GetStringSet(r.NoneIncludedConnectionCriteria)
+	state.AllIncludedConnectionCriteria = internaltypes.GetStringList(r.AllIncludedConnectionCriteria)
+	state.AnyIncludedConnectionCriteria = internaltypes.GetStringList(r.AnyIncludedConnectionCriteria)
+	state.NotAllIncludedConnectionCriteria = internaltypes.GetStringList(r.NotAllIncludedConnectionCriteria)
+	state.NoneIncludedConnectionCriteria = internaltypes.GetStringList(r.NoneIncludedConnectionCriteria)
 	state.Description = internaltypes.StringTypeOrNil(r.Description, internaltypes.IsEmptyString(expectedValues.Description))
 	state.Notifications, state.RequiredActions = config.ReadMessages(ctx, r.Urnpingidentityschemasconfigurationmessages20, diagnostics)
 	populateConnectionCriteriaUnknownValues(state)
@@ -1019,10 +1021,10 @@ func createConnectionCriteriaOperations(plan connectionCriteriaResourceModel, st
 	var ops []client.Operation
 	operations.AddStringOperationIfNecessary(&ops, plan.ExtensionClass, state.ExtensionClass, "extension-class")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.ExtensionArgument, state.ExtensionArgument, "extension-argument")
-	operations.AddStringSetOperationsIfNecessary(&ops, plan.AllIncludedConnectionCriteria, state.AllIncludedConnectionCriteria, "all-included-connection-criteria")
-	operations.AddStringSetOperationsIfNecessary(&ops, plan.AnyIncludedConnectionCriteria, state.AnyIncludedConnectionCriteria, "any-included-connection-criteria")
-	operations.AddStringSetOperationsIfNecessary(&ops, plan.NotAllIncludedConnectionCriteria, state.NotAllIncludedConnectionCriteria, "not-all-included-connection-criteria")
-	operations.AddStringSetOperationsIfNecessary(&ops, plan.NoneIncludedConnectionCriteria, state.NoneIncludedConnectionCriteria, "none-included-connection-criteria")
+	operations.AddStringListOperationsIfNecessary(&ops, plan.AllIncludedConnectionCriteria, state.AllIncludedConnectionCriteria, "all-included-connection-criteria")
+	operations.AddStringListOperationsIfNecessary(&ops, plan.AnyIncludedConnectionCriteria, state.AnyIncludedConnectionCriteria, "any-included-connection-criteria")
+	operations.AddStringListOperationsIfNecessary(&ops, plan.NotAllIncludedConnectionCriteria, state.NotAllIncludedConnectionCriteria, "not-all-included-connection-criteria")
+	operations.AddStringListOperationsIfNecessary(&ops, plan.NoneIncludedConnectionCriteria, state.NoneIncludedConnectionCriteria, "none-included-connection-criteria")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.IncludedClientAddress, state.IncludedClientAddress, "included-client-address")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.ExcludedClientAddress, state.ExcludedClientAddress, "excluded-client-address")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.IncludedConnectionHandler, state.IncludedConnectionHandler, "included-connection-handler")
@@ -1073,7 +1075,7 @@ func (r *connectionCriteriaResource) CreateSimpleConnectionCriteria(ctx context.
 
 	addResponse, httpResp, err := r.apiClient.ConnectionCriteriaAPI.AddConnectionCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Connection Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Connection Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -1110,7 +1112,7 @@ func (r *connectionCriteriaResource) CreateAggregateConnectionCriteria(ctx conte
 
 	addResponse, httpResp, err := r.apiClient.ConnectionCriteriaAPI.AddConnectionCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Connection Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Connection Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -1148,7 +1150,7 @@ func (r *connectionCriteriaResource) CreateThirdPartyConnectionCriteria(ctx cont
 
 	addResponse, httpResp, err := r.apiClient.ConnectionCriteriaAPI.AddConnectionCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Connection Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Connection Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -1195,6 +1197,8 @@ func (r *connectionCriteriaResource) Create(ctx context.Context, req resource.Cr
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Connection Criteria", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -1219,7 +1223,7 @@ func (r *defaultConnectionCriteriaResource) Create(ctx context.Context, req reso
 	readResponse, httpResp, err := r.apiClient.ConnectionCriteriaAPI.GetConnectionCriteria(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Connection Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Connection Criteria", err, httpResp)
 		return
 	}
 
@@ -1251,7 +1255,7 @@ func (r *defaultConnectionCriteriaResource) Create(ctx context.Context, req reso
 
 		updateResponse, httpResp, err := r.apiClient.ConnectionCriteriaAPI.UpdateConnectionCriteriaExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Connection Criteria", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Connection Criteria", err, httpResp)
 			return
 		}
 
@@ -1271,6 +1275,8 @@ func (r *defaultConnectionCriteriaResource) Create(ctx context.Context, req reso
 		if updateResponse.ThirdPartyConnectionCriteriaResponse != nil {
 			readThirdPartyConnectionCriteriaResponse(ctx, updateResponse.ThirdPartyConnectionCriteriaResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Connection Criteria", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -1370,7 +1376,7 @@ func updateConnectionCriteria(ctx context.Context, req resource.UpdateRequest, r
 
 		updateResponse, httpResp, err := apiClient.ConnectionCriteriaAPI.UpdateConnectionCriteriaExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Connection Criteria", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Connection Criteria", err, httpResp)
 			return
 		}
 
@@ -1390,6 +1396,8 @@ func updateConnectionCriteria(ctx context.Context, req resource.UpdateRequest, r
 		if updateResponse.ThirdPartyConnectionCriteriaResponse != nil {
 			readThirdPartyConnectionCriteriaResponse(ctx, updateResponse.ThirdPartyConnectionCriteriaResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Connection Criteria", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -1437,3 +1445,16 @@ func importConnectionCriteria(ctx context.Context, req resource.ImportStateReque
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *connectionCriteriaResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_connection_criteria"),
+	}
+}
+
+func (r *defaultConnectionCriteriaResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_connection_criteria"),
+	}
+}
@@ -33,9 +33,11 @@ var (
 	_ resource.Resource                = &connectionHandlerResource{}
 	_ resource.ResourceWithConfigure   = &connectionHandlerResource{}
 	_ resource.ResourceWithImportState = &connectionHandlerResource{}
+	_ resource.ResourceWithMoveState   = &connectionHandlerResource{}
 	_ resource.Resource                = &defaultConnectionHandlerResource{}
 	_ resource.ResourceWithConfigure   = &defaultConnectionHandlerResource{}
 	_ resource.ResourceWithImportState = &defaultConnectionHandlerResource{}
+	_ resource.ResourceWithMoveState   = &defaultConnectionHandlerResource{}
 )
 
 // Create a Connection Handler resource
@@ -1762,7 +1764,7 @@ func (r *connectionHandlerResource) CreateJmxConnectionHandler(ctx context.Conte
 
 	addResponse, httpResp, err := r.apiClient.ConnectionHandlerAPI.AddConnectionHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Connection Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Connection Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1801,7 +1803,7 @@ func (r *connectionHandlerResource) CreateLdapConnectionHandler(ctx context.Cont
 
 	addResponse, httpResp, err := r.apiClient.ConnectionHandlerAPI.AddConnectionHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Connection Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Connection Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1839,7 +1841,7 @@ func (r *connectionHandlerResource) CreateLdifConnectionHandler(ctx context.Cont
 
 	addResponse, httpResp, err := r.apiClient.ConnectionHandlerAPI.AddConnectionHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Connection Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Connection Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1878,7 +1880,7 @@ func (r *connectionHandlerResource) CreateHttpConnectionHandler(ctx context.Cont
 
 	addResponse, httpResp, err := r.apiClient.ConnectionHandlerAPI.AddConnectionHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Connection Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Connection Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1931,6 +1933,8 @@ func (r *connectionHandlerResource) Create(ctx context.Context, req resource.Cre
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Connection Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -1955,7 +1959,7 @@ func (r *defaultConnectionHandlerResource) Create(ctx context.Context, req resou
 	readResponse, httpResp, err := r.apiClient.ConnectionHandlerAPI.GetConnectionHandler(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Connection Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Connection Handler", err, httpResp)
 		return
 	}
 
@@ -1990,7 +1994,7 @@ func (r *defaultConnectionHandlerResource) Create(ctx context.Context, req resou
 
 		updateResponse, httpResp, err := r.apiClient.ConnectionHandlerAPI.UpdateConnectionHandlerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Connection Handler", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Connection Handler", err, httpResp)
 			return
 		}
 
@@ -2013,6 +2017,8 @@ func (r *defaultConnectionHandlerResource) Create(ctx context.Context, req resou
 		if updateResponse.HttpConnectionHandlerResponse != nil {
 			readHttpConnectionHandlerResponse(ctx, updateResponse.HttpConnectionHandlerResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Connection Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -2115,7 +2121,7 @@ func updateConnectionHandler(ctx context.Context, req resource.UpdateRequest, re
 
 		updateResponse, httpResp, err := apiClient.ConnectionHandlerAPI.UpdateConnectionHandlerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Connection Handler", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Connection Handler", err, httpResp)
 			return
 		}
 
@@ -2138,6 +2144,8 @@ func updateConnectionHandler(ctx context.Context, req resource.UpdateRequest, re
 		if updateResponse.HttpConnectionHandlerResponse != nil {
 			readHttpConnectionHandlerResponse(ctx, updateResponse.HttpConnectionHandlerResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Connection Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -2185,3 +2193,16 @@ func importConnectionHandler(ctx context.Context, req resource.ImportStateReques
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *connectionHandlerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_connection_handler"),
+	}
+}
+
+func (r *defaultConnectionHandlerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_connection_handler"),
+	}
+}
@@ -49,29 +49,21 @@ func (r *connectionHandlersDataSource) Configure(_ context.Context, req datasour
 }
 
 type connectionHandlersDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *connectionHandlersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Connection Handler objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Connection Handler objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewConnectionHandlerDataSource(), "Connection Handler")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *connectionHandlersDataSource) Read(ctx context.Context, req datasource.
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewConnectionHandlerDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.ConnectionHandlerAPI.ListConnectionHandlers(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.ConnectionHandlerAPI.ListConnectionHandlersExecute(listRequest)
@@ -106,33 +104,32 @@ func (r *connectionHandlersDataSource) Read(ctx context.Context, req datasource.
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object connectionHandlerDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.HttpConnectionHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.HttpConnectionHandlerResponse.Id)
-			attributes["type"] = types.StringValue("http")
+			readHttpConnectionHandlerResponseDataSource(ctx, response.HttpConnectionHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.JmxConnectionHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.JmxConnectionHandlerResponse.Id)
-			attributes["type"] = types.StringValue("jmx")
+			readJmxConnectionHandlerResponseDataSource(ctx, response.JmxConnectionHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.LdapConnectionHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.LdapConnectionHandlerResponse.Id)
-			attributes["type"] = types.StringValue("ldap")
+			readLdapConnectionHandlerResponseDataSource(ctx, response.LdapConnectionHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.LdifConnectionHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.LdifConnectionHandlerResponse.Id)
-			attributes["type"] = types.StringValue("ldif")
+			readLdifConnectionHandlerResponseDataSource(ctx, response.LdifConnectionHandlerResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -27,9 +27,11 @@ var (
 	_ resource.Resource                = &consentDefinitionResource{}
 	_ resource.ResourceWithConfigure   = &consentDefinitionResource{}
 	_ resource.ResourceWithImportState = &consentDefinitionResource{}
+	_ resource.ResourceWithMoveState   = &consentDefinitionResource{}
 	_ resource.Resource                = &defaultConsentDefinitionResource{}
 	_ resource.ResourceWithConfigure   = &defaultConsentDefinitionResource{}
 	_ resource.ResourceWithImportState = &defaultConsentDefinitionResource{}
+	_ resource.ResourceWithMoveState   = &defaultConsentDefinitionResource{}
 )
 
 // Create a Consent Definition resource
@@ -228,7 +230,7 @@ func (r *consentDefinitionResource) CreateConsentDefinition(ctx context.Context,
 
 	addResponse, httpResp, err := r.apiClient.ConsentDefinitionAPI.AddConsentDefinitionExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Consent Definition", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Consent Definition", err, httpResp)
 		return nil, err
 	}
 
@@ -259,6 +261,8 @@ func (r *consentDefinitionResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Consent Definition", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -283,7 +287,7 @@ func (r *defaultConsentDefinitionResource) Create(ctx context.Context, req resou
 	readResponse, httpResp, err := r.apiClient.ConsentDefinitionAPI.GetConsentDefinition(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.UniqueID.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Consent Definition", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Consent Definition", err, httpResp)
 		return
 	}
 
@@ -307,7 +311,7 @@ func (r *defaultConsentDefinitionResource) Create(ctx context.Context, req resou
 
 		updateResponse, httpResp, err := r.apiClient.ConsentDefinitionAPI.UpdateConsentDefinitionExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Consent Definition", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Consent Definition", err, httpResp)
 			return
 		}
 
@@ -319,6 +323,8 @@ func (r *defaultConsentDefinitionResource) Create(ctx context.Context, req resou
 
 		// Read the response
 		readConsentDefinitionResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Consent Definition", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -410,7 +416,7 @@ func updateConsentDefinition(ctx context.Context, req resource.UpdateRequest, re
 
 		updateResponse, httpResp, err := apiClient.ConsentDefinitionAPI.UpdateConsentDefinitionExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Consent Definition", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Consent Definition", err, httpResp)
 			return
 		}
 
@@ -422,6 +428,8 @@ func updateConsentDefinition(ctx context.Context, req resource.UpdateRequest, re
 
 		// Read the response
 		readConsentDefinitionResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Consent Definition", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -469,3 +477,16 @@ func importConsentDefinition(ctx context.Context, req resource.ImportStateReques
 	// Retrieve import ID and save to unique_id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("unique_id"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *consentDefinitionResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_consent_definition"),
+	}
+}
+
+func (r *defaultConsentDefinitionResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_consent_definition"),
+	}
+}
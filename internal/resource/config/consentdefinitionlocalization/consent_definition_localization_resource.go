@@ -28,9 +28,11 @@ var (
 	_ resource.Resource                = &consentDefinitionLocalizationResource{}
 	_ resource.ResourceWithConfigure   = &consentDefinitionLocalizationResource{}
 	_ resource.ResourceWithImportState = &consentDefinitionLocalizationResource{}
+	_ resource.ResourceWithMoveState   = &consentDefinitionLocalizationResource{}
 	_ resource.Resource                = &defaultConsentDefinitionLocalizationResource{}
 	_ resource.ResourceWithConfigure   = &defaultConsentDefinitionLocalizationResource{}
 	_ resource.ResourceWithImportState = &defaultConsentDefinitionLocalizationResource{}
+	_ resource.ResourceWithMoveState   = &defaultConsentDefinitionLocalizationResource{}
 )
 
 // Create a Consent Definition Localization resource
@@ -249,7 +251,7 @@ func (r *consentDefinitionLocalizationResource) CreateConsentDefinitionLocalizat
 
 	addResponse, httpResp, err := r.apiClient.ConsentDefinitionLocalizationAPI.AddConsentDefinitionLocalizationExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Consent Definition Localization", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Consent Definition Localization", err, httpResp)
 		return nil, err
 	}
 
@@ -282,6 +284,8 @@ func (r *consentDefinitionLocalizationResource) Create(ctx context.Context, req
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Consent Definition Localization", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -306,7 +310,7 @@ func (r *defaultConsentDefinitionLocalizationResource) Create(ctx context.Contex
 	readResponse, httpResp, err := r.apiClient.ConsentDefinitionLocalizationAPI.GetConsentDefinitionLocalization(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Locale.ValueString(), plan.ConsentDefinitionName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Consent Definition Localization", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Consent Definition Localization", err, httpResp)
 		return
 	}
 
@@ -330,7 +334,7 @@ func (r *defaultConsentDefinitionLocalizationResource) Create(ctx context.Contex
 
 		updateResponse, httpResp, err := r.apiClient.ConsentDefinitionLocalizationAPI.UpdateConsentDefinitionLocalizationExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Consent Definition Localization", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Consent Definition Localization", err, httpResp)
 			return
 		}
 
@@ -342,6 +346,8 @@ func (r *defaultConsentDefinitionLocalizationResource) Create(ctx context.Contex
 
 		// Read the response
 		readConsentDefinitionLocalizationResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Consent Definition Localization", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -434,7 +440,7 @@ func updateConsentDefinitionLocalization(ctx context.Context, req resource.Updat
 
 		updateResponse, httpResp, err := apiClient.ConsentDefinitionLocalizationAPI.UpdateConsentDefinitionLocalizationExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Consent Definition Localization", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Consent Definition Localization", err, httpResp)
 			return
 		}
 
@@ -446,6 +452,8 @@ func updateConsentDefinitionLocalization(ctx context.Context, req resource.Updat
 
 		// Read the response
 		readConsentDefinitionLocalizationResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Consent Definition Localization", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -500,3 +508,16 @@ func importConsentDefinitionLocalization(ctx context.Context, req resource.Impor
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("consent_definition_name"), split[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("locale"), split[1])...)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *consentDefinitionLocalizationResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_consent_definition_localization"),
+	}
+}
+
+func (r *defaultConsentDefinitionLocalizationResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_consent_definition_localization"),
+	}
+}
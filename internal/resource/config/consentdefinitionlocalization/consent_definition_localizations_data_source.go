@@ -50,9 +50,12 @@ func (r *consentDefinitionLocalizationsDataSource) Configure(_ context.Context,
 
 type consentDefinitionLocalizationsDataSourceModel struct {
 	Id                    types.String `tfsdk:"id"`
-	Filter                types.String `tfsdk:"filter"`
 	Ids                   types.Set    `tfsdk:"ids"`
+	Objects               types.Set    `tfsdk:"objects"`
+	ObjectsById           types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes     types.Bool   `tfsdk:"include_attributes"`
 	ConsentDefinitionName types.String `tfsdk:"consent_definition_name"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
@@ -64,10 +67,6 @@ func (r *consentDefinitionLocalizationsDataSource) Schema(ctx context.Context, r
 				Description: "Name of the parent Consent Definition",
 				Required:    true,
 			},
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
 			"ids": schema.SetAttribute{
 				Description: "Consent Definition Localization IDs found in the configuration",
 				Required:    false,
@@ -77,6 +76,8 @@ func (r *consentDefinitionLocalizationsDataSource) Schema(ctx context.Context, r
 			},
 		},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewConsentDefinitionLocalizationDataSource(), "Consent Definition Localization")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -91,9 +92,15 @@ func (r *consentDefinitionLocalizationsDataSource) Read(ctx context.Context, req
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewConsentDefinitionLocalizationDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.ConsentDefinitionLocalizationAPI.ListConsentDefinitionLocalizations(config.ProviderBasicAuthContext(ctx, r.providerConfig), state.ConsentDefinitionName.ValueString())
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.ConsentDefinitionLocalizationAPI.ListConsentDefinitionLocalizationsExecute(listRequest)
@@ -110,12 +117,24 @@ func (r *consentDefinitionLocalizationsDataSource) Read(ctx context.Context, req
 
 	// Read the response into the state
 	ids := []attr.Value{}
+	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
+		var object consentDefinitionLocalizationDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
+		readConsentDefinitionLocalizationResponseDataSource(ctx, &response, &object, &resp.Diagnostics)
+		object.ConsentDefinitionName = state.ConsentDefinitionName
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
+		}
 		ids = append(ids, types.StringValue(response.Id))
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 
 	state.Ids, diags = types.SetValue(types.StringType, ids)
 	resp.Diagnostics.Append(diags...)
+	state.Objects, diags = types.SetValue(objectType, objects)
+	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
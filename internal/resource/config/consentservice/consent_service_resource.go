@@ -219,7 +219,7 @@ func (r *consentServiceResource) Create(ctx context.Context, req resource.Create
 	readResponse, httpResp, err := r.apiClient.ConsentServiceAPI.GetConsentService(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig)).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Consent Service", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Consent Service", err, httpResp)
 		return
 	}
 
@@ -243,7 +243,7 @@ func (r *consentServiceResource) Create(ctx context.Context, req resource.Create
 
 		updateResponse, httpResp, err := r.apiClient.ConsentServiceAPI.UpdateConsentServiceExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Consent Service", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Consent Service", err, httpResp)
 			return
 		}
 
@@ -255,6 +255,8 @@ func (r *consentServiceResource) Create(ctx context.Context, req resource.Create
 
 		// Read the response
 		readConsentServiceResponse(ctx, updateResponse, &state, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Consent Service", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -320,7 +322,7 @@ func (r *consentServiceResource) Update(ctx context.Context, req resource.Update
 
 		updateResponse, httpResp, err := r.apiClient.ConsentServiceAPI.UpdateConsentServiceExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Consent Service", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Consent Service", err, httpResp)
 			return
 		}
 
@@ -332,6 +334,8 @@ func (r *consentServiceResource) Update(ctx context.Context, req resource.Update
 
 		// Read the response
 		readConsentServiceResponse(ctx, updateResponse, &state, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Consent Service", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
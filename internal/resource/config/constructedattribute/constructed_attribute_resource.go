@@ -27,9 +27,11 @@ var (
 	_ resource.Resource                = &constructedAttributeResource{}
 	_ resource.ResourceWithConfigure   = &constructedAttributeResource{}
 	_ resource.ResourceWithImportState = &constructedAttributeResource{}
+	_ resource.ResourceWithMoveState   = &constructedAttributeResource{}
 	_ resource.Resource                = &defaultConstructedAttributeResource{}
 	_ resource.ResourceWithConfigure   = &defaultConstructedAttributeResource{}
 	_ resource.ResourceWithImportState = &defaultConstructedAttributeResource{}
+	_ resource.ResourceWithMoveState   = &defaultConstructedAttributeResource{}
 )
 
 // Create a Constructed Attribute resource
@@ -204,7 +206,7 @@ func (r *constructedAttributeResource) CreateConstructedAttribute(ctx context.Co
 
 	addResponse, httpResp, err := r.apiClient.ConstructedAttributeAPI.AddConstructedAttributeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Constructed Attribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Constructed Attribute", err, httpResp)
 		return nil, err
 	}
 
@@ -235,6 +237,8 @@ func (r *constructedAttributeResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Constructed Attribute", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -259,7 +263,7 @@ func (r *defaultConstructedAttributeResource) Create(ctx context.Context, req re
 	readResponse, httpResp, err := r.apiClient.ConstructedAttributeAPI.GetConstructedAttribute(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Constructed Attribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Constructed Attribute", err, httpResp)
 		return
 	}
 
@@ -283,7 +287,7 @@ func (r *defaultConstructedAttributeResource) Create(ctx context.Context, req re
 
 		updateResponse, httpResp, err := r.apiClient.ConstructedAttributeAPI.UpdateConstructedAttributeExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Constructed Attribute", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Constructed Attribute", err, httpResp)
 			return
 		}
 
@@ -295,6 +299,8 @@ func (r *defaultConstructedAttributeResource) Create(ctx context.Context, req re
 
 		// Read the response
 		readConstructedAttributeResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Constructed Attribute", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -386,7 +392,7 @@ func updateConstructedAttribute(ctx context.Context, req resource.UpdateRequest,
 
 		updateResponse, httpResp, err := apiClient.ConstructedAttributeAPI.UpdateConstructedAttributeExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Constructed Attribute", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Constructed Attribute", err, httpResp)
 			return
 		}
 
@@ -398,6 +404,8 @@ func updateConstructedAttribute(ctx context.Context, req resource.UpdateRequest,
 
 		// Read the response
 		readConstructedAttributeResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Constructed Attribute", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -445,3 +453,16 @@ func importConstructedAttribute(ctx context.Context, req resource.ImportStateReq
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *constructedAttributeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_constructed_attribute"),
+	}
+}
+
+func (r *defaultConstructedAttributeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_constructed_attribute"),
+	}
+}
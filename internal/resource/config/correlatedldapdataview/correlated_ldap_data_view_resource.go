@@ -28,9 +28,11 @@ var (
 	_ resource.Resource                = &correlatedLdapDataViewResource{}
 	_ resource.ResourceWithConfigure   = &correlatedLdapDataViewResource{}
 	_ resource.ResourceWithImportState = &correlatedLdapDataViewResource{}
+	_ resource.ResourceWithMoveState   = &correlatedLdapDataViewResource{}
 	_ resource.Resource                = &defaultCorrelatedLdapDataViewResource{}
 	_ resource.ResourceWithConfigure   = &defaultCorrelatedLdapDataViewResource{}
 	_ resource.ResourceWithImportState = &defaultCorrelatedLdapDataViewResource{}
+	_ resource.ResourceWithMoveState   = &defaultCorrelatedLdapDataViewResource{}
 )
 
 // Create a Correlated Ldap Data View resource
@@ -288,7 +290,7 @@ func (r *correlatedLdapDataViewResource) CreateCorrelatedLdapDataView(ctx contex
 
 	addResponse, httpResp, err := r.apiClient.CorrelatedLdapDataViewAPI.AddCorrelatedLdapDataViewExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Correlated Ldap Data View", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Correlated Ldap Data View", err, httpResp)
 		return nil, err
 	}
 
@@ -321,6 +323,8 @@ func (r *correlatedLdapDataViewResource) Create(ctx context.Context, req resourc
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Correlated Ldap Data View", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -345,7 +349,7 @@ func (r *defaultCorrelatedLdapDataViewResource) Create(ctx context.Context, req
 	readResponse, httpResp, err := r.apiClient.CorrelatedLdapDataViewAPI.GetCorrelatedLdapDataView(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString(), plan.ScimResourceTypeName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Correlated Ldap Data View", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Correlated Ldap Data View", err, httpResp)
 		return
 	}
 
@@ -369,7 +373,7 @@ func (r *defaultCorrelatedLdapDataViewResource) Create(ctx context.Context, req
 
 		updateResponse, httpResp, err := r.apiClient.CorrelatedLdapDataViewAPI.UpdateCorrelatedLdapDataViewExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Correlated Ldap Data View", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Correlated Ldap Data View", err, httpResp)
 			return
 		}
 
@@ -381,6 +385,8 @@ func (r *defaultCorrelatedLdapDataViewResource) Create(ctx context.Context, req
 
 		// Read the response
 		readCorrelatedLdapDataViewResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Correlated Ldap Data View", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -473,7 +479,7 @@ func updateCorrelatedLdapDataView(ctx context.Context, req resource.UpdateReques
 
 		updateResponse, httpResp, err := apiClient.CorrelatedLdapDataViewAPI.UpdateCorrelatedLdapDataViewExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Correlated Ldap Data View", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Correlated Ldap Data View", err, httpResp)
 			return
 		}
 
@@ -485,6 +491,8 @@ func updateCorrelatedLdapDataView(ctx context.Context, req resource.UpdateReques
 
 		// Read the response
 		readCorrelatedLdapDataViewResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Correlated Ldap Data View", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -539,3 +547,16 @@ func importCorrelatedLdapDataView(ctx context.Context, req resource.ImportStateR
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scim_resource_type_name"), split[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), split[1])...)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *correlatedLdapDataViewResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_correlated_ldap_data_view"),
+	}
+}
+
+func (r *defaultCorrelatedLdapDataViewResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_correlated_ldap_data_view"),
+	}
+}
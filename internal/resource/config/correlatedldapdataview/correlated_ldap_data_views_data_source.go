@@ -50,9 +50,12 @@ func (r *correlatedLdapDataViewsDataSource) Configure(_ context.Context, req dat
 
 type correlatedLdapDataViewsDataSourceModel struct {
 	Id                   types.String `tfsdk:"id"`
-	Filter               types.String `tfsdk:"filter"`
 	Ids                  types.Set    `tfsdk:"ids"`
+	Objects              types.Set    `tfsdk:"objects"`
+	ObjectsById          types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes    types.Bool   `tfsdk:"include_attributes"`
 	ScimResourceTypeName types.String `tfsdk:"scim_resource_type_name"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
@@ -64,10 +67,6 @@ func (r *correlatedLdapDataViewsDataSource) Schema(ctx context.Context, req data
 				Description: "Name of the parent SCIM Resource Type",
 				Required:    true,
 			},
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
 			"ids": schema.SetAttribute{
 				Description: "Correlated Ldap Data View IDs found in the configuration",
 				Required:    false,
@@ -77,6 +76,8 @@ func (r *correlatedLdapDataViewsDataSource) Schema(ctx context.Context, req data
 			},
 		},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewCorrelatedLdapDataViewDataSource(), "Correlated Ldap Data View")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -91,9 +92,15 @@ func (r *correlatedLdapDataViewsDataSource) Read(ctx context.Context, req dataso
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewCorrelatedLdapDataViewDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.CorrelatedLdapDataViewAPI.ListCorrelatedLdapDataViews(config.ProviderBasicAuthContext(ctx, r.providerConfig), state.ScimResourceTypeName.ValueString())
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.CorrelatedLdapDataViewAPI.ListCorrelatedLdapDataViewsExecute(listRequest)
@@ -110,12 +117,24 @@ func (r *correlatedLdapDataViewsDataSource) Read(ctx context.Context, req dataso
 
 	// Read the response into the state
 	ids := []attr.Value{}
+	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
+		var object correlatedLdapDataViewDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
+		readCorrelatedLdapDataViewResponseDataSource(ctx, &response, &object, &resp.Diagnostics)
+		object.ScimResourceTypeName = state.ScimResourceTypeName
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
+		}
 		ids = append(ids, types.StringValue(response.Id))
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 
 	state.Ids, diags = types.SetValue(types.StringType, ids)
 	resp.Diagnostics.Append(diags...)
+	state.Objects, diags = types.SetValue(objectType, objects)
+	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
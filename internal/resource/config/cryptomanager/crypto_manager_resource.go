@@ -276,7 +276,7 @@ func (r *cryptoManagerResource) Create(ctx context.Context, req resource.CreateR
 	readResponse, httpResp, err := r.apiClient.CryptoManagerAPI.GetCryptoManager(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig)).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Crypto Manager", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Crypto Manager", err, httpResp)
 		return
 	}
 
@@ -300,7 +300,7 @@ func (r *cryptoManagerResource) Create(ctx context.Context, req resource.CreateR
 
 		updateResponse, httpResp, err := r.apiClient.CryptoManagerAPI.UpdateCryptoManagerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Crypto Manager", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Crypto Manager", err, httpResp)
 			return
 		}
 
@@ -312,6 +312,8 @@ func (r *cryptoManagerResource) Create(ctx context.Context, req resource.CreateR
 
 		// Read the response
 		readCryptoManagerResponse(ctx, updateResponse, &state, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Crypto Manager", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -377,7 +379,7 @@ func (r *cryptoManagerResource) Update(ctx context.Context, req resource.UpdateR
 
 		updateResponse, httpResp, err := r.apiClient.CryptoManagerAPI.UpdateCryptoManagerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Crypto Manager", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Crypto Manager", err, httpResp)
 			return
 		}
 
@@ -389,6 +391,8 @@ func (r *cryptoManagerResource) Update(ctx context.Context, req resource.UpdateR
 
 		// Read the response
 		readCryptoManagerResponse(ctx, updateResponse, &state, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Crypto Manager", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
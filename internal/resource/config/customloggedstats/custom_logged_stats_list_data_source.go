@@ -49,10 +49,13 @@ func (r *customLoggedStatsListDataSource) Configure(_ context.Context, req datas
 }
 
 type customLoggedStatsListDataSourceModel struct {
-	Id         types.String `tfsdk:"id"`
-	Filter     types.String `tfsdk:"filter"`
-	Ids        types.Set    `tfsdk:"ids"`
-	PluginName types.String `tfsdk:"plugin_name"`
+	Id                types.String `tfsdk:"id"`
+	Ids               types.Set    `tfsdk:"ids"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	PluginName        types.String `tfsdk:"plugin_name"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
@@ -64,10 +67,6 @@ func (r *customLoggedStatsListDataSource) Schema(ctx context.Context, req dataso
 				Description: "Name of the parent Plugin",
 				Required:    true,
 			},
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
 			"ids": schema.SetAttribute{
 				Description: "Custom Logged Stats IDs found in the configuration",
 				Required:    false,
@@ -77,6 +76,8 @@ func (r *customLoggedStatsListDataSource) Schema(ctx context.Context, req dataso
 			},
 		},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewCustomLoggedStatsDataSource(), "Custom Logged Stats")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -91,9 +92,15 @@ func (r *customLoggedStatsListDataSource) Read(ctx context.Context, req datasour
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewCustomLoggedStatsDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.CustomLoggedStatsAPI.ListCustomLoggedStats(config.ProviderBasicAuthContext(ctx, r.providerConfig), state.PluginName.ValueString())
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.CustomLoggedStatsAPI.ListCustomLoggedStatsExecute(listRequest)
@@ -110,12 +117,24 @@ func (r *customLoggedStatsListDataSource) Read(ctx context.Context, req datasour
 
 	// Read the response into the state
 	ids := []attr.Value{}
+	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
+		var object customLoggedStatsDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
+		readCustomLoggedStatsResponseDataSource(ctx, &response, &object, &resp.Diagnostics)
+		object.PluginName = state.PluginName
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
+		}
 		ids = append(ids, types.StringValue(response.Id))
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 
 	state.Ids, diags = types.SetValue(types.StringType, ids)
 	resp.Diagnostics.Append(diags...)
+	state.Objects, diags = types.SetValue(objectType, objects)
+	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
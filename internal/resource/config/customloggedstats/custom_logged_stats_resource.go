@@ -30,9 +30,11 @@ var (
 	_ resource.Resource                = &customLoggedStatsResource{}
 	_ resource.ResourceWithConfigure   = &customLoggedStatsResource{}
 	_ resource.ResourceWithImportState = &customLoggedStatsResource{}
+	_ resource.ResourceWithMoveState   = &customLoggedStatsResource{}
 	_ resource.Resource                = &defaultCustomLoggedStatsResource{}
 	_ resource.ResourceWithConfigure   = &defaultCustomLoggedStatsResource{}
 	_ resource.ResourceWithImportState = &defaultCustomLoggedStatsResource{}
+	_ resource.ResourceWithMoveState   = &defaultCustomLoggedStatsResource{}
 )
 
 // Create a Custom Logged Stats resource
@@ -409,7 +411,7 @@ func (r *customLoggedStatsResource) CreateCustomLoggedStats(ctx context.Context,
 
 	addResponse, httpResp, err := r.apiClient.CustomLoggedStatsAPI.AddCustomLoggedStatsExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Custom Logged Stats", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Custom Logged Stats", err, httpResp)
 		return nil, err
 	}
 
@@ -442,6 +444,8 @@ func (r *customLoggedStatsResource) Create(ctx context.Context, req resource.Cre
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Custom Logged Stats", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -466,7 +470,7 @@ func (r *defaultCustomLoggedStatsResource) Create(ctx context.Context, req resou
 	readResponse, httpResp, err := r.apiClient.CustomLoggedStatsAPI.GetCustomLoggedStats(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString(), plan.PluginName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Custom Logged Stats", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Custom Logged Stats", err, httpResp)
 		return
 	}
 
@@ -490,7 +494,7 @@ func (r *defaultCustomLoggedStatsResource) Create(ctx context.Context, req resou
 
 		updateResponse, httpResp, err := r.apiClient.CustomLoggedStatsAPI.UpdateCustomLoggedStatsExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Custom Logged Stats", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Custom Logged Stats", err, httpResp)
 			return
 		}
 
@@ -502,6 +506,8 @@ func (r *defaultCustomLoggedStatsResource) Create(ctx context.Context, req resou
 
 		// Read the response
 		readCustomLoggedStatsResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Custom Logged Stats", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -594,7 +600,7 @@ func updateCustomLoggedStats(ctx context.Context, req resource.UpdateRequest, re
 
 		updateResponse, httpResp, err := apiClient.CustomLoggedStatsAPI.UpdateCustomLoggedStatsExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Custom Logged Stats", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Custom Logged Stats", err, httpResp)
 			return
 		}
 
@@ -606,6 +612,8 @@ func updateCustomLoggedStats(ctx context.Context, req resource.UpdateRequest, re
 
 		// Read the response
 		readCustomLoggedStatsResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Custom Logged Stats", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -660,3 +668,16 @@ func importCustomLoggedStats(ctx context.Context, req resource.ImportStateReques
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("plugin_name"), split[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), split[1])...)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *customLoggedStatsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_custom_logged_stats"),
+	}
+}
+
+func (r *defaultCustomLoggedStatsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_custom_logged_stats"),
+	}
+}
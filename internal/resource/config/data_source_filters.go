@@ -0,0 +1,200 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Filter attributes shared by the plural data sources. Embedded in each plural data source model.
+type DataSourceFiltersModel struct {
+	Filter          types.String `tfsdk:"filter"`
+	Type            types.String `tfsdk:"type"`
+	NameRegex       types.String `tfsdk:"name_regex"`
+	AttributeEquals types.Map    `tfsdk:"attribute_equals"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+}
+
+// Add the filter attributes to a plural data source schema
+func AddFiltersDataSourceSchema(s *schema.Schema) {
+	s.Attributes["filter"] = schema.StringAttribute{
+		Description: "SCIM filter used when searching the configuration. The filter syntax is checked before any request is sent.",
+		Optional:    true,
+		Validators: []validator.String{
+			ScimFilterValidator(),
+		},
+	}
+	s.Attributes["type"] = schema.StringAttribute{
+		Description: "Only include config objects of this type, such as `local-db` for Backends. This filter is applied by the provider to the config objects returned by the server, rather than sent to the server in the SCIM `filter`.",
+		Optional:    true,
+	}
+	s.Attributes["name_regex"] = schema.StringAttribute{
+		Description: "Only include config objects with an ID matching this regular expression. This filter is applied by the provider to the config objects returned by the server, rather than sent to the server in the SCIM `filter`.",
+		Optional:    true,
+		Validators: []validator.String{
+			regexValidator{},
+		},
+	}
+	s.Attributes["attribute_equals"] = schema.MapAttribute{
+		Description: "Only include config objects where each named attribute equals the given value. Keys are attribute names of the singular data source. Multi-valued attributes match when any of their values equals the given value. This filter is applied by the provider to the config objects returned by the server, rather than sent to the server in the SCIM `filter`.",
+		Optional:    true,
+		ElementType: types.StringType,
+	}
+	s.Attributes["enabled"] = schema.BoolAttribute{
+		Description: "Only include config objects with this value for the `enabled` attribute.",
+		Optional:    true,
+	}
+}
+
+// Get the SCIM filter to send to the server, combining the raw filter with the structured filters that the
+// server can apply. Returns an empty string when there is nothing to filter on the server.
+func (f DataSourceFiltersModel) ScimFilter(objectType types.ObjectType) string {
+	var clauses []string
+	if internaltypes.IsDefined(f.Filter) {
+		clauses = append(clauses, f.Filter.ValueString())
+	}
+	if _, hasEnabled := objectType.AttrTypes["enabled"]; hasEnabled && internaltypes.IsDefined(f.Enabled) {
+		clauses = append(clauses, fmt.Sprintf("enabled eq %t", f.Enabled.ValueBool()))
+	}
+	if len(clauses) > 1 {
+		for i := range clauses {
+			clauses[i] = "(" + clauses[i] + ")"
+		}
+	}
+	return strings.Join(clauses, " and ")
+}
+
+// Check that the attribute_equals keys are attributes of the singular data source
+func (f DataSourceFiltersModel) ValidateAttributeEquals(objectType types.ObjectType, diagnostics *diag.Diagnostics) {
+	if !internaltypes.IsDefined(f.AttributeEquals) {
+		return
+	}
+	for name := range f.AttributeEquals.Elements() {
+		if _, ok := objectType.AttrTypes[name]; !ok {
+			names := make([]string, 0, len(objectType.AttrTypes))
+			for attrName := range objectType.AttrTypes {
+				names = append(names, attrName)
+			}
+			sort.Strings(names)
+			diagnostics.AddAttributeError(path.Root("attribute_equals").AtMapKey(name), "Unknown attribute in attribute_equals",
+				"\""+name+"\" is not an attribute of these config objects. Valid attributes are: "+strings.Join(names, ", "))
+		}
+	}
+}
+
+// Check whether a singular data source model matches the filters that are applied on the client.
+// The model must be a singular data source model struct, as used with DataSourceObjectValue.
+func (f DataSourceFiltersModel) Matches(ctx context.Context, objectType types.ObjectType, model any, diagnostics *diag.Diagnostics) bool {
+	object, diags := types.ObjectValueFrom(ctx, objectType.AttrTypes, model)
+	diagnostics.Append(diags...)
+	if diags.HasError() {
+		return false
+	}
+	attributes := object.Attributes()
+
+	if internaltypes.IsDefined(f.Type) && !slices.Contains(objectTypeNames(attributes), f.Type.ValueString()) {
+		return false
+	}
+	if internaltypes.IsDefined(f.NameRegex) {
+		// The regex has already been validated at plan time
+		nameRegex, err := regexp.Compile(f.NameRegex.ValueString())
+		if err != nil {
+			diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid regular expression", err.Error())
+			return false
+		}
+		if !nameRegex.MatchString(stringAttribute(attributes["id"])) {
+			return false
+		}
+	}
+	if enabled, hasEnabled := attributes["enabled"]; internaltypes.IsDefined(f.Enabled) && (!hasEnabled || !enabled.Equal(f.Enabled)) {
+		return false
+	}
+	if internaltypes.IsDefined(f.AttributeEquals) {
+		for name, expected := range f.AttributeEquals.Elements() {
+			if !slices.Contains(attributeValueStrings(attributes[name]), stringAttribute(expected)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Get the names that can identify the type of a config object. Most data sources use the type attribute,
+// but a few use resource_type because type is one of the object's properties.
+func objectTypeNames(attributes map[string]attr.Value) []string {
+	if resourceType, ok := attributes["resource_type"]; ok {
+		return []string{stringAttribute(resourceType)}
+	}
+	return attributeValueStrings(attributes["type"])
+}
+
+// Get the string form of each value of an attribute, for comparison with configured strings
+func attributeValueStrings(value attr.Value) []string {
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	switch v := value.(type) {
+	case types.String:
+		return []string{v.ValueString()}
+	case types.Bool:
+		return []string{fmt.Sprintf("%t", v.ValueBool())}
+	case types.Int64:
+		return []string{fmt.Sprintf("%d", v.ValueInt64())}
+	case types.Float64:
+		return []string{fmt.Sprintf("%v", v.ValueFloat64())}
+	case types.Set:
+		return elementStrings(v.Elements())
+	case types.List:
+		return elementStrings(v.Elements())
+	}
+	return []string{value.String()}
+}
+
+func elementStrings(elements []attr.Value) []string {
+	var result []string
+	for _, element := range elements {
+		result = append(result, attributeValueStrings(element)...)
+	}
+	return result
+}
+
+func stringAttribute(value attr.Value) string {
+	values := attributeValueStrings(value)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// Validator that checks that a string is a valid regular expression
+type regexValidator struct{}
+
+func (v regexValidator) Description(_ context.Context) string {
+	return "value must be a valid regular expression"
+}
+
+func (v regexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v regexValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid regular expression", err.Error())
+	}
+}
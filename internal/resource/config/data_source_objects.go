@@ -0,0 +1,114 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package config
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Get the object type describing a single config object, based on the schema of the singular data source
+func DataSourceObjectType(ctx context.Context, singular datasource.DataSource) types.ObjectType {
+	var schemaResp datasource.SchemaResponse
+	singular.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	return schemaResp.Schema.Type().(types.ObjectType)
+}
+
+// Add the objects, objects_by_id and include_attributes attributes to a plural data source schema. Each element of
+// objects has the attributes of the singular data source. Only id and type are filled in unless include_attributes
+// is true. objects_by_id holds the same elements keyed by id, so that it can be used with for_each.
+func AddObjectsDataSourceSchema(ctx context.Context, s *schema.Schema, singular datasource.DataSource, objectDescription string) {
+	s.Attributes["include_attributes"] = schema.BoolAttribute{
+		Description: "Set to true to fill in all attributes of each element of `objects`. By default only `id` and `type` (or `resource_type`) are filled in, which keeps the state small for large collections.",
+		Optional:    true,
+	}
+	s.Attributes["objects"] = schema.SetAttribute{
+		Description: objectDescription + " objects found in the configuration. Each element has the attributes of the singular data source, all of which are filled in when `include_attributes` is true.",
+		Required:    false,
+		Optional:    false,
+		Computed:    true,
+		ElementType: DataSourceObjectType(ctx, singular),
+	}
+	s.Attributes["objects_by_id"] = schema.MapAttribute{
+		Description: "The elements of `objects` keyed by `id`, for use with `for_each`.",
+		Required:    false,
+		Optional:    false,
+		Computed:    true,
+		ElementType: DataSourceObjectType(ctx, singular),
+	}
+}
+
+// Key the elements of a plural data source's objects attribute by their id
+func DataSourceObjectsById(objectType types.ObjectType, objects []attr.Value, diagnostics *diag.Diagnostics) types.Map {
+	objectsById := make(map[string]attr.Value, len(objects))
+	for _, object := range objects {
+		objectValue, ok := object.(types.Object)
+		if !ok {
+			continue
+		}
+		if id, ok := objectValue.Attributes()["id"].(types.String); ok && !id.IsNull() {
+			objectsById[id.ValueString()] = object
+		}
+	}
+	objectsByIdMap, diags := types.MapValue(objectType, objectsById)
+	diagnostics.Append(diags...)
+	return objectsByIdMap
+}
+
+// Initialize a singular data source model so that every attribute is a null value of the correct type.
+// The model must be a pointer to the data source model struct.
+func NewDataSourceObjectModel(ctx context.Context, objectType types.ObjectType, model any, diagnostics *diag.Diagnostics) {
+	nullAttributes := map[string]attr.Value{}
+	for name, attrType := range objectType.AttrTypes {
+		nullAttributes[name] = nullValue(ctx, attrType, diagnostics)
+	}
+	object, diags := types.ObjectValue(objectType.AttrTypes, nullAttributes)
+	diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	diagnostics.Append(object.As(ctx, model, basetypes.ObjectAsOptions{})...)
+}
+
+// Convert a singular data source model to an element of a plural data source's objects attribute. When
+// includeAttributes is false, only the id and type attributes are kept, along with resource_type for the
+// data sources that use type for something other than the type of the config object.
+func DataSourceObjectValue(ctx context.Context, objectType types.ObjectType, model any, includeAttributes bool, diagnostics *diag.Diagnostics) attr.Value {
+	object, diags := types.ObjectValueFrom(ctx, objectType.AttrTypes, model)
+	diagnostics.Append(diags...)
+	if diags.HasError() {
+		return types.ObjectNull(objectType.AttrTypes)
+	}
+
+	attributes := object.Attributes()
+	// Some singular data sources identify the object by an attribute other than name
+	if name, ok := attributes["name"]; ok && name.IsNull() {
+		attributes["name"] = attributes["id"]
+	}
+	if !includeAttributes {
+		for name, attrType := range objectType.AttrTypes {
+			if name != "id" && name != "type" && name != "resource_type" {
+				attributes[name] = nullValue(ctx, attrType, diagnostics)
+			}
+		}
+	}
+	object, diags = types.ObjectValue(objectType.AttrTypes, attributes)
+	diagnostics.Append(diags...)
+	return object
+}
+
+// Get a null value of the given type
+func nullValue(ctx context.Context, attrType attr.Type, diagnostics *diag.Diagnostics) attr.Value {
+	value, err := attrType.ValueFromTerraform(ctx, tftypes.NewValue(attrType.TerraformType(ctx), nil))
+	if err != nil {
+		diagnostics.AddError("Failed to create null value", err.Error())
+	}
+	return value
+}
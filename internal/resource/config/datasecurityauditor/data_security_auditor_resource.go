@@ -31,9 +31,11 @@ var (
 	_ resource.Resource                = &dataSecurityAuditorResource{}
 	_ resource.ResourceWithConfigure   = &dataSecurityAuditorResource{}
 	_ resource.ResourceWithImportState = &dataSecurityAuditorResource{}
+	_ resource.ResourceWithMoveState   = &dataSecurityAuditorResource{}
 	_ resource.Resource                = &defaultDataSecurityAuditorResource{}
 	_ resource.ResourceWithConfigure   = &defaultDataSecurityAuditorResource{}
 	_ resource.ResourceWithImportState = &defaultDataSecurityAuditorResource{}
+	_ resource.ResourceWithMoveState   = &defaultDataSecurityAuditorResource{}
 )
 
 // Create a Data Security Auditor resource
@@ -1421,7 +1423,7 @@ func (r *dataSecurityAuditorResource) CreateExpiredPasswordDataSecurityAuditor(c
 
 	addResponse, httpResp, err := r.apiClient.DataSecurityAuditorAPI.AddDataSecurityAuditorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
 		return nil, err
 	}
 
@@ -1459,7 +1461,7 @@ func (r *dataSecurityAuditorResource) CreateIdleAccountDataSecurityAuditor(ctx c
 
 	addResponse, httpResp, err := r.apiClient.DataSecurityAuditorAPI.AddDataSecurityAuditorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
 		return nil, err
 	}
 
@@ -1496,7 +1498,7 @@ func (r *dataSecurityAuditorResource) CreateDisabledAccountDataSecurityAuditor(c
 
 	addResponse, httpResp, err := r.apiClient.DataSecurityAuditorAPI.AddDataSecurityAuditorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
 		return nil, err
 	}
 
@@ -1533,7 +1535,7 @@ func (r *dataSecurityAuditorResource) CreateWeaklyEncodedPasswordDataSecurityAud
 
 	addResponse, httpResp, err := r.apiClient.DataSecurityAuditorAPI.AddDataSecurityAuditorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
 		return nil, err
 	}
 
@@ -1570,7 +1572,7 @@ func (r *dataSecurityAuditorResource) CreatePrivilegeDataSecurityAuditor(ctx con
 
 	addResponse, httpResp, err := r.apiClient.DataSecurityAuditorAPI.AddDataSecurityAuditorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
 		return nil, err
 	}
 
@@ -1607,7 +1609,7 @@ func (r *dataSecurityAuditorResource) CreateAccountUsabilityIssuesDataSecurityAu
 
 	addResponse, httpResp, err := r.apiClient.DataSecurityAuditorAPI.AddDataSecurityAuditorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
 		return nil, err
 	}
 
@@ -1644,7 +1646,7 @@ func (r *dataSecurityAuditorResource) CreateLockedAccountDataSecurityAuditor(ctx
 
 	addResponse, httpResp, err := r.apiClient.DataSecurityAuditorAPI.AddDataSecurityAuditorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
 		return nil, err
 	}
 
@@ -1685,7 +1687,7 @@ func (r *dataSecurityAuditorResource) CreateFilterDataSecurityAuditor(ctx contex
 
 	addResponse, httpResp, err := r.apiClient.DataSecurityAuditorAPI.AddDataSecurityAuditorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
 		return nil, err
 	}
 
@@ -1722,7 +1724,7 @@ func (r *dataSecurityAuditorResource) CreateAccountValidityWindowDataSecurityAud
 
 	addResponse, httpResp, err := r.apiClient.DataSecurityAuditorAPI.AddDataSecurityAuditorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
 		return nil, err
 	}
 
@@ -1759,7 +1761,7 @@ func (r *dataSecurityAuditorResource) CreateMultiplePasswordDataSecurityAuditor(
 
 	addResponse, httpResp, err := r.apiClient.DataSecurityAuditorAPI.AddDataSecurityAuditorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
 		return nil, err
 	}
 
@@ -1796,7 +1798,7 @@ func (r *dataSecurityAuditorResource) CreateDeprecatedPasswordStorageSchemeDataS
 
 	addResponse, httpResp, err := r.apiClient.DataSecurityAuditorAPI.AddDataSecurityAuditorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
 		return nil, err
 	}
 
@@ -1833,7 +1835,7 @@ func (r *dataSecurityAuditorResource) CreateNonexistentPasswordPolicyDataSecurit
 
 	addResponse, httpResp, err := r.apiClient.DataSecurityAuditorAPI.AddDataSecurityAuditorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
 		return nil, err
 	}
 
@@ -1870,7 +1872,7 @@ func (r *dataSecurityAuditorResource) CreateAccessControlDataSecurityAuditor(ctx
 
 	addResponse, httpResp, err := r.apiClient.DataSecurityAuditorAPI.AddDataSecurityAuditorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
 		return nil, err
 	}
 
@@ -1909,7 +1911,7 @@ func (r *dataSecurityAuditorResource) CreateThirdPartyDataSecurityAuditor(ctx co
 
 	addResponse, httpResp, err := r.apiClient.DataSecurityAuditorAPI.AddDataSecurityAuditorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Data Security Auditor", err, httpResp)
 		return nil, err
 	}
 
@@ -2022,6 +2024,8 @@ func (r *dataSecurityAuditorResource) Create(ctx context.Context, req resource.C
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Data Security Auditor", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -2046,7 +2050,7 @@ func (r *defaultDataSecurityAuditorResource) Create(ctx context.Context, req res
 	readResponse, httpResp, err := r.apiClient.DataSecurityAuditorAPI.GetDataSecurityAuditor(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Data Security Auditor", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Data Security Auditor", err, httpResp)
 		return
 	}
 
@@ -2111,7 +2115,7 @@ func (r *defaultDataSecurityAuditorResource) Create(ctx context.Context, req res
 
 		updateResponse, httpResp, err := r.apiClient.DataSecurityAuditorAPI.UpdateDataSecurityAuditorExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Data Security Auditor", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Data Security Auditor", err, httpResp)
 			return
 		}
 
@@ -2164,6 +2168,8 @@ func (r *defaultDataSecurityAuditorResource) Create(ctx context.Context, req res
 		if updateResponse.ThirdPartyDataSecurityAuditorResponse != nil {
 			readThirdPartyDataSecurityAuditorResponse(ctx, updateResponse.ThirdPartyDataSecurityAuditorResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Data Security Auditor", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -2296,7 +2302,7 @@ func updateDataSecurityAuditor(ctx context.Context, req resource.UpdateRequest,
 
 		updateResponse, httpResp, err := apiClient.DataSecurityAuditorAPI.UpdateDataSecurityAuditorExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Data Security Auditor", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Data Security Auditor", err, httpResp)
 			return
 		}
 
@@ -2349,6 +2355,8 @@ func updateDataSecurityAuditor(ctx context.Context, req resource.UpdateRequest,
 		if updateResponse.ThirdPartyDataSecurityAuditorResponse != nil {
 			readThirdPartyDataSecurityAuditorResponse(ctx, updateResponse.ThirdPartyDataSecurityAuditorResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Data Security Auditor", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -2396,3 +2404,16 @@ func importDataSecurityAuditor(ctx context.Context, req resource.ImportStateRequ
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *dataSecurityAuditorResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_data_security_auditor"),
+	}
+}
+
+func (r *defaultDataSecurityAuditorResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_data_security_auditor"),
+	}
+}
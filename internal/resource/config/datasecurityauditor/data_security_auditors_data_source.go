@@ -49,29 +49,21 @@ func (r *dataSecurityAuditorsDataSource) Configure(_ context.Context, req dataso
 }
 
 type dataSecurityAuditorsDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *dataSecurityAuditorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Data Security Auditor objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Data Security Auditor objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewDataSecurityAuditorDataSource(), "Data Security Auditor")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *dataSecurityAuditorsDataSource) Read(ctx context.Context, req datasourc
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewDataSecurityAuditorDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.DataSecurityAuditorAPI.ListDataSecurityAuditors(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.DataSecurityAuditorAPI.ListDataSecurityAuditorsExecute(listRequest)
@@ -106,73 +104,62 @@ func (r *dataSecurityAuditorsDataSource) Read(ctx context.Context, req datasourc
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object dataSecurityAuditorDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.ExpiredPasswordDataSecurityAuditorResponse != nil {
-			attributes["id"] = types.StringValue(response.ExpiredPasswordDataSecurityAuditorResponse.Id)
-			attributes["type"] = types.StringValue("expired-password")
+			readExpiredPasswordDataSecurityAuditorResponseDataSource(ctx, response.ExpiredPasswordDataSecurityAuditorResponse, &object, &resp.Diagnostics)
 		}
 		if response.IdleAccountDataSecurityAuditorResponse != nil {
-			attributes["id"] = types.StringValue(response.IdleAccountDataSecurityAuditorResponse.Id)
-			attributes["type"] = types.StringValue("idle-account")
+			readIdleAccountDataSecurityAuditorResponseDataSource(ctx, response.IdleAccountDataSecurityAuditorResponse, &object, &resp.Diagnostics)
 		}
 		if response.DisabledAccountDataSecurityAuditorResponse != nil {
-			attributes["id"] = types.StringValue(response.DisabledAccountDataSecurityAuditorResponse.Id)
-			attributes["type"] = types.StringValue("disabled-account")
+			readDisabledAccountDataSecurityAuditorResponseDataSource(ctx, response.DisabledAccountDataSecurityAuditorResponse, &object, &resp.Diagnostics)
 		}
 		if response.WeaklyEncodedPasswordDataSecurityAuditorResponse != nil {
-			attributes["id"] = types.StringValue(response.WeaklyEncodedPasswordDataSecurityAuditorResponse.Id)
-			attributes["type"] = types.StringValue("weakly-encoded-password")
+			readWeaklyEncodedPasswordDataSecurityAuditorResponseDataSource(ctx, response.WeaklyEncodedPasswordDataSecurityAuditorResponse, &object, &resp.Diagnostics)
 		}
 		if response.PrivilegeDataSecurityAuditorResponse != nil {
-			attributes["id"] = types.StringValue(response.PrivilegeDataSecurityAuditorResponse.Id)
-			attributes["type"] = types.StringValue("privilege")
+			readPrivilegeDataSecurityAuditorResponseDataSource(ctx, response.PrivilegeDataSecurityAuditorResponse, &object, &resp.Diagnostics)
 		}
 		if response.AccountUsabilityIssuesDataSecurityAuditorResponse != nil {
-			attributes["id"] = types.StringValue(response.AccountUsabilityIssuesDataSecurityAuditorResponse.Id)
-			attributes["type"] = types.StringValue("account-usability-issues")
+			readAccountUsabilityIssuesDataSecurityAuditorResponseDataSource(ctx, response.AccountUsabilityIssuesDataSecurityAuditorResponse, &object, &resp.Diagnostics)
 		}
 		if response.LockedAccountDataSecurityAuditorResponse != nil {
-			attributes["id"] = types.StringValue(response.LockedAccountDataSecurityAuditorResponse.Id)
-			attributes["type"] = types.StringValue("locked-account")
+			readLockedAccountDataSecurityAuditorResponseDataSource(ctx, response.LockedAccountDataSecurityAuditorResponse, &object, &resp.Diagnostics)
 		}
 		if response.FilterDataSecurityAuditorResponse != nil {
-			attributes["id"] = types.StringValue(response.FilterDataSecurityAuditorResponse.Id)
-			attributes["type"] = types.StringValue("filter")
+			readFilterDataSecurityAuditorResponseDataSource(ctx, response.FilterDataSecurityAuditorResponse, &object, &resp.Diagnostics)
 		}
 		if response.AccountValidityWindowDataSecurityAuditorResponse != nil {
-			attributes["id"] = types.StringValue(response.AccountValidityWindowDataSecurityAuditorResponse.Id)
-			attributes["type"] = types.StringValue("account-validity-window")
+			readAccountValidityWindowDataSecurityAuditorResponseDataSource(ctx, response.AccountValidityWindowDataSecurityAuditorResponse, &object, &resp.Diagnostics)
 		}
 		if response.MultiplePasswordDataSecurityAuditorResponse != nil {
-			attributes["id"] = types.StringValue(response.MultiplePasswordDataSecurityAuditorResponse.Id)
-			attributes["type"] = types.StringValue("multiple-password")
+			readMultiplePasswordDataSecurityAuditorResponseDataSource(ctx, response.MultiplePasswordDataSecurityAuditorResponse, &object, &resp.Diagnostics)
 		}
 		if response.DeprecatedPasswordStorageSchemeDataSecurityAuditorResponse != nil {
-			attributes["id"] = types.StringValue(response.DeprecatedPasswordStorageSchemeDataSecurityAuditorResponse.Id)
-			attributes["type"] = types.StringValue("deprecated-password-storage-scheme")
+			readDeprecatedPasswordStorageSchemeDataSecurityAuditorResponseDataSource(ctx, response.DeprecatedPasswordStorageSchemeDataSecurityAuditorResponse, &object, &resp.Diagnostics)
 		}
 		if response.NonexistentPasswordPolicyDataSecurityAuditorResponse != nil {
-			attributes["id"] = types.StringValue(response.NonexistentPasswordPolicyDataSecurityAuditorResponse.Id)
-			attributes["type"] = types.StringValue("nonexistent-password-policy")
+			readNonexistentPasswordPolicyDataSecurityAuditorResponseDataSource(ctx, response.NonexistentPasswordPolicyDataSecurityAuditorResponse, &object, &resp.Diagnostics)
 		}
 		if response.AccessControlDataSecurityAuditorResponse != nil {
-			attributes["id"] = types.StringValue(response.AccessControlDataSecurityAuditorResponse.Id)
-			attributes["type"] = types.StringValue("access-control")
+			readAccessControlDataSecurityAuditorResponseDataSource(ctx, response.AccessControlDataSecurityAuditorResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyDataSecurityAuditorResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyDataSecurityAuditorResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyDataSecurityAuditorResponseDataSource(ctx, response.ThirdPartyDataSecurityAuditorResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
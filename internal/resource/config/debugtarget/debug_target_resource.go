@@ -30,9 +30,11 @@ var (
 	_ resource.Resource                = &debugTargetResource{}
 	_ resource.ResourceWithConfigure   = &debugTargetResource{}
 	_ resource.ResourceWithImportState = &debugTargetResource{}
+	_ resource.ResourceWithMoveState   = &debugTargetResource{}
 	_ resource.Resource                = &defaultDebugTargetResource{}
 	_ resource.ResourceWithConfigure   = &defaultDebugTargetResource{}
 	_ resource.ResourceWithImportState = &defaultDebugTargetResource{}
+	_ resource.ResourceWithMoveState   = &defaultDebugTargetResource{}
 )
 
 // Create a Debug Target resource
@@ -314,7 +316,7 @@ func (r *debugTargetResource) CreateDebugTarget(ctx context.Context, req resourc
 
 	addResponse, httpResp, err := r.apiClient.DebugTargetAPI.AddDebugTargetExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Debug Target", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Debug Target", err, httpResp)
 		return nil, err
 	}
 
@@ -347,6 +349,8 @@ func (r *debugTargetResource) Create(ctx context.Context, req resource.CreateReq
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Debug Target", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -371,7 +375,7 @@ func (r *defaultDebugTargetResource) Create(ctx context.Context, req resource.Cr
 	readResponse, httpResp, err := r.apiClient.DebugTargetAPI.GetDebugTarget(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.DebugScope.ValueString(), plan.LogPublisherName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Debug Target", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Debug Target", err, httpResp)
 		return
 	}
 
@@ -395,7 +399,7 @@ func (r *defaultDebugTargetResource) Create(ctx context.Context, req resource.Cr
 
 		updateResponse, httpResp, err := r.apiClient.DebugTargetAPI.UpdateDebugTargetExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Debug Target", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Debug Target", err, httpResp)
 			return
 		}
 
@@ -407,6 +411,8 @@ func (r *defaultDebugTargetResource) Create(ctx context.Context, req resource.Cr
 
 		// Read the response
 		readDebugTargetResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Debug Target", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -499,7 +505,7 @@ func updateDebugTarget(ctx context.Context, req resource.UpdateRequest, resp *re
 
 		updateResponse, httpResp, err := apiClient.DebugTargetAPI.UpdateDebugTargetExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Debug Target", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Debug Target", err, httpResp)
 			return
 		}
 
@@ -511,6 +517,8 @@ func updateDebugTarget(ctx context.Context, req resource.UpdateRequest, resp *re
 
 		// Read the response
 		readDebugTargetResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Debug Target", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -565,3 +573,16 @@ func importDebugTarget(ctx context.Context, req resource.ImportStateRequest, res
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("log_publisher_name"), split[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("debug_scope"), split[1])...)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *debugTargetResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_debug_target"),
+	}
+}
+
+func (r *defaultDebugTargetResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_debug_target"),
+	}
+}
@@ -49,10 +49,13 @@ func (r *debugTargetsDataSource) Configure(_ context.Context, req datasource.Con
 }
 
 type debugTargetsDataSourceModel struct {
-	Id               types.String `tfsdk:"id"`
-	Filter           types.String `tfsdk:"filter"`
-	Ids              types.Set    `tfsdk:"ids"`
-	LogPublisherName types.String `tfsdk:"log_publisher_name"`
+	Id                types.String `tfsdk:"id"`
+	Ids               types.Set    `tfsdk:"ids"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	LogPublisherName  types.String `tfsdk:"log_publisher_name"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
@@ -64,10 +67,6 @@ func (r *debugTargetsDataSource) Schema(ctx context.Context, req datasource.Sche
 				Description: "Name of the parent Log Publisher",
 				Required:    true,
 			},
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
 			"ids": schema.SetAttribute{
 				Description: "Debug Target IDs found in the configuration",
 				Required:    false,
@@ -77,6 +76,8 @@ func (r *debugTargetsDataSource) Schema(ctx context.Context, req datasource.Sche
 			},
 		},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewDebugTargetDataSource(), "Debug Target")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -91,9 +92,15 @@ func (r *debugTargetsDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewDebugTargetDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.DebugTargetAPI.ListDebugTargets(config.ProviderBasicAuthContext(ctx, r.providerConfig), state.LogPublisherName.ValueString())
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.DebugTargetAPI.ListDebugTargetsExecute(listRequest)
@@ -110,12 +117,24 @@ func (r *debugTargetsDataSource) Read(ctx context.Context, req datasource.ReadRe
 
 	// Read the response into the state
 	ids := []attr.Value{}
+	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
+		var object debugTargetDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
+		readDebugTargetResponseDataSource(ctx, &response, &object, &resp.Diagnostics)
+		object.LogPublisherName = state.LogPublisherName
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
+		}
 		ids = append(ids, types.StringValue(response.Id))
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 
 	state.Ids, diags = types.SetValue(types.StringType, ids)
 	resp.Diagnostics.Append(diags...)
+	state.Objects, diags = types.SetValue(objectType, objects)
+	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
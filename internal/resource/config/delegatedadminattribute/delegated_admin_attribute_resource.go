@@ -32,9 +32,11 @@ var (
 	_ resource.Resource                = &delegatedAdminAttributeResource{}
 	_ resource.ResourceWithConfigure   = &delegatedAdminAttributeResource{}
 	_ resource.ResourceWithImportState = &delegatedAdminAttributeResource{}
+	_ resource.ResourceWithMoveState   = &delegatedAdminAttributeResource{}
 	_ resource.Resource                = &defaultDelegatedAdminAttributeResource{}
 	_ resource.ResourceWithConfigure   = &defaultDelegatedAdminAttributeResource{}
 	_ resource.ResourceWithImportState = &defaultDelegatedAdminAttributeResource{}
+	_ resource.ResourceWithMoveState   = &defaultDelegatedAdminAttributeResource{}
 )
 
 // Create a Delegated Admin Attribute resource
@@ -595,7 +597,7 @@ func (r *delegatedAdminAttributeResource) CreateCertificateDelegatedAdminAttribu
 
 	addResponse, httpResp, err := r.apiClient.DelegatedAdminAttributeAPI.AddDelegatedAdminAttributeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Delegated Admin Attribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Delegated Admin Attribute", err, httpResp)
 		return nil, err
 	}
 
@@ -633,7 +635,7 @@ func (r *delegatedAdminAttributeResource) CreatePhotoDelegatedAdminAttribute(ctx
 
 	addResponse, httpResp, err := r.apiClient.DelegatedAdminAttributeAPI.AddDelegatedAdminAttributeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Delegated Admin Attribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Delegated Admin Attribute", err, httpResp)
 		return nil, err
 	}
 
@@ -671,7 +673,7 @@ func (r *delegatedAdminAttributeResource) CreateGenericDelegatedAdminAttribute(c
 
 	addResponse, httpResp, err := r.apiClient.DelegatedAdminAttributeAPI.AddDelegatedAdminAttributeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Delegated Admin Attribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Delegated Admin Attribute", err, httpResp)
 		return nil, err
 	}
 
@@ -720,6 +722,8 @@ func (r *delegatedAdminAttributeResource) Create(ctx context.Context, req resour
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Delegated Admin Attribute", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -744,7 +748,7 @@ func (r *defaultDelegatedAdminAttributeResource) Create(ctx context.Context, req
 	readResponse, httpResp, err := r.apiClient.DelegatedAdminAttributeAPI.GetDelegatedAdminAttribute(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.AttributeType.ValueString(), plan.RestResourceTypeName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Delegated Admin Attribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Delegated Admin Attribute", err, httpResp)
 		return
 	}
 
@@ -776,7 +780,7 @@ func (r *defaultDelegatedAdminAttributeResource) Create(ctx context.Context, req
 
 		updateResponse, httpResp, err := r.apiClient.DelegatedAdminAttributeAPI.UpdateDelegatedAdminAttributeExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Delegated Admin Attribute", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Delegated Admin Attribute", err, httpResp)
 			return
 		}
 
@@ -796,6 +800,8 @@ func (r *defaultDelegatedAdminAttributeResource) Create(ctx context.Context, req
 		if updateResponse.GenericDelegatedAdminAttributeResponse != nil {
 			readGenericDelegatedAdminAttributeResponse(ctx, updateResponse.GenericDelegatedAdminAttributeResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Delegated Admin Attribute", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -896,7 +902,7 @@ func updateDelegatedAdminAttribute(ctx context.Context, req resource.UpdateReque
 
 		updateResponse, httpResp, err := apiClient.DelegatedAdminAttributeAPI.UpdateDelegatedAdminAttributeExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Delegated Admin Attribute", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Delegated Admin Attribute", err, httpResp)
 			return
 		}
 
@@ -916,6 +922,8 @@ func updateDelegatedAdminAttribute(ctx context.Context, req resource.UpdateReque
 		if updateResponse.GenericDelegatedAdminAttributeResponse != nil {
 			readGenericDelegatedAdminAttributeResponse(ctx, updateResponse.GenericDelegatedAdminAttributeResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Delegated Admin Attribute", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -970,3 +978,16 @@ func importDelegatedAdminAttribute(ctx context.Context, req resource.ImportState
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rest_resource_type_name"), split[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("attribute_type"), split[1])...)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *delegatedAdminAttributeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_delegated_admin_attribute"),
+	}
+}
+
+func (r *defaultDelegatedAdminAttributeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_delegated_admin_attribute"),
+	}
+}
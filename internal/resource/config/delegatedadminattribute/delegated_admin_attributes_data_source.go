@@ -50,9 +50,11 @@ func (r *delegatedAdminAttributesDataSource) Configure(_ context.Context, req da
 
 type delegatedAdminAttributesDataSourceModel struct {
 	Id                   types.String `tfsdk:"id"`
-	Filter               types.String `tfsdk:"filter"`
 	Objects              types.Set    `tfsdk:"objects"`
+	ObjectsById          types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes    types.Bool   `tfsdk:"include_attributes"`
 	RestResourceTypeName types.String `tfsdk:"rest_resource_type_name"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
@@ -64,19 +66,10 @@ func (r *delegatedAdminAttributesDataSource) Schema(ctx context.Context, req dat
 				Description: "Name of the parent REST Resource Type",
 				Required:    true,
 			},
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Delegated Admin Attribute objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
 		},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewDelegatedAdminAttributeDataSource(), "Delegated Admin Attribute")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -91,9 +84,15 @@ func (r *delegatedAdminAttributesDataSource) Read(ctx context.Context, req datas
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewDelegatedAdminAttributeDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.DelegatedAdminAttributeAPI.ListDelegatedAdminAttributes(config.ProviderBasicAuthContext(ctx, r.providerConfig), state.RestResourceTypeName.ValueString())
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.DelegatedAdminAttributeAPI.ListDelegatedAdminAttributesExecute(listRequest)
@@ -111,29 +110,30 @@ func (r *delegatedAdminAttributesDataSource) Read(ctx context.Context, req datas
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object delegatedAdminAttributeDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.CertificateDelegatedAdminAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.CertificateDelegatedAdminAttributeResponse.Id)
-			attributes["type"] = types.StringValue("certificate")
+			readCertificateDelegatedAdminAttributeResponseDataSource(ctx, response.CertificateDelegatedAdminAttributeResponse, &object, &resp.Diagnostics)
 		}
 		if response.PhotoDelegatedAdminAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.PhotoDelegatedAdminAttributeResponse.Id)
-			attributes["type"] = types.StringValue("photo")
+			readPhotoDelegatedAdminAttributeResponseDataSource(ctx, response.PhotoDelegatedAdminAttributeResponse, &object, &resp.Diagnostics)
 		}
 		if response.GenericDelegatedAdminAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.GenericDelegatedAdminAttributeResponse.Id)
-			attributes["type"] = types.StringValue("generic")
+			readGenericDelegatedAdminAttributeResponseDataSource(ctx, response.GenericDelegatedAdminAttributeResponse, &object, &resp.Diagnostics)
+		}
+		object.RestResourceTypeName = state.RestResourceTypeName
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -49,9 +49,12 @@ func (r *delegatedAdminAttributeCategoriesDataSource) Configure(_ context.Contex
 }
 
 type delegatedAdminAttributeCategoriesDataSourceModel struct {
-	Id     types.String `tfsdk:"id"`
-	Filter types.String `tfsdk:"filter"`
-	Ids    types.Set    `tfsdk:"ids"`
+	Id                types.String `tfsdk:"id"`
+	Ids               types.Set    `tfsdk:"ids"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
@@ -59,10 +62,6 @@ func (r *delegatedAdminAttributeCategoriesDataSource) Schema(ctx context.Context
 	schemaDef := schema.Schema{
 		Description: "Lists Delegated Admin Attribute Category objects in the server configuration.",
 		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
 			"ids": schema.SetAttribute{
 				Description: "Delegated Admin Attribute Category IDs found in the configuration",
 				Required:    false,
@@ -72,6 +71,8 @@ func (r *delegatedAdminAttributeCategoriesDataSource) Schema(ctx context.Context
 			},
 		},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewDelegatedAdminAttributeCategoryDataSource(), "Delegated Admin Attribute Category")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +87,15 @@ func (r *delegatedAdminAttributeCategoriesDataSource) Read(ctx context.Context,
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewDelegatedAdminAttributeCategoryDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.DelegatedAdminAttributeCategoryAPI.ListDelegatedAdminAttributeCategories(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.DelegatedAdminAttributeCategoryAPI.ListDelegatedAdminAttributeCategoriesExecute(listRequest)
@@ -105,12 +112,23 @@ func (r *delegatedAdminAttributeCategoriesDataSource) Read(ctx context.Context,
 
 	// Read the response into the state
 	ids := []attr.Value{}
+	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
+		var object delegatedAdminAttributeCategoryDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
+		readDelegatedAdminAttributeCategoryResponseDataSource(ctx, &response, &object, &resp.Diagnostics)
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
+		}
 		ids = append(ids, types.StringValue(response.Id))
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 
 	state.Ids, diags = types.SetValue(types.StringType, ids)
 	resp.Diagnostics.Append(diags...)
+	state.Objects, diags = types.SetValue(objectType, objects)
+	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
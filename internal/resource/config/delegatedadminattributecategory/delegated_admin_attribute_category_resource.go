@@ -27,9 +27,11 @@ var (
 	_ resource.Resource                = &delegatedAdminAttributeCategoryResource{}
 	_ resource.ResourceWithConfigure   = &delegatedAdminAttributeCategoryResource{}
 	_ resource.ResourceWithImportState = &delegatedAdminAttributeCategoryResource{}
+	_ resource.ResourceWithMoveState   = &delegatedAdminAttributeCategoryResource{}
 	_ resource.Resource                = &defaultDelegatedAdminAttributeCategoryResource{}
 	_ resource.ResourceWithConfigure   = &defaultDelegatedAdminAttributeCategoryResource{}
 	_ resource.ResourceWithImportState = &defaultDelegatedAdminAttributeCategoryResource{}
+	_ resource.ResourceWithMoveState   = &defaultDelegatedAdminAttributeCategoryResource{}
 )
 
 // Create a Delegated Admin Attribute Category resource
@@ -206,7 +208,7 @@ func (r *delegatedAdminAttributeCategoryResource) CreateDelegatedAdminAttributeC
 
 	addResponse, httpResp, err := r.apiClient.DelegatedAdminAttributeCategoryAPI.AddDelegatedAdminAttributeCategoryExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Delegated Admin Attribute Category", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Delegated Admin Attribute Category", err, httpResp)
 		return nil, err
 	}
 
@@ -237,6 +239,8 @@ func (r *delegatedAdminAttributeCategoryResource) Create(ctx context.Context, re
 		return
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Delegated Admin Attribute Category", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -261,7 +265,7 @@ func (r *defaultDelegatedAdminAttributeCategoryResource) Create(ctx context.Cont
 	readResponse, httpResp, err := r.apiClient.DelegatedAdminAttributeCategoryAPI.GetDelegatedAdminAttributeCategory(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.DisplayName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Delegated Admin Attribute Category", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Delegated Admin Attribute Category", err, httpResp)
 		return
 	}
 
@@ -285,7 +289,7 @@ func (r *defaultDelegatedAdminAttributeCategoryResource) Create(ctx context.Cont
 
 		updateResponse, httpResp, err := r.apiClient.DelegatedAdminAttributeCategoryAPI.UpdateDelegatedAdminAttributeCategoryExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Delegated Admin Attribute Category", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Delegated Admin Attribute Category", err, httpResp)
 			return
 		}
 
@@ -297,6 +301,8 @@ func (r *defaultDelegatedAdminAttributeCategoryResource) Create(ctx context.Cont
 
 		// Read the response
 		readDelegatedAdminAttributeCategoryResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Delegated Admin Attribute Category", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -388,7 +394,7 @@ func updateDelegatedAdminAttributeCategory(ctx context.Context, req resource.Upd
 
 		updateResponse, httpResp, err := apiClient.DelegatedAdminAttributeCategoryAPI.UpdateDelegatedAdminAttributeCategoryExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Delegated Admin Attribute Category", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Delegated Admin Attribute Category", err, httpResp)
 			return
 		}
 
@@ -400,6 +406,8 @@ func updateDelegatedAdminAttributeCategory(ctx context.Context, req resource.Upd
 
 		// Read the response
 		readDelegatedAdminAttributeCategoryResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Delegated Admin Attribute Category", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -447,3 +455,16 @@ func importDelegatedAdminAttributeCategory(ctx context.Context, req resource.Imp
 	// Retrieve import ID and save to display_name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("display_name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *delegatedAdminAttributeCategoryResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_delegated_admin_attribute_category"),
+	}
+}
+
+func (r *defaultDelegatedAdminAttributeCategoryResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_delegated_admin_attribute_category"),
+	}
+}
@@ -29,9 +29,11 @@ var (
 	_ resource.Resource                = &delegatedAdminCorrelatedRestResourceResource{}
 	_ resource.ResourceWithConfigure   = &delegatedAdminCorrelatedRestResourceResource{}
 	_ resource.ResourceWithImportState = &delegatedAdminCorrelatedRestResourceResource{}
+	_ resource.ResourceWithMoveState   = &delegatedAdminCorrelatedRestResourceResource{}
 	_ resource.Resource                = &defaultDelegatedAdminCorrelatedRestResourceResource{}
 	_ resource.ResourceWithConfigure   = &defaultDelegatedAdminCorrelatedRestResourceResource{}
 	_ resource.ResourceWithImportState = &defaultDelegatedAdminCorrelatedRestResourceResource{}
+	_ resource.ResourceWithMoveState   = &defaultDelegatedAdminCorrelatedRestResourceResource{}
 )
 
 // Create a Delegated Admin Correlated Rest Resource resource
@@ -242,7 +244,7 @@ func (r *delegatedAdminCorrelatedRestResourceResource) CreateDelegatedAdminCorre
 
 	addResponse, httpResp, err := r.apiClient.DelegatedAdminCorrelatedRestResourceAPI.AddDelegatedAdminCorrelatedRestResourceExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Delegated Admin Correlated Rest Resource", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Delegated Admin Correlated Rest Resource", err, httpResp)
 		return nil, err
 	}
 
@@ -275,6 +277,8 @@ func (r *delegatedAdminCorrelatedRestResourceResource) Create(ctx context.Contex
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Delegated Admin Correlated Rest Resource", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -299,7 +303,7 @@ func (r *defaultDelegatedAdminCorrelatedRestResourceResource) Create(ctx context
 	readResponse, httpResp, err := r.apiClient.DelegatedAdminCorrelatedRestResourceAPI.GetDelegatedAdminCorrelatedRestResource(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString(), plan.RestResourceTypeName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Delegated Admin Correlated Rest Resource", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Delegated Admin Correlated Rest Resource", err, httpResp)
 		return
 	}
 
@@ -323,7 +327,7 @@ func (r *defaultDelegatedAdminCorrelatedRestResourceResource) Create(ctx context
 
 		updateResponse, httpResp, err := r.apiClient.DelegatedAdminCorrelatedRestResourceAPI.UpdateDelegatedAdminCorrelatedRestResourceExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Delegated Admin Correlated Rest Resource", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Delegated Admin Correlated Rest Resource", err, httpResp)
 			return
 		}
 
@@ -335,6 +339,8 @@ func (r *defaultDelegatedAdminCorrelatedRestResourceResource) Create(ctx context
 
 		// Read the response
 		readDelegatedAdminCorrelatedRestResourceResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Delegated Admin Correlated Rest Resource", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -427,7 +433,7 @@ func updateDelegatedAdminCorrelatedRestResource(ctx context.Context, req resourc
 
 		updateResponse, httpResp, err := apiClient.DelegatedAdminCorrelatedRestResourceAPI.UpdateDelegatedAdminCorrelatedRestResourceExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Delegated Admin Correlated Rest Resource", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Delegated Admin Correlated Rest Resource", err, httpResp)
 			return
 		}
 
@@ -439,6 +445,8 @@ func updateDelegatedAdminCorrelatedRestResource(ctx context.Context, req resourc
 
 		// Read the response
 		readDelegatedAdminCorrelatedRestResourceResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Delegated Admin Correlated Rest Resource", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -493,3 +501,16 @@ func importDelegatedAdminCorrelatedRestResource(ctx context.Context, req resourc
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rest_resource_type_name"), split[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), split[1])...)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *delegatedAdminCorrelatedRestResourceResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_delegated_admin_correlated_rest_resource"),
+	}
+}
+
+func (r *defaultDelegatedAdminCorrelatedRestResourceResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_delegated_admin_correlated_rest_resource"),
+	}
+}
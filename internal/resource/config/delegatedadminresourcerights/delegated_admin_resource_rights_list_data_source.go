@@ -50,9 +50,12 @@ func (r *delegatedAdminResourceRightsListDataSource) Configure(_ context.Context
 
 type delegatedAdminResourceRightsListDataSourceModel struct {
 	Id                       types.String `tfsdk:"id"`
-	Filter                   types.String `tfsdk:"filter"`
 	Ids                      types.Set    `tfsdk:"ids"`
+	Objects                  types.Set    `tfsdk:"objects"`
+	ObjectsById              types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes        types.Bool   `tfsdk:"include_attributes"`
 	DelegatedAdminRightsName types.String `tfsdk:"delegated_admin_rights_name"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
@@ -64,10 +67,6 @@ func (r *delegatedAdminResourceRightsListDataSource) Schema(ctx context.Context,
 				Description: "Name of the parent Delegated Admin Rights",
 				Required:    true,
 			},
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
 			"ids": schema.SetAttribute{
 				Description: "Delegated Admin Resource Rights IDs found in the configuration",
 				Required:    false,
@@ -77,6 +76,8 @@ func (r *delegatedAdminResourceRightsListDataSource) Schema(ctx context.Context,
 			},
 		},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewDelegatedAdminResourceRightsDataSource(), "Delegated Admin Resource Rights")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -91,9 +92,15 @@ func (r *delegatedAdminResourceRightsListDataSource) Read(ctx context.Context, r
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewDelegatedAdminResourceRightsDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.DelegatedAdminResourceRightsAPI.ListDelegatedAdminResourceRights(config.ProviderBasicAuthContext(ctx, r.providerConfig), state.DelegatedAdminRightsName.ValueString())
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.DelegatedAdminResourceRightsAPI.ListDelegatedAdminResourceRightsExecute(listRequest)
@@ -110,12 +117,24 @@ func (r *delegatedAdminResourceRightsListDataSource) Read(ctx context.Context, r
 
 	// Read the response into the state
 	ids := []attr.Value{}
+	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
+		var object delegatedAdminResourceRightsDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
+		readDelegatedAdminResourceRightsResponseDataSource(ctx, &response, &object, &resp.Diagnostics)
+		object.DelegatedAdminRightsName = state.DelegatedAdminRightsName
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
+		}
 		ids = append(ids, types.StringValue(response.Id))
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 
 	state.Ids, diags = types.SetValue(types.StringType, ids)
 	resp.Diagnostics.Append(diags...)
+	state.Objects, diags = types.SetValue(objectType, objects)
+	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
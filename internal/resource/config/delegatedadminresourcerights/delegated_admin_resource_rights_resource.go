@@ -28,9 +28,11 @@ var (
 	_ resource.Resource                = &delegatedAdminResourceRightsResource{}
 	_ resource.ResourceWithConfigure   = &delegatedAdminResourceRightsResource{}
 	_ resource.ResourceWithImportState = &delegatedAdminResourceRightsResource{}
+	_ resource.ResourceWithMoveState   = &delegatedAdminResourceRightsResource{}
 	_ resource.Resource                = &defaultDelegatedAdminResourceRightsResource{}
 	_ resource.ResourceWithConfigure   = &defaultDelegatedAdminResourceRightsResource{}
 	_ resource.ResourceWithImportState = &defaultDelegatedAdminResourceRightsResource{}
+	_ resource.ResourceWithMoveState   = &defaultDelegatedAdminResourceRightsResource{}
 )
 
 // Create a Delegated Admin Resource Rights resource
@@ -306,7 +308,7 @@ func (r *delegatedAdminResourceRightsResource) CreateDelegatedAdminResourceRight
 
 	addResponse, httpResp, err := r.apiClient.DelegatedAdminResourceRightsAPI.AddDelegatedAdminResourceRightsExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Delegated Admin Resource Rights", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Delegated Admin Resource Rights", err, httpResp)
 		return nil, err
 	}
 
@@ -339,6 +341,8 @@ func (r *delegatedAdminResourceRightsResource) Create(ctx context.Context, req r
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Delegated Admin Resource Rights", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -363,7 +367,7 @@ func (r *defaultDelegatedAdminResourceRightsResource) Create(ctx context.Context
 	readResponse, httpResp, err := r.apiClient.DelegatedAdminResourceRightsAPI.GetDelegatedAdminResourceRights(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.RestResourceType.ValueString(), plan.DelegatedAdminRightsName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Delegated Admin Resource Rights", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Delegated Admin Resource Rights", err, httpResp)
 		return
 	}
 
@@ -387,7 +391,7 @@ func (r *defaultDelegatedAdminResourceRightsResource) Create(ctx context.Context
 
 		updateResponse, httpResp, err := r.apiClient.DelegatedAdminResourceRightsAPI.UpdateDelegatedAdminResourceRightsExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Delegated Admin Resource Rights", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Delegated Admin Resource Rights", err, httpResp)
 			return
 		}
 
@@ -399,6 +403,8 @@ func (r *defaultDelegatedAdminResourceRightsResource) Create(ctx context.Context
 
 		// Read the response
 		readDelegatedAdminResourceRightsResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Delegated Admin Resource Rights", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -491,7 +497,7 @@ func updateDelegatedAdminResourceRights(ctx context.Context, req resource.Update
 
 		updateResponse, httpResp, err := apiClient.DelegatedAdminResourceRightsAPI.UpdateDelegatedAdminResourceRightsExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Delegated Admin Resource Rights", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Delegated Admin Resource Rights", err, httpResp)
 			return
 		}
 
@@ -503,6 +509,8 @@ func updateDelegatedAdminResourceRights(ctx context.Context, req resource.Update
 
 		// Read the response
 		readDelegatedAdminResourceRightsResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Delegated Admin Resource Rights", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -557,3 +565,16 @@ func importDelegatedAdminResourceRights(ctx context.Context, req resource.Import
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("delegated_admin_rights_name"), split[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rest_resource_type"), split[1])...)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *delegatedAdminResourceRightsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_delegated_admin_resource_rights"),
+	}
+}
+
+func (r *defaultDelegatedAdminResourceRightsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_delegated_admin_resource_rights"),
+	}
+}
@@ -28,9 +28,11 @@ var (
 	_ resource.Resource                = &delegatedAdminRightsResource{}
 	_ resource.ResourceWithConfigure   = &delegatedAdminRightsResource{}
 	_ resource.ResourceWithImportState = &delegatedAdminRightsResource{}
+	_ resource.ResourceWithMoveState   = &delegatedAdminRightsResource{}
 	_ resource.Resource                = &defaultDelegatedAdminRightsResource{}
 	_ resource.ResourceWithConfigure   = &defaultDelegatedAdminRightsResource{}
 	_ resource.ResourceWithImportState = &defaultDelegatedAdminRightsResource{}
+	_ resource.ResourceWithMoveState   = &defaultDelegatedAdminRightsResource{}
 )
 
 // Create a Delegated Admin Rights resource
@@ -239,7 +241,7 @@ func (r *delegatedAdminRightsResource) CreateDelegatedAdminRights(ctx context.Co
 
 	addResponse, httpResp, err := r.apiClient.DelegatedAdminRightsAPI.AddDelegatedAdminRightsExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Delegated Admin Rights", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Delegated Admin Rights", err, httpResp)
 		return nil, err
 	}
 
@@ -270,6 +272,8 @@ func (r *delegatedAdminRightsResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Delegated Admin Rights", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -294,7 +298,7 @@ func (r *defaultDelegatedAdminRightsResource) Create(ctx context.Context, req re
 	readResponse, httpResp, err := r.apiClient.DelegatedAdminRightsAPI.GetDelegatedAdminRights(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Delegated Admin Rights", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Delegated Admin Rights", err, httpResp)
 		return
 	}
 
@@ -318,7 +322,7 @@ func (r *defaultDelegatedAdminRightsResource) Create(ctx context.Context, req re
 
 		updateResponse, httpResp, err := r.apiClient.DelegatedAdminRightsAPI.UpdateDelegatedAdminRightsExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Delegated Admin Rights", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Delegated Admin Rights", err, httpResp)
 			return
 		}
 
@@ -330,6 +334,8 @@ func (r *defaultDelegatedAdminRightsResource) Create(ctx context.Context, req re
 
 		// Read the response
 		readDelegatedAdminRightsResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Delegated Admin Rights", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -421,7 +427,7 @@ func updateDelegatedAdminRights(ctx context.Context, req resource.UpdateRequest,
 
 		updateResponse, httpResp, err := apiClient.DelegatedAdminRightsAPI.UpdateDelegatedAdminRightsExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Delegated Admin Rights", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Delegated Admin Rights", err, httpResp)
 			return
 		}
 
@@ -433,6 +439,8 @@ func updateDelegatedAdminRights(ctx context.Context, req resource.UpdateRequest,
 
 		// Read the response
 		readDelegatedAdminRightsResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Delegated Admin Rights", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -480,3 +488,16 @@ func importDelegatedAdminRights(ctx context.Context, req resource.ImportStateReq
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *delegatedAdminRightsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_delegated_admin_rights"),
+	}
+}
+
+func (r *defaultDelegatedAdminRightsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_delegated_admin_rights"),
+	}
+}
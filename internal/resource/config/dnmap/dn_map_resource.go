@@ -27,9 +27,11 @@ var (
 	_ resource.Resource                = &dnMapResource{}
 	_ resource.ResourceWithConfigure   = &dnMapResource{}
 	_ resource.ResourceWithImportState = &dnMapResource{}
+	_ resource.ResourceWithMoveState   = &dnMapResource{}
 	_ resource.Resource                = &defaultDnMapResource{}
 	_ resource.ResourceWithConfigure   = &defaultDnMapResource{}
 	_ resource.ResourceWithImportState = &defaultDnMapResource{}
+	_ resource.ResourceWithMoveState   = &defaultDnMapResource{}
 )
 
 // Create a Dn Map resource
@@ -204,7 +206,7 @@ func (r *dnMapResource) CreateDnMap(ctx context.Context, req resource.CreateRequ
 
 	addResponse, httpResp, err := r.apiClient.DnMapAPI.AddDnMapExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Dn Map", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Dn Map", err, httpResp)
 		return nil, err
 	}
 
@@ -235,6 +237,8 @@ func (r *dnMapResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Dn Map", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -259,7 +263,7 @@ func (r *defaultDnMapResource) Create(ctx context.Context, req resource.CreateRe
 	readResponse, httpResp, err := r.apiClient.DnMapAPI.GetDnMap(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Dn Map", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Dn Map", err, httpResp)
 		return
 	}
 
@@ -283,7 +287,7 @@ func (r *defaultDnMapResource) Create(ctx context.Context, req resource.CreateRe
 
 		updateResponse, httpResp, err := r.apiClient.DnMapAPI.UpdateDnMapExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Dn Map", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Dn Map", err, httpResp)
 			return
 		}
 
@@ -295,6 +299,8 @@ func (r *defaultDnMapResource) Create(ctx context.Context, req resource.CreateRe
 
 		// Read the response
 		readDnMapResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Dn Map", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -386,7 +392,7 @@ func updateDnMap(ctx context.Context, req resource.UpdateRequest, resp *resource
 
 		updateResponse, httpResp, err := apiClient.DnMapAPI.UpdateDnMapExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Dn Map", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Dn Map", err, httpResp)
 			return
 		}
 
@@ -398,6 +404,8 @@ func updateDnMap(ctx context.Context, req resource.UpdateRequest, resp *resource
 
 		// Read the response
 		readDnMapResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Dn Map", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -445,3 +453,16 @@ func importDnMap(ctx context.Context, req resource.ImportStateRequest, resp *res
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *dnMapResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_dn_map"),
+	}
+}
+
+func (r *defaultDnMapResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_dn_map"),
+	}
+}
@@ -29,9 +29,11 @@ var (
 	_ resource.Resource                = &entryCacheResource{}
 	_ resource.ResourceWithConfigure   = &entryCacheResource{}
 	_ resource.ResourceWithImportState = &entryCacheResource{}
+	_ resource.ResourceWithMoveState   = &entryCacheResource{}
 	_ resource.Resource                = &defaultEntryCacheResource{}
 	_ resource.ResourceWithConfigure   = &defaultEntryCacheResource{}
 	_ resource.ResourceWithImportState = &defaultEntryCacheResource{}
+	_ resource.ResourceWithMoveState   = &defaultEntryCacheResource{}
 )
 
 // Create a Entry Cache resource
@@ -306,7 +308,7 @@ func (r *entryCacheResource) CreateFifoEntryCache(ctx context.Context, req resou
 
 	addResponse, httpResp, err := r.apiClient.EntryCacheAPI.AddEntryCacheExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Entry Cache", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Entry Cache", err, httpResp)
 		return nil, err
 	}
 
@@ -337,6 +339,8 @@ func (r *entryCacheResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Entry Cache", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -361,7 +365,7 @@ func (r *defaultEntryCacheResource) Create(ctx context.Context, req resource.Cre
 	readResponse, httpResp, err := r.apiClient.EntryCacheAPI.GetEntryCache(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Entry Cache", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Entry Cache", err, httpResp)
 		return
 	}
 
@@ -385,7 +389,7 @@ func (r *defaultEntryCacheResource) Create(ctx context.Context, req resource.Cre
 
 		updateResponse, httpResp, err := r.apiClient.EntryCacheAPI.UpdateEntryCacheExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Entry Cache", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Entry Cache", err, httpResp)
 			return
 		}
 
@@ -397,6 +401,8 @@ func (r *defaultEntryCacheResource) Create(ctx context.Context, req resource.Cre
 
 		// Read the response
 		readFifoEntryCacheResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Entry Cache", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -488,7 +494,7 @@ func updateEntryCache(ctx context.Context, req resource.UpdateRequest, resp *res
 
 		updateResponse, httpResp, err := apiClient.EntryCacheAPI.UpdateEntryCacheExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Entry Cache", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Entry Cache", err, httpResp)
 			return
 		}
 
@@ -500,6 +506,8 @@ func updateEntryCache(ctx context.Context, req resource.UpdateRequest, resp *res
 
 		// Read the response
 		readFifoEntryCacheResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Entry Cache", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -547,3 +555,16 @@ func importEntryCache(ctx context.Context, req resource.ImportStateRequest, resp
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *entryCacheResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_entry_cache"),
+	}
+}
+
+func (r *defaultEntryCacheResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_entry_cache"),
+	}
+}
@@ -49,29 +49,21 @@ func (r *entryCachesDataSource) Configure(_ context.Context, req datasource.Conf
 }
 
 type entryCachesDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *entryCachesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Entry Cache objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Entry Cache objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewEntryCacheDataSource(), "Entry Cache")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *entryCachesDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewEntryCacheDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.EntryCacheAPI.ListEntryCaches(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.EntryCacheAPI.ListEntryCachesExecute(listRequest)
@@ -106,29 +104,31 @@ func (r *entryCachesDataSource) Read(ctx context.Context, req datasource.ReadReq
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object entryCacheDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.FileSystemEntryCacheResponse != nil {
-			attributes["id"] = types.StringValue(response.FileSystemEntryCacheResponse.Id)
-			attributes["type"] = types.StringValue("file-system")
+			object.Id = types.StringValue(response.FileSystemEntryCacheResponse.Id)
+			object.Type = types.StringValue("file-system")
 		}
 		if response.SoftReferenceEntryCacheResponse != nil {
-			attributes["id"] = types.StringValue(response.SoftReferenceEntryCacheResponse.Id)
-			attributes["type"] = types.StringValue("soft-reference")
+			object.Id = types.StringValue(response.SoftReferenceEntryCacheResponse.Id)
+			object.Type = types.StringValue("soft-reference")
 		}
 		if response.FifoEntryCacheResponse != nil {
-			attributes["id"] = types.StringValue(response.FifoEntryCacheResponse.Id)
-			attributes["type"] = types.StringValue("fifo")
+			readFifoEntryCacheResponseDataSource(ctx, response.FifoEntryCacheResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
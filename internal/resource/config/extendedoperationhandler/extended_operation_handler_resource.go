@@ -30,9 +30,11 @@ var (
 	_ resource.Resource                = &extendedOperationHandlerResource{}
 	_ resource.ResourceWithConfigure   = &extendedOperationHandlerResource{}
 	_ resource.ResourceWithImportState = &extendedOperationHandlerResource{}
+	_ resource.ResourceWithMoveState   = &extendedOperationHandlerResource{}
 	_ resource.Resource                = &defaultExtendedOperationHandlerResource{}
 	_ resource.ResourceWithConfigure   = &defaultExtendedOperationHandlerResource{}
 	_ resource.ResourceWithImportState = &defaultExtendedOperationHandlerResource{}
+	_ resource.ResourceWithMoveState   = &defaultExtendedOperationHandlerResource{}
 )
 
 // Create a Extended Operation Handler resource
@@ -1337,7 +1339,7 @@ func (r *extendedOperationHandlerResource) CreateValidateTotpPasswordExtendedOpe
 
 	addResponse, httpResp, err := r.apiClient.ExtendedOperationHandlerAPI.AddExtendedOperationHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Extended Operation Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Extended Operation Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1379,7 +1381,7 @@ func (r *extendedOperationHandlerResource) CreateSingleUseTokensExtendedOperatio
 
 	addResponse, httpResp, err := r.apiClient.ExtendedOperationHandlerAPI.AddExtendedOperationHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Extended Operation Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Extended Operation Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1421,7 +1423,7 @@ func (r *extendedOperationHandlerResource) CreateDeliverPasswordResetTokenExtend
 
 	addResponse, httpResp, err := r.apiClient.ExtendedOperationHandlerAPI.AddExtendedOperationHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Extended Operation Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Extended Operation Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1459,7 +1461,7 @@ func (r *extendedOperationHandlerResource) CreateReplaceCertificateExtendedOpera
 
 	addResponse, httpResp, err := r.apiClient.ExtendedOperationHandlerAPI.AddExtendedOperationHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Extended Operation Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Extended Operation Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1497,7 +1499,7 @@ func (r *extendedOperationHandlerResource) CreateCollectSupportDataExtendedOpera
 
 	addResponse, httpResp, err := r.apiClient.ExtendedOperationHandlerAPI.AddExtendedOperationHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Extended Operation Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Extended Operation Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1535,7 +1537,7 @@ func (r *extendedOperationHandlerResource) CreateExportReversiblePasswordsExtend
 
 	addResponse, httpResp, err := r.apiClient.ExtendedOperationHandlerAPI.AddExtendedOperationHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Extended Operation Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Extended Operation Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1578,7 +1580,7 @@ func (r *extendedOperationHandlerResource) CreateDeliverOtpExtendedOperationHand
 
 	addResponse, httpResp, err := r.apiClient.ExtendedOperationHandlerAPI.AddExtendedOperationHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Extended Operation Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Extended Operation Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1616,7 +1618,7 @@ func (r *extendedOperationHandlerResource) CreateVerifyPasswordExtendedOperation
 
 	addResponse, httpResp, err := r.apiClient.ExtendedOperationHandlerAPI.AddExtendedOperationHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Extended Operation Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Extended Operation Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1655,7 +1657,7 @@ func (r *extendedOperationHandlerResource) CreateThirdPartyExtendedOperationHand
 
 	addResponse, httpResp, err := r.apiClient.ExtendedOperationHandlerAPI.AddExtendedOperationHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Extended Operation Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Extended Operation Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1738,6 +1740,8 @@ func (r *extendedOperationHandlerResource) Create(ctx context.Context, req resou
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Extended Operation Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -1762,7 +1766,7 @@ func (r *defaultExtendedOperationHandlerResource) Create(ctx context.Context, re
 	readResponse, httpResp, err := r.apiClient.ExtendedOperationHandlerAPI.GetExtendedOperationHandler(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Extended Operation Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Extended Operation Handler", err, httpResp)
 		return
 	}
 
@@ -1854,7 +1858,7 @@ func (r *defaultExtendedOperationHandlerResource) Create(ctx context.Context, re
 
 		updateResponse, httpResp, err := r.apiClient.ExtendedOperationHandlerAPI.UpdateExtendedOperationHandlerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Extended Operation Handler", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Extended Operation Handler", err, httpResp)
 			return
 		}
 
@@ -1934,6 +1938,8 @@ func (r *defaultExtendedOperationHandlerResource) Create(ctx context.Context, re
 		if updateResponse.ThirdPartyExtendedOperationHandlerResponse != nil {
 			readThirdPartyExtendedOperationHandlerResponseDefault(ctx, updateResponse.ThirdPartyExtendedOperationHandlerResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Extended Operation Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -2101,7 +2107,7 @@ func (r *extendedOperationHandlerResource) Update(ctx context.Context, req resou
 
 		updateResponse, httpResp, err := r.apiClient.ExtendedOperationHandlerAPI.UpdateExtendedOperationHandlerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Extended Operation Handler", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Extended Operation Handler", err, httpResp)
 			return
 		}
 
@@ -2139,6 +2145,8 @@ func (r *extendedOperationHandlerResource) Update(ctx context.Context, req resou
 		if updateResponse.ThirdPartyExtendedOperationHandlerResponse != nil {
 			readThirdPartyExtendedOperationHandlerResponse(ctx, updateResponse.ThirdPartyExtendedOperationHandlerResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Extended Operation Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -2174,7 +2182,7 @@ func (r *defaultExtendedOperationHandlerResource) Update(ctx context.Context, re
 
 		updateResponse, httpResp, err := r.apiClient.ExtendedOperationHandlerAPI.UpdateExtendedOperationHandlerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Extended Operation Handler", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Extended Operation Handler", err, httpResp)
 			return
 		}
 
@@ -2254,6 +2262,8 @@ func (r *defaultExtendedOperationHandlerResource) Update(ctx context.Context, re
 		if updateResponse.ThirdPartyExtendedOperationHandlerResponse != nil {
 			readThirdPartyExtendedOperationHandlerResponseDefault(ctx, updateResponse.ThirdPartyExtendedOperationHandlerResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Extended Operation Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -2301,3 +2311,16 @@ func importExtendedOperationHandler(ctx context.Context, req resource.ImportStat
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *extendedOperationHandlerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_extended_operation_handler"),
+	}
+}
+
+func (r *defaultExtendedOperationHandlerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_extended_operation_handler"),
+	}
+}
@@ -49,29 +49,21 @@ func (r *extendedOperationHandlersDataSource) Configure(_ context.Context, req d
 }
 
 type extendedOperationHandlersDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *extendedOperationHandlersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Extended Operation Handler objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Extended Operation Handler objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewExtendedOperationHandlerDataSource(), "Extended Operation Handler")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *extendedOperationHandlersDataSource) Read(ctx context.Context, req data
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewExtendedOperationHandlerDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.ExtendedOperationHandlerAPI.ListExtendedOperationHandlers(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.ExtendedOperationHandlerAPI.ListExtendedOperationHandlersExecute(listRequest)
@@ -106,149 +104,129 @@ func (r *extendedOperationHandlersDataSource) Read(ctx context.Context, req data
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object extendedOperationHandlerDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.CancelExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.CancelExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("cancel")
+			readCancelExtendedOperationHandlerResponseDataSource(ctx, response.CancelExtendedOperationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.ValidateTotpPasswordExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.ValidateTotpPasswordExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("validate-totp-password")
+			readValidateTotpPasswordExtendedOperationHandlerResponseDataSource(ctx, response.ValidateTotpPasswordExtendedOperationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.InteractiveTransactionsExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.InteractiveTransactionsExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("interactive-transactions")
+			object.Id = types.StringValue(response.InteractiveTransactionsExtendedOperationHandlerResponse.Id)
+			object.Type = types.StringValue("interactive-transactions")
 		}
 		if response.ReplaceCertificateExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.ReplaceCertificateExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("replace-certificate")
+			readReplaceCertificateExtendedOperationHandlerResponseDataSource(ctx, response.ReplaceCertificateExtendedOperationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.BackupCompatibilityExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.BackupCompatibilityExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("backup-compatibility")
+			object.Id = types.StringValue(response.BackupCompatibilityExtendedOperationHandlerResponse.Id)
+			object.Type = types.StringValue("backup-compatibility")
 		}
 		if response.GetConnectionIdExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.GetConnectionIdExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("get-connection-id")
+			readGetConnectionIdExtendedOperationHandlerResponseDataSource(ctx, response.GetConnectionIdExtendedOperationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.BatchedTransactionsExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.BatchedTransactionsExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("batched-transactions")
+			readBatchedTransactionsExtendedOperationHandlerResponseDataSource(ctx, response.BatchedTransactionsExtendedOperationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.AdministrativeSessionExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.AdministrativeSessionExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("administrative-session")
+			object.Id = types.StringValue(response.AdministrativeSessionExtendedOperationHandlerResponse.Id)
+			object.Type = types.StringValue("administrative-session")
 		}
 		if response.SingleUseTokensExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.SingleUseTokensExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("single-use-tokens")
+			readSingleUseTokensExtendedOperationHandlerResponseDataSource(ctx, response.SingleUseTokensExtendedOperationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.StreamDirectoryValuesExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.StreamDirectoryValuesExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("stream-directory-values")
+			object.Id = types.StringValue(response.StreamDirectoryValuesExtendedOperationHandlerResponse.Id)
+			object.Type = types.StringValue("stream-directory-values")
 		}
 		if response.StartTlsExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.StartTlsExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("start-tls")
+			readStartTlsExtendedOperationHandlerResponseDataSource(ctx, response.StartTlsExtendedOperationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.SubtreeAccessibilityExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.SubtreeAccessibilityExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("subtree-accessibility")
+			object.Id = types.StringValue(response.SubtreeAccessibilityExtendedOperationHandlerResponse.Id)
+			object.Type = types.StringValue("subtree-accessibility")
 		}
 		if response.GetSymmetricKeyExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.GetSymmetricKeyExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("get-symmetric-key")
+			object.Id = types.StringValue(response.GetSymmetricKeyExtendedOperationHandlerResponse.Id)
+			object.Type = types.StringValue("get-symmetric-key")
 		}
 		if response.GetPasswordQualityRequirementsExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.GetPasswordQualityRequirementsExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("get-password-quality-requirements")
+			readGetPasswordQualityRequirementsExtendedOperationHandlerResponseDataSource(ctx, response.GetPasswordQualityRequirementsExtendedOperationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.DeliverOtpExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.DeliverOtpExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("deliver-otp")
+			readDeliverOtpExtendedOperationHandlerResponseDataSource(ctx, response.DeliverOtpExtendedOperationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyExtendedOperationHandlerResponseDataSource(ctx, response.ThirdPartyExtendedOperationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyProxiedExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyProxiedExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("third-party-proxied")
+			object.Id = types.StringValue(response.ThirdPartyProxiedExtendedOperationHandlerResponse.Id)
+			object.Type = types.StringValue("third-party-proxied")
 		}
 		if response.SynchronizeEncryptionSettingsExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.SynchronizeEncryptionSettingsExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("synchronize-encryption-settings")
+			object.Id = types.StringValue(response.SynchronizeEncryptionSettingsExtendedOperationHandlerResponse.Id)
+			object.Type = types.StringValue("synchronize-encryption-settings")
 		}
 		if response.MultiUpdateExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.MultiUpdateExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("multi-update")
+			readMultiUpdateExtendedOperationHandlerResponseDataSource(ctx, response.MultiUpdateExtendedOperationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.NotificationSubscriptionExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.NotificationSubscriptionExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("notification-subscription")
+			readNotificationSubscriptionExtendedOperationHandlerResponseDataSource(ctx, response.NotificationSubscriptionExtendedOperationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.PasswordModifyExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.PasswordModifyExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("password-modify")
+			readPasswordModifyExtendedOperationHandlerResponseDataSource(ctx, response.PasswordModifyExtendedOperationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.CustomExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.CustomExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("custom")
+			readCustomExtendedOperationHandlerResponseDataSource(ctx, response.CustomExtendedOperationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.CollectSupportDataExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.CollectSupportDataExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("collect-support-data")
+			readCollectSupportDataExtendedOperationHandlerResponseDataSource(ctx, response.CollectSupportDataExtendedOperationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.ExportReversiblePasswordsExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.ExportReversiblePasswordsExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("export-reversible-passwords")
+			readExportReversiblePasswordsExtendedOperationHandlerResponseDataSource(ctx, response.ExportReversiblePasswordsExtendedOperationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.GetChangelogBatchExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.GetChangelogBatchExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("get-changelog-batch")
+			readGetChangelogBatchExtendedOperationHandlerResponseDataSource(ctx, response.GetChangelogBatchExtendedOperationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.GetSupportedOtpDeliveryMechanismsExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.GetSupportedOtpDeliveryMechanismsExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("get-supported-otp-delivery-mechanisms")
+			readGetSupportedOtpDeliveryMechanismsExtendedOperationHandlerResponseDataSource(ctx, response.GetSupportedOtpDeliveryMechanismsExtendedOperationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.VerifyPasswordExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.VerifyPasswordExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("verify-password")
+			readVerifyPasswordExtendedOperationHandlerResponseDataSource(ctx, response.VerifyPasswordExtendedOperationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.GeneratePasswordExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.GeneratePasswordExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("generate-password")
+			readGeneratePasswordExtendedOperationHandlerResponseDataSource(ctx, response.GeneratePasswordExtendedOperationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.WhoAmIExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.WhoAmIExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("who-am-i")
+			readWhoAmIExtendedOperationHandlerResponseDataSource(ctx, response.WhoAmIExtendedOperationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.DeliverPasswordResetTokenExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.DeliverPasswordResetTokenExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("deliver-password-reset-token")
+			readDeliverPasswordResetTokenExtendedOperationHandlerResponseDataSource(ctx, response.DeliverPasswordResetTokenExtendedOperationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.StreamProxyValuesExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.StreamProxyValuesExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("stream-proxy-values")
+			object.Id = types.StringValue(response.StreamProxyValuesExtendedOperationHandlerResponse.Id)
+			object.Type = types.StringValue("stream-proxy-values")
 		}
 		if response.PasswordPolicyStateExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.PasswordPolicyStateExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("password-policy-state")
+			readPasswordPolicyStateExtendedOperationHandlerResponseDataSource(ctx, response.PasswordPolicyStateExtendedOperationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.GetConfigurationExtendedOperationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.GetConfigurationExtendedOperationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("get-configuration")
+			object.Id = types.StringValue(response.GetConfigurationExtendedOperationHandlerResponse.Id)
+			object.Type = types.StringValue("get-configuration")
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -33,9 +33,11 @@ var (
 	_ resource.Resource                = &externalServerResource{}
 	_ resource.ResourceWithConfigure   = &externalServerResource{}
 	_ resource.ResourceWithImportState = &externalServerResource{}
+	_ resource.ResourceWithMoveState   = &externalServerResource{}
 	_ resource.Resource                = &defaultExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &defaultExternalServerResource{}
 	_ resource.ResourceWithImportState = &defaultExternalServerResource{}
+	_ resource.ResourceWithMoveState   = &defaultExternalServerResource{}
 )
 
 // Create a External Server resource
@@ -101,12 +103,16 @@ type externalServerResourceModel struct {
 	ConjurAuthenticationMethod             types.String `tfsdk:"conjur_authentication_method"`
 	AwsAccessKeyID                         types.String `tfsdk:"aws_access_key_id"`
 	AwsSecretAccessKey                     types.String `tfsdk:"aws_secret_access_key"`
+	AwsSecretAccessKeyWo                   types.String `tfsdk:"aws_secret_access_key_wo"`
+	AwsSecretAccessKeyWoVersion            types.Int64  `tfsdk:"aws_secret_access_key_wo_version"`
 	AwsRegionName                          types.String `tfsdk:"aws_region_name"`
 	ConjurAccountName                      types.String `tfsdk:"conjur_account_name"`
 	HttpConnectTimeout                     types.String `tfsdk:"http_connect_timeout"`
 	HttpResponseTimeout                    types.String `tfsdk:"http_response_timeout"`
 	TrustStoreFile                         types.String `tfsdk:"trust_store_file"`
 	TrustStorePin                          types.String `tfsdk:"trust_store_pin"`
+	TrustStorePinWo                        types.String `tfsdk:"trust_store_pin_wo"`
+	TrustStorePinWoVersion                 types.Int64  `tfsdk:"trust_store_pin_wo_version"`
 	TrustStoreType                         types.String `tfsdk:"trust_store_type"`
 	BaseURL                                types.String `tfsdk:"base_url"`
 	HostnameVerificationMethod             types.String `tfsdk:"hostname_verification_method"`
@@ -146,6 +152,8 @@ type externalServerResourceModel struct {
 	DefunctConnectionResultCode            types.Set    `tfsdk:"defunct_connection_result_code"`
 	AbandonOnTimeout                       types.Bool   `tfsdk:"abandon_on_timeout"`
 	Password                               types.String `tfsdk:"password"`
+	PasswordWo                             types.String `tfsdk:"password_wo"`
+	PasswordWoVersion                      types.Int64  `tfsdk:"password_wo_version"`
 	PassphraseProvider                     types.String `tfsdk:"passphrase_provider"`
 	SmtpTimeout                            types.String `tfsdk:"smtp_timeout"`
 	SmtpConnectionProperties               types.Set    `tfsdk:"smtp_connection_properties"`
@@ -530,6 +538,9 @@ func externalServerSchema(ctx context.Context, req resource.SchemaRequest, resp
 		transportMechanismAttr.PlanModifiers = append(transportMechanismAttr.PlanModifiers, stringplanmodifier.RequiresReplace())
 		schemaDef.Attributes["transport_mechanism"] = transportMechanismAttr
 	}
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "aws_secret_access_key")
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "trust_store_pin")
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "password")
 	config.AddCommonResourceSchema(&schemaDef, true)
 	resp.Schema = schemaDef
 }
@@ -1536,6 +1547,7 @@ func configValidatorsExternalServer() []resource.ConfigValidator {
 			[]string{"smtp", "nokia-ds", "ping-identity-ds", "active-directory", "jdbc", "ping-identity-proxy-server", "nokia-proxy-server", "opendj", "ldap", "oracle-unified-directory"},
 			resourcevalidator.Conflicting(
 				path.MatchRoot("password"),
+				path.MatchRoot("password_wo"),
 				path.MatchRoot("passphrase_provider"),
 			),
 		),
@@ -1545,6 +1557,7 @@ func configValidatorsExternalServer() []resource.ConfigValidator {
 			configvalidators.Implies(
 				path.MatchRoot("aws_access_key_id"),
 				path.MatchRoot("aws_secret_access_key"),
+				path.MatchRoot("aws_secret_access_key_wo"),
 			),
 		),
 		configvalidators.ImpliesOtherAttributeOneOfString(
@@ -1572,6 +1585,11 @@ func configValidatorsExternalServer() []resource.ConfigValidator {
 			path.MatchRoot("type"),
 			[]string{"smtp", "nokia-ds", "ping-identity-ds", "active-directory", "jdbc", "ping-identity-proxy-server", "nokia-proxy-server", "opendj", "ldap", "oracle-unified-directory"},
 		),
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("password_wo"),
+			path.MatchRoot("type"),
+			[]string{"smtp", "nokia-ds", "ping-identity-ds", "active-directory", "jdbc", "ping-identity-proxy-server", "nokia-proxy-server", "opendj", "ldap", "oracle-unified-directory"},
+		),
 		configvalidators.ImpliesOtherAttributeOneOfString(
 			path.MatchRoot("passphrase_provider"),
 			path.MatchRoot("type"),
@@ -1787,6 +1805,11 @@ func configValidatorsExternalServer() []resource.ConfigValidator {
 			path.MatchRoot("type"),
 			[]string{"conjur", "vault"},
 		),
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("trust_store_pin_wo"),
+			path.MatchRoot("type"),
+			[]string{"conjur", "vault"},
+		),
 		configvalidators.ImpliesOtherAttributeOneOfString(
 			path.MatchRoot("trust_store_type"),
 			path.MatchRoot("type"),
@@ -1802,6 +1825,11 @@ func configValidatorsExternalServer() []resource.ConfigValidator {
 			path.MatchRoot("type"),
 			[]string{"amazon-aws"},
 		),
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("aws_secret_access_key_wo"),
+			path.MatchRoot("type"),
+			[]string{"amazon-aws"},
+		),
 		configvalidators.ImpliesOtherAttributeOneOfString(
 			path.MatchRoot("aws_region_name"),
 			path.MatchRoot("type"),
@@ -1931,6 +1959,9 @@ func addOptionalSmtpExternalServerFields(ctx context.Context, addRequest *client
 	if internaltypes.IsNonEmptyString(plan.Password) {
 		addRequest.Password = plan.Password.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.PasswordWo) {
+		addRequest.Password = plan.PasswordWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.PassphraseProvider) {
 		addRequest.PassphraseProvider = plan.PassphraseProvider.ValueStringPointer()
@@ -1979,6 +2010,9 @@ func addOptionalNokiaDsExternalServerFields(ctx context.Context, addRequest *cli
 	if internaltypes.IsNonEmptyString(plan.Password) {
 		addRequest.Password = plan.Password.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.PasswordWo) {
+		addRequest.Password = plan.PasswordWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.PassphraseProvider) {
 		addRequest.PassphraseProvider = plan.PassphraseProvider.ValueStringPointer()
@@ -2087,6 +2121,9 @@ func addOptionalPingIdentityDsExternalServerFields(ctx context.Context, addReque
 	if internaltypes.IsNonEmptyString(plan.Password) {
 		addRequest.Password = plan.Password.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.PasswordWo) {
+		addRequest.Password = plan.PasswordWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.PassphraseProvider) {
 		addRequest.PassphraseProvider = plan.PassphraseProvider.ValueStringPointer()
@@ -2184,6 +2221,9 @@ func addOptionalActiveDirectoryExternalServerFields(ctx context.Context, addRequ
 	if internaltypes.IsNonEmptyString(plan.Password) {
 		addRequest.Password = plan.Password.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.PasswordWo) {
+		addRequest.Password = plan.PasswordWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.PassphraseProvider) {
 		addRequest.PassphraseProvider = plan.PassphraseProvider.ValueStringPointer()
@@ -2297,6 +2337,9 @@ func addOptionalJdbcExternalServerFields(ctx context.Context, addRequest *client
 	if internaltypes.IsNonEmptyString(plan.Password) {
 		addRequest.Password = plan.Password.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.PasswordWo) {
+		addRequest.Password = plan.PasswordWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.PassphraseProvider) {
 		addRequest.PassphraseProvider = plan.PassphraseProvider.ValueStringPointer()
@@ -2381,6 +2424,9 @@ func addOptionalPingIdentityProxyServerExternalServerFields(ctx context.Context,
 	if internaltypes.IsNonEmptyString(plan.Password) {
 		addRequest.Password = plan.Password.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.PasswordWo) {
+		addRequest.Password = plan.PasswordWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.PassphraseProvider) {
 		addRequest.PassphraseProvider = plan.PassphraseProvider.ValueStringPointer()
@@ -2506,6 +2552,9 @@ func addOptionalNokiaProxyServerExternalServerFields(ctx context.Context, addReq
 	if internaltypes.IsNonEmptyString(plan.Password) {
 		addRequest.Password = plan.Password.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.PasswordWo) {
+		addRequest.Password = plan.PasswordWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.PassphraseProvider) {
 		addRequest.PassphraseProvider = plan.PassphraseProvider.ValueStringPointer()
@@ -2603,6 +2652,9 @@ func addOptionalOpendjExternalServerFields(ctx context.Context, addRequest *clie
 	if internaltypes.IsNonEmptyString(plan.Password) {
 		addRequest.Password = plan.Password.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.PasswordWo) {
+		addRequest.Password = plan.PasswordWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.PassphraseProvider) {
 		addRequest.PassphraseProvider = plan.PassphraseProvider.ValueStringPointer()
@@ -2708,6 +2760,9 @@ func addOptionalLdapExternalServerFields(ctx context.Context, addRequest *client
 	if internaltypes.IsNonEmptyString(plan.Password) {
 		addRequest.Password = plan.Password.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.PasswordWo) {
+		addRequest.Password = plan.PasswordWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.PassphraseProvider) {
 		addRequest.PassphraseProvider = plan.PassphraseProvider.ValueStringPointer()
@@ -2887,6 +2942,9 @@ func addOptionalOracleUnifiedDirectoryExternalServerFields(ctx context.Context,
 	if internaltypes.IsNonEmptyString(plan.Password) {
 		addRequest.Password = plan.Password.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.PasswordWo) {
+		addRequest.Password = plan.PasswordWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.PassphraseProvider) {
 		addRequest.PassphraseProvider = plan.PassphraseProvider.ValueStringPointer()
@@ -2993,6 +3051,9 @@ func addOptionalConjurExternalServerFields(ctx context.Context, addRequest *clie
 	if internaltypes.IsNonEmptyString(plan.TrustStorePin) {
 		addRequest.TrustStorePin = plan.TrustStorePin.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.TrustStorePinWo) {
+		addRequest.TrustStorePin = plan.TrustStorePinWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.TrustStoreType) {
 		addRequest.TrustStoreType = plan.TrustStoreType.ValueStringPointer()
@@ -3026,6 +3087,9 @@ func addOptionalAmazonAwsExternalServerFields(ctx context.Context, addRequest *c
 	if internaltypes.IsNonEmptyString(plan.AwsSecretAccessKey) {
 		addRequest.AwsSecretAccessKey = plan.AwsSecretAccessKey.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.AwsSecretAccessKeyWo) {
+		addRequest.AwsSecretAccessKey = plan.AwsSecretAccessKeyWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.Description) {
 		addRequest.Description = plan.Description.ValueStringPointer()
@@ -3051,6 +3115,9 @@ func addOptionalVaultExternalServerFields(ctx context.Context, addRequest *clien
 	if internaltypes.IsNonEmptyString(plan.TrustStorePin) {
 		addRequest.TrustStorePin = plan.TrustStorePin.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.TrustStorePinWo) {
+		addRequest.TrustStorePin = plan.TrustStorePinWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.TrustStoreType) {
 		addRequest.TrustStoreType = plan.TrustStoreType.ValueStringPointer()
@@ -3732,12 +3799,21 @@ func (state *externalServerResourceModel) setStateValuesNotReturnedByAPI(expecte
 	if !expectedValues.Password.IsUnknown() {
 		state.Password = expectedValues.Password
 	}
+	if !expectedValues.PasswordWoVersion.IsUnknown() {
+		state.PasswordWoVersion = expectedValues.PasswordWoVersion
+	}
 	if !expectedValues.TrustStorePin.IsUnknown() {
 		state.TrustStorePin = expectedValues.TrustStorePin
 	}
+	if !expectedValues.TrustStorePinWoVersion.IsUnknown() {
+		state.TrustStorePinWoVersion = expectedValues.TrustStorePinWoVersion
+	}
 	if !expectedValues.AwsSecretAccessKey.IsUnknown() {
 		state.AwsSecretAccessKey = expectedValues.AwsSecretAccessKey
 	}
+	if !expectedValues.AwsSecretAccessKeyWoVersion.IsUnknown() {
+		state.AwsSecretAccessKeyWoVersion = expectedValues.AwsSecretAccessKeyWoVersion
+	}
 }
 
 // Create any update operations necessary to make the state match the plan
@@ -3749,12 +3825,14 @@ func createExternalServerOperations(plan externalServerResourceModel, state exte
 	operations.AddStringOperationIfNecessary(&ops, plan.ConjurAuthenticationMethod, state.ConjurAuthenticationMethod, "conjur-authentication-method")
 	operations.AddStringOperationIfNecessary(&ops, plan.AwsAccessKeyID, state.AwsAccessKeyID, "aws-access-key-id")
 	operations.AddStringOperationIfNecessary(&ops, plan.AwsSecretAccessKey, state.AwsSecretAccessKey, "aws-secret-access-key")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.AwsSecretAccessKeyWo, plan.AwsSecretAccessKeyWoVersion, state.AwsSecretAccessKeyWoVersion, "aws-secret-access-key")
 	operations.AddStringOperationIfNecessary(&ops, plan.AwsRegionName, state.AwsRegionName, "aws-region-name")
 	operations.AddStringOperationIfNecessary(&ops, plan.ConjurAccountName, state.ConjurAccountName, "conjur-account-name")
 	operations.AddStringOperationIfNecessary(&ops, plan.HttpConnectTimeout, state.HttpConnectTimeout, "http-connect-timeout")
 	operations.AddStringOperationIfNecessary(&ops, plan.HttpResponseTimeout, state.HttpResponseTimeout, "http-response-timeout")
 	operations.AddStringOperationIfNecessary(&ops, plan.TrustStoreFile, state.TrustStoreFile, "trust-store-file")
 	operations.AddStringOperationIfNecessary(&ops, plan.TrustStorePin, state.TrustStorePin, "trust-store-pin")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.TrustStorePinWo, plan.TrustStorePinWoVersion, state.TrustStorePinWoVersion, "trust-store-pin")
 	operations.AddStringOperationIfNecessary(&ops, plan.TrustStoreType, state.TrustStoreType, "trust-store-type")
 	operations.AddStringOperationIfNecessary(&ops, plan.BaseURL, state.BaseURL, "base-url")
 	operations.AddStringOperationIfNecessary(&ops, plan.HostnameVerificationMethod, state.HostnameVerificationMethod, "hostname-verification-method")
@@ -3794,6 +3872,7 @@ func createExternalServerOperations(plan externalServerResourceModel, state exte
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.DefunctConnectionResultCode, state.DefunctConnectionResultCode, "defunct-connection-result-code")
 	operations.AddBoolOperationIfNecessary(&ops, plan.AbandonOnTimeout, state.AbandonOnTimeout, "abandon-on-timeout")
 	operations.AddStringOperationIfNecessary(&ops, plan.Password, state.Password, "password")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.PasswordWo, plan.PasswordWoVersion, state.PasswordWoVersion, "password")
 	operations.AddStringOperationIfNecessary(&ops, plan.PassphraseProvider, state.PassphraseProvider, "passphrase-provider")
 	operations.AddStringOperationIfNecessary(&ops, plan.SmtpTimeout, state.SmtpTimeout, "smtp-timeout")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.SmtpConnectionProperties, state.SmtpConnectionProperties, "smtp-connection-properties")
@@ -3823,7 +3902,7 @@ func (r *externalServerResource) CreateSmtpExternalServer(ctx context.Context, r
 
 	addResponse, httpResp, err := r.apiClient.ExternalServerAPI.AddExternalServerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
 		return nil, err
 	}
 
@@ -3861,7 +3940,7 @@ func (r *externalServerResource) CreateNokiaDsExternalServer(ctx context.Context
 
 	addResponse, httpResp, err := r.apiClient.ExternalServerAPI.AddExternalServerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
 		return nil, err
 	}
 
@@ -3899,7 +3978,7 @@ func (r *externalServerResource) CreatePingIdentityDsExternalServer(ctx context.
 
 	addResponse, httpResp, err := r.apiClient.ExternalServerAPI.AddExternalServerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
 		return nil, err
 	}
 
@@ -3937,7 +4016,7 @@ func (r *externalServerResource) CreateActiveDirectoryExternalServer(ctx context
 
 	addResponse, httpResp, err := r.apiClient.ExternalServerAPI.AddExternalServerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
 		return nil, err
 	}
 
@@ -3980,7 +4059,7 @@ func (r *externalServerResource) CreateJdbcExternalServer(ctx context.Context, r
 
 	addResponse, httpResp, err := r.apiClient.ExternalServerAPI.AddExternalServerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
 		return nil, err
 	}
 
@@ -4024,7 +4103,7 @@ func (r *externalServerResource) CreateSyslogExternalServer(ctx context.Context,
 
 	addResponse, httpResp, err := r.apiClient.ExternalServerAPI.AddExternalServerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
 		return nil, err
 	}
 
@@ -4062,7 +4141,7 @@ func (r *externalServerResource) CreatePingIdentityProxyServerExternalServer(ctx
 
 	addResponse, httpResp, err := r.apiClient.ExternalServerAPI.AddExternalServerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
 		return nil, err
 	}
 
@@ -4101,7 +4180,7 @@ func (r *externalServerResource) CreateHttpProxyExternalServer(ctx context.Conte
 
 	addResponse, httpResp, err := r.apiClient.ExternalServerAPI.AddExternalServerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
 		return nil, err
 	}
 
@@ -4139,7 +4218,7 @@ func (r *externalServerResource) CreateNokiaProxyServerExternalServer(ctx contex
 
 	addResponse, httpResp, err := r.apiClient.ExternalServerAPI.AddExternalServerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
 		return nil, err
 	}
 
@@ -4177,7 +4256,7 @@ func (r *externalServerResource) CreateOpendjExternalServer(ctx context.Context,
 
 	addResponse, httpResp, err := r.apiClient.ExternalServerAPI.AddExternalServerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
 		return nil, err
 	}
 
@@ -4215,7 +4294,7 @@ func (r *externalServerResource) CreateLdapExternalServer(ctx context.Context, r
 
 	addResponse, httpResp, err := r.apiClient.ExternalServerAPI.AddExternalServerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
 		return nil, err
 	}
 
@@ -4252,7 +4331,7 @@ func (r *externalServerResource) CreatePingOneHttpExternalServer(ctx context.Con
 
 	addResponse, httpResp, err := r.apiClient.ExternalServerAPI.AddExternalServerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
 		return nil, err
 	}
 
@@ -4290,7 +4369,7 @@ func (r *externalServerResource) CreateHttpExternalServer(ctx context.Context, r
 
 	addResponse, httpResp, err := r.apiClient.ExternalServerAPI.AddExternalServerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
 		return nil, err
 	}
 
@@ -4328,7 +4407,7 @@ func (r *externalServerResource) CreateOracleUnifiedDirectoryExternalServer(ctx
 
 	addResponse, httpResp, err := r.apiClient.ExternalServerAPI.AddExternalServerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
 		return nil, err
 	}
 
@@ -4370,7 +4449,7 @@ func (r *externalServerResource) CreateConjurExternalServer(ctx context.Context,
 
 	addResponse, httpResp, err := r.apiClient.ExternalServerAPI.AddExternalServerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
 		return nil, err
 	}
 
@@ -4408,7 +4487,7 @@ func (r *externalServerResource) CreateAmazonAwsExternalServer(ctx context.Conte
 
 	addResponse, httpResp, err := r.apiClient.ExternalServerAPI.AddExternalServerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
 		return nil, err
 	}
 
@@ -4449,7 +4528,7 @@ func (r *externalServerResource) CreateVaultExternalServer(ctx context.Context,
 
 	addResponse, httpResp, err := r.apiClient.ExternalServerAPI.AddExternalServerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the External Server", err, httpResp)
 		return nil, err
 	}
 
@@ -4474,6 +4553,13 @@ func (r *externalServerResource) Create(ctx context.Context, req resource.Create
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("aws_secret_access_key_wo"), &plan.AwsSecretAccessKeyWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("trust_store_pin_wo"), &plan.TrustStorePinWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	var state *externalServerResourceModel
 	var err error
@@ -4582,6 +4668,8 @@ func (r *externalServerResource) Create(ctx context.Context, req resource.Create
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "External Server", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -4602,11 +4690,18 @@ func (r *defaultExternalServerResource) Create(ctx context.Context, req resource
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("aws_secret_access_key_wo"), &plan.AwsSecretAccessKeyWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("trust_store_pin_wo"), &plan.TrustStorePinWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	readResponse, httpResp, err := r.apiClient.ExternalServerAPI.GetExternalServer(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the External Server", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the External Server", err, httpResp)
 		return
 	}
 
@@ -4680,7 +4775,7 @@ func (r *defaultExternalServerResource) Create(ctx context.Context, req resource
 
 		updateResponse, httpResp, err := r.apiClient.ExternalServerAPI.UpdateExternalServerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the External Server", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the External Server", err, httpResp)
 			return
 		}
 
@@ -4742,6 +4837,8 @@ func (r *defaultExternalServerResource) Create(ctx context.Context, req resource
 		if updateResponse.VaultExternalServerResponse != nil {
 			readVaultExternalServerResponse(ctx, updateResponse.VaultExternalServerResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "External Server", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -4868,6 +4965,13 @@ func updateExternalServer(ctx context.Context, req resource.UpdateRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("aws_secret_access_key_wo"), &plan.AwsSecretAccessKeyWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("trust_store_pin_wo"), &plan.TrustStorePinWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Get the current state to see how any attributes are changing
 	var state externalServerResourceModel
@@ -4884,7 +4988,7 @@ func updateExternalServer(ctx context.Context, req resource.UpdateRequest, resp
 
 		updateResponse, httpResp, err := apiClient.ExternalServerAPI.UpdateExternalServerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the External Server", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the External Server", err, httpResp)
 			return
 		}
 
@@ -4946,6 +5050,8 @@ func updateExternalServer(ctx context.Context, req resource.UpdateRequest, resp
 		if updateResponse.VaultExternalServerResponse != nil {
 			readVaultExternalServerResponse(ctx, updateResponse.VaultExternalServerResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "External Server", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -4994,3 +5100,16 @@ func importExternalServer(ctx context.Context, req resource.ImportStateRequest,
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *externalServerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_external_server"),
+	}
+}
+
+func (r *defaultExternalServerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_external_server"),
+	}
+}
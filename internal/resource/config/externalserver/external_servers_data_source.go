@@ -49,29 +49,21 @@ func (r *externalServersDataSource) Configure(_ context.Context, req datasource.
 }
 
 type externalServersDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *externalServersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists External Server objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "External Server objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewExternalServerDataSource(), "External Server")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *externalServersDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewExternalServerDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.ExternalServerAPI.ListExternalServers(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.ExternalServerAPI.ListExternalServersExecute(listRequest)
@@ -106,133 +104,119 @@ func (r *externalServersDataSource) Read(ctx context.Context, req datasource.Rea
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object externalServerDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.ConsentServiceExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.ConsentServiceExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("consent-service")
+			object.Id = types.StringValue(response.ConsentServiceExternalServerResponse.Id)
+			object.Type = types.StringValue("consent-service")
 		}
 		if response.ScimExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.ScimExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("scim")
+			object.Id = types.StringValue(response.ScimExternalServerResponse.Id)
+			object.Type = types.StringValue("scim")
 		}
 		if response.NokiaDsExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.NokiaDsExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("nokia-ds")
+			readNokiaDsExternalServerResponseDataSource(ctx, response.NokiaDsExternalServerResponse, &object, &resp.Diagnostics)
 		}
 		if response.PingIdentityDsExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.PingIdentityDsExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("ping-identity-ds")
+			readPingIdentityDsExternalServerResponseDataSource(ctx, response.PingIdentityDsExternalServerResponse, &object, &resp.Diagnostics)
 		}
 		if response.MetricsEngineExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.MetricsEngineExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("metrics-engine")
+			object.Id = types.StringValue(response.MetricsEngineExternalServerResponse.Id)
+			object.Type = types.StringValue("metrics-engine")
 		}
 		if response.JdbcExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.JdbcExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("jdbc")
+			readJdbcExternalServerResponseDataSource(ctx, response.JdbcExternalServerResponse, &object, &resp.Diagnostics)
 		}
 		if response.SyslogExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.SyslogExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("syslog")
+			readSyslogExternalServerResponseDataSource(ctx, response.SyslogExternalServerResponse, &object, &resp.Diagnostics)
 		}
 		if response.PingIdentityProxyServerExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.PingIdentityProxyServerExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("ping-identity-proxy-server")
+			readPingIdentityProxyServerExternalServerResponseDataSource(ctx, response.PingIdentityProxyServerExternalServerResponse, &object, &resp.Diagnostics)
 		}
 		if response.NokiaProxyServerExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.NokiaProxyServerExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("nokia-proxy-server")
+			readNokiaProxyServerExternalServerResponseDataSource(ctx, response.NokiaProxyServerExternalServerResponse, &object, &resp.Diagnostics)
 		}
 		if response.SunDsExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.SunDsExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("sun-ds")
+			object.Id = types.StringValue(response.SunDsExternalServerResponse.Id)
+			object.Type = types.StringValue("sun-ds")
 		}
 		if response.OpendjExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.OpendjExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("opendj")
+			readOpendjExternalServerResponseDataSource(ctx, response.OpendjExternalServerResponse, &object, &resp.Diagnostics)
 		}
 		if response.LdapExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.LdapExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("ldap")
+			readLdapExternalServerResponseDataSource(ctx, response.LdapExternalServerResponse, &object, &resp.Diagnostics)
 		}
 		if response.PingOneHttpExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.PingOneHttpExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("ping-one-http")
+			readPingOneHttpExternalServerResponseDataSource(ctx, response.PingOneHttpExternalServerResponse, &object, &resp.Diagnostics)
 		}
 		if response.ApiExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.ApiExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("api")
+			object.Id = types.StringValue(response.ApiExternalServerResponse.Id)
+			object.Type = types.StringValue("api")
 		}
 		if response.RedHatDsExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.RedHatDsExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("red-hat-ds")
+			object.Id = types.StringValue(response.RedHatDsExternalServerResponse.Id)
+			object.Type = types.StringValue("red-hat-ds")
 		}
 		if response.SyncServerExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.SyncServerExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("sync-server")
+			object.Id = types.StringValue(response.SyncServerExternalServerResponse.Id)
+			object.Type = types.StringValue("sync-server")
 		}
 		if response.VaultExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.VaultExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("vault")
+			readVaultExternalServerResponseDataSource(ctx, response.VaultExternalServerResponse, &object, &resp.Diagnostics)
 		}
 		if response.PolicyExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.PolicyExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("policy")
+			object.Id = types.StringValue(response.PolicyExternalServerResponse.Id)
+			object.Type = types.StringValue("policy")
 		}
 		if response.SmtpExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.SmtpExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("smtp")
+			readSmtpExternalServerResponseDataSource(ctx, response.SmtpExternalServerResponse, &object, &resp.Diagnostics)
 		}
 		if response.ActiveDirectoryExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.ActiveDirectoryExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("active-directory")
+			readActiveDirectoryExternalServerResponseDataSource(ctx, response.ActiveDirectoryExternalServerResponse, &object, &resp.Diagnostics)
 		}
 		if response.BrokerExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.BrokerExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("broker")
+			object.Id = types.StringValue(response.BrokerExternalServerResponse.Id)
+			object.Type = types.StringValue("broker")
 		}
 		if response.HttpProxyExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.HttpProxyExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("http-proxy")
+			readHttpProxyExternalServerResponseDataSource(ctx, response.HttpProxyExternalServerResponse, &object, &resp.Diagnostics)
 		}
 		if response.KafkaClusterExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.KafkaClusterExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("kafka-cluster")
+			object.Id = types.StringValue(response.KafkaClusterExternalServerResponse.Id)
+			object.Type = types.StringValue("kafka-cluster")
 		}
 		if response.HttpExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.HttpExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("http")
+			readHttpExternalServerResponseDataSource(ctx, response.HttpExternalServerResponse, &object, &resp.Diagnostics)
 		}
 		if response.MockExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.MockExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("mock")
+			object.Id = types.StringValue(response.MockExternalServerResponse.Id)
+			object.Type = types.StringValue("mock")
 		}
 		if response.OracleUnifiedDirectoryExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.OracleUnifiedDirectoryExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("oracle-unified-directory")
+			readOracleUnifiedDirectoryExternalServerResponseDataSource(ctx, response.OracleUnifiedDirectoryExternalServerResponse, &object, &resp.Diagnostics)
 		}
 		if response.ConjurExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.ConjurExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("conjur")
+			readConjurExternalServerResponseDataSource(ctx, response.ConjurExternalServerResponse, &object, &resp.Diagnostics)
 		}
 		if response.AmazonAwsExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.AmazonAwsExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("amazon-aws")
+			readAmazonAwsExternalServerResponseDataSource(ctx, response.AmazonAwsExternalServerResponse, &object, &resp.Diagnostics)
 		}
 		if response.Scim2ExternalServerResponse != nil {
-			attributes["id"] = types.StringValue(response.Scim2ExternalServerResponse.Id)
-			attributes["type"] = types.StringValue("scim2")
+			object.Id = types.StringValue(response.Scim2ExternalServerResponse.Id)
+			object.Type = types.StringValue("scim2")
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -27,9 +27,11 @@ var (
 	_ resource.Resource                = &failureLockoutActionResource{}
 	_ resource.ResourceWithConfigure   = &failureLockoutActionResource{}
 	_ resource.ResourceWithImportState = &failureLockoutActionResource{}
+	_ resource.ResourceWithMoveState   = &failureLockoutActionResource{}
 	_ resource.Resource                = &defaultFailureLockoutActionResource{}
 	_ resource.ResourceWithConfigure   = &defaultFailureLockoutActionResource{}
 	_ resource.ResourceWithImportState = &defaultFailureLockoutActionResource{}
+	_ resource.ResourceWithMoveState   = &defaultFailureLockoutActionResource{}
 )
 
 // Create a Failure Lockout Action resource
@@ -349,7 +351,7 @@ func (r *failureLockoutActionResource) CreateDelayBindResponseFailureLockoutActi
 
 	addResponse, httpResp, err := r.apiClient.FailureLockoutActionAPI.AddFailureLockoutActionExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Failure Lockout Action", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Failure Lockout Action", err, httpResp)
 		return nil, err
 	}
 
@@ -382,7 +384,7 @@ func (r *failureLockoutActionResource) CreateNoOperationFailureLockoutAction(ctx
 
 	addResponse, httpResp, err := r.apiClient.FailureLockoutActionAPI.AddFailureLockoutActionExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Failure Lockout Action", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Failure Lockout Action", err, httpResp)
 		return nil, err
 	}
 
@@ -415,7 +417,7 @@ func (r *failureLockoutActionResource) CreateLockAccountFailureLockoutAction(ctx
 
 	addResponse, httpResp, err := r.apiClient.FailureLockoutActionAPI.AddFailureLockoutActionExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Failure Lockout Action", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Failure Lockout Action", err, httpResp)
 		return nil, err
 	}
 
@@ -462,6 +464,8 @@ func (r *failureLockoutActionResource) Create(ctx context.Context, req resource.
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Failure Lockout Action", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -486,7 +490,7 @@ func (r *defaultFailureLockoutActionResource) Create(ctx context.Context, req re
 	readResponse, httpResp, err := r.apiClient.FailureLockoutActionAPI.GetFailureLockoutAction(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Failure Lockout Action", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Failure Lockout Action", err, httpResp)
 		return
 	}
 
@@ -518,7 +522,7 @@ func (r *defaultFailureLockoutActionResource) Create(ctx context.Context, req re
 
 		updateResponse, httpResp, err := r.apiClient.FailureLockoutActionAPI.UpdateFailureLockoutActionExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Failure Lockout Action", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Failure Lockout Action", err, httpResp)
 			return
 		}
 
@@ -538,6 +542,8 @@ func (r *defaultFailureLockoutActionResource) Create(ctx context.Context, req re
 		if updateResponse.LockAccountFailureLockoutActionResponse != nil {
 			readLockAccountFailureLockoutActionResponse(ctx, updateResponse.LockAccountFailureLockoutActionResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Failure Lockout Action", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -637,7 +643,7 @@ func updateFailureLockoutAction(ctx context.Context, req resource.UpdateRequest,
 
 		updateResponse, httpResp, err := apiClient.FailureLockoutActionAPI.UpdateFailureLockoutActionExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Failure Lockout Action", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Failure Lockout Action", err, httpResp)
 			return
 		}
 
@@ -657,6 +663,8 @@ func updateFailureLockoutAction(ctx context.Context, req resource.UpdateRequest,
 		if updateResponse.LockAccountFailureLockoutActionResponse != nil {
 			readLockAccountFailureLockoutActionResponse(ctx, updateResponse.LockAccountFailureLockoutActionResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Failure Lockout Action", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -704,3 +712,16 @@ func importFailureLockoutAction(ctx context.Context, req resource.ImportStateReq
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *failureLockoutActionResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_failure_lockout_action"),
+	}
+}
+
+func (r *defaultFailureLockoutActionResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_failure_lockout_action"),
+	}
+}
@@ -31,9 +31,11 @@ var (
 	_ resource.Resource                = &gaugeResource{}
 	_ resource.ResourceWithConfigure   = &gaugeResource{}
 	_ resource.ResourceWithImportState = &gaugeResource{}
+	_ resource.ResourceWithMoveState   = &gaugeResource{}
 	_ resource.Resource                = &defaultGaugeResource{}
 	_ resource.ResourceWithConfigure   = &defaultGaugeResource{}
 	_ resource.ResourceWithImportState = &defaultGaugeResource{}
+	_ resource.ResourceWithMoveState   = &defaultGaugeResource{}
 )
 
 // Create a Gauge resource
@@ -658,7 +660,7 @@ func (r *gaugeResource) CreateIndicatorGauge(ctx context.Context, req resource.C
 
 	addResponse, httpResp, err := r.apiClient.GaugeAPI.AddGaugeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Gauge", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Gauge", err, httpResp)
 		return nil, err
 	}
 
@@ -696,7 +698,7 @@ func (r *gaugeResource) CreateNumericGauge(ctx context.Context, req resource.Cre
 
 	addResponse, httpResp, err := r.apiClient.GaugeAPI.AddGaugeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Gauge", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Gauge", err, httpResp)
 		return nil, err
 	}
 
@@ -737,6 +739,8 @@ func (r *gaugeResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Gauge", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -761,7 +765,7 @@ func (r *defaultGaugeResource) Create(ctx context.Context, req resource.CreateRe
 	readResponse, httpResp, err := r.apiClient.GaugeAPI.GetGauge(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Gauge", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Gauge", err, httpResp)
 		return
 	}
 
@@ -790,7 +794,7 @@ func (r *defaultGaugeResource) Create(ctx context.Context, req resource.CreateRe
 
 		updateResponse, httpResp, err := r.apiClient.GaugeAPI.UpdateGaugeExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Gauge", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Gauge", err, httpResp)
 			return
 		}
 
@@ -807,6 +811,8 @@ func (r *defaultGaugeResource) Create(ctx context.Context, req resource.CreateRe
 		if updateResponse.NumericGaugeResponse != nil {
 			readNumericGaugeResponse(ctx, updateResponse.NumericGaugeResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Gauge", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -903,7 +909,7 @@ func updateGauge(ctx context.Context, req resource.UpdateRequest, resp *resource
 
 		updateResponse, httpResp, err := apiClient.GaugeAPI.UpdateGaugeExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Gauge", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Gauge", err, httpResp)
 			return
 		}
 
@@ -920,6 +926,8 @@ func updateGauge(ctx context.Context, req resource.UpdateRequest, resp *resource
 		if updateResponse.NumericGaugeResponse != nil {
 			readNumericGaugeResponse(ctx, updateResponse.NumericGaugeResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Gauge", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -967,3 +975,16 @@ func importGauge(ctx context.Context, req resource.ImportStateRequest, resp *res
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *gaugeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_gauge"),
+	}
+}
+
+func (r *defaultGaugeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_gauge"),
+	}
+}
@@ -28,9 +28,11 @@ var (
 	_ resource.Resource                = &gaugeDataSourceResource{}
 	_ resource.ResourceWithConfigure   = &gaugeDataSourceResource{}
 	_ resource.ResourceWithImportState = &gaugeDataSourceResource{}
+	_ resource.ResourceWithMoveState   = &gaugeDataSourceResource{}
 	_ resource.Resource                = &defaultGaugeDataSourceResource{}
 	_ resource.ResourceWithConfigure   = &defaultGaugeDataSourceResource{}
 	_ resource.ResourceWithImportState = &defaultGaugeDataSourceResource{}
+	_ resource.ResourceWithMoveState   = &defaultGaugeDataSourceResource{}
 )
 
 // Create a Gauge Data Source resource
@@ -508,7 +510,7 @@ func (r *gaugeDataSourceResource) CreateIndicatorGaugeDataSource(ctx context.Con
 
 	addResponse, httpResp, err := r.apiClient.GaugeDataSourceAPI.AddGaugeDataSourceExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Gauge Data Source", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Gauge Data Source", err, httpResp)
 		return nil, err
 	}
 
@@ -547,7 +549,7 @@ func (r *gaugeDataSourceResource) CreateNumericGaugeDataSource(ctx context.Conte
 
 	addResponse, httpResp, err := r.apiClient.GaugeDataSourceAPI.AddGaugeDataSourceExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Gauge Data Source", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Gauge Data Source", err, httpResp)
 		return nil, err
 	}
 
@@ -588,6 +590,8 @@ func (r *gaugeDataSourceResource) Create(ctx context.Context, req resource.Creat
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Gauge Data Source", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -612,7 +616,7 @@ func (r *defaultGaugeDataSourceResource) Create(ctx context.Context, req resourc
 	readResponse, httpResp, err := r.apiClient.GaugeDataSourceAPI.GetGaugeDataSource(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Gauge Data Source", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Gauge Data Source", err, httpResp)
 		return
 	}
 
@@ -641,7 +645,7 @@ func (r *defaultGaugeDataSourceResource) Create(ctx context.Context, req resourc
 
 		updateResponse, httpResp, err := r.apiClient.GaugeDataSourceAPI.UpdateGaugeDataSourceExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Gauge Data Source", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Gauge Data Source", err, httpResp)
 			return
 		}
 
@@ -658,6 +662,8 @@ func (r *defaultGaugeDataSourceResource) Create(ctx context.Context, req resourc
 		if updateResponse.NumericGaugeDataSourceResponse != nil {
 			readNumericGaugeDataSourceResponse(ctx, updateResponse.NumericGaugeDataSourceResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Gauge Data Source", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -754,7 +760,7 @@ func updateGaugeDataSource(ctx context.Context, req resource.UpdateRequest, resp
 
 		updateResponse, httpResp, err := apiClient.GaugeDataSourceAPI.UpdateGaugeDataSourceExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Gauge Data Source", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Gauge Data Source", err, httpResp)
 			return
 		}
 
@@ -771,6 +777,8 @@ func updateGaugeDataSource(ctx context.Context, req resource.UpdateRequest, resp
 		if updateResponse.NumericGaugeDataSourceResponse != nil {
 			readNumericGaugeDataSourceResponse(ctx, updateResponse.NumericGaugeDataSourceResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Gauge Data Source", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -818,3 +826,16 @@ func importGaugeDataSource(ctx context.Context, req resource.ImportStateRequest,
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *gaugeDataSourceResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_gauge_data_source"),
+	}
+}
+
+func (r *defaultGaugeDataSourceResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_gauge_data_source"),
+	}
+}
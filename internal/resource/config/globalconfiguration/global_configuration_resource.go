@@ -1230,7 +1230,7 @@ func (r *globalConfigurationResource) Create(ctx context.Context, req resource.C
 	readResponse, httpResp, err := r.apiClient.GlobalConfigurationAPI.GetGlobalConfiguration(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig)).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Global Configuration", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Global Configuration", err, httpResp)
 		return
 	}
 
@@ -1254,7 +1254,7 @@ func (r *globalConfigurationResource) Create(ctx context.Context, req resource.C
 
 		updateResponse, httpResp, err := r.apiClient.GlobalConfigurationAPI.UpdateGlobalConfigurationExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Global Configuration", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Global Configuration", err, httpResp)
 			return
 		}
 
@@ -1266,6 +1266,8 @@ func (r *globalConfigurationResource) Create(ctx context.Context, req resource.C
 
 		// Read the response
 		readGlobalConfigurationResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Global Configuration", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -1331,7 +1333,7 @@ func (r *globalConfigurationResource) Update(ctx context.Context, req resource.U
 
 		updateResponse, httpResp, err := r.apiClient.GlobalConfigurationAPI.UpdateGlobalConfigurationExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Global Configuration", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Global Configuration", err, httpResp)
 			return
 		}
 
@@ -1343,6 +1345,8 @@ func (r *globalConfigurationResource) Update(ctx context.Context, req resource.U
 
 		// Read the response
 		readGlobalConfigurationResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Global Configuration", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
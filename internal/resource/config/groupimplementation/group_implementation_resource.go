@@ -189,7 +189,7 @@ func (r *groupImplementationResource) Create(ctx context.Context, req resource.C
 	readResponse, httpResp, err := r.apiClient.GroupImplementationAPI.GetGroupImplementation(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Group Implementation", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Group Implementation", err, httpResp)
 		return
 	}
 
@@ -224,7 +224,7 @@ func (r *groupImplementationResource) Create(ctx context.Context, req resource.C
 
 		updateResponse, httpResp, err := r.apiClient.GroupImplementationAPI.UpdateGroupImplementationExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Group Implementation", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Group Implementation", err, httpResp)
 			return
 		}
 
@@ -247,6 +247,8 @@ func (r *groupImplementationResource) Create(ctx context.Context, req resource.C
 		if updateResponse.DynamicGroupImplementationResponse != nil {
 			readDynamicGroupImplementationResponse(ctx, updateResponse.DynamicGroupImplementationResponse, &state, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Group Implementation", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -323,7 +325,7 @@ func (r *groupImplementationResource) Update(ctx context.Context, req resource.U
 
 		updateResponse, httpResp, err := r.apiClient.GroupImplementationAPI.UpdateGroupImplementationExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Group Implementation", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Group Implementation", err, httpResp)
 			return
 		}
 
@@ -346,6 +348,8 @@ func (r *groupImplementationResource) Update(ctx context.Context, req resource.U
 		if updateResponse.DynamicGroupImplementationResponse != nil {
 			readDynamicGroupImplementationResponse(ctx, updateResponse.DynamicGroupImplementationResponse, &state, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Group Implementation", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
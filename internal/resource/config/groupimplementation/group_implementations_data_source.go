@@ -49,29 +49,21 @@ func (r *groupImplementationsDataSource) Configure(_ context.Context, req dataso
 }
 
 type groupImplementationsDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *groupImplementationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Group Implementation objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Group Implementation objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewGroupImplementationDataSource(), "Group Implementation")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *groupImplementationsDataSource) Read(ctx context.Context, req datasourc
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewGroupImplementationDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.GroupImplementationAPI.ListGroupImplementations(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.GroupImplementationAPI.ListGroupImplementationsExecute(listRequest)
@@ -106,33 +104,32 @@ func (r *groupImplementationsDataSource) Read(ctx context.Context, req datasourc
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object groupImplementationDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.VirtualStaticGroupImplementationResponse != nil {
-			attributes["id"] = types.StringValue(response.VirtualStaticGroupImplementationResponse.Id)
-			attributes["type"] = types.StringValue("virtual-static")
+			readVirtualStaticGroupImplementationResponseDataSource(ctx, response.VirtualStaticGroupImplementationResponse, &object, &resp.Diagnostics)
 		}
 		if response.DynamicGroupImplementationResponse != nil {
-			attributes["id"] = types.StringValue(response.DynamicGroupImplementationResponse.Id)
-			attributes["type"] = types.StringValue("dynamic")
+			readDynamicGroupImplementationResponseDataSource(ctx, response.DynamicGroupImplementationResponse, &object, &resp.Diagnostics)
 		}
 		if response.StaticGroupImplementationResponse != nil {
-			attributes["id"] = types.StringValue(response.StaticGroupImplementationResponse.Id)
-			attributes["type"] = types.StringValue("static")
+			readStaticGroupImplementationResponseDataSource(ctx, response.StaticGroupImplementationResponse, &object, &resp.Diagnostics)
 		}
 		if response.InvertedStaticGroupImplementationResponse != nil {
-			attributes["id"] = types.StringValue(response.InvertedStaticGroupImplementationResponse.Id)
-			attributes["type"] = types.StringValue("inverted-static")
+			readInvertedStaticGroupImplementationResponseDataSource(ctx, response.InvertedStaticGroupImplementationResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -138,7 +138,7 @@ func (r *httpConfigurationResource) Create(ctx context.Context, req resource.Cre
 	readResponse, httpResp, err := r.apiClient.HttpConfigurationAPI.GetHttpConfiguration(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig)).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Http Configuration", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Http Configuration", err, httpResp)
 		return
 	}
 
@@ -162,7 +162,7 @@ func (r *httpConfigurationResource) Create(ctx context.Context, req resource.Cre
 
 		updateResponse, httpResp, err := r.apiClient.HttpConfigurationAPI.UpdateHttpConfigurationExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Http Configuration", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Http Configuration", err, httpResp)
 			return
 		}
 
@@ -174,6 +174,8 @@ func (r *httpConfigurationResource) Create(ctx context.Context, req resource.Cre
 
 		// Read the response
 		readHttpConfigurationResponse(ctx, updateResponse, &state, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Http Configuration", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -239,7 +241,7 @@ func (r *httpConfigurationResource) Update(ctx context.Context, req resource.Upd
 
 		updateResponse, httpResp, err := r.apiClient.HttpConfigurationAPI.UpdateHttpConfigurationExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Http Configuration", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Http Configuration", err, httpResp)
 			return
 		}
 
@@ -251,6 +253,8 @@ func (r *httpConfigurationResource) Update(ctx context.Context, req resource.Upd
 
 		// Read the response
 		readHttpConfigurationResponse(ctx, updateResponse, &state, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Http Configuration", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -30,9 +30,11 @@ var (
 	_ resource.Resource                = &httpServletCrossOriginPolicyResource{}
 	_ resource.ResourceWithConfigure   = &httpServletCrossOriginPolicyResource{}
 	_ resource.ResourceWithImportState = &httpServletCrossOriginPolicyResource{}
+	_ resource.ResourceWithMoveState   = &httpServletCrossOriginPolicyResource{}
 	_ resource.Resource                = &defaultHttpServletCrossOriginPolicyResource{}
 	_ resource.ResourceWithConfigure   = &defaultHttpServletCrossOriginPolicyResource{}
 	_ resource.ResourceWithImportState = &defaultHttpServletCrossOriginPolicyResource{}
+	_ resource.ResourceWithMoveState   = &defaultHttpServletCrossOriginPolicyResource{}
 )
 
 // Create a Http Servlet Cross Origin Policy resource
@@ -281,7 +283,7 @@ func (r *httpServletCrossOriginPolicyResource) CreateHttpServletCrossOriginPolic
 
 	addResponse, httpResp, err := r.apiClient.HttpServletCrossOriginPolicyAPI.AddHttpServletCrossOriginPolicyExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Http Servlet Cross Origin Policy", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Http Servlet Cross Origin Policy", err, httpResp)
 		return nil, err
 	}
 
@@ -312,6 +314,8 @@ func (r *httpServletCrossOriginPolicyResource) Create(ctx context.Context, req r
 		return
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Http Servlet Cross Origin Policy", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -336,7 +340,7 @@ func (r *defaultHttpServletCrossOriginPolicyResource) Create(ctx context.Context
 	readResponse, httpResp, err := r.apiClient.HttpServletCrossOriginPolicyAPI.GetHttpServletCrossOriginPolicy(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Http Servlet Cross Origin Policy", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Http Servlet Cross Origin Policy", err, httpResp)
 		return
 	}
 
@@ -360,7 +364,7 @@ func (r *defaultHttpServletCrossOriginPolicyResource) Create(ctx context.Context
 
 		updateResponse, httpResp, err := r.apiClient.HttpServletCrossOriginPolicyAPI.UpdateHttpServletCrossOriginPolicyExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Http Servlet Cross Origin Policy", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Http Servlet Cross Origin Policy", err, httpResp)
 			return
 		}
 
@@ -372,6 +376,8 @@ func (r *defaultHttpServletCrossOriginPolicyResource) Create(ctx context.Context
 
 		// Read the response
 		readHttpServletCrossOriginPolicyResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Http Servlet Cross Origin Policy", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -463,7 +469,7 @@ func updateHttpServletCrossOriginPolicy(ctx context.Context, req resource.Update
 
 		updateResponse, httpResp, err := apiClient.HttpServletCrossOriginPolicyAPI.UpdateHttpServletCrossOriginPolicyExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Http Servlet Cross Origin Policy", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Http Servlet Cross Origin Policy", err, httpResp)
 			return
 		}
 
@@ -475,6 +481,8 @@ func updateHttpServletCrossOriginPolicy(ctx context.Context, req resource.Update
 
 		// Read the response
 		readHttpServletCrossOriginPolicyResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Http Servlet Cross Origin Policy", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -522,3 +530,16 @@ func importHttpServletCrossOriginPolicy(ctx context.Context, req resource.Import
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *httpServletCrossOriginPolicyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_http_servlet_cross_origin_policy"),
+	}
+}
+
+func (r *defaultHttpServletCrossOriginPolicyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_http_servlet_cross_origin_policy"),
+	}
+}
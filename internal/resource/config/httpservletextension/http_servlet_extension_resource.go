@@ -29,9 +29,11 @@ var (
 	_ resource.Resource                = &httpServletExtensionResource{}
 	_ resource.ResourceWithConfigure   = &httpServletExtensionResource{}
 	_ resource.ResourceWithImportState = &httpServletExtensionResource{}
+	_ resource.ResourceWithMoveState   = &httpServletExtensionResource{}
 	_ resource.Resource                = &defaultHttpServletExtensionResource{}
 	_ resource.ResourceWithConfigure   = &defaultHttpServletExtensionResource{}
 	_ resource.ResourceWithImportState = &defaultHttpServletExtensionResource{}
+	_ resource.ResourceWithMoveState   = &defaultHttpServletExtensionResource{}
 )
 
 // Create a Http Servlet Extension resource
@@ -2456,7 +2458,7 @@ func (r *httpServletExtensionResource) CreateQuickstartHttpServletExtension(ctx
 
 	addResponse, httpResp, err := r.apiClient.HttpServletExtensionAPI.AddHttpServletExtensionExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Http Servlet Extension", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Http Servlet Extension", err, httpResp)
 		return nil, err
 	}
 
@@ -2494,7 +2496,7 @@ func (r *httpServletExtensionResource) CreateGroovyScriptedHttpServletExtension(
 
 	addResponse, httpResp, err := r.apiClient.HttpServletExtensionAPI.AddHttpServletExtensionExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Http Servlet Extension", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Http Servlet Extension", err, httpResp)
 		return nil, err
 	}
 
@@ -2535,7 +2537,7 @@ func (r *httpServletExtensionResource) CreateAvailabilityStateHttpServletExtensi
 
 	addResponse, httpResp, err := r.apiClient.HttpServletExtensionAPI.AddHttpServletExtensionExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Http Servlet Extension", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Http Servlet Extension", err, httpResp)
 		return nil, err
 	}
 
@@ -2572,7 +2574,7 @@ func (r *httpServletExtensionResource) CreatePrometheusMonitoringHttpServletExte
 
 	addResponse, httpResp, err := r.apiClient.HttpServletExtensionAPI.AddHttpServletExtensionExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Http Servlet Extension", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Http Servlet Extension", err, httpResp)
 		return nil, err
 	}
 
@@ -2611,7 +2613,7 @@ func (r *httpServletExtensionResource) CreateFileServerHttpServletExtension(ctx
 
 	addResponse, httpResp, err := r.apiClient.HttpServletExtensionAPI.AddHttpServletExtensionExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Http Servlet Extension", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Http Servlet Extension", err, httpResp)
 		return nil, err
 	}
 
@@ -2648,7 +2650,7 @@ func (r *httpServletExtensionResource) CreateLdapMappedScimHttpServletExtension(
 
 	addResponse, httpResp, err := r.apiClient.HttpServletExtensionAPI.AddHttpServletExtensionExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Http Servlet Extension", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Http Servlet Extension", err, httpResp)
 		return nil, err
 	}
 
@@ -2686,7 +2688,7 @@ func (r *httpServletExtensionResource) CreateThirdPartyHttpServletExtension(ctx
 
 	addResponse, httpResp, err := r.apiClient.HttpServletExtensionAPI.AddHttpServletExtensionExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Http Servlet Extension", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Http Servlet Extension", err, httpResp)
 		return nil, err
 	}
 
@@ -2757,6 +2759,8 @@ func (r *httpServletExtensionResource) Create(ctx context.Context, req resource.
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Http Servlet Extension", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -2781,7 +2785,7 @@ func (r *defaultHttpServletExtensionResource) Create(ctx context.Context, req re
 	readResponse, httpResp, err := r.apiClient.HttpServletExtensionAPI.GetHttpServletExtension(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Http Servlet Extension", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Http Servlet Extension", err, httpResp)
 		return
 	}
 
@@ -2843,7 +2847,7 @@ func (r *defaultHttpServletExtensionResource) Create(ctx context.Context, req re
 
 		updateResponse, httpResp, err := r.apiClient.HttpServletExtensionAPI.UpdateHttpServletExtensionExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Http Servlet Extension", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Http Servlet Extension", err, httpResp)
 			return
 		}
 
@@ -2893,6 +2897,8 @@ func (r *defaultHttpServletExtensionResource) Create(ctx context.Context, req re
 		if updateResponse.ThirdPartyHttpServletExtensionResponse != nil {
 			readThirdPartyHttpServletExtensionResponseDefault(ctx, updateResponse.ThirdPartyHttpServletExtensionResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Http Servlet Extension", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -3030,7 +3036,7 @@ func (r *httpServletExtensionResource) Update(ctx context.Context, req resource.
 
 		updateResponse, httpResp, err := r.apiClient.HttpServletExtensionAPI.UpdateHttpServletExtensionExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Http Servlet Extension", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Http Servlet Extension", err, httpResp)
 			return
 		}
 
@@ -3062,6 +3068,8 @@ func (r *httpServletExtensionResource) Update(ctx context.Context, req resource.
 		if updateResponse.ThirdPartyHttpServletExtensionResponse != nil {
 			readThirdPartyHttpServletExtensionResponse(ctx, updateResponse.ThirdPartyHttpServletExtensionResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Http Servlet Extension", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -3097,7 +3105,7 @@ func (r *defaultHttpServletExtensionResource) Update(ctx context.Context, req re
 
 		updateResponse, httpResp, err := r.apiClient.HttpServletExtensionAPI.UpdateHttpServletExtensionExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Http Servlet Extension", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Http Servlet Extension", err, httpResp)
 			return
 		}
 
@@ -3147,6 +3155,8 @@ func (r *defaultHttpServletExtensionResource) Update(ctx context.Context, req re
 		if updateResponse.ThirdPartyHttpServletExtensionResponse != nil {
 			readThirdPartyHttpServletExtensionResponseDefault(ctx, updateResponse.ThirdPartyHttpServletExtensionResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Http Servlet Extension", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -3194,3 +3204,16 @@ func importHttpServletExtension(ctx context.Context, req resource.ImportStateReq
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *httpServletExtensionResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_http_servlet_extension"),
+	}
+}
+
+func (r *defaultHttpServletExtensionResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_http_servlet_extension"),
+	}
+}
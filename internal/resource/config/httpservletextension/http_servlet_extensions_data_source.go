@@ -49,29 +49,21 @@ func (r *httpServletExtensionsDataSource) Configure(_ context.Context, req datas
 }
 
 type httpServletExtensionsDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *httpServletExtensionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Http Servlet Extension objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Http Servlet Extension objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewHttpServletExtensionDataSource(), "Http Servlet Extension")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *httpServletExtensionsDataSource) Read(ctx context.Context, req datasour
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewHttpServletExtensionDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.HttpServletExtensionAPI.ListHttpServletExtensions(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.HttpServletExtensionAPI.ListHttpServletExtensionsExecute(listRequest)
@@ -106,97 +104,87 @@ func (r *httpServletExtensionsDataSource) Read(ctx context.Context, req datasour
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object httpServletExtensionDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.StandardHttpServletExtensionResponse != nil {
-			attributes["id"] = types.StringValue(response.StandardHttpServletExtensionResponse.Id)
-			attributes["type"] = types.StringValue("standard")
+			object.Id = types.StringValue(response.StandardHttpServletExtensionResponse.Id)
+			object.Type = types.StringValue("standard")
 		}
 		if response.DelegatedAdminHttpServletExtensionResponse != nil {
-			attributes["id"] = types.StringValue(response.DelegatedAdminHttpServletExtensionResponse.Id)
-			attributes["type"] = types.StringValue("delegated-admin")
+			readDelegatedAdminHttpServletExtensionResponseDataSource(ctx, response.DelegatedAdminHttpServletExtensionResponse, &object, &resp.Diagnostics)
 		}
 		if response.QuickstartHttpServletExtensionResponse != nil {
-			attributes["id"] = types.StringValue(response.QuickstartHttpServletExtensionResponse.Id)
-			attributes["type"] = types.StringValue("quickstart")
+			readQuickstartHttpServletExtensionResponseDataSource(ctx, response.QuickstartHttpServletExtensionResponse, &object, &resp.Diagnostics)
 		}
 		if response.AvailabilityStateHttpServletExtensionResponse != nil {
-			attributes["id"] = types.StringValue(response.AvailabilityStateHttpServletExtensionResponse.Id)
-			attributes["type"] = types.StringValue("availability-state")
+			readAvailabilityStateHttpServletExtensionResponseDataSource(ctx, response.AvailabilityStateHttpServletExtensionResponse, &object, &resp.Diagnostics)
 		}
 		if response.PrometheusMonitoringHttpServletExtensionResponse != nil {
-			attributes["id"] = types.StringValue(response.PrometheusMonitoringHttpServletExtensionResponse.Id)
-			attributes["type"] = types.StringValue("prometheus-monitoring")
+			readPrometheusMonitoringHttpServletExtensionResponseDataSource(ctx, response.PrometheusMonitoringHttpServletExtensionResponse, &object, &resp.Diagnostics)
 		}
 		if response.VelocityHttpServletExtensionResponse != nil {
-			attributes["id"] = types.StringValue(response.VelocityHttpServletExtensionResponse.Id)
-			attributes["type"] = types.StringValue("velocity")
+			readVelocityHttpServletExtensionResponseDataSource(ctx, response.VelocityHttpServletExtensionResponse, &object, &resp.Diagnostics)
 		}
 		if response.ConsentHttpServletExtensionResponse != nil {
-			attributes["id"] = types.StringValue(response.ConsentHttpServletExtensionResponse.Id)
-			attributes["type"] = types.StringValue("consent")
+			readConsentHttpServletExtensionResponseDataSource(ctx, response.ConsentHttpServletExtensionResponse, &object, &resp.Diagnostics)
 		}
 		if response.LdapMappedScimHttpServletExtensionResponse != nil {
-			attributes["id"] = types.StringValue(response.LdapMappedScimHttpServletExtensionResponse.Id)
-			attributes["type"] = types.StringValue("ldap-mapped-scim")
+			readLdapMappedScimHttpServletExtensionResponseDataSource(ctx, response.LdapMappedScimHttpServletExtensionResponse, &object, &resp.Diagnostics)
 		}
 		if response.GroovyScriptedHttpServletExtensionResponse != nil {
-			attributes["id"] = types.StringValue(response.GroovyScriptedHttpServletExtensionResponse.Id)
-			attributes["type"] = types.StringValue("groovy-scripted")
+			readGroovyScriptedHttpServletExtensionResponseDataSource(ctx, response.GroovyScriptedHttpServletExtensionResponse, &object, &resp.Diagnostics)
 		}
 		if response.OpenBankingHttpServletExtensionResponse != nil {
-			attributes["id"] = types.StringValue(response.OpenBankingHttpServletExtensionResponse.Id)
-			attributes["type"] = types.StringValue("open-banking")
+			object.Id = types.StringValue(response.OpenBankingHttpServletExtensionResponse.Id)
+			object.Type = types.StringValue("open-banking")
 		}
 		if response.PdpEndpointHttpServletExtensionResponse != nil {
-			attributes["id"] = types.StringValue(response.PdpEndpointHttpServletExtensionResponse.Id)
-			attributes["type"] = types.StringValue("pdp-endpoint")
+			object.Id = types.StringValue(response.PdpEndpointHttpServletExtensionResponse.Id)
+			object.Type = types.StringValue("pdp-endpoint")
 		}
 		if response.FileServerHttpServletExtensionResponse != nil {
-			attributes["id"] = types.StringValue(response.FileServerHttpServletExtensionResponse.Id)
-			attributes["type"] = types.StringValue("file-server")
+			readFileServerHttpServletExtensionResponseDataSource(ctx, response.FileServerHttpServletExtensionResponse, &object, &resp.Diagnostics)
 		}
 		if response.JsonPdpApiHttpServletExtensionResponse != nil {
-			attributes["id"] = types.StringValue(response.JsonPdpApiHttpServletExtensionResponse.Id)
-			attributes["type"] = types.StringValue("json-pdp-api")
+			object.Id = types.StringValue(response.JsonPdpApiHttpServletExtensionResponse.Id)
+			object.Type = types.StringValue("json-pdp-api")
 		}
 		if response.MetricsHttpServletExtensionResponse != nil {
-			attributes["id"] = types.StringValue(response.MetricsHttpServletExtensionResponse.Id)
-			attributes["type"] = types.StringValue("metrics")
+			object.Id = types.StringValue(response.MetricsHttpServletExtensionResponse.Id)
+			object.Type = types.StringValue("metrics")
 		}
 		if response.ConfigHttpServletExtensionResponse != nil {
-			attributes["id"] = types.StringValue(response.ConfigHttpServletExtensionResponse.Id)
-			attributes["type"] = types.StringValue("config")
+			readConfigHttpServletExtensionResponseDataSource(ctx, response.ConfigHttpServletExtensionResponse, &object, &resp.Diagnostics)
 		}
 		if response.Scim2HttpServletExtensionResponse != nil {
-			attributes["id"] = types.StringValue(response.Scim2HttpServletExtensionResponse.Id)
-			attributes["type"] = types.StringValue("scim2")
+			readScim2HttpServletExtensionResponseDataSource(ctx, response.Scim2HttpServletExtensionResponse, &object, &resp.Diagnostics)
 		}
 		if response.DirectoryRestApiHttpServletExtensionResponse != nil {
-			attributes["id"] = types.StringValue(response.DirectoryRestApiHttpServletExtensionResponse.Id)
-			attributes["type"] = types.StringValue("directory-rest-api")
+			readDirectoryRestApiHttpServletExtensionResponseDataSource(ctx, response.DirectoryRestApiHttpServletExtensionResponse, &object, &resp.Diagnostics)
 		}
 		if response.GatewayHttpServletExtensionResponse != nil {
-			attributes["id"] = types.StringValue(response.GatewayHttpServletExtensionResponse.Id)
-			attributes["type"] = types.StringValue("gateway")
+			object.Id = types.StringValue(response.GatewayHttpServletExtensionResponse.Id)
+			object.Type = types.StringValue("gateway")
 		}
 		if response.ThirdPartyHttpServletExtensionResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyHttpServletExtensionResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyHttpServletExtensionResponseDataSource(ctx, response.ThirdPartyHttpServletExtensionResponse, &object, &resp.Diagnostics)
 		}
 		if response.SidebandApiHttpServletExtensionResponse != nil {
-			attributes["id"] = types.StringValue(response.SidebandApiHttpServletExtensionResponse.Id)
-			attributes["type"] = types.StringValue("sideband-api")
+			object.Id = types.StringValue(response.SidebandApiHttpServletExtensionResponse.Id)
+			object.Type = types.StringValue("sideband-api")
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -4,6 +4,7 @@ package identitymapper
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -30,9 +31,11 @@ var (
 	_ resource.Resource                = &identityMapperResource{}
 	_ resource.ResourceWithConfigure   = &identityMapperResource{}
 	_ resource.ResourceWithImportState = &identityMapperResource{}
+	_ resource.ResourceWithMoveState   = &identityMapperResource{}
 	_ resource.Resource                = &defaultIdentityMapperResource{}
 	_ resource.ResourceWithConfigure   = &defaultIdentityMapperResource{}
 	_ resource.ResourceWithImportState = &defaultIdentityMapperResource{}
+	_ resource.ResourceWithMoveState   = &defaultIdentityMapperResource{}
 )
 
 // Create a Identity Mapper resource
@@ -105,6 +108,7 @@ type identityMapperResourceModel struct {
 	MatchFilter               types.String `tfsdk:"match_filter"`
 	Description               types.String `tfsdk:"description"`
 	Enabled                   types.Bool   `tfsdk:"enabled"`
+	DetachOnDestroy           types.Bool   `tfsdk:"detach_on_destroy"`
 }
 
 // GetSchema defines the schema for the resource.
@@ -220,6 +224,7 @@ func identityMapperSchema(ctx context.Context, req resource.SchemaRequest, resp
 		extensionClassAttr.PlanModifiers = append(extensionClassAttr.PlanModifiers, stringplanmodifier.RequiresReplace())
 		schemaDef.Attributes["extension_class"] = extensionClassAttr
 	}
+	config.AddDetachOnDestroySchema(&schemaDef, "Identity Mapper", isDefault)
 	config.AddCommonResourceSchema(&schemaDef, true)
 	resp.Schema = schemaDef
 }
@@ -617,6 +622,11 @@ func readThirdPartyIdentityMapperResponse(ctx context.Context, r *client.ThirdPa
 	populateIdentityMapperUnknownValues(state)
 }
 
+// Set any properties that aren't returned by the API in the state, based on some expected value (usually the plan value)
+func (state *identityMapperResourceModel) setStateValuesNotReturnedByAPI(expectedValues *identityMapperResourceModel) {
+	state.DetachOnDestroy = expectedValues.DetachOnDestroy
+}
+
 // Create any update operations necessary to make the state match the plan
 func createIdentityMapperOperations(plan identityMapperResourceModel, state identityMapperResourceModel) []client.Operation {
 	var ops []client.Operation
@@ -654,7 +664,7 @@ func (r *identityMapperResource) CreateExactMatchIdentityMapper(ctx context.Cont
 
 	addResponse, httpResp, err := r.apiClient.IdentityMapperAPI.AddIdentityMapperExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Identity Mapper", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Identity Mapper", err, httpResp)
 		return nil, err
 	}
 
@@ -689,7 +699,7 @@ func (r *identityMapperResource) CreateGroovyScriptedIdentityMapper(ctx context.
 
 	addResponse, httpResp, err := r.apiClient.IdentityMapperAPI.AddIdentityMapperExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Identity Mapper", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Identity Mapper", err, httpResp)
 		return nil, err
 	}
 
@@ -723,7 +733,7 @@ func (r *identityMapperResource) CreateDnIdentityMapper(ctx context.Context, req
 
 	addResponse, httpResp, err := r.apiClient.IdentityMapperAPI.AddIdentityMapperExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Identity Mapper", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Identity Mapper", err, httpResp)
 		return nil, err
 	}
 
@@ -758,7 +768,7 @@ func (r *identityMapperResource) CreateRegularExpressionIdentityMapper(ctx conte
 
 	addResponse, httpResp, err := r.apiClient.IdentityMapperAPI.AddIdentityMapperExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Identity Mapper", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Identity Mapper", err, httpResp)
 		return nil, err
 	}
 
@@ -792,7 +802,7 @@ func (r *identityMapperResource) CreateAggregateIdentityMapper(ctx context.Conte
 
 	addResponse, httpResp, err := r.apiClient.IdentityMapperAPI.AddIdentityMapperExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Identity Mapper", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Identity Mapper", err, httpResp)
 		return nil, err
 	}
 
@@ -827,7 +837,7 @@ func (r *identityMapperResource) CreateThirdPartyIdentityMapper(ctx context.Cont
 
 	addResponse, httpResp, err := r.apiClient.IdentityMapperAPI.AddIdentityMapperExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Identity Mapper", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Identity Mapper", err, httpResp)
 		return nil, err
 	}
 
@@ -892,6 +902,10 @@ func (r *identityMapperResource) Create(ctx context.Context, req resource.Create
 		}
 	}
 
+	// Populate Computed attribute values
+	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Identity Mapper", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -916,7 +930,7 @@ func (r *defaultIdentityMapperResource) Create(ctx context.Context, req resource
 	readResponse, httpResp, err := r.apiClient.IdentityMapperAPI.GetIdentityMapper(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Identity Mapper", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Identity Mapper", err, httpResp)
 		return
 	}
 
@@ -957,7 +971,7 @@ func (r *defaultIdentityMapperResource) Create(ctx context.Context, req resource
 
 		updateResponse, httpResp, err := r.apiClient.IdentityMapperAPI.UpdateIdentityMapperExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Identity Mapper", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Identity Mapper", err, httpResp)
 			return
 		}
 
@@ -986,8 +1000,11 @@ func (r *defaultIdentityMapperResource) Create(ctx context.Context, req resource
 		if updateResponse.ThirdPartyIdentityMapperResponse != nil {
 			readThirdPartyIdentityMapperResponse(ctx, updateResponse.ThirdPartyIdentityMapperResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Identity Mapper", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
+	state.setStateValuesNotReturnedByAPI(&plan)
 	state.populateAllComputedStringAttributes()
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -1094,7 +1111,7 @@ func updateIdentityMapper(ctx context.Context, req resource.UpdateRequest, resp
 
 		updateResponse, httpResp, err := apiClient.IdentityMapperAPI.UpdateIdentityMapperExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Identity Mapper", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Identity Mapper", err, httpResp)
 			return
 		}
 
@@ -1123,10 +1140,13 @@ func updateIdentityMapper(ctx context.Context, req resource.UpdateRequest, resp
 		if updateResponse.ThirdPartyIdentityMapperResponse != nil {
 			readThirdPartyIdentityMapperResponse(ctx, updateResponse.ThirdPartyIdentityMapperResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Identity Mapper", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
 
+	state.setStateValuesNotReturnedByAPI(&plan)
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -1150,14 +1170,109 @@ func (r *identityMapperResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	referencingTypes := identityMapperReferencingTypes(r.apiClient, r.providerConfig)
+	if state.DetachOnDestroy.ValueBool() && !config.DetachReferences(ctx, referencingTypes, state.Name.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
 	httpResp, err := r.apiClient.IdentityMapperAPI.DeleteIdentityMapperExecute(r.apiClient.IdentityMapperAPI.DeleteIdentityMapper(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Name.ValueString()))
 	if err != nil && (httpResp == nil || httpResp.StatusCode != 404) {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting the Identity Mapper", err, httpResp)
+		// List any config objects that are preventing the delete
+		config.ReportReferences(ctx, referencingTypes, "Identity Mapper", state.Name.ValueString(), &resp.Diagnostics)
 		return
 	}
 }
 
+// Config object types that can reference an Identity Mapper
+func identityMapperReferencingTypes(apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) []config.ReferencingType {
+	properties := []string{"identityMapper", "alternateAuthorizationIdentityMapper", "allIncludedIdentityMapper",
+		"anyIncludedIdentityMapper", "consentRecordIdentityMapper", "proxiedAuthorizationIdentityMapper"}
+	return []config.ReferencingType{
+		{
+			Description: "Identity Mapper",
+			Properties:  properties,
+			List: func(ctx context.Context) ([]map[string]any, *http.Response, error) {
+				return config.ListResponseObjects(apiClient.IdentityMapperAPI.ListIdentityMappers(config.ProviderBasicAuthContext(ctx, providerConfig)).Execute())
+			},
+			Update: func(ctx context.Context, id string, ops []client.Operation) (*http.Response, error) {
+				return config.UpdateResult(apiClient.IdentityMapperAPI.UpdateIdentityMapper(config.ProviderBasicAuthContext(ctx, providerConfig), id).UpdateRequest(*client.NewUpdateRequest(ops)).Execute())
+			},
+		},
+		{
+			Description: "SASL Mechanism Handler",
+			Properties:  properties,
+			List: func(ctx context.Context) ([]map[string]any, *http.Response, error) {
+				return config.ListResponseObjects(apiClient.SaslMechanismHandlerAPI.ListSaslMechanismHandlers(config.ProviderBasicAuthContext(ctx, providerConfig)).Execute())
+			},
+			Update: func(ctx context.Context, id string, ops []client.Operation) (*http.Response, error) {
+				return config.UpdateResult(apiClient.SaslMechanismHandlerAPI.UpdateSaslMechanismHandler(config.ProviderBasicAuthContext(ctx, providerConfig), id).UpdateRequest(*client.NewUpdateRequest(ops)).Execute())
+			},
+		},
+		{
+			Description: "Access Token Validator",
+			Properties:  properties,
+			List: func(ctx context.Context) ([]map[string]any, *http.Response, error) {
+				return config.ListResponseObjects(apiClient.AccessTokenValidatorAPI.ListAccessTokenValidators(config.ProviderBasicAuthContext(ctx, providerConfig)).Execute())
+			},
+			Update: func(ctx context.Context, id string, ops []client.Operation) (*http.Response, error) {
+				return config.UpdateResult(apiClient.AccessTokenValidatorAPI.UpdateAccessTokenValidator(config.ProviderBasicAuthContext(ctx, providerConfig), id).UpdateRequest(*client.NewUpdateRequest(ops)).Execute())
+			},
+		},
+		{
+			Description: "ID Token Validator",
+			Properties:  properties,
+			List: func(ctx context.Context) ([]map[string]any, *http.Response, error) {
+				return config.ListResponseObjects(apiClient.IdTokenValidatorAPI.ListIdTokenValidators(config.ProviderBasicAuthContext(ctx, providerConfig)).Execute())
+			},
+			Update: func(ctx context.Context, id string, ops []client.Operation) (*http.Response, error) {
+				return config.UpdateResult(apiClient.IdTokenValidatorAPI.UpdateIdTokenValidator(config.ProviderBasicAuthContext(ctx, providerConfig), id).UpdateRequest(*client.NewUpdateRequest(ops)).Execute())
+			},
+		},
+		{
+			Description: "HTTP Servlet Extension",
+			Properties:  properties,
+			List: func(ctx context.Context) ([]map[string]any, *http.Response, error) {
+				return config.ListResponseObjects(apiClient.HttpServletExtensionAPI.ListHttpServletExtensions(config.ProviderBasicAuthContext(ctx, providerConfig)).Execute())
+			},
+			Update: func(ctx context.Context, id string, ops []client.Operation) (*http.Response, error) {
+				return config.UpdateResult(apiClient.HttpServletExtensionAPI.UpdateHttpServletExtension(config.ProviderBasicAuthContext(ctx, providerConfig), id).UpdateRequest(*client.NewUpdateRequest(ops)).Execute())
+			},
+		},
+		{
+			Description: "Extended Operation Handler",
+			Properties:  properties,
+			List: func(ctx context.Context) ([]map[string]any, *http.Response, error) {
+				return config.ListResponseObjects(apiClient.ExtendedOperationHandlerAPI.ListExtendedOperationHandlers(config.ProviderBasicAuthContext(ctx, providerConfig)).Execute())
+			},
+			Update: func(ctx context.Context, id string, ops []client.Operation) (*http.Response, error) {
+				return config.UpdateResult(apiClient.ExtendedOperationHandlerAPI.UpdateExtendedOperationHandler(config.ProviderBasicAuthContext(ctx, providerConfig), id).UpdateRequest(*client.NewUpdateRequest(ops)).Execute())
+			},
+		},
+		{
+			Description: "Consent Service",
+			Properties:  properties,
+			List: func(ctx context.Context) ([]map[string]any, *http.Response, error) {
+				return config.SingletonResponseObjects(apiClient.ConsentServiceAPI.GetConsentService(config.ProviderBasicAuthContext(ctx, providerConfig)).Execute())
+			},
+			Update: func(ctx context.Context, id string, ops []client.Operation) (*http.Response, error) {
+				return config.UpdateResult(apiClient.ConsentServiceAPI.UpdateConsentService(config.ProviderBasicAuthContext(ctx, providerConfig)).UpdateRequest(*client.NewUpdateRequest(ops)).Execute())
+			},
+		},
+		{
+			Description: "Global Configuration",
+			Properties:  properties,
+			List: func(ctx context.Context) ([]map[string]any, *http.Response, error) {
+				return config.SingletonResponseObjects(apiClient.GlobalConfigurationAPI.GetGlobalConfiguration(config.ProviderBasicAuthContext(ctx, providerConfig)).Execute())
+			},
+			Update: func(ctx context.Context, id string, ops []client.Operation) (*http.Response, error) {
+				return config.UpdateResult(apiClient.GlobalConfigurationAPI.UpdateGlobalConfiguration(config.ProviderBasicAuthContext(ctx, providerConfig)).UpdateRequest(*client.NewUpdateRequest(ops)).Execute())
+			},
+		},
+	}
+}
+
 func (r *identityMapperResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	importIdentityMapper(ctx, req, resp)
 }
@@ -1170,3 +1285,16 @@ func importIdentityMapper(ctx context.Context, req resource.ImportStateRequest,
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *identityMapperResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_identity_mapper"),
+	}
+}
+
+func (r *defaultIdentityMapperResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_identity_mapper"),
+	}
+}
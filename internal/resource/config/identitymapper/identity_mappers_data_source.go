@@ -49,29 +49,21 @@ func (r *identityMappersDataSource) Configure(_ context.Context, req datasource.
 }
 
 type identityMappersDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *identityMappersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Identity Mapper objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Identity Mapper objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewIdentityMapperDataSource(), "Identity Mapper")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *identityMappersDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewIdentityMapperDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.IdentityMapperAPI.ListIdentityMappers(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.IdentityMapperAPI.ListIdentityMappersExecute(listRequest)
@@ -106,41 +104,38 @@ func (r *identityMappersDataSource) Read(ctx context.Context, req datasource.Rea
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object identityMapperDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.ExactMatchIdentityMapperResponse != nil {
-			attributes["id"] = types.StringValue(response.ExactMatchIdentityMapperResponse.Id)
-			attributes["type"] = types.StringValue("exact-match")
+			readExactMatchIdentityMapperResponseDataSource(ctx, response.ExactMatchIdentityMapperResponse, &object, &resp.Diagnostics)
 		}
 		if response.GroovyScriptedIdentityMapperResponse != nil {
-			attributes["id"] = types.StringValue(response.GroovyScriptedIdentityMapperResponse.Id)
-			attributes["type"] = types.StringValue("groovy-scripted")
+			readGroovyScriptedIdentityMapperResponseDataSource(ctx, response.GroovyScriptedIdentityMapperResponse, &object, &resp.Diagnostics)
 		}
 		if response.DnIdentityMapperResponse != nil {
-			attributes["id"] = types.StringValue(response.DnIdentityMapperResponse.Id)
-			attributes["type"] = types.StringValue("dn")
+			readDnIdentityMapperResponseDataSource(ctx, response.DnIdentityMapperResponse, &object, &resp.Diagnostics)
 		}
 		if response.RegularExpressionIdentityMapperResponse != nil {
-			attributes["id"] = types.StringValue(response.RegularExpressionIdentityMapperResponse.Id)
-			attributes["type"] = types.StringValue("regular-expression")
+			readRegularExpressionIdentityMapperResponseDataSource(ctx, response.RegularExpressionIdentityMapperResponse, &object, &resp.Diagnostics)
 		}
 		if response.AggregateIdentityMapperResponse != nil {
-			attributes["id"] = types.StringValue(response.AggregateIdentityMapperResponse.Id)
-			attributes["type"] = types.StringValue("aggregate")
+			readAggregateIdentityMapperResponseDataSource(ctx, response.AggregateIdentityMapperResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyIdentityMapperResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyIdentityMapperResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyIdentityMapperResponseDataSource(ctx, response.ThirdPartyIdentityMapperResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
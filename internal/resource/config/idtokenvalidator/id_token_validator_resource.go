@@ -30,9 +30,11 @@ var (
 	_ resource.Resource                = &idTokenValidatorResource{}
 	_ resource.ResourceWithConfigure   = &idTokenValidatorResource{}
 	_ resource.ResourceWithImportState = &idTokenValidatorResource{}
+	_ resource.ResourceWithMoveState   = &idTokenValidatorResource{}
 	_ resource.Resource                = &defaultIdTokenValidatorResource{}
 	_ resource.ResourceWithConfigure   = &defaultIdTokenValidatorResource{}
 	_ resource.ResourceWithImportState = &defaultIdTokenValidatorResource{}
+	_ resource.ResourceWithMoveState   = &defaultIdTokenValidatorResource{}
 )
 
 // Create a Id Token Validator resource
@@ -481,7 +483,7 @@ func (r *idTokenValidatorResource) CreatePingOneIdTokenValidator(ctx context.Con
 
 	addResponse, httpResp, err := r.apiClient.IdTokenValidatorAPI.AddIdTokenValidatorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Id Token Validator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Id Token Validator", err, httpResp)
 		return nil, err
 	}
 
@@ -521,7 +523,7 @@ func (r *idTokenValidatorResource) CreateOpenidConnectIdTokenValidator(ctx conte
 
 	addResponse, httpResp, err := r.apiClient.IdTokenValidatorAPI.AddIdTokenValidatorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Id Token Validator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Id Token Validator", err, httpResp)
 		return nil, err
 	}
 
@@ -562,6 +564,8 @@ func (r *idTokenValidatorResource) Create(ctx context.Context, req resource.Crea
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Id Token Validator", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -586,7 +590,7 @@ func (r *defaultIdTokenValidatorResource) Create(ctx context.Context, req resour
 	readResponse, httpResp, err := r.apiClient.IdTokenValidatorAPI.GetIdTokenValidator(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Id Token Validator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Id Token Validator", err, httpResp)
 		return
 	}
 
@@ -615,7 +619,7 @@ func (r *defaultIdTokenValidatorResource) Create(ctx context.Context, req resour
 
 		updateResponse, httpResp, err := r.apiClient.IdTokenValidatorAPI.UpdateIdTokenValidatorExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Id Token Validator", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Id Token Validator", err, httpResp)
 			return
 		}
 
@@ -632,6 +636,8 @@ func (r *defaultIdTokenValidatorResource) Create(ctx context.Context, req resour
 		if updateResponse.OpenidConnectIdTokenValidatorResponse != nil {
 			readOpenidConnectIdTokenValidatorResponse(ctx, updateResponse.OpenidConnectIdTokenValidatorResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Id Token Validator", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -728,7 +734,7 @@ func updateIdTokenValidator(ctx context.Context, req resource.UpdateRequest, res
 
 		updateResponse, httpResp, err := apiClient.IdTokenValidatorAPI.UpdateIdTokenValidatorExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Id Token Validator", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Id Token Validator", err, httpResp)
 			return
 		}
 
@@ -745,6 +751,8 @@ func updateIdTokenValidator(ctx context.Context, req resource.UpdateRequest, res
 		if updateResponse.OpenidConnectIdTokenValidatorResponse != nil {
 			readOpenidConnectIdTokenValidatorResponse(ctx, updateResponse.OpenidConnectIdTokenValidatorResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Id Token Validator", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -792,3 +800,16 @@ func importIdTokenValidator(ctx context.Context, req resource.ImportStateRequest
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *idTokenValidatorResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_id_token_validator"),
+	}
+}
+
+func (r *defaultIdTokenValidatorResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_id_token_validator"),
+	}
+}
@@ -50,10 +50,12 @@ func (r *interServerAuthenticationInfoListDataSource) Configure(_ context.Contex
 
 type interServerAuthenticationInfoListDataSourceModel struct {
 	Id                         types.String `tfsdk:"id"`
-	Filter                     types.String `tfsdk:"filter"`
 	Objects                    types.Set    `tfsdk:"objects"`
+	ObjectsById                types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes          types.Bool   `tfsdk:"include_attributes"`
 	ServerInstanceListenerName types.String `tfsdk:"server_instance_listener_name"`
 	ServerInstanceName         types.String `tfsdk:"server_instance_name"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
@@ -69,19 +71,10 @@ func (r *interServerAuthenticationInfoListDataSource) Schema(ctx context.Context
 				Description: "Name of the parent Server Instance",
 				Required:    true,
 			},
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Inter Server Authentication Info objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
 		},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewInterServerAuthenticationInfoDataSource(), "Inter Server Authentication Info")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -96,9 +89,15 @@ func (r *interServerAuthenticationInfoListDataSource) Read(ctx context.Context,
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewInterServerAuthenticationInfoDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.InterServerAuthenticationInfoAPI.ListInterServerAuthenticationInfo(config.ProviderBasicAuthContext(ctx, r.providerConfig), state.ServerInstanceListenerName.ValueString(), state.ServerInstanceName.ValueString())
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.InterServerAuthenticationInfoAPI.ListInterServerAuthenticationInfoExecute(listRequest)
@@ -116,25 +115,28 @@ func (r *interServerAuthenticationInfoListDataSource) Read(ctx context.Context,
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object interServerAuthenticationInfoDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.CertificateInterServerAuthenticationInfoResponse != nil {
-			attributes["id"] = types.StringValue(response.CertificateInterServerAuthenticationInfoResponse.Id)
-			attributes["type"] = types.StringValue("certificate")
+			readCertificateInterServerAuthenticationInfoResponseDataSource(ctx, response.CertificateInterServerAuthenticationInfoResponse, &object, &resp.Diagnostics)
 		}
 		if response.PasswordInterServerAuthenticationInfoResponse != nil {
-			attributes["id"] = types.StringValue(response.PasswordInterServerAuthenticationInfoResponse.Id)
-			attributes["type"] = types.StringValue("password")
+			readPasswordInterServerAuthenticationInfoResponseDataSource(ctx, response.PasswordInterServerAuthenticationInfoResponse, &object, &resp.Diagnostics)
+		}
+		object.ServerInstanceListenerName = state.ServerInstanceListenerName
+		object.ServerInstanceName = state.ServerInstanceName
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
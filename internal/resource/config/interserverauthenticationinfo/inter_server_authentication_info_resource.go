@@ -70,6 +70,8 @@ type interServerAuthenticationInfoResourceModel struct {
 	BindDN                     types.String `tfsdk:"bind_dn"`
 	Username                   types.String `tfsdk:"username"`
 	Password                   types.String `tfsdk:"password"`
+	PasswordWo                 types.String `tfsdk:"password_wo"`
+	PasswordWoVersion          types.Int64  `tfsdk:"password_wo_version"`
 	Purpose                    types.Set    `tfsdk:"purpose"`
 }
 
@@ -151,6 +153,7 @@ func (r *interServerAuthenticationInfoResource) Schema(ctx context.Context, req
 			},
 		},
 	}
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "password")
 	config.AddCommonResourceSchema(&schemaDef, true)
 	resp.Schema = schemaDef
 }
@@ -178,6 +181,11 @@ func (r interServerAuthenticationInfoResource) ConfigValidators(ctx context.Cont
 			path.MatchRoot("type"),
 			[]string{"password"},
 		),
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("password_wo"),
+			path.MatchRoot("type"),
+			[]string{"password"},
+		),
 	}
 }
 
@@ -211,6 +219,9 @@ func (state *interServerAuthenticationInfoResourceModel) setStateValuesNotReturn
 	if !expectedValues.Password.IsUnknown() {
 		state.Password = expectedValues.Password
 	}
+	if !expectedValues.PasswordWoVersion.IsUnknown() {
+		state.PasswordWoVersion = expectedValues.PasswordWoVersion
+	}
 	if !expectedValues.ServerInstanceListenerName.IsUnknown() {
 		state.ServerInstanceListenerName = expectedValues.ServerInstanceListenerName
 	}
@@ -226,6 +237,7 @@ func createInterServerAuthenticationInfoOperations(plan interServerAuthenticatio
 	operations.AddStringOperationIfNecessary(&ops, plan.BindDN, state.BindDN, "bind-dn")
 	operations.AddStringOperationIfNecessary(&ops, plan.Username, state.Username, "username")
 	operations.AddStringOperationIfNecessary(&ops, plan.Password, state.Password, "password")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.PasswordWo, plan.PasswordWoVersion, state.PasswordWoVersion, "password")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.Purpose, state.Purpose, "purpose")
 	return ops
 }
@@ -242,11 +254,16 @@ func (r *interServerAuthenticationInfoResource) Create(ctx context.Context, req
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	readResponse, httpResp, err := r.apiClient.InterServerAuthenticationInfoAPI.GetInterServerAuthenticationInfo(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString(), plan.ServerInstanceListenerName.ValueString(), plan.ServerInstanceName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Inter Server Authentication Info", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Inter Server Authentication Info", err, httpResp)
 		return
 	}
 
@@ -275,7 +292,7 @@ func (r *interServerAuthenticationInfoResource) Create(ctx context.Context, req
 
 		updateResponse, httpResp, err := r.apiClient.InterServerAuthenticationInfoAPI.UpdateInterServerAuthenticationInfoExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Inter Server Authentication Info", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Inter Server Authentication Info", err, httpResp)
 			return
 		}
 
@@ -292,6 +309,8 @@ func (r *interServerAuthenticationInfoResource) Create(ctx context.Context, req
 		if updateResponse.CertificateInterServerAuthenticationInfoResponse != nil {
 			readCertificateInterServerAuthenticationInfoResponse(ctx, updateResponse.CertificateInterServerAuthenticationInfoResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Inter Server Authentication Info", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -347,6 +366,11 @@ func (r *interServerAuthenticationInfoResource) Update(ctx context.Context, req
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Get the current state to see how any attributes are changing
 	var state interServerAuthenticationInfoResourceModel
@@ -363,7 +387,7 @@ func (r *interServerAuthenticationInfoResource) Update(ctx context.Context, req
 
 		updateResponse, httpResp, err := r.apiClient.InterServerAuthenticationInfoAPI.UpdateInterServerAuthenticationInfoExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Inter Server Authentication Info", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Inter Server Authentication Info", err, httpResp)
 			return
 		}
 
@@ -380,6 +404,8 @@ func (r *interServerAuthenticationInfoResource) Update(ctx context.Context, req
 		if updateResponse.CertificateInterServerAuthenticationInfoResponse != nil {
 			readCertificateInterServerAuthenticationInfoResponse(ctx, updateResponse.CertificateInterServerAuthenticationInfoResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Inter Server Authentication Info", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
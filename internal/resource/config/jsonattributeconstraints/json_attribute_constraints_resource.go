@@ -28,9 +28,11 @@ var (
 	_ resource.Resource                = &jsonAttributeConstraintsResource{}
 	_ resource.ResourceWithConfigure   = &jsonAttributeConstraintsResource{}
 	_ resource.ResourceWithImportState = &jsonAttributeConstraintsResource{}
+	_ resource.ResourceWithMoveState   = &jsonAttributeConstraintsResource{}
 	_ resource.Resource                = &defaultJsonAttributeConstraintsResource{}
 	_ resource.ResourceWithConfigure   = &defaultJsonAttributeConstraintsResource{}
 	_ resource.ResourceWithImportState = &defaultJsonAttributeConstraintsResource{}
+	_ resource.ResourceWithMoveState   = &defaultJsonAttributeConstraintsResource{}
 )
 
 // Create a Json Attribute Constraints resource
@@ -223,7 +225,7 @@ func (r *jsonAttributeConstraintsResource) CreateJsonAttributeConstraints(ctx co
 
 	addResponse, httpResp, err := r.apiClient.JsonAttributeConstraintsAPI.AddJsonAttributeConstraintsExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Json Attribute Constraints", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Json Attribute Constraints", err, httpResp)
 		return nil, err
 	}
 
@@ -254,6 +256,8 @@ func (r *jsonAttributeConstraintsResource) Create(ctx context.Context, req resou
 		return
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Json Attribute Constraints", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -278,7 +282,7 @@ func (r *defaultJsonAttributeConstraintsResource) Create(ctx context.Context, re
 	readResponse, httpResp, err := r.apiClient.JsonAttributeConstraintsAPI.GetJsonAttributeConstraints(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.AttributeType.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Json Attribute Constraints", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Json Attribute Constraints", err, httpResp)
 		return
 	}
 
@@ -302,7 +306,7 @@ func (r *defaultJsonAttributeConstraintsResource) Create(ctx context.Context, re
 
 		updateResponse, httpResp, err := r.apiClient.JsonAttributeConstraintsAPI.UpdateJsonAttributeConstraintsExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Json Attribute Constraints", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Json Attribute Constraints", err, httpResp)
 			return
 		}
 
@@ -314,6 +318,8 @@ func (r *defaultJsonAttributeConstraintsResource) Create(ctx context.Context, re
 
 		// Read the response
 		readJsonAttributeConstraintsResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Json Attribute Constraints", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -405,7 +411,7 @@ func updateJsonAttributeConstraints(ctx context.Context, req resource.UpdateRequ
 
 		updateResponse, httpResp, err := apiClient.JsonAttributeConstraintsAPI.UpdateJsonAttributeConstraintsExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Json Attribute Constraints", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Json Attribute Constraints", err, httpResp)
 			return
 		}
 
@@ -417,6 +423,8 @@ func updateJsonAttributeConstraints(ctx context.Context, req resource.UpdateRequ
 
 		// Read the response
 		readJsonAttributeConstraintsResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Json Attribute Constraints", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -464,3 +472,16 @@ func importJsonAttributeConstraints(ctx context.Context, req resource.ImportStat
 	// Retrieve import ID and save to attribute_type attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("attribute_type"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *jsonAttributeConstraintsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_json_attribute_constraints"),
+	}
+}
+
+func (r *defaultJsonAttributeConstraintsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_json_attribute_constraints"),
+	}
+}
@@ -50,9 +50,12 @@ func (r *jsonFieldConstraintsListDataSource) Configure(_ context.Context, req da
 
 type jsonFieldConstraintsListDataSourceModel struct {
 	Id                           types.String `tfsdk:"id"`
-	Filter                       types.String `tfsdk:"filter"`
 	Ids                          types.Set    `tfsdk:"ids"`
+	Objects                      types.Set    `tfsdk:"objects"`
+	ObjectsById                  types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes            types.Bool   `tfsdk:"include_attributes"`
 	JsonAttributeConstraintsName types.String `tfsdk:"json_attribute_constraints_name"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
@@ -64,10 +67,6 @@ func (r *jsonFieldConstraintsListDataSource) Schema(ctx context.Context, req dat
 				Description: "Name of the parent JSON Attribute Constraints",
 				Required:    true,
 			},
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
 			"ids": schema.SetAttribute{
 				Description: "Json Field Constraints IDs found in the configuration",
 				Required:    false,
@@ -77,6 +76,8 @@ func (r *jsonFieldConstraintsListDataSource) Schema(ctx context.Context, req dat
 			},
 		},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewJsonFieldConstraintsDataSource(), "Json Field Constraints")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -91,9 +92,15 @@ func (r *jsonFieldConstraintsListDataSource) Read(ctx context.Context, req datas
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewJsonFieldConstraintsDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.JsonFieldConstraintsAPI.ListJsonFieldConstraints(config.ProviderBasicAuthContext(ctx, r.providerConfig), state.JsonAttributeConstraintsName.ValueString())
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.JsonFieldConstraintsAPI.ListJsonFieldConstraintsExecute(listRequest)
@@ -110,12 +117,24 @@ func (r *jsonFieldConstraintsListDataSource) Read(ctx context.Context, req datas
 
 	// Read the response into the state
 	ids := []attr.Value{}
+	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
+		var object jsonFieldConstraintsDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
+		readJsonFieldConstraintsResponseDataSource(ctx, &response, &object, &resp.Diagnostics)
+		object.JsonAttributeConstraintsName = state.JsonAttributeConstraintsName
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
+		}
 		ids = append(ids, types.StringValue(response.Id))
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 
 	state.Ids, diags = types.SetValue(types.StringType, ids)
 	resp.Diagnostics.Append(diags...)
+	state.Objects, diags = types.SetValue(objectType, objects)
+	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
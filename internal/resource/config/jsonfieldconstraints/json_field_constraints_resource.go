@@ -29,9 +29,11 @@ var (
 	_ resource.Resource                = &jsonFieldConstraintsResource{}
 	_ resource.ResourceWithConfigure   = &jsonFieldConstraintsResource{}
 	_ resource.ResourceWithImportState = &jsonFieldConstraintsResource{}
+	_ resource.ResourceWithMoveState   = &jsonFieldConstraintsResource{}
 	_ resource.Resource                = &defaultJsonFieldConstraintsResource{}
 	_ resource.ResourceWithConfigure   = &defaultJsonFieldConstraintsResource{}
 	_ resource.ResourceWithImportState = &defaultJsonFieldConstraintsResource{}
+	_ resource.ResourceWithMoveState   = &defaultJsonFieldConstraintsResource{}
 )
 
 // Create a Json Field Constraints resource
@@ -469,7 +471,7 @@ func (r *jsonFieldConstraintsResource) CreateJsonFieldConstraints(ctx context.Co
 
 	addResponse, httpResp, err := r.apiClient.JsonFieldConstraintsAPI.AddJsonFieldConstraintsExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Json Field Constraints", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Json Field Constraints", err, httpResp)
 		return nil, err
 	}
 
@@ -502,6 +504,8 @@ func (r *jsonFieldConstraintsResource) Create(ctx context.Context, req resource.
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Json Field Constraints", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -526,7 +530,7 @@ func (r *defaultJsonFieldConstraintsResource) Create(ctx context.Context, req re
 	readResponse, httpResp, err := r.apiClient.JsonFieldConstraintsAPI.GetJsonFieldConstraints(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.JsonField.ValueString(), plan.JsonAttributeConstraintsName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Json Field Constraints", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Json Field Constraints", err, httpResp)
 		return
 	}
 
@@ -550,7 +554,7 @@ func (r *defaultJsonFieldConstraintsResource) Create(ctx context.Context, req re
 
 		updateResponse, httpResp, err := r.apiClient.JsonFieldConstraintsAPI.UpdateJsonFieldConstraintsExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Json Field Constraints", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Json Field Constraints", err, httpResp)
 			return
 		}
 
@@ -562,6 +566,8 @@ func (r *defaultJsonFieldConstraintsResource) Create(ctx context.Context, req re
 
 		// Read the response
 		readJsonFieldConstraintsResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Json Field Constraints", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -654,7 +660,7 @@ func updateJsonFieldConstraints(ctx context.Context, req resource.UpdateRequest,
 
 		updateResponse, httpResp, err := apiClient.JsonFieldConstraintsAPI.UpdateJsonFieldConstraintsExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Json Field Constraints", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Json Field Constraints", err, httpResp)
 			return
 		}
 
@@ -666,6 +672,8 @@ func updateJsonFieldConstraints(ctx context.Context, req resource.UpdateRequest,
 
 		// Read the response
 		readJsonFieldConstraintsResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Json Field Constraints", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -720,3 +728,16 @@ func importJsonFieldConstraints(ctx context.Context, req resource.ImportStateReq
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("json_attribute_constraints_name"), split[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("json_field"), split[1])...)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *jsonFieldConstraintsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_json_field_constraints"),
+	}
+}
+
+func (r *defaultJsonFieldConstraintsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_json_field_constraints"),
+	}
+}
@@ -30,9 +30,11 @@ var (
 	_ resource.Resource                = &keyManagerProviderResource{}
 	_ resource.ResourceWithConfigure   = &keyManagerProviderResource{}
 	_ resource.ResourceWithImportState = &keyManagerProviderResource{}
+	_ resource.ResourceWithMoveState   = &keyManagerProviderResource{}
 	_ resource.Resource                = &defaultKeyManagerProviderResource{}
 	_ resource.ResourceWithConfigure   = &defaultKeyManagerProviderResource{}
 	_ resource.ResourceWithImportState = &defaultKeyManagerProviderResource{}
+	_ resource.ResourceWithMoveState   = &defaultKeyManagerProviderResource{}
 )
 
 // Create a Key Manager Provider resource
@@ -101,9 +103,13 @@ type keyManagerProviderResourceModel struct {
 	KeyStoreFile                    types.String `tfsdk:"key_store_file"`
 	KeyStoreType                    types.String `tfsdk:"key_store_type"`
 	KeyStorePin                     types.String `tfsdk:"key_store_pin"`
+	KeyStorePinWo                   types.String `tfsdk:"key_store_pin_wo"`
+	KeyStorePinWoVersion            types.Int64  `tfsdk:"key_store_pin_wo_version"`
 	KeyStorePinFile                 types.String `tfsdk:"key_store_pin_file"`
 	KeyStorePinPassphraseProvider   types.String `tfsdk:"key_store_pin_passphrase_provider"`
 	PrivateKeyPin                   types.String `tfsdk:"private_key_pin"`
+	PrivateKeyPinWo                 types.String `tfsdk:"private_key_pin_wo"`
+	PrivateKeyPinWoVersion          types.Int64  `tfsdk:"private_key_pin_wo_version"`
 	PrivateKeyPinFile               types.String `tfsdk:"private_key_pin_file"`
 	PrivateKeyPinPassphraseProvider types.String `tfsdk:"private_key_pin_passphrase_provider"`
 	EnableKeyManagerCaching         types.Bool   `tfsdk:"enable_key_manager_caching"`
@@ -238,6 +244,8 @@ func keyManagerProviderSchema(ctx context.Context, req resource.SchemaRequest, r
 		extensionClassAttr.PlanModifiers = append(extensionClassAttr.PlanModifiers, stringplanmodifier.RequiresReplace())
 		schemaDef.Attributes["extension_class"] = extensionClassAttr
 	}
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "key_store_pin")
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "private_key_pin")
 	config.AddCommonResourceSchema(&schemaDef, true)
 	resp.Schema = schemaDef
 }
@@ -324,6 +332,11 @@ func configValidatorsKeyManagerProvider() []resource.ConfigValidator {
 			path.MatchRoot("type"),
 			[]string{"file-based", "pkcs11"},
 		),
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("key_store_pin_wo"),
+			path.MatchRoot("type"),
+			[]string{"file-based", "pkcs11"},
+		),
 		configvalidators.ImpliesOtherAttributeOneOfString(
 			path.MatchRoot("key_store_pin_file"),
 			path.MatchRoot("type"),
@@ -339,6 +352,11 @@ func configValidatorsKeyManagerProvider() []resource.ConfigValidator {
 			path.MatchRoot("type"),
 			[]string{"file-based"},
 		),
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("private_key_pin_wo"),
+			path.MatchRoot("type"),
+			[]string{"file-based"},
+		),
 		configvalidators.ImpliesOtherAttributeOneOfString(
 			path.MatchRoot("private_key_pin_file"),
 			path.MatchRoot("type"),
@@ -422,6 +440,9 @@ func addOptionalFileBasedKeyManagerProviderFields(ctx context.Context, addReques
 	if internaltypes.IsNonEmptyString(plan.KeyStorePin) {
 		addRequest.KeyStorePin = plan.KeyStorePin.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.KeyStorePinWo) {
+		addRequest.KeyStorePin = plan.KeyStorePinWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.KeyStorePinFile) {
 		addRequest.KeyStorePinFile = plan.KeyStorePinFile.ValueStringPointer()
@@ -434,6 +455,9 @@ func addOptionalFileBasedKeyManagerProviderFields(ctx context.Context, addReques
 	if internaltypes.IsNonEmptyString(plan.PrivateKeyPin) {
 		addRequest.PrivateKeyPin = plan.PrivateKeyPin.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.PrivateKeyPinWo) {
+		addRequest.PrivateKeyPin = plan.PrivateKeyPinWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.PrivateKeyPinFile) {
 		addRequest.PrivateKeyPinFile = plan.PrivateKeyPinFile.ValueStringPointer()
@@ -473,6 +497,9 @@ func addOptionalPkcs11KeyManagerProviderFields(ctx context.Context, addRequest *
 	if internaltypes.IsNonEmptyString(plan.KeyStorePin) {
 		addRequest.KeyStorePin = plan.KeyStorePin.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.KeyStorePinWo) {
+		addRequest.KeyStorePin = plan.KeyStorePinWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.KeyStorePinFile) {
 		addRequest.KeyStorePinFile = plan.KeyStorePinFile.ValueStringPointer()
@@ -620,9 +647,15 @@ func (state *keyManagerProviderResourceModel) setStateValuesNotReturnedByAPI(exp
 	if !expectedValues.KeyStorePin.IsUnknown() {
 		state.KeyStorePin = expectedValues.KeyStorePin
 	}
+	if !expectedValues.KeyStorePinWoVersion.IsUnknown() {
+		state.KeyStorePinWoVersion = expectedValues.KeyStorePinWoVersion
+	}
 	if !expectedValues.PrivateKeyPin.IsUnknown() {
 		state.PrivateKeyPin = expectedValues.PrivateKeyPin
 	}
+	if !expectedValues.PrivateKeyPinWoVersion.IsUnknown() {
+		state.PrivateKeyPinWoVersion = expectedValues.PrivateKeyPinWoVersion
+	}
 }
 
 // Create any update operations necessary to make the state match the plan
@@ -637,9 +670,11 @@ func createKeyManagerProviderOperations(plan keyManagerProviderResourceModel, st
 	operations.AddStringOperationIfNecessary(&ops, plan.KeyStoreFile, state.KeyStoreFile, "key-store-file")
 	operations.AddStringOperationIfNecessary(&ops, plan.KeyStoreType, state.KeyStoreType, "key-store-type")
 	operations.AddStringOperationIfNecessary(&ops, plan.KeyStorePin, state.KeyStorePin, "key-store-pin")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.KeyStorePinWo, plan.KeyStorePinWoVersion, state.KeyStorePinWoVersion, "key-store-pin")
 	operations.AddStringOperationIfNecessary(&ops, plan.KeyStorePinFile, state.KeyStorePinFile, "key-store-pin-file")
 	operations.AddStringOperationIfNecessary(&ops, plan.KeyStorePinPassphraseProvider, state.KeyStorePinPassphraseProvider, "key-store-pin-passphrase-provider")
 	operations.AddStringOperationIfNecessary(&ops, plan.PrivateKeyPin, state.PrivateKeyPin, "private-key-pin")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.PrivateKeyPinWo, plan.PrivateKeyPinWoVersion, state.PrivateKeyPinWoVersion, "private-key-pin")
 	operations.AddStringOperationIfNecessary(&ops, plan.PrivateKeyPinFile, state.PrivateKeyPinFile, "private-key-pin-file")
 	operations.AddStringOperationIfNecessary(&ops, plan.PrivateKeyPinPassphraseProvider, state.PrivateKeyPinPassphraseProvider, "private-key-pin-passphrase-provider")
 	operations.AddBoolOperationIfNecessary(&ops, plan.EnableKeyManagerCaching, state.EnableKeyManagerCaching, "enable-key-manager-caching")
@@ -667,7 +702,7 @@ func (r *keyManagerProviderResource) CreateFileBasedKeyManagerProvider(ctx conte
 
 	addResponse, httpResp, err := r.apiClient.KeyManagerProviderAPI.AddKeyManagerProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Key Manager Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Key Manager Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -701,7 +736,7 @@ func (r *keyManagerProviderResource) CreatePkcs11KeyManagerProvider(ctx context.
 
 	addResponse, httpResp, err := r.apiClient.KeyManagerProviderAPI.AddKeyManagerProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Key Manager Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Key Manager Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -736,7 +771,7 @@ func (r *keyManagerProviderResource) CreateThirdPartyKeyManagerProvider(ctx cont
 
 	addResponse, httpResp, err := r.apiClient.KeyManagerProviderAPI.AddKeyManagerProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Key Manager Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Key Manager Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -761,6 +796,12 @@ func (r *keyManagerProviderResource) Create(ctx context.Context, req resource.Cr
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("key_store_pin_wo"), &plan.KeyStorePinWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("private_key_pin_wo"), &plan.PrivateKeyPinWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	var state *keyManagerProviderResourceModel
 	var err error
@@ -785,6 +826,8 @@ func (r *keyManagerProviderResource) Create(ctx context.Context, req resource.Cr
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Key Manager Provider", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -805,11 +848,17 @@ func (r *defaultKeyManagerProviderResource) Create(ctx context.Context, req reso
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("key_store_pin_wo"), &plan.KeyStorePinWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("private_key_pin_wo"), &plan.PrivateKeyPinWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	readResponse, httpResp, err := r.apiClient.KeyManagerProviderAPI.GetKeyManagerProvider(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Key Manager Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Key Manager Provider", err, httpResp)
 		return
 	}
 
@@ -844,7 +893,7 @@ func (r *defaultKeyManagerProviderResource) Create(ctx context.Context, req reso
 
 		updateResponse, httpResp, err := r.apiClient.KeyManagerProviderAPI.UpdateKeyManagerProviderExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Key Manager Provider", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Key Manager Provider", err, httpResp)
 			return
 		}
 
@@ -867,6 +916,8 @@ func (r *defaultKeyManagerProviderResource) Create(ctx context.Context, req reso
 		if updateResponse.ThirdPartyKeyManagerProviderResponse != nil {
 			readThirdPartyKeyManagerProviderResponse(ctx, updateResponse.ThirdPartyKeyManagerProviderResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Key Manager Provider", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -954,6 +1005,12 @@ func updateKeyManagerProvider(ctx context.Context, req resource.UpdateRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("key_store_pin_wo"), &plan.KeyStorePinWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("private_key_pin_wo"), &plan.PrivateKeyPinWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Get the current state to see how any attributes are changing
 	var state keyManagerProviderResourceModel
@@ -970,7 +1027,7 @@ func updateKeyManagerProvider(ctx context.Context, req resource.UpdateRequest, r
 
 		updateResponse, httpResp, err := apiClient.KeyManagerProviderAPI.UpdateKeyManagerProviderExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Key Manager Provider", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Key Manager Provider", err, httpResp)
 			return
 		}
 
@@ -993,6 +1050,8 @@ func updateKeyManagerProvider(ctx context.Context, req resource.UpdateRequest, r
 		if updateResponse.ThirdPartyKeyManagerProviderResponse != nil {
 			readThirdPartyKeyManagerProviderResponse(ctx, updateResponse.ThirdPartyKeyManagerProviderResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Key Manager Provider", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -1041,3 +1100,16 @@ func importKeyManagerProvider(ctx context.Context, req resource.ImportStateReque
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *keyManagerProviderResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_key_manager_provider"),
+	}
+}
+
+func (r *defaultKeyManagerProviderResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_key_manager_provider"),
+	}
+}
@@ -49,29 +49,21 @@ func (r *keyManagerProvidersDataSource) Configure(_ context.Context, req datasou
 }
 
 type keyManagerProvidersDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *keyManagerProvidersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Key Manager Provider objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Key Manager Provider objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewKeyManagerProviderDataSource(), "Key Manager Provider")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *keyManagerProvidersDataSource) Read(ctx context.Context, req datasource
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewKeyManagerProviderDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.KeyManagerProviderAPI.ListKeyManagerProviders(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.KeyManagerProviderAPI.ListKeyManagerProvidersExecute(listRequest)
@@ -106,33 +104,32 @@ func (r *keyManagerProvidersDataSource) Read(ctx context.Context, req datasource
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object keyManagerProviderDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.Pkcs11KeyManagerProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.Pkcs11KeyManagerProviderResponse.Id)
-			attributes["type"] = types.StringValue("pkcs11")
+			readPkcs11KeyManagerProviderResponseDataSource(ctx, response.Pkcs11KeyManagerProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.FileBasedKeyManagerProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.FileBasedKeyManagerProviderResponse.Id)
-			attributes["type"] = types.StringValue("file-based")
+			readFileBasedKeyManagerProviderResponseDataSource(ctx, response.FileBasedKeyManagerProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.CustomKeyManagerProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.CustomKeyManagerProviderResponse.Id)
-			attributes["type"] = types.StringValue("custom")
+			readCustomKeyManagerProviderResponseDataSource(ctx, response.CustomKeyManagerProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyKeyManagerProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyKeyManagerProviderResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyKeyManagerProviderResponseDataSource(ctx, response.ThirdPartyKeyManagerProviderResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
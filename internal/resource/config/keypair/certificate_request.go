@@ -0,0 +1,192 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package keypair
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// Object identifiers of the attribute types that can be used by name in a subject DN
+var subjectAttributeTypes = map[string]asn1.ObjectIdentifier{
+	"cn":                     {2, 5, 4, 3},
+	"serialnumber":           {2, 5, 4, 5},
+	"c":                      {2, 5, 4, 6},
+	"l":                      {2, 5, 4, 7},
+	"st":                     {2, 5, 4, 8},
+	"street":                 {2, 5, 4, 9},
+	"o":                      {2, 5, 4, 10},
+	"ou":                     {2, 5, 4, 11},
+	"title":                  {2, 5, 4, 12},
+	"postalcode":             {2, 5, 4, 17},
+	"dc":                     {0, 9, 2342, 19200300, 100, 1, 25},
+	"uid":                    {0, 9, 2342, 19200300, 100, 1, 1},
+	"emailaddress":           {1, 2, 840, 113549, 1, 9, 1},
+	"e":                      {1, 2, 840, 113549, 1, 9, 1},
+	"organizationidentifier": {2, 5, 4, 97},
+}
+
+// Parse a PEM-encoded private key in PKCS#8, PKCS#1 or SEC 1 form
+func parsePrivateKeyPEM(privateKeyPem string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(privateKeyPem))
+	if block == nil {
+		return nil, errors.New("no PEM-encoded private key was found")
+	}
+	var key any
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type '%s', expected 'PRIVATE KEY', 'RSA PRIVATE KEY' or 'EC PRIVATE KEY'", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the private key: %w", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return signer, nil
+}
+
+// Check that a certificate uses the public key of the given private key
+func certificateMatchesPrivateKey(cert *x509.Certificate, privateKey crypto.Signer) bool {
+	publicKey, ok := privateKey.Public().(interface{ Equal(crypto.PublicKey) bool })
+	return ok && publicKey.Equal(cert.PublicKey)
+}
+
+// Split a string on a separator character, ignoring separators escaped with a backslash
+func splitUnescaped(value string, separators string) []string {
+	var parts []string
+	start := 0
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' {
+			i++
+		} else if strings.IndexByte(separators, value[i]) >= 0 {
+			parts = append(parts, value[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, value[start:])
+}
+
+// Remove the escaping from an RFC 4514 attribute value, including escaped hex pairs
+func unescapeDNValue(value string) (string, error) {
+	var unescaped []byte
+	for i := 0; i < len(value); i++ {
+		if value[i] != '\\' {
+			unescaped = append(unescaped, value[i])
+			continue
+		}
+		if i+1 >= len(value) {
+			return "", errors.New("the value ends with an incomplete escape")
+		}
+		if i+2 < len(value) {
+			if b, err := hex.DecodeString(value[i+1 : i+3]); err == nil {
+				unescaped = append(unescaped, b[0])
+				i += 2
+				continue
+			}
+		}
+		unescaped = append(unescaped, value[i+1])
+		i++
+	}
+	return string(unescaped), nil
+}
+
+// Parse the attribute type of an RDN, either by name or as a dotted object identifier
+func parseSubjectAttributeType(attributeType string) (asn1.ObjectIdentifier, error) {
+	if oid, ok := subjectAttributeTypes[strings.ToLower(attributeType)]; ok {
+		return oid, nil
+	}
+	var oid asn1.ObjectIdentifier
+	for _, arc := range strings.Split(strings.TrimPrefix(strings.ToLower(attributeType), "oid."), ".") {
+		value, err := strconv.Atoi(arc)
+		if err != nil || value < 0 {
+			return nil, fmt.Errorf("unsupported attribute type '%s', use a dotted object identifier for attribute types other than CN, SERIALNUMBER, C, L, ST, STREET, O, OU, TITLE, POSTALCODE, DC, UID, EMAILADDRESS and ORGANIZATIONIDENTIFIER", attributeType)
+		}
+		oid = append(oid, value)
+	}
+	if len(oid) < 2 {
+		return nil, fmt.Errorf("invalid object identifier '%s'", attributeType)
+	}
+	return oid, nil
+}
+
+// Parse an RFC 4514 string DN into the DER-encoded subject of a certificate signing request. The RDNs of a string
+// DN start with the most specific one, while the encoded subject starts with the least specific one.
+func parseSubjectDN(dn string) ([]byte, error) {
+	var rdnSequence pkix.RDNSequence
+	if strings.TrimSpace(dn) != "" {
+		for _, rdn := range splitUnescaped(dn, ",;") {
+			var rdnSet pkix.RelativeDistinguishedNameSET
+			for _, attributeTypeAndValue := range splitUnescaped(rdn, "+") {
+				attributeType, value, found := strings.Cut(attributeTypeAndValue, "=")
+				if !found {
+					return nil, fmt.Errorf("the RDN '%s' is not of the form type=value", strings.TrimSpace(rdn))
+				}
+				oid, err := parseSubjectAttributeType(strings.TrimSpace(attributeType))
+				if err != nil {
+					return nil, err
+				}
+				value = strings.TrimSpace(value)
+				var encodedValue any
+				if strings.HasPrefix(value, "#") {
+					// Hex values hold the BER encoding of the value, as used for unknown attribute types
+					der, err := hex.DecodeString(value[1:])
+					if err != nil {
+						return nil, fmt.Errorf("invalid hex value '%s': %w", value, err)
+					}
+					encodedValue = asn1.RawValue{FullBytes: der}
+				} else {
+					encodedValue, err = unescapeDNValue(value)
+					if err != nil {
+						return nil, fmt.Errorf("invalid value '%s': %w", value, err)
+					}
+				}
+				rdnSet = append(rdnSet, pkix.AttributeTypeAndValue{Type: oid, Value: encodedValue})
+			}
+			rdnSequence = append(pkix.RDNSequence{rdnSet}, rdnSequence...)
+		}
+	}
+	return asn1.Marshal(rdnSequence)
+}
+
+// Build a PEM-encoded PKCS#10 certificate signing request signed with the given private key
+func createCertificateRequest(privateKey crypto.Signer, subjectDN string, dnsNames, ipAddresses []string) (string, error) {
+	rawSubject, err := parseSubjectDN(subjectDN)
+	if err != nil {
+		return "", fmt.Errorf("invalid subject DN '%s': %w", subjectDN, err)
+	}
+	template := &x509.CertificateRequest{
+		RawSubject: rawSubject,
+		DNSNames:   dnsNames,
+	}
+	for _, ipAddress := range ipAddresses {
+		ip := net.ParseIP(ipAddress)
+		if ip == nil {
+			return "", fmt.Errorf("invalid IP address '%s'", ipAddress)
+		}
+		template.IPAddresses = append(template.IPAddresses, ip)
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, template, privateKey)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr})), nil
+}
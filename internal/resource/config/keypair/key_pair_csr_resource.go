@@ -0,0 +1,443 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package keypair
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/operations"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &keyPairCsrResource{}
+	_ resource.ResourceWithConfigure  = &keyPairCsrResource{}
+	_ resource.ResourceWithModifyPlan = &keyPairCsrResource{}
+)
+
+// Create a Key Pair CSR resource
+func NewKeyPairCsrResource() resource.Resource {
+	return &keyPairCsrResource{}
+}
+
+// keyPairCsrResource is the resource implementation.
+type keyPairCsrResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the resource type name.
+func (r *keyPairCsrResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_key_pair_csr"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *keyPairCsrResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+type keyPairCsrResourceModel struct {
+	Id                     types.String `tfsdk:"id"`
+	KeyPairName            types.String `tfsdk:"key_pair_name"`
+	PrivateKeyPemWo        types.String `tfsdk:"private_key_pem_wo"`
+	PrivateKeyPemWoVersion types.Int64  `tfsdk:"private_key_pem_wo_version"`
+	SubjectDN              types.String `tfsdk:"subject_dn"`
+	DnsNames               types.List   `tfsdk:"dns_names"`
+	IpAddresses            types.List   `tfsdk:"ip_addresses"`
+	CertificateRequest     types.String `tfsdk:"certificate_request"`
+	SignedCertificateChain types.String `tfsdk:"signed_certificate_chain"`
+	CertificateChain       types.String `tfsdk:"certificate_chain"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *keyPairCsrResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a PKCS#10 certificate signing request for an existing Key Pair, and optionally applies the certificate chain signed by a certificate authority to the Key Pair. PingDirectory never returns the private key of a Key Pair in a usable form, so the CSR is signed with the private key supplied in the write-only `private_key_pem_wo`, which must match the public key of the Key Pair's current certificate. Destroying this resource leaves the Key Pair and its certificate chain unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The name of the Key Pair.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_pair_name": schema.StringAttribute{
+				Description: "Name of the Key Pair to generate the certificate signing request for.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"private_key_pem_wo": schema.StringAttribute{
+				Description: "PEM-encoded private key of the Key Pair, in PKCS#8, PKCS#1 or SEC 1 form. This is the same private key that was supplied when the Key Pair was imported, and it is used to sign the certificate signing request. This value is never stored in the Terraform plan or state. Change `private_key_pem_wo_version` to sign a new request with a different private key. Requires Terraform 1.11 or later.",
+				Required:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"private_key_pem_wo_version": schema.Int64Attribute{
+				Description: "Version of the `private_key_pem_wo` value. The certificate signing request is generated again when this version changes.",
+				Optional:    true,
+			},
+			"subject_dn": schema.StringAttribute{
+				Description: "Subject DN of the certificate signing request, such as `cn=ds.example.com,o=Example`. Defaults to the subject of the Key Pair's current certificate.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dns_names": schema.ListAttribute{
+				Description: "DNS names to include in the subject alternative name extension of the certificate signing request. Defaults to the DNS names of the Key Pair's current certificate.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip_addresses": schema.ListAttribute{
+				Description: "IP addresses to include in the subject alternative name extension of the certificate signing request. Defaults to the IP addresses of the Key Pair's current certificate.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"certificate_request": schema.StringAttribute{
+				Description: "The PEM-encoded PKCS#10 certificate signing request. It is generated again when `private_key_pem_wo_version`, the subject DN or the subject alternative names change.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"signed_certificate_chain": schema.StringAttribute{
+				Description: "PEM-encoded certificate chain signed by a certificate authority, starting with the certificate issued for `certificate_request`. When set, it replaces the `certificate_chain` of the Key Pair in place. The first certificate must use the public key of `private_key_pem_wo`. Removing this value doesn't restore the previous certificate chain.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"certificate_chain": schema.StringAttribute{
+				Description: "The PEM-encoded X.509 certificate chain of the Key Pair.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Determine whether the certificate signing request needs to be generated again, because one of the values used to
+// build it has changed
+func certificateRequestInputsChanged(plan, state keyPairCsrResourceModel) bool {
+	return !plan.PrivateKeyPemWoVersion.Equal(state.PrivateKeyPemWoVersion) || !plan.SubjectDN.Equal(state.SubjectDN) ||
+		!plan.DnsNames.Equal(state.DnsNames) || !plan.IpAddresses.Equal(state.IpAddresses)
+}
+
+// Generate the certificate signing request again when its inputs change, and check during plan that any signed
+// certificate chain uses the public key of the private key
+func (r *keyPairCsrResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan keyPairCsrResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readPrivateKeyPemWo(ctx, req.Config, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if internaltypes.IsDefined(plan.PrivateKeyPemWo) && internaltypes.IsDefined(plan.SignedCertificateChain) {
+		privateKey, err := parsePrivateKeyPEM(plan.PrivateKeyPemWo.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("private_key_pem_wo"), "Invalid private key", err.Error())
+			return
+		}
+		checkSignedCertificateChain(plan.SignedCertificateChain.ValueString(), privateKey, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var state keyPairCsrResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if certificateRequestInputsChanged(plan, state) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("certificate_request"), types.StringUnknown())...)
+	}
+	if internaltypes.IsDefined(plan.SignedCertificateChain) && !plan.SignedCertificateChain.Equal(state.SignedCertificateChain) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("certificate_chain"), types.StringUnknown())...)
+	}
+}
+
+// Read the write-only private key from the config, since it is never included in the plan or state
+func readPrivateKeyPemWo(ctx context.Context, config tfsdk.Config, model *keyPairCsrResourceModel) diag.Diagnostics {
+	return config.GetAttribute(ctx, path.Root("private_key_pem_wo"), &model.PrivateKeyPemWo)
+}
+
+// Check that a signed certificate chain is valid PEM and that its first certificate uses the public key of the
+// private key
+func checkSignedCertificateChain(signedCertificateChain string, privateKey crypto.Signer, diagnostics *diag.Diagnostics) {
+	certs, err := config.ParsePEMCertificates(signedCertificateChain)
+	if err != nil {
+		diagnostics.AddAttributeError(path.Root("signed_certificate_chain"), "Invalid signed certificate chain", err.Error())
+		return
+	}
+	if !certificateMatchesPrivateKey(certs[0], privateKey) {
+		diagnostics.AddAttributeError(path.Root("signed_certificate_chain"), "Invalid signed certificate chain",
+			"The first certificate of the signed certificate chain does not use the public key of private_key_pem_wo. The chain must start with the certificate issued for certificate_request.")
+	}
+}
+
+// Determine if two PEM-encoded certificate chains contain the same certificates, ignoring any differences in the
+// PEM formatting
+func sameCertificateChain(certificateChain, otherCertificateChain string) bool {
+	certs, err := config.ParsePEMCertificates(certificateChain)
+	if err != nil {
+		return certificateChain == otherCertificateChain
+	}
+	otherCerts, err := config.ParsePEMCertificates(otherCertificateChain)
+	if err != nil || len(certs) != len(otherCerts) {
+		return false
+	}
+	for i := range certs {
+		if !certs[i].Equal(otherCerts[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Get a Key Pair and parse the first certificate of its certificate chain
+func (r *keyPairCsrResource) getKeyPairCertificate(ctx context.Context, keyPairName string, diagnostics *diag.Diagnostics) (*client.KeyPairResponse, *x509.Certificate) {
+	readResponse, httpResp, err := r.apiClient.KeyPairAPI.GetKeyPair(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig), keyPairName).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diagnostics, "An error occurred while getting the Key Pair", err, httpResp)
+		return nil, nil
+	}
+
+	// Log response JSON
+	responseJson, err := readResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	certs, err := config.ParsePEMCertificates(readResponse.GetCertificateChain())
+	if err != nil {
+		diagnostics.AddError("Failed to parse the certificate chain of the Key Pair", err.Error())
+		return nil, nil
+	}
+	return readResponse, certs[0]
+}
+
+// Generate the certificate signing request, using the Key Pair's current certificate for any values that aren't configured
+func generateCertificateRequest(ctx context.Context, plan *keyPairCsrResourceModel, privateKey crypto.Signer, cert *x509.Certificate, diagnostics *diag.Diagnostics) {
+	if plan.SubjectDN.IsUnknown() {
+		plan.SubjectDN = types.StringValue(cert.Subject.String())
+	}
+	if plan.DnsNames.IsUnknown() {
+		plan.DnsNames = internaltypes.GetStringList(cert.DNSNames)
+	}
+	if plan.IpAddresses.IsUnknown() {
+		var ipAddresses []string
+		for _, ip := range cert.IPAddresses {
+			ipAddresses = append(ipAddresses, ip.String())
+		}
+		plan.IpAddresses = internaltypes.GetStringList(ipAddresses)
+	}
+
+	var dnsNames, ipAddresses []string
+	diagnostics.Append(plan.DnsNames.ElementsAs(ctx, &dnsNames, false)...)
+	diagnostics.Append(plan.IpAddresses.ElementsAs(ctx, &ipAddresses, false)...)
+	if diagnostics.HasError() {
+		return
+	}
+	certificateRequest, err := createCertificateRequest(privateKey, plan.SubjectDN.ValueString(), dnsNames, ipAddresses)
+	if err != nil {
+		diagnostics.AddError("Failed to generate the certificate signing request", err.Error())
+		return
+	}
+	plan.CertificateRequest = types.StringValue(certificateRequest)
+}
+
+// Replace the certificate chain of the Key Pair with the signed certificate chain
+func (r *keyPairCsrResource) applySignedCertificateChain(ctx context.Context, plan *keyPairCsrResourceModel, diagnostics *diag.Diagnostics) {
+	op := client.NewOperation(client.ENUMOPERATION_REPLACE, "certificate-chain")
+	op.SetValue(plan.SignedCertificateChain.ValueString())
+	ops := []client.Operation{*op}
+	// Log operations
+	operations.LogUpdateOperations(ctx, ops)
+
+	updateRequest := r.apiClient.KeyPairAPI.UpdateKeyPair(config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.KeyPairName.ValueString())
+	updateRequest = updateRequest.UpdateRequest(*client.NewUpdateRequest(ops))
+	updateResponse, httpResp, err := r.apiClient.KeyPairAPI.UpdateKeyPairExecute(updateRequest)
+	if err != nil {
+		config.ReportHttpError(ctx, diagnostics, "An error occurred while updating the certificate chain of the Key Pair", err, httpResp)
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := updateResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Update response: "+string(responseJson))
+	}
+	plan.CertificateChain = types.StringValue(updateResponse.GetCertificateChain())
+}
+
+// Generate the certificate signing request and apply any signed certificate chain, as needed by the plan
+func (r *keyPairCsrResource) applyPlan(ctx context.Context, plan *keyPairCsrResourceModel, diagnostics *diag.Diagnostics) {
+	privateKey, err := parsePrivateKeyPEM(plan.PrivateKeyPemWo.ValueString())
+	if err != nil {
+		diagnostics.AddAttributeError(path.Root("private_key_pem_wo"), "Invalid private key", err.Error())
+		return
+	}
+	keyPair, cert := r.getKeyPairCertificate(ctx, plan.KeyPairName.ValueString(), diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+	plan.Id = plan.KeyPairName
+	plan.CertificateChain = types.StringValue(keyPair.GetCertificateChain())
+
+	// A new certificate signing request is only needed when the key pair still uses the private key
+	if plan.CertificateRequest.IsUnknown() {
+		if !certificateMatchesPrivateKey(cert, privateKey) {
+			diagnostics.AddAttributeError(path.Root("private_key_pem_wo"), "Private key does not match the Key Pair",
+				"The private key does not match the public key of the current certificate of Key Pair '"+plan.KeyPairName.ValueString()+"'.")
+			return
+		}
+		generateCertificateRequest(ctx, plan, privateKey, cert, diagnostics)
+		if diagnostics.HasError() {
+			return
+		}
+	}
+
+	if internaltypes.IsDefined(plan.SignedCertificateChain) && !sameCertificateChain(plan.SignedCertificateChain.ValueString(), keyPair.GetCertificateChain()) {
+		checkSignedCertificateChain(plan.SignedCertificateChain.ValueString(), privateKey, diagnostics)
+		if diagnostics.HasError() {
+			return
+		}
+		r.applySignedCertificateChain(ctx, plan, diagnostics)
+	}
+}
+
+// Create a new resource
+func (r *keyPairCsrResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan keyPairCsrResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(readPrivateKeyPemWo(ctx, req.Config, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyPlan(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Write-only values are never stored in state
+	plan.PrivateKeyPemWo = types.StringNull()
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information
+func (r *keyPairCsrResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state keyPairCsrResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResponse, httpResp, err := r.apiClient.KeyPairAPI.GetKeyPair(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.KeyPairName.ValueString()).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.ReportHttpErrorAsWarning(ctx, &resp.Diagnostics, "An error occurred while getting the Key Pair", err, httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Key Pair", err, httpResp)
+		}
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := readResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	state.CertificateChain = types.StringValue(readResponse.GetCertificateChain())
+	// Plan to apply the signed certificate chain again if the certificate chain was changed outside of Terraform
+	if internaltypes.IsDefined(state.SignedCertificateChain) && !sameCertificateChain(state.SignedCertificateChain.ValueString(), state.CertificateChain.ValueString()) {
+		state.SignedCertificateChain = types.StringNull()
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update a resource
+func (r *keyPairCsrResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan keyPairCsrResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(readPrivateKeyPemWo(ctx, req.Config, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyPlan(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Write-only values are never stored in state
+	plan.PrivateKeyPemWo = types.StringNull()
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the resource from the Terraform state. The Key Pair and its certificate chain are left unchanged.
+func (r *keyPairCsrResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No implementation necessary
+}
@@ -57,6 +57,8 @@ type keyPairDataSourceModel struct {
 	SubjectDN                     types.String `tfsdk:"subject_dn"`
 	CertificateChain              types.String `tfsdk:"certificate_chain"`
 	PrivateKey                    types.String `tfsdk:"private_key"`
+	CertificateDetails            types.Object `tfsdk:"certificate_details"`
+	CertificateChainComplete      types.Bool   `tfsdk:"certificate_chain_complete"`
 }
 
 // GetSchema defines the schema for the datasource.
@@ -103,6 +105,7 @@ func (r *keyPairDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 			},
 		},
 	}
+	config.AddCertificateDetailsDataSourceSchema(&schemaDef, "certificate_chain", true)
 	config.AddCommonDataSourceSchema(&schemaDef, true)
 	resp.Schema = schemaDef
 }
@@ -116,6 +119,7 @@ func readKeyPairResponseDataSource(ctx context.Context, r *client.KeyPairRespons
 	state.SelfSignedCertificateValidity = internaltypes.StringTypeOrNil(r.SelfSignedCertificateValidity, false)
 	state.SubjectDN = internaltypes.StringTypeOrNil(r.SubjectDN, false)
 	state.CertificateChain = internaltypes.StringTypeOrNil(r.CertificateChain, false)
+	state.CertificateDetails, state.CertificateChainComplete = config.ReadCertificateDetails(ctx, r.CertificateChain, diagnostics)
 }
 
 // Read resource information
@@ -5,18 +5,22 @@ package keypair
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/configvalidators"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/operations"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
 	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
@@ -24,12 +28,18 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &keyPairResource{}
-	_ resource.ResourceWithConfigure   = &keyPairResource{}
-	_ resource.ResourceWithImportState = &keyPairResource{}
-	_ resource.Resource                = &defaultKeyPairResource{}
-	_ resource.ResourceWithConfigure   = &defaultKeyPairResource{}
-	_ resource.ResourceWithImportState = &defaultKeyPairResource{}
+	_ resource.Resource                     = &keyPairResource{}
+	_ resource.ResourceWithConfigure        = &keyPairResource{}
+	_ resource.ResourceWithImportState      = &keyPairResource{}
+	_ resource.ResourceWithMoveState        = &keyPairResource{}
+	_ resource.ResourceWithModifyPlan       = &keyPairResource{}
+	_ resource.ResourceWithConfigValidators = &keyPairResource{}
+	_ resource.Resource                     = &defaultKeyPairResource{}
+	_ resource.ResourceWithConfigure        = &defaultKeyPairResource{}
+	_ resource.ResourceWithImportState      = &defaultKeyPairResource{}
+	_ resource.ResourceWithMoveState        = &defaultKeyPairResource{}
+	_ resource.ResourceWithModifyPlan       = &defaultKeyPairResource{}
+	_ resource.ResourceWithConfigValidators = &defaultKeyPairResource{}
 )
 
 // Create a Key Pair resource
@@ -94,6 +104,18 @@ type keyPairResourceModel struct {
 	SubjectDN                     types.String `tfsdk:"subject_dn"`
 	CertificateChain              types.String `tfsdk:"certificate_chain"`
 	PrivateKey                    types.String `tfsdk:"private_key"`
+	PrivateKeyWo                  types.String `tfsdk:"private_key_wo"`
+	PrivateKeyWoVersion           types.Int64  `tfsdk:"private_key_wo_version"`
+	CertificateDetails            types.Object `tfsdk:"certificate_details"`
+	CertificateChainComplete      types.Bool   `tfsdk:"certificate_chain_complete"`
+	ExpirationWarningDays         types.Int64  `tfsdk:"expiration_warning_days"`
+	Pkcs12File                    types.String `tfsdk:"pkcs12_file"`
+	Pkcs12Base64Wo                types.String `tfsdk:"pkcs12_base64_wo"`
+	Pkcs12PasswordWo              types.String `tfsdk:"pkcs12_password_wo"`
+	Pkcs12WoVersion               types.Int64  `tfsdk:"pkcs12_wo_version"`
+	KeystoreType                  types.String `tfsdk:"keystore_type"`
+	KeyAlias                      types.String `tfsdk:"key_alias"`
+	RenewBefore                   types.String `tfsdk:"renew_before"`
 }
 
 // GetSchema defines the schema for the resource.
@@ -156,6 +178,7 @@ func keyPairSchema(ctx context.Context, req resource.SchemaRequest, resp *resour
 			},
 		},
 	}
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "private_key")
 	if isDefault {
 		typeAttr := schemaDef.Attributes["type"].(schema.StringAttribute)
 		typeAttr.Optional = false
@@ -181,11 +204,105 @@ func keyPairSchema(ctx context.Context, req resource.SchemaRequest, resp *resour
 		privateKeyAttr := schemaDef.Attributes["private_key"].(schema.StringAttribute)
 		privateKeyAttr.PlanModifiers = append(privateKeyAttr.PlanModifiers, stringplanmodifier.RequiresReplace())
 		schemaDef.Attributes["private_key"] = privateKeyAttr
+		privateKeyWoVersionAttr := schemaDef.Attributes["private_key_wo_version"].(schema.Int64Attribute)
+		privateKeyWoVersionAttr.PlanModifiers = append(privateKeyWoVersionAttr.PlanModifiers, int64planmodifier.RequiresReplace())
+		schemaDef.Attributes["private_key_wo_version"] = privateKeyWoVersionAttr
 	}
+	addKeystoreSchema(&schemaDef, isDefault)
+	addRenewBeforeSchema(&schemaDef)
+	config.AddCertificateDetailsSchema(&schemaDef, "certificate_chain", true)
 	config.AddCommonResourceSchema(&schemaDef, true)
 	resp.Schema = schemaDef
 }
 
+// Add the attributes used to import the key pair from a PKCS#12 or JKS keystore. These are added after any default
+// resource handling, since they are never returned by PingDirectory and shouldn't be computed. The keystore contents
+// and password are write-only, so that neither the keystore nor anything needed to decode it is stored in state.
+func addKeystoreSchema(s *schema.Schema, isDefault bool) {
+	conflictingAttributes := []path.Expression{
+		path.MatchRoot("certificate_chain"),
+		path.MatchRoot("private_key"),
+		path.MatchRoot("private_key_wo"),
+	}
+	s.Attributes["pkcs12_file"] = schema.StringAttribute{
+		Description: "Path to a PKCS#12 or JKS keystore file on the machine running Terraform to import the certificate chain and private key from. The keystore is decoded by the provider, and the decoded private key is sent to PingDirectory but never stored in the Terraform plan or state.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(append(conflictingAttributes, path.MatchRoot("pkcs12_base64_wo"))...),
+			stringvalidator.AlsoRequires(path.MatchRoot("pkcs12_password_wo")),
+		},
+	}
+	s.Attributes["pkcs12_base64_wo"] = schema.StringAttribute{
+		Description: "Base64-encoded PKCS#12 or JKS keystore to import the certificate chain and private key from, as an alternative to `pkcs12_file`. This value is never stored in the Terraform plan or state. Requires Terraform 1.11 or later.",
+		Optional:    true,
+		Sensitive:   true,
+		WriteOnly:   true,
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(conflictingAttributes...),
+			stringvalidator.AlsoRequires(path.MatchRoot("pkcs12_password_wo")),
+		},
+	}
+	s.Attributes["pkcs12_password_wo"] = schema.StringAttribute{
+		Description: "Password of the keystore in `pkcs12_file` or `pkcs12_base64_wo`. For JKS keystores, this is also used as the password of the private key entry. This value is never stored in the Terraform plan or state. Requires Terraform 1.11 or later.",
+		Optional:    true,
+		Sensitive:   true,
+		WriteOnly:   true,
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(path.MatchRoot("pkcs12_wo_version")),
+		},
+	}
+	versionDescription := "Version of the keystore in `pkcs12_file` or `pkcs12_base64_wo`. Change this version when importing a different keystore."
+	versionPlanModifiers := []planmodifier.Int64{}
+	if !isDefault {
+		versionDescription += " The private key of a Key Pair can't be changed once it is created, so changing this version replaces the Key Pair."
+		versionPlanModifiers = append(versionPlanModifiers, int64planmodifier.RequiresReplace())
+	}
+	s.Attributes["pkcs12_wo_version"] = schema.Int64Attribute{
+		Description: versionDescription,
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRoot("pkcs12_password_wo")),
+		},
+		PlanModifiers: versionPlanModifiers,
+	}
+	s.Attributes["keystore_type"] = schema.StringAttribute{
+		Description: "Format of the keystore in `pkcs12_file` or `pkcs12_base64_wo`. Options are ['PKCS12', 'JKS']. Defaults to PKCS12.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf([]string{keystoreTypePKCS12, keystoreTypeJKS}...),
+		},
+	}
+	s.Attributes["key_alias"] = schema.StringAttribute{
+		Description: "Alias of the private key entry to import from a JKS keystore. Required when the keystore has more than one private key entry. PKCS#12 bundles must have a single private key entry, so this is only supported for JKS keystores.",
+		Optional:    true,
+	}
+}
+
+// Add config validators that apply to both default_ and non-default_
+func configValidatorsKeyPair() []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		configvalidators.Implies(
+			path.MatchRoot("key_alias"),
+			path.MatchRoot("keystore_type"),
+		),
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("key_alias"),
+			path.MatchRoot("keystore_type"),
+			[]string{keystoreTypeJKS},
+		),
+	}
+}
+
+// Add config validators
+func (r keyPairResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return configValidatorsKeyPair()
+}
+
+// Add config validators
+func (r defaultKeyPairResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return configValidatorsKeyPair()
+}
+
 // Add optional fields to create request for key-pair key-pair
 func addOptionalKeyPairFields(ctx context.Context, addRequest *client.AddKeyPairRequest, plan keyPairResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -212,6 +329,19 @@ func addOptionalKeyPairFields(ctx context.Context, addRequest *client.AddKeyPair
 	if internaltypes.IsNonEmptyString(plan.PrivateKey) {
 		addRequest.PrivateKey = plan.PrivateKey.ValueStringPointer()
 	}
+	// Write-only values are read from the config, since they are not included in the plan
+	if internaltypes.IsNonEmptyString(plan.PrivateKeyWo) {
+		addRequest.PrivateKey = plan.PrivateKeyWo.ValueStringPointer()
+	}
+	// The certificate chain and private key are decoded from the keystore when importing one
+	if plan.keystoreConfigured() {
+		keystoreKeyPair, err := plan.readKeystore()
+		if err != nil {
+			return err
+		}
+		addRequest.CertificateChain = &keystoreKeyPair.certificateChain
+		addRequest.PrivateKey = &keystoreKeyPair.privateKey
+	}
 	return nil
 }
 
@@ -245,6 +375,7 @@ func readKeyPairResponse(ctx context.Context, r *client.KeyPairResponse, state *
 		expectedValues.SelfSignedCertificateValidity, state.SelfSignedCertificateValidity, diagnostics)
 	state.SubjectDN = internaltypes.StringTypeOrNil(r.SubjectDN, true)
 	state.CertificateChain = internaltypes.StringTypeOrNil(r.CertificateChain, true)
+	state.CertificateDetails, state.CertificateChainComplete = config.ReadCertificateDetails(ctx, r.CertificateChain, diagnostics)
 	state.Notifications, state.RequiredActions = config.ReadMessages(ctx, r.Urnpingidentityschemasconfigurationmessages20, diagnostics)
 }
 
@@ -254,6 +385,89 @@ func (state *keyPairResourceModel) setStateValuesNotReturnedByAPI(expectedValues
 	if !expectedValues.PrivateKey.IsUnknown() {
 		state.PrivateKey = expectedValues.PrivateKey
 	}
+	if !expectedValues.PrivateKeyWoVersion.IsUnknown() {
+		state.PrivateKeyWoVersion = expectedValues.PrivateKeyWoVersion
+	}
+	if !expectedValues.ExpirationWarningDays.IsUnknown() {
+		state.ExpirationWarningDays = expectedValues.ExpirationWarningDays
+	}
+	if !expectedValues.Pkcs12File.IsUnknown() {
+		state.Pkcs12File = expectedValues.Pkcs12File
+	}
+	if !expectedValues.Pkcs12WoVersion.IsUnknown() {
+		state.Pkcs12WoVersion = expectedValues.Pkcs12WoVersion
+	}
+	if !expectedValues.KeystoreType.IsUnknown() {
+		state.KeystoreType = expectedValues.KeystoreType
+	}
+	if !expectedValues.KeyAlias.IsUnknown() {
+		state.KeyAlias = expectedValues.KeyAlias
+	}
+	if !expectedValues.RenewBefore.IsUnknown() {
+		state.RenewBefore = expectedValues.RenewBefore
+	}
+}
+
+// Plan the certificate chain from any configured keystore or renewal, then parse the planned certificate chain and
+// warn if any certificate in it expires soon
+func (r *keyPairResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planKeystoreCertificateChain(ctx, req, resp, false)
+	planCertificateRenewal(ctx, req, resp)
+	config.PlanCertificateDetails(ctx, req, resp, "certificate_chain", true)
+}
+
+func (r *defaultKeyPairResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planKeystoreCertificateChain(ctx, req, resp, true)
+	planCertificateRenewal(ctx, req, resp)
+	config.PlanCertificateDetails(ctx, req, resp, "certificate_chain", true)
+}
+
+// Decode any configured keystore during plan, so that the certificate chain it will import is shown in the plan and
+// any problem with the keystore is reported before apply. The private key of a key pair can't be changed once it is
+// created, so importing a different key pair from the keystore requires replacing a non-default key pair.
+func planKeystoreCertificateChain(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, isDefault bool) {
+	// Nothing to do when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan keyPairResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(plan.readWriteOnlyValues(ctx, req.Config)...)
+	if resp.Diagnostics.HasError() || (plan.Pkcs12File.IsNull() && plan.Pkcs12Base64Wo.IsNull()) {
+		return
+	}
+	if !plan.keystoreKnown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("certificate_chain"), types.StringUnknown())...)
+		return
+	}
+
+	keystoreKeyPair, err := plan.readKeystore()
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read the keystore for the Key Pair", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("certificate_chain"), keystoreKeyPair.certificateChain)...)
+
+	if !isDefault && !req.State.Raw.IsNull() {
+		var stateCertificateChain types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("certificate_chain"), &stateCertificateChain)...)
+		if stateCertificateChain.ValueString() != keystoreKeyPair.certificateChain {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("certificate_chain"))
+		}
+	}
+}
+
+// Read the write-only values from the config, since they are never included in the plan or state
+func (model *keyPairResourceModel) readWriteOnlyValues(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.Append(config.GetAttribute(ctx, path.Root("private_key_wo"), &model.PrivateKeyWo)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("pkcs12_base64_wo"), &model.Pkcs12Base64Wo)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("pkcs12_password_wo"), &model.Pkcs12PasswordWo)...)
+	return diags
 }
 
 // Create any update operations necessary to make the state match the plan
@@ -264,9 +478,29 @@ func createKeyPairOperations(plan keyPairResourceModel, state keyPairResourceMod
 	operations.AddStringOperationIfNecessary(&ops, plan.SubjectDN, state.SubjectDN, "subject-dn")
 	operations.AddStringOperationIfNecessary(&ops, plan.CertificateChain, state.CertificateChain, "certificate-chain")
 	operations.AddStringOperationIfNecessary(&ops, plan.PrivateKey, state.PrivateKey, "private-key")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.PrivateKeyWo, plan.PrivateKeyWoVersion, state.PrivateKeyWoVersion, "private-key")
 	return ops
 }
 
+// Add an operation to apply the private key decoded from the keystore, when the keystore's certificate chain is
+// being applied
+func addKeystoreOperations(ops *[]client.Operation, plan keyPairResourceModel) error {
+	if !plan.keystoreConfigured() {
+		return nil
+	}
+	if _, ok := operations.GetReplacedValue(*ops, "certificate-chain"); !ok {
+		return nil
+	}
+	keystoreKeyPair, err := plan.readKeystore()
+	if err != nil {
+		return err
+	}
+	op := client.NewOperation(client.ENUMOPERATION_REPLACE, "private-key")
+	op.SetValue(keystoreKeyPair.privateKey)
+	*ops = append(*ops, *op)
+	return nil
+}
+
 // Create a key-pair key-pair
 func (r *keyPairResource) CreateKeyPair(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse, plan keyPairResourceModel) (*keyPairResourceModel, error) {
 	addRequest := client.NewAddKeyPairRequest(plan.Name.ValueString())
@@ -286,7 +520,7 @@ func (r *keyPairResource) CreateKeyPair(ctx context.Context, req resource.Create
 
 	addResponse, httpResp, err := r.apiClient.KeyPairAPI.AddKeyPairExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Key Pair", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Key Pair", err, httpResp)
 		return nil, err
 	}
 
@@ -296,6 +530,11 @@ func (r *keyPairResource) CreateKeyPair(ctx context.Context, req resource.Create
 		tflog.Debug(ctx, "Add response: "+string(responseJson))
 	}
 
+	// Record the applied secret so that changes made outside of Terraform can be detected
+	if addRequest.PrivateKey != nil {
+		config.RecordSecretFingerprint(ctx, resp.Private, "private_key", *addRequest.PrivateKey, config.CertificatePublicKeyEvidence(addResponse.CertificateChain), &resp.Diagnostics)
+	}
+
 	// Read the response into the state
 	var state keyPairResourceModel
 	readKeyPairResponse(ctx, addResponse, &state, &plan, &resp.Diagnostics)
@@ -311,6 +550,12 @@ func (r *keyPairResource) Create(ctx context.Context, req resource.CreateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	diags = plan.readWriteOnlyValues(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	state, err := r.CreateKeyPair(ctx, req, resp, plan)
 	if err != nil {
@@ -319,6 +564,8 @@ func (r *keyPairResource) Create(ctx context.Context, req resource.CreateRequest
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Key Pair", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -339,11 +586,17 @@ func (r *defaultKeyPairResource) Create(ctx context.Context, req resource.Create
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	diags = plan.readWriteOnlyValues(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	readResponse, httpResp, err := r.apiClient.KeyPairAPI.GetKeyPair(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Key Pair", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Key Pair", err, httpResp)
 		return
 	}
 
@@ -360,6 +613,11 @@ func (r *defaultKeyPairResource) Create(ctx context.Context, req resource.Create
 	// Determine what changes are needed to match the plan
 	updateRequest := r.apiClient.KeyPairAPI.UpdateKeyPair(config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString())
 	ops := createKeyPairOperations(plan, state)
+	err = addKeystoreOperations(&ops, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read the keystore for the Key Pair", err.Error())
+		return
+	}
 	if len(ops) > 0 {
 		updateRequest = updateRequest.UpdateRequest(*client.NewUpdateRequest(ops))
 		// Log operations
@@ -367,7 +625,7 @@ func (r *defaultKeyPairResource) Create(ctx context.Context, req resource.Create
 
 		updateResponse, httpResp, err := r.apiClient.KeyPairAPI.UpdateKeyPairExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Key Pair", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Key Pair", err, httpResp)
 			return
 		}
 
@@ -379,6 +637,12 @@ func (r *defaultKeyPairResource) Create(ctx context.Context, req resource.Create
 
 		// Read the response
 		readKeyPairResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+		// Record the applied secret so that changes made outside of Terraform can be detected
+		if privateKey, ok := operations.GetReplacedValue(ops, "private-key"); ok {
+			config.RecordSecretFingerprint(ctx, resp.Private, "private_key", privateKey, config.CertificatePublicKeyEvidence(updateResponse.CertificateChain), &resp.Diagnostics)
+		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Key Pair", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -429,6 +693,12 @@ func readKeyPair(ctx context.Context, req resource.ReadRequest, resp *resource.R
 	// Read the response into the state
 	readKeyPairResponse(ctx, readResponse, &state, &state, &resp.Diagnostics)
 
+	// Plan to apply the secret again if it was changed outside of Terraform
+	if config.SecretChangedOutsideTerraform(ctx, req.Private, "private_key", config.CertificatePublicKeyEvidence(readResponse.CertificateChain), &resp.Diagnostics) {
+		state.PrivateKey = types.StringNull()
+		state.PrivateKeyWoVersion = types.Int64Null()
+	}
+
 	if isDefault {
 		state.populateAllComputedStringAttributes()
 	}
@@ -455,6 +725,12 @@ func updateKeyPair(ctx context.Context, req resource.UpdateRequest, resp *resour
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	diags = plan.readWriteOnlyValues(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Get the current state to see how any attributes are changing
 	var state keyPairResourceModel
@@ -462,8 +738,22 @@ func updateKeyPair(ctx context.Context, req resource.UpdateRequest, resp *resour
 	updateRequest := apiClient.KeyPairAPI.UpdateKeyPair(
 		config.ProviderBasicAuthContext(ctx, providerConfig), plan.Name.ValueString())
 
+	// Renew the certificate first, so that any other changes are applied to the renewed key pair
+	if renewalPlanned(ctx, req.Private, &resp.Diagnostics) {
+		renewKeyPair(ctx, apiClient, providerConfig, plan, &state, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, renewalPlannedPrivateStateKey, nil)...)
+	}
+
 	// Determine what update operations are necessary
 	ops := createKeyPairOperations(plan, state)
+	err := addKeystoreOperations(&ops, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read the keystore for the Key Pair", err.Error())
+		return
+	}
 	if len(ops) > 0 {
 		updateRequest = updateRequest.UpdateRequest(*client.NewUpdateRequest(ops))
 		// Log operations
@@ -471,7 +761,7 @@ func updateKeyPair(ctx context.Context, req resource.UpdateRequest, resp *resour
 
 		updateResponse, httpResp, err := apiClient.KeyPairAPI.UpdateKeyPairExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Key Pair", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Key Pair", err, httpResp)
 			return
 		}
 
@@ -483,6 +773,12 @@ func updateKeyPair(ctx context.Context, req resource.UpdateRequest, resp *resour
 
 		// Read the response
 		readKeyPairResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+		// Record the applied secret so that changes made outside of Terraform can be detected
+		if privateKey, ok := operations.GetReplacedValue(ops, "private-key"); ok {
+			config.RecordSecretFingerprint(ctx, resp.Private, "private_key", privateKey, config.CertificatePublicKeyEvidence(updateResponse.CertificateChain), &resp.Diagnostics)
+		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Key Pair", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -531,3 +827,16 @@ func importKeyPair(ctx context.Context, req resource.ImportStateRequest, resp *r
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *keyPairResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_key_pair"),
+	}
+}
+
+func (r *defaultKeyPairResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_key_pair"),
+	}
+}
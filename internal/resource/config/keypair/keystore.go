@@ -0,0 +1,162 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package keypair
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	keystore "github.com/pavlo-v-chernykh/keystore-go/v4"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// Keystore formats that can be imported into a key pair
+const (
+	keystoreTypePKCS12 = "PKCS12"
+	keystoreTypeJKS    = "JKS"
+)
+
+// Certificate chain and private key decoded from a keystore. These values are only sent to PingDirectory, and the
+// private key is never stored in the plan or state.
+type keystoreKeyPair struct {
+	certificateChain string
+	privateKey       string
+}
+
+// Determine if the key pair should be imported from a keystore
+func (model *keyPairResourceModel) keystoreConfigured() bool {
+	return internaltypes.IsDefined(model.Pkcs12File) || internaltypes.IsDefined(model.Pkcs12Base64Wo)
+}
+
+// Determine if all the values needed to read the keystore are known
+func (model *keyPairResourceModel) keystoreKnown() bool {
+	return !model.Pkcs12File.IsUnknown() && !model.Pkcs12Base64Wo.IsUnknown() && !model.Pkcs12PasswordWo.IsUnknown() &&
+		!model.KeystoreType.IsUnknown() && !model.KeyAlias.IsUnknown()
+}
+
+// Read and decode the configured keystore
+func (model *keyPairResourceModel) readKeystore() (*keystoreKeyPair, error) {
+	var data []byte
+	var err error
+	if internaltypes.IsDefined(model.Pkcs12File) {
+		data, err = os.ReadFile(model.Pkcs12File.ValueString())
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		data, err = base64.StdEncoding.DecodeString(model.Pkcs12Base64Wo.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("pkcs12_base64_wo is not valid base64: %w", err)
+		}
+	}
+
+	if strings.EqualFold(model.KeystoreType.ValueString(), keystoreTypeJKS) {
+		return decodeJKS(data, model.Pkcs12PasswordWo.ValueString(), model.KeyAlias.ValueString())
+	}
+	return decodePKCS12(data, model.Pkcs12PasswordWo.ValueString())
+}
+
+// Decode a PKCS#12 bundle, which must contain a single private key entry
+func decodePKCS12(data []byte, password string) (*keystoreKeyPair, error) {
+	privateKey, cert, caCerts, err := pkcs12.DecodeChain(data, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the PKCS#12 bundle: %w", err)
+	}
+	return encodeKeystoreKeyPair(privateKey, orderCertificateChain(cert, caCerts))
+}
+
+// Decode a JKS keystore, using the private key entry with the given alias. The alias can be left empty when the
+// keystore has only one private key entry.
+func decodeJKS(data []byte, password, alias string) (*keystoreKeyPair, error) {
+	ks := keystore.New()
+	err := ks.Load(bytes.NewReader(data), []byte(password))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the JKS keystore: %w", err)
+	}
+
+	if alias == "" {
+		var privateKeyAliases []string
+		for _, entryAlias := range ks.Aliases() {
+			if ks.IsPrivateKeyEntry(entryAlias) {
+				privateKeyAliases = append(privateKeyAliases, entryAlias)
+			}
+		}
+		if len(privateKeyAliases) != 1 {
+			return nil, fmt.Errorf("the JKS keystore has %d private key entries, so key_alias must be set to one of: %s",
+				len(privateKeyAliases), strings.Join(privateKeyAliases, ", "))
+		}
+		alias = privateKeyAliases[0]
+	}
+
+	entry, err := ks.GetPrivateKeyEntry(alias, []byte(password))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the private key entry '%s' from the JKS keystore: %w", alias, err)
+	}
+	privateKey, err := x509.ParsePKCS8PrivateKey(entry.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the private key entry '%s': %w", alias, err)
+	}
+	var chain []*x509.Certificate
+	for _, entryCert := range entry.CertificateChain {
+		cert, err := x509.ParseCertificate(entryCert.Content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the certificate chain of entry '%s': %w", alias, err)
+		}
+		chain = append(chain, cert)
+	}
+	if len(chain) == 0 {
+		return nil, fmt.Errorf("the private key entry '%s' has no certificate chain", alias)
+	}
+	return encodeKeystoreKeyPair(privateKey, chain)
+}
+
+// Order the CA certificates from a keystore so that each certificate is followed by its issuer, starting from the
+// key pair's certificate. CA certificates that aren't part of the chain are left out.
+func orderCertificateChain(cert *x509.Certificate, caCerts []*x509.Certificate) []*x509.Certificate {
+	chain := []*x509.Certificate{cert}
+	remaining := caCerts
+	for {
+		last := chain[len(chain)-1]
+		if bytes.Equal(last.RawIssuer, last.RawSubject) {
+			return chain
+		}
+		found := false
+		for i, caCert := range remaining {
+			if bytes.Equal(last.RawIssuer, caCert.RawSubject) {
+				chain = append(chain, caCert)
+				remaining = append(remaining[:i:i], remaining[i+1:]...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return chain
+		}
+	}
+}
+
+// Encode a decoded private key and certificate chain as PEM, in the form expected by the add request
+func encodeKeystoreKeyPair(privateKey any, chain []*x509.Certificate) (*keystoreKeyPair, error) {
+	if privateKey == nil {
+		return nil, errors.New("the keystore does not contain a private key")
+	}
+	privateKeyBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	var certificateChain strings.Builder
+	for _, cert := range chain {
+		certificateChain.Write(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+	}
+	return &keystoreKeyPair{
+		certificateChain: certificateChain.String(),
+		privateKey:       string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKeyBytes})),
+	}, nil
+}
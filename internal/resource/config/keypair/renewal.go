@@ -0,0 +1,254 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package keypair
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Durations like "30 d", using the same unit abbreviations as PingDirectory duration properties
+var renewBeforeRegex = regexp.MustCompile(`^(\d+)\s*(ms|s|m|h|d|w)$`)
+
+var renewBeforeUnits = map[string]time.Duration{
+	"ms": time.Millisecond,
+	"s":  time.Second,
+	"m":  time.Minute,
+	"h":  time.Hour,
+	"d":  24 * time.Hour,
+	"w":  7 * 24 * time.Hour,
+}
+
+// Suffix of the name of the temporary key pair generated when renewing a key pair
+const renewalKeyPairSuffix = "-renewal"
+
+// Private state key recording that the plan renews the certificate of the key pair
+const renewalPlannedPrivateStateKey = "certificate_renewal_planned"
+
+// Add the renew_before attribute. It is added after any default resource handling, since it is never returned by
+// PingDirectory and shouldn't be computed.
+func addRenewBeforeSchema(s *schema.Schema) {
+	s.Attributes["renew_before"] = schema.StringAttribute{
+		Description: "Renew the certificate of the Key Pair when it expires within this duration, such as `30 d`. Supported units are ms, s, m, h, d and w. When renewal is due, the plan shows a new certificate chain, and during apply a replacement Key Pair with a new key and self-signed certificate is generated with the same `key_algorithm`, `subject_dn` and `self_signed_certificate_validity`. Config objects that reference the Key Pair, such as access token validators, are repointed to the replacement while the old Key Pair is retired, and the replacement then takes over the original name, so the configuration doesn't need to change. Certificates imported with `certificate_chain` or a keystore can't be renewed by the provider, so a warning is shown instead.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(renewBeforeRegex, "must be a duration such as \"30 d\", using one of the units ms, s, m, h, d or w"),
+		},
+	}
+}
+
+// Parse a renew_before duration
+func parseRenewBefore(renewBefore string) (time.Duration, error) {
+	match := renewBeforeRegex.FindStringSubmatch(renewBefore)
+	if match == nil {
+		return 0, fmt.Errorf("invalid duration '%s'", renewBefore)
+	}
+	value, err := strconv.ParseInt(match[1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(value) * renewBeforeUnits[match[2]], nil
+}
+
+// Determine whether the certificate of the key pair is imported rather than generated by PingDirectory, based on
+// the config. Only generated certificates can be renewed by the provider.
+func certificateImported(ctx context.Context, req resource.ModifyPlanRequest, diagnostics *diag.Diagnostics) bool {
+	for _, attributeName := range []string{"certificate_chain", "private_key", "private_key_wo", "pkcs12_file", "pkcs12_base64_wo"} {
+		var value types.String
+		diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attributeName), &value)...)
+		if !value.IsNull() {
+			return true
+		}
+	}
+	return false
+}
+
+// Plan to renew the certificate of the key pair when it expires within renew_before. The new certificate isn't
+// known until apply, so the planned certificate chain is set to unknown. The decision is recorded in private state,
+// since the certificate chain can also be unknown for other reasons, such as a keystore that isn't known until apply.
+func planCertificateRenewal(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to renew when the resource is being created or destroyed
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+	// Clear any decision left over from a previous plan
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, renewalPlannedPrivateStateKey, nil)...)
+
+	var plan keyPairResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || !internaltypes.IsDefined(plan.RenewBefore) {
+		return
+	}
+	renewBefore, err := parseRenewBefore(plan.RenewBefore.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("renew_before"), "Invalid renew_before", err.Error())
+		return
+	}
+
+	var stateCertificateChain types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("certificate_chain"), &stateCertificateChain)...)
+	if resp.Diagnostics.HasError() || !internaltypes.IsNonEmptyString(stateCertificateChain) {
+		return
+	}
+	certs, err := config.ParsePEMCertificates(stateCertificateChain.ValueString())
+	if err != nil {
+		// Problems parsing the certificate chain are reported with the certificate details
+		return
+	}
+	cert := certs[0]
+	if time.Now().Add(renewBefore).Before(cert.NotAfter) {
+		return
+	}
+
+	notAfter := cert.NotAfter.UTC().Format(time.RFC3339)
+	if certificateImported(ctx, req, &resp.Diagnostics) {
+		resp.Diagnostics.AddAttributeWarning(path.Root("renew_before"), "Key Pair certificate is due for renewal",
+			"The certificate of Key Pair '"+plan.Name.ValueString()+"' expires at "+notAfter+". The certificate was imported, so it can't be renewed by the provider. Import a renewed certificate chain, for example by signing a pingdirectory_key_pair_csr request.")
+		return
+	}
+	if cert.NotAfter.Sub(cert.NotBefore) <= renewBefore {
+		resp.Diagnostics.AddAttributeWarning(path.Root("renew_before"), "Key Pair certificate is renewed on every apply",
+			"The certificate of Key Pair '"+plan.Name.ValueString()+"' is valid for less than renew_before, so every renewed certificate is immediately due for renewal again. Set renew_before to a duration shorter than self_signed_certificate_validity.")
+	}
+	resp.Diagnostics.AddAttributeWarning(path.Root("renew_before"), "Key Pair certificate will be renewed",
+		"The certificate of Key Pair '"+plan.Name.ValueString()+"' expires at "+notAfter+", which is within renew_before. A new key and self-signed certificate will be generated during apply.")
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("certificate_chain"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, renewalPlannedPrivateStateKey, []byte("true"))...)
+}
+
+// Determine whether the plan renews the certificate of the key pair, based on the decision recorded in private
+// state during plan
+func renewalPlanned(ctx context.Context, private config.PrivateState, diagnostics *diag.Diagnostics) bool {
+	value, diags := private.GetKey(ctx, renewalPlannedPrivateStateKey)
+	diagnostics.Append(diags...)
+	return string(value) == "true"
+}
+
+// Config object types that can reference a key pair by name. Key manager providers and connection handlers use
+// keystore files and certificate nicknames rather than key pair config objects, so they don't need to be repointed.
+func keyPairReferencingTypes(apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) []config.ReferencingType {
+	return []config.ReferencingType{
+		{
+			Description: "Access Token Validator",
+			Properties:  []string{"encryptionKeyPair"},
+			List: func(ctx context.Context) ([]map[string]any, *http.Response, error) {
+				return config.ListResponseObjects(apiClient.AccessTokenValidatorAPI.ListAccessTokenValidators(config.ProviderBasicAuthContext(ctx, providerConfig)).Execute())
+			},
+			Update: func(ctx context.Context, id string, ops []client.Operation) (*http.Response, error) {
+				return config.UpdateResult(apiClient.AccessTokenValidatorAPI.UpdateAccessTokenValidator(config.ProviderBasicAuthContext(ctx, providerConfig), id).UpdateRequest(*client.NewUpdateRequest(ops)).Execute())
+			},
+		},
+	}
+}
+
+// Renew the certificate of a key pair generated by PingDirectory. The private key of a key pair can't be changed
+// once it is created, so a replacement key pair is generated with the same settings, the config objects that
+// reference the key pair are repointed to the replacement, and the old key pair is retired. The replacement is then
+// moved back to the original name, so that the Terraform configuration and any references keep using that name.
+func renewKeyPair(ctx context.Context, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration, plan keyPairResourceModel, state *keyPairResourceModel, diagnostics *diag.Diagnostics) {
+	name := plan.Name.ValueString()
+	renewalName := name + renewalKeyPairSuffix
+	referencingTypes := keyPairReferencingTypes(apiClient, providerConfig)
+
+	// Generate the replacement key pair
+	keyAlgorithm, err := client.NewEnumkeyPairKeyAlgorithmPropFromValue(state.KeyAlgorithm.ValueString())
+	if err != nil {
+		diagnostics.AddError("Failed to renew the Key Pair", err.Error())
+		return
+	}
+	replacementRequest := newRenewedKeyPairRequest(renewalName, *keyAlgorithm, plan)
+	replacement := addRenewedKeyPair(ctx, apiClient, providerConfig, replacementRequest, "An error occurred while generating the replacement Key Pair", diagnostics)
+	if replacement == nil {
+		return
+	}
+	if replacement.PrivateKey == nil || replacement.CertificateChain == nil {
+		diagnostics.AddError("Failed to renew the Key Pair", "PingDirectory did not return the private key and certificate chain of the generated Key Pair '"+renewalName+"'.")
+		deleteRenewalKeyPair(ctx, apiClient, providerConfig, renewalName, diagnostics)
+		return
+	}
+
+	// Repoint references to the replacement and retire the old key pair. If either step fails, the references are
+	// moved back and the replacement is removed, leaving the old key pair in place.
+	if !config.RepointReferences(ctx, referencingTypes, name, renewalName, diagnostics) {
+		config.RepointReferences(ctx, referencingTypes, renewalName, name, diagnostics)
+		deleteRenewalKeyPair(ctx, apiClient, providerConfig, renewalName, diagnostics)
+		return
+	}
+	httpResp, err := apiClient.KeyPairAPI.DeleteKeyPairExecute(apiClient.KeyPairAPI.DeleteKeyPair(
+		config.ProviderBasicAuthContext(ctx, providerConfig), name))
+	if err != nil && (httpResp == nil || httpResp.StatusCode != 404) {
+		config.ReportHttpError(ctx, diagnostics, "An error occurred while retiring the Key Pair being renewed", err, httpResp)
+		config.ReportReferences(ctx, referencingTypes, "Key Pair", name, diagnostics)
+		config.RepointReferences(ctx, referencingTypes, renewalName, name, diagnostics)
+		deleteRenewalKeyPair(ctx, apiClient, providerConfig, renewalName, diagnostics)
+		return
+	}
+
+	// Move the replacement key and certificate back to the original name
+	renewedRequest := newRenewedKeyPairRequest(name, *keyAlgorithm, plan)
+	renewedRequest.PrivateKey = replacement.PrivateKey
+	renewedRequest.CertificateChain = replacement.CertificateChain
+	renewed := addRenewedKeyPair(ctx, apiClient, providerConfig, renewedRequest, "An error occurred while creating the renewed Key Pair", diagnostics)
+	if renewed == nil {
+		diagnostics.AddError("Key Pair renewal is incomplete",
+			"The old Key Pair '"+name+"' was retired, and the config objects that referenced it now use the replacement Key Pair '"+renewalName+"'. Apply again to finish the renewal, or rename the replacement manually.")
+		return
+	}
+	if !config.RepointReferences(ctx, referencingTypes, renewalName, name, diagnostics) {
+		diagnostics.AddError("Key Pair renewal is incomplete",
+			"The Key Pair '"+name+"' was renewed, but some config objects still reference the replacement Key Pair '"+renewalName+"'. Update those references to '"+name+"' and delete '"+renewalName+"'.")
+		return
+	}
+	deleteRenewalKeyPair(ctx, apiClient, providerConfig, renewalName, diagnostics)
+
+	readKeyPairResponse(ctx, renewed, state, &plan, diagnostics)
+}
+
+// Build an add request for a key pair generated or moved during renewal, using the settings of the key pair being renewed
+func newRenewedKeyPairRequest(name string, keyAlgorithm client.EnumkeyPairKeyAlgorithmProp, plan keyPairResourceModel) *client.AddKeyPairRequest {
+	addRequest := client.NewAddKeyPairRequest(name)
+	addRequest.KeyAlgorithm = &keyAlgorithm
+	if internaltypes.IsNonEmptyString(plan.SubjectDN) {
+		addRequest.SubjectDN = plan.SubjectDN.ValueStringPointer()
+	}
+	if internaltypes.IsNonEmptyString(plan.SelfSignedCertificateValidity) {
+		addRequest.SelfSignedCertificateValidity = plan.SelfSignedCertificateValidity.ValueStringPointer()
+	}
+	return addRequest
+}
+
+// Add a key pair during renewal. Returns nil if the add fails.
+func addRenewedKeyPair(ctx context.Context, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration, addRequest *client.AddKeyPairRequest, errorSummary string, diagnostics *diag.Diagnostics) *client.KeyPairResponse {
+	apiAddRequest := apiClient.KeyPairAPI.AddKeyPair(config.ProviderBasicAuthContext(ctx, providerConfig))
+	apiAddRequest = apiAddRequest.AddKeyPairRequest(*addRequest)
+	addResponse, httpResp, err := apiClient.KeyPairAPI.AddKeyPairExecute(apiAddRequest)
+	if err != nil {
+		config.ReportHttpError(ctx, diagnostics, errorSummary, err, httpResp)
+		return nil
+	}
+	return addResponse
+}
+
+// Remove the temporary key pair used for renewal
+func deleteRenewalKeyPair(ctx context.Context, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration, renewalName string, diagnostics *diag.Diagnostics) {
+	httpResp, err := apiClient.KeyPairAPI.DeleteKeyPairExecute(apiClient.KeyPairAPI.DeleteKeyPair(
+		config.ProviderBasicAuthContext(ctx, providerConfig), renewalName))
+	if err != nil && (httpResp == nil || httpResp.StatusCode != 404) {
+		config.ReportHttpErrorAsWarning(ctx, diagnostics, "An error occurred while deleting the temporary Key Pair '"+renewalName+"' used for renewal", err, httpResp)
+	}
+}
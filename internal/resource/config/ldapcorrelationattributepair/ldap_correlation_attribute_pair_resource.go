@@ -28,9 +28,11 @@ var (
 	_ resource.Resource                = &ldapCorrelationAttributePairResource{}
 	_ resource.ResourceWithConfigure   = &ldapCorrelationAttributePairResource{}
 	_ resource.ResourceWithImportState = &ldapCorrelationAttributePairResource{}
+	_ resource.ResourceWithMoveState   = &ldapCorrelationAttributePairResource{}
 	_ resource.Resource                = &defaultLdapCorrelationAttributePairResource{}
 	_ resource.ResourceWithConfigure   = &defaultLdapCorrelationAttributePairResource{}
 	_ resource.ResourceWithImportState = &defaultLdapCorrelationAttributePairResource{}
+	_ resource.ResourceWithMoveState   = &defaultLdapCorrelationAttributePairResource{}
 )
 
 // Create a Ldap Correlation Attribute Pair resource
@@ -218,7 +220,7 @@ func (r *ldapCorrelationAttributePairResource) CreateLdapCorrelationAttributePai
 
 	addResponse, httpResp, err := r.apiClient.LdapCorrelationAttributePairAPI.AddLdapCorrelationAttributePairExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Ldap Correlation Attribute Pair", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Ldap Correlation Attribute Pair", err, httpResp)
 		return nil, err
 	}
 
@@ -251,6 +253,8 @@ func (r *ldapCorrelationAttributePairResource) Create(ctx context.Context, req r
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Ldap Correlation Attribute Pair", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -275,7 +279,7 @@ func (r *defaultLdapCorrelationAttributePairResource) Create(ctx context.Context
 	readResponse, httpResp, err := r.apiClient.LdapCorrelationAttributePairAPI.GetLdapCorrelationAttributePair(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString(), plan.CorrelatedLdapDataViewName.ValueString(), plan.ScimResourceTypeName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ldap Correlation Attribute Pair", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Ldap Correlation Attribute Pair", err, httpResp)
 		return
 	}
 
@@ -299,7 +303,7 @@ func (r *defaultLdapCorrelationAttributePairResource) Create(ctx context.Context
 
 		updateResponse, httpResp, err := r.apiClient.LdapCorrelationAttributePairAPI.UpdateLdapCorrelationAttributePairExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Ldap Correlation Attribute Pair", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Ldap Correlation Attribute Pair", err, httpResp)
 			return
 		}
 
@@ -311,6 +315,8 @@ func (r *defaultLdapCorrelationAttributePairResource) Create(ctx context.Context
 
 		// Read the response
 		readLdapCorrelationAttributePairResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Ldap Correlation Attribute Pair", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -403,7 +409,7 @@ func updateLdapCorrelationAttributePair(ctx context.Context, req resource.Update
 
 		updateResponse, httpResp, err := apiClient.LdapCorrelationAttributePairAPI.UpdateLdapCorrelationAttributePairExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Ldap Correlation Attribute Pair", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Ldap Correlation Attribute Pair", err, httpResp)
 			return
 		}
 
@@ -415,6 +421,8 @@ func updateLdapCorrelationAttributePair(ctx context.Context, req resource.Update
 
 		// Read the response
 		readLdapCorrelationAttributePairResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Ldap Correlation Attribute Pair", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -470,3 +478,16 @@ func importLdapCorrelationAttributePair(ctx context.Context, req resource.Import
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("correlated_ldap_data_view_name"), split[1])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), split[2])...)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *ldapCorrelationAttributePairResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_ldap_correlation_attribute_pair"),
+	}
+}
+
+func (r *defaultLdapCorrelationAttributePairResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_ldap_correlation_attribute_pair"),
+	}
+}
@@ -50,10 +50,13 @@ func (r *ldapCorrelationAttributePairsDataSource) Configure(_ context.Context, r
 
 type ldapCorrelationAttributePairsDataSourceModel struct {
 	Id                         types.String `tfsdk:"id"`
-	Filter                     types.String `tfsdk:"filter"`
 	Ids                        types.Set    `tfsdk:"ids"`
+	Objects                    types.Set    `tfsdk:"objects"`
+	ObjectsById                types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes          types.Bool   `tfsdk:"include_attributes"`
 	CorrelatedLdapDataViewName types.String `tfsdk:"correlated_ldap_data_view_name"`
 	ScimResourceTypeName       types.String `tfsdk:"scim_resource_type_name"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
@@ -69,10 +72,6 @@ func (r *ldapCorrelationAttributePairsDataSource) Schema(ctx context.Context, re
 				Description: "Name of the parent SCIM Resource Type",
 				Required:    true,
 			},
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
 			"ids": schema.SetAttribute{
 				Description: "Ldap Correlation Attribute Pair IDs found in the configuration",
 				Required:    false,
@@ -82,6 +81,8 @@ func (r *ldapCorrelationAttributePairsDataSource) Schema(ctx context.Context, re
 			},
 		},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewLdapCorrelationAttributePairDataSource(), "Ldap Correlation Attribute Pair")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -96,9 +97,15 @@ func (r *ldapCorrelationAttributePairsDataSource) Read(ctx context.Context, req
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewLdapCorrelationAttributePairDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.LdapCorrelationAttributePairAPI.ListLdapCorrelationAttributePairs(config.ProviderBasicAuthContext(ctx, r.providerConfig), state.CorrelatedLdapDataViewName.ValueString(), state.ScimResourceTypeName.ValueString())
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.LdapCorrelationAttributePairAPI.ListLdapCorrelationAttributePairsExecute(listRequest)
@@ -115,12 +122,25 @@ func (r *ldapCorrelationAttributePairsDataSource) Read(ctx context.Context, req
 
 	// Read the response into the state
 	ids := []attr.Value{}
+	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
+		var object ldapCorrelationAttributePairDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
+		readLdapCorrelationAttributePairResponseDataSource(ctx, &response, &object, &resp.Diagnostics)
+		object.CorrelatedLdapDataViewName = state.CorrelatedLdapDataViewName
+		object.ScimResourceTypeName = state.ScimResourceTypeName
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
+		}
 		ids = append(ids, types.StringValue(response.Id))
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 
 	state.Ids, diags = types.SetValue(types.StringType, ids)
 	resp.Diagnostics.Append(diags...)
+	state.Objects, diags = types.SetValue(objectType, objects)
+	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
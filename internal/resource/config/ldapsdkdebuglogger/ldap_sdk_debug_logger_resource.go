@@ -384,7 +384,7 @@ func (r *ldapSdkDebugLoggerResource) Create(ctx context.Context, req resource.Cr
 	readResponse, httpResp, err := r.apiClient.LdapSdkDebugLoggerAPI.GetLdapSdkDebugLogger(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig)).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ldap Sdk Debug Logger", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Ldap Sdk Debug Logger", err, httpResp)
 		return
 	}
 
@@ -408,7 +408,7 @@ func (r *ldapSdkDebugLoggerResource) Create(ctx context.Context, req resource.Cr
 
 		updateResponse, httpResp, err := r.apiClient.LdapSdkDebugLoggerAPI.UpdateLdapSdkDebugLoggerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Ldap Sdk Debug Logger", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Ldap Sdk Debug Logger", err, httpResp)
 			return
 		}
 
@@ -420,6 +420,8 @@ func (r *ldapSdkDebugLoggerResource) Create(ctx context.Context, req resource.Cr
 
 		// Read the response
 		readLdapSdkDebugLoggerResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Ldap Sdk Debug Logger", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -485,7 +487,7 @@ func (r *ldapSdkDebugLoggerResource) Update(ctx context.Context, req resource.Up
 
 		updateResponse, httpResp, err := r.apiClient.LdapSdkDebugLoggerAPI.UpdateLdapSdkDebugLoggerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Ldap Sdk Debug Logger", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Ldap Sdk Debug Logger", err, httpResp)
 			return
 		}
 
@@ -497,6 +499,8 @@ func (r *ldapSdkDebugLoggerResource) Update(ctx context.Context, req resource.Up
 
 		// Read the response
 		readLdapSdkDebugLoggerResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Ldap Sdk Debug Logger", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -126,7 +126,7 @@ func (r *licenseResource) Create(ctx context.Context, req resource.CreateRequest
 	readResponse, httpResp, err := r.apiClient.LicenseAPI.GetLicense(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig)).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the License", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the License", err, httpResp)
 		return
 	}
 
@@ -150,7 +150,7 @@ func (r *licenseResource) Create(ctx context.Context, req resource.CreateRequest
 
 		updateResponse, httpResp, err := r.apiClient.LicenseAPI.UpdateLicenseExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the License", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the License", err, httpResp)
 			return
 		}
 
@@ -162,6 +162,8 @@ func (r *licenseResource) Create(ctx context.Context, req resource.CreateRequest
 
 		// Read the response
 		readLicenseResponse(ctx, updateResponse, &state, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "License", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -227,7 +229,7 @@ func (r *licenseResource) Update(ctx context.Context, req resource.UpdateRequest
 
 		updateResponse, httpResp, err := r.apiClient.LicenseAPI.UpdateLicenseExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the License", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the License", err, httpResp)
 			return
 		}
 
@@ -239,6 +241,8 @@ func (r *licenseResource) Update(ctx context.Context, req resource.UpdateRequest
 
 		// Read the response
 		readLicenseResponse(ctx, updateResponse, &state, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "License", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -30,9 +30,11 @@ var (
 	_ resource.Resource                = &localDbCompositeIndexResource{}
 	_ resource.ResourceWithConfigure   = &localDbCompositeIndexResource{}
 	_ resource.ResourceWithImportState = &localDbCompositeIndexResource{}
+	_ resource.ResourceWithMoveState   = &localDbCompositeIndexResource{}
 	_ resource.Resource                = &defaultLocalDbCompositeIndexResource{}
 	_ resource.ResourceWithConfigure   = &defaultLocalDbCompositeIndexResource{}
 	_ resource.ResourceWithImportState = &defaultLocalDbCompositeIndexResource{}
+	_ resource.ResourceWithMoveState   = &defaultLocalDbCompositeIndexResource{}
 )
 
 // Create a Local Db Composite Index resource
@@ -299,7 +301,7 @@ func (r *localDbCompositeIndexResource) CreateLocalDbCompositeIndex(ctx context.
 
 	addResponse, httpResp, err := r.apiClient.LocalDbCompositeIndexAPI.AddLocalDbCompositeIndexExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Local Db Composite Index", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Local Db Composite Index", err, httpResp)
 		return nil, err
 	}
 
@@ -332,6 +334,8 @@ func (r *localDbCompositeIndexResource) Create(ctx context.Context, req resource
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Local Db Composite Index", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -356,7 +360,7 @@ func (r *defaultLocalDbCompositeIndexResource) Create(ctx context.Context, req r
 	readResponse, httpResp, err := r.apiClient.LocalDbCompositeIndexAPI.GetLocalDbCompositeIndex(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString(), plan.BackendName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Local Db Composite Index", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Local Db Composite Index", err, httpResp)
 		return
 	}
 
@@ -380,7 +384,7 @@ func (r *defaultLocalDbCompositeIndexResource) Create(ctx context.Context, req r
 
 		updateResponse, httpResp, err := r.apiClient.LocalDbCompositeIndexAPI.UpdateLocalDbCompositeIndexExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Local Db Composite Index", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Local Db Composite Index", err, httpResp)
 			return
 		}
 
@@ -392,6 +396,8 @@ func (r *defaultLocalDbCompositeIndexResource) Create(ctx context.Context, req r
 
 		// Read the response
 		readLocalDbCompositeIndexResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Local Db Composite Index", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -484,7 +490,7 @@ func updateLocalDbCompositeIndex(ctx context.Context, req resource.UpdateRequest
 
 		updateResponse, httpResp, err := apiClient.LocalDbCompositeIndexAPI.UpdateLocalDbCompositeIndexExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Local Db Composite Index", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Local Db Composite Index", err, httpResp)
 			return
 		}
 
@@ -496,6 +502,8 @@ func updateLocalDbCompositeIndex(ctx context.Context, req resource.UpdateRequest
 
 		// Read the response
 		readLocalDbCompositeIndexResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Local Db Composite Index", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -550,3 +558,16 @@ func importLocalDbCompositeIndex(ctx context.Context, req resource.ImportStateRe
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("backend_name"), split[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), split[1])...)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *localDbCompositeIndexResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_local_db_composite_index"),
+	}
+}
+
+func (r *defaultLocalDbCompositeIndexResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_local_db_composite_index"),
+	}
+}
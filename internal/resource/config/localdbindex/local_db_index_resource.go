@@ -31,9 +31,11 @@ var (
 	_ resource.Resource                = &localDbIndexResource{}
 	_ resource.ResourceWithConfigure   = &localDbIndexResource{}
 	_ resource.ResourceWithImportState = &localDbIndexResource{}
+	_ resource.ResourceWithMoveState   = &localDbIndexResource{}
 	_ resource.Resource                = &defaultLocalDbIndexResource{}
 	_ resource.ResourceWithConfigure   = &defaultLocalDbIndexResource{}
 	_ resource.ResourceWithImportState = &defaultLocalDbIndexResource{}
+	_ resource.ResourceWithMoveState   = &defaultLocalDbIndexResource{}
 )
 
 // Create a Local Db Index resource
@@ -343,7 +345,7 @@ func (r *localDbIndexResource) CreateLocalDbIndex(ctx context.Context, req resou
 
 	addResponse, httpResp, err := r.apiClient.LocalDbIndexAPI.AddLocalDbIndexExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Local Db Index", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Local Db Index", err, httpResp)
 		return nil, err
 	}
 
@@ -376,6 +378,8 @@ func (r *localDbIndexResource) Create(ctx context.Context, req resource.CreateRe
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Local Db Index", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -400,7 +404,7 @@ func (r *defaultLocalDbIndexResource) Create(ctx context.Context, req resource.C
 	readResponse, httpResp, err := r.apiClient.LocalDbIndexAPI.GetLocalDbIndex(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Attribute.ValueString(), plan.BackendName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Local Db Index", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Local Db Index", err, httpResp)
 		return
 	}
 
@@ -424,7 +428,7 @@ func (r *defaultLocalDbIndexResource) Create(ctx context.Context, req resource.C
 
 		updateResponse, httpResp, err := r.apiClient.LocalDbIndexAPI.UpdateLocalDbIndexExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Local Db Index", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Local Db Index", err, httpResp)
 			return
 		}
 
@@ -436,6 +440,8 @@ func (r *defaultLocalDbIndexResource) Create(ctx context.Context, req resource.C
 
 		// Read the response
 		readLocalDbIndexResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Local Db Index", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -528,7 +534,7 @@ func updateLocalDbIndex(ctx context.Context, req resource.UpdateRequest, resp *r
 
 		updateResponse, httpResp, err := apiClient.LocalDbIndexAPI.UpdateLocalDbIndexExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Local Db Index", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Local Db Index", err, httpResp)
 			return
 		}
 
@@ -540,6 +546,8 @@ func updateLocalDbIndex(ctx context.Context, req resource.UpdateRequest, resp *r
 
 		// Read the response
 		readLocalDbIndexResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Local Db Index", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -594,3 +602,16 @@ func importLocalDbIndex(ctx context.Context, req resource.ImportStateRequest, re
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("backend_name"), split[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("attribute"), split[1])...)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *localDbIndexResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_local_db_index"),
+	}
+}
+
+func (r *defaultLocalDbIndexResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_local_db_index"),
+	}
+}
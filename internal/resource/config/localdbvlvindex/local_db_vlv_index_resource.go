@@ -30,9 +30,11 @@ var (
 	_ resource.Resource                = &localDbVlvIndexResource{}
 	_ resource.ResourceWithConfigure   = &localDbVlvIndexResource{}
 	_ resource.ResourceWithImportState = &localDbVlvIndexResource{}
+	_ resource.ResourceWithMoveState   = &localDbVlvIndexResource{}
 	_ resource.Resource                = &defaultLocalDbVlvIndexResource{}
 	_ resource.ResourceWithConfigure   = &defaultLocalDbVlvIndexResource{}
 	_ resource.ResourceWithImportState = &defaultLocalDbVlvIndexResource{}
+	_ resource.ResourceWithMoveState   = &defaultLocalDbVlvIndexResource{}
 )
 
 // Create a Local Db Vlv Index resource
@@ -298,7 +300,7 @@ func (r *localDbVlvIndexResource) CreateLocalDbVlvIndex(ctx context.Context, req
 
 	addResponse, httpResp, err := r.apiClient.LocalDbVlvIndexAPI.AddLocalDbVlvIndexExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Local Db Vlv Index", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Local Db Vlv Index", err, httpResp)
 		return nil, err
 	}
 
@@ -331,6 +333,8 @@ func (r *localDbVlvIndexResource) Create(ctx context.Context, req resource.Creat
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Local Db Vlv Index", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -355,7 +359,7 @@ func (r *defaultLocalDbVlvIndexResource) Create(ctx context.Context, req resourc
 	readResponse, httpResp, err := r.apiClient.LocalDbVlvIndexAPI.GetLocalDbVlvIndex(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString(), plan.BackendName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Local Db Vlv Index", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Local Db Vlv Index", err, httpResp)
 		return
 	}
 
@@ -379,7 +383,7 @@ func (r *defaultLocalDbVlvIndexResource) Create(ctx context.Context, req resourc
 
 		updateResponse, httpResp, err := r.apiClient.LocalDbVlvIndexAPI.UpdateLocalDbVlvIndexExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Local Db Vlv Index", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Local Db Vlv Index", err, httpResp)
 			return
 		}
 
@@ -391,6 +395,8 @@ func (r *defaultLocalDbVlvIndexResource) Create(ctx context.Context, req resourc
 
 		// Read the response
 		readLocalDbVlvIndexResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Local Db Vlv Index", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -483,7 +489,7 @@ func updateLocalDbVlvIndex(ctx context.Context, req resource.UpdateRequest, resp
 
 		updateResponse, httpResp, err := apiClient.LocalDbVlvIndexAPI.UpdateLocalDbVlvIndexExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Local Db Vlv Index", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Local Db Vlv Index", err, httpResp)
 			return
 		}
 
@@ -495,6 +501,8 @@ func updateLocalDbVlvIndex(ctx context.Context, req resource.UpdateRequest, resp
 
 		// Read the response
 		readLocalDbVlvIndexResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Local Db Vlv Index", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -549,3 +557,16 @@ func importLocalDbVlvIndex(ctx context.Context, req resource.ImportStateRequest,
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("backend_name"), split[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), split[1])...)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *localDbVlvIndexResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_local_db_vlv_index"),
+	}
+}
+
+func (r *defaultLocalDbVlvIndexResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_local_db_vlv_index"),
+	}
+}
@@ -27,9 +27,11 @@ var (
 	_ resource.Resource                = &locationResource{}
 	_ resource.ResourceWithConfigure   = &locationResource{}
 	_ resource.ResourceWithImportState = &locationResource{}
+	_ resource.ResourceWithMoveState   = &locationResource{}
 	_ resource.Resource                = &defaultLocationResource{}
 	_ resource.ResourceWithConfigure   = &defaultLocationResource{}
 	_ resource.ResourceWithImportState = &defaultLocationResource{}
+	_ resource.ResourceWithMoveState   = &defaultLocationResource{}
 )
 
 // Create a Location resource
@@ -182,7 +184,7 @@ func (r *locationResource) CreateLocation(ctx context.Context, req resource.Crea
 
 	addResponse, httpResp, err := r.apiClient.LocationAPI.AddLocationExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Location", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Location", err, httpResp)
 		return nil, err
 	}
 
@@ -213,6 +215,8 @@ func (r *locationResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Location", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -237,7 +241,7 @@ func (r *defaultLocationResource) Create(ctx context.Context, req resource.Creat
 	readResponse, httpResp, err := r.apiClient.LocationAPI.GetLocation(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Location", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Location", err, httpResp)
 		return
 	}
 
@@ -261,7 +265,7 @@ func (r *defaultLocationResource) Create(ctx context.Context, req resource.Creat
 
 		updateResponse, httpResp, err := r.apiClient.LocationAPI.UpdateLocationExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Location", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Location", err, httpResp)
 			return
 		}
 
@@ -273,6 +277,8 @@ func (r *defaultLocationResource) Create(ctx context.Context, req resource.Creat
 
 		// Read the response
 		readLocationResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Location", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -364,7 +370,7 @@ func updateLocation(ctx context.Context, req resource.UpdateRequest, resp *resou
 
 		updateResponse, httpResp, err := apiClient.LocationAPI.UpdateLocationExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Location", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Location", err, httpResp)
 			return
 		}
 
@@ -376,6 +382,8 @@ func updateLocation(ctx context.Context, req resource.UpdateRequest, resp *resou
 
 		// Read the response
 		readLocationResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Location", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -423,3 +431,16 @@ func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *locationResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_location"),
+	}
+}
+
+func (r *defaultLocationResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_location"),
+	}
+}
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &logFieldBehaviorResource{}
 	_ resource.ResourceWithConfigure   = &logFieldBehaviorResource{}
 	_ resource.ResourceWithImportState = &logFieldBehaviorResource{}
+	_ resource.ResourceWithMoveState   = &logFieldBehaviorResource{}
 	_ resource.Resource                = &defaultLogFieldBehaviorResource{}
 	_ resource.ResourceWithConfigure   = &defaultLogFieldBehaviorResource{}
 	_ resource.ResourceWithImportState = &defaultLogFieldBehaviorResource{}
+	_ resource.ResourceWithMoveState   = &defaultLogFieldBehaviorResource{}
 )
 
 // Create a Log Field Behavior resource
@@ -599,7 +601,7 @@ func (r *logFieldBehaviorResource) CreateTextAccessLogFieldBehavior(ctx context.
 
 	addResponse, httpResp, err := r.apiClient.LogFieldBehaviorAPI.AddLogFieldBehaviorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Field Behavior", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Field Behavior", err, httpResp)
 		return nil, err
 	}
 
@@ -636,7 +638,7 @@ func (r *logFieldBehaviorResource) CreateJsonFormattedAccessLogFieldBehavior(ctx
 
 	addResponse, httpResp, err := r.apiClient.LogFieldBehaviorAPI.AddLogFieldBehaviorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Field Behavior", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Field Behavior", err, httpResp)
 		return nil, err
 	}
 
@@ -677,6 +679,8 @@ func (r *logFieldBehaviorResource) Create(ctx context.Context, req resource.Crea
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Log Field Behavior", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -701,7 +705,7 @@ func (r *defaultLogFieldBehaviorResource) Create(ctx context.Context, req resour
 	readResponse, httpResp, err := r.apiClient.LogFieldBehaviorAPI.GetLogFieldBehavior(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Log Field Behavior", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Log Field Behavior", err, httpResp)
 		return
 	}
 
@@ -730,7 +734,7 @@ func (r *defaultLogFieldBehaviorResource) Create(ctx context.Context, req resour
 
 		updateResponse, httpResp, err := r.apiClient.LogFieldBehaviorAPI.UpdateLogFieldBehaviorExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Log Field Behavior", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Log Field Behavior", err, httpResp)
 			return
 		}
 
@@ -747,6 +751,8 @@ func (r *defaultLogFieldBehaviorResource) Create(ctx context.Context, req resour
 		if updateResponse.JsonFormattedAccessLogFieldBehaviorResponse != nil {
 			readJsonFormattedAccessLogFieldBehaviorResponse(ctx, updateResponse.JsonFormattedAccessLogFieldBehaviorResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Log Field Behavior", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -843,7 +849,7 @@ func updateLogFieldBehavior(ctx context.Context, req resource.UpdateRequest, res
 
 		updateResponse, httpResp, err := apiClient.LogFieldBehaviorAPI.UpdateLogFieldBehaviorExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Log Field Behavior", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Log Field Behavior", err, httpResp)
 			return
 		}
 
@@ -860,6 +866,8 @@ func updateLogFieldBehavior(ctx context.Context, req resource.UpdateRequest, res
 		if updateResponse.JsonFormattedAccessLogFieldBehaviorResponse != nil {
 			readJsonFormattedAccessLogFieldBehaviorResponse(ctx, updateResponse.JsonFormattedAccessLogFieldBehaviorResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Log Field Behavior", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -907,3 +915,16 @@ func importLogFieldBehavior(ctx context.Context, req resource.ImportStateRequest
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *logFieldBehaviorResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_log_field_behavior"),
+	}
+}
+
+func (r *defaultLogFieldBehaviorResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_log_field_behavior"),
+	}
+}
@@ -27,9 +27,11 @@ var (
 	_ resource.Resource                = &logFieldMappingResource{}
 	_ resource.ResourceWithConfigure   = &logFieldMappingResource{}
 	_ resource.ResourceWithImportState = &logFieldMappingResource{}
+	_ resource.ResourceWithMoveState   = &logFieldMappingResource{}
 	_ resource.Resource                = &defaultLogFieldMappingResource{}
 	_ resource.ResourceWithConfigure   = &defaultLogFieldMappingResource{}
 	_ resource.ResourceWithImportState = &defaultLogFieldMappingResource{}
+	_ resource.ResourceWithMoveState   = &defaultLogFieldMappingResource{}
 )
 
 // Create a Log Field Mapping resource
@@ -1248,7 +1250,7 @@ func (r *logFieldMappingResource) CreateAccessLogFieldMapping(ctx context.Contex
 
 	addResponse, httpResp, err := r.apiClient.LogFieldMappingAPI.AddLogFieldMappingExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Field Mapping", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Field Mapping", err, httpResp)
 		return nil, err
 	}
 
@@ -1281,7 +1283,7 @@ func (r *logFieldMappingResource) CreateErrorLogFieldMapping(ctx context.Context
 
 	addResponse, httpResp, err := r.apiClient.LogFieldMappingAPI.AddLogFieldMappingExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Field Mapping", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Field Mapping", err, httpResp)
 		return nil, err
 	}
 
@@ -1322,6 +1324,8 @@ func (r *logFieldMappingResource) Create(ctx context.Context, req resource.Creat
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Log Field Mapping", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -1346,7 +1350,7 @@ func (r *defaultLogFieldMappingResource) Create(ctx context.Context, req resourc
 	readResponse, httpResp, err := r.apiClient.LogFieldMappingAPI.GetLogFieldMapping(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Log Field Mapping", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Log Field Mapping", err, httpResp)
 		return
 	}
 
@@ -1375,7 +1379,7 @@ func (r *defaultLogFieldMappingResource) Create(ctx context.Context, req resourc
 
 		updateResponse, httpResp, err := r.apiClient.LogFieldMappingAPI.UpdateLogFieldMappingExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Log Field Mapping", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Log Field Mapping", err, httpResp)
 			return
 		}
 
@@ -1392,6 +1396,8 @@ func (r *defaultLogFieldMappingResource) Create(ctx context.Context, req resourc
 		if updateResponse.ErrorLogFieldMappingResponse != nil {
 			readErrorLogFieldMappingResponse(ctx, updateResponse.ErrorLogFieldMappingResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Log Field Mapping", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -1488,7 +1494,7 @@ func updateLogFieldMapping(ctx context.Context, req resource.UpdateRequest, resp
 
 		updateResponse, httpResp, err := apiClient.LogFieldMappingAPI.UpdateLogFieldMappingExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Log Field Mapping", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Log Field Mapping", err, httpResp)
 			return
 		}
 
@@ -1505,6 +1511,8 @@ func updateLogFieldMapping(ctx context.Context, req resource.UpdateRequest, resp
 		if updateResponse.ErrorLogFieldMappingResponse != nil {
 			readErrorLogFieldMappingResponse(ctx, updateResponse.ErrorLogFieldMappingResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Log Field Mapping", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -1552,3 +1560,16 @@ func importLogFieldMapping(ctx context.Context, req resource.ImportStateRequest,
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *logFieldMappingResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_log_field_mapping"),
+	}
+}
+
+func (r *defaultLogFieldMappingResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_log_field_mapping"),
+	}
+}
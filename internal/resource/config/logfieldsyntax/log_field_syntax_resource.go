@@ -260,7 +260,7 @@ func (r *logFieldSyntaxResource) Create(ctx context.Context, req resource.Create
 	readResponse, httpResp, err := r.apiClient.LogFieldSyntaxAPI.GetLogFieldSyntax(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Log Field Syntax", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Log Field Syntax", err, httpResp)
 		return
 	}
 
@@ -292,7 +292,7 @@ func (r *logFieldSyntaxResource) Create(ctx context.Context, req resource.Create
 
 		updateResponse, httpResp, err := r.apiClient.LogFieldSyntaxAPI.UpdateLogFieldSyntaxExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Log Field Syntax", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Log Field Syntax", err, httpResp)
 			return
 		}
 
@@ -312,6 +312,8 @@ func (r *logFieldSyntaxResource) Create(ctx context.Context, req resource.Create
 		if updateResponse.GenericLogFieldSyntaxResponse != nil {
 			readGenericLogFieldSyntaxResponse(ctx, updateResponse.GenericLogFieldSyntaxResponse, &state, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Log Field Syntax", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -385,7 +387,7 @@ func (r *logFieldSyntaxResource) Update(ctx context.Context, req resource.Update
 
 		updateResponse, httpResp, err := r.apiClient.LogFieldSyntaxAPI.UpdateLogFieldSyntaxExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Log Field Syntax", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Log Field Syntax", err, httpResp)
 			return
 		}
 
@@ -405,6 +407,8 @@ func (r *logFieldSyntaxResource) Update(ctx context.Context, req resource.Update
 		if updateResponse.GenericLogFieldSyntaxResponse != nil {
 			readGenericLogFieldSyntaxResponse(ctx, updateResponse.GenericLogFieldSyntaxResponse, &state, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Log Field Syntax", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
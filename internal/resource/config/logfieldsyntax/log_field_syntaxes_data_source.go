@@ -49,29 +49,21 @@ func (r *logFieldSyntaxesDataSource) Configure(_ context.Context, req datasource
 }
 
 type logFieldSyntaxesDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *logFieldSyntaxesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Log Field Syntax objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Log Field Syntax objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewLogFieldSyntaxDataSource(), "Log Field Syntax")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *logFieldSyntaxesDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewLogFieldSyntaxDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.LogFieldSyntaxAPI.ListLogFieldSyntaxes(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.LogFieldSyntaxAPI.ListLogFieldSyntaxesExecute(listRequest)
@@ -106,29 +104,29 @@ func (r *logFieldSyntaxesDataSource) Read(ctx context.Context, req datasource.Re
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object logFieldSyntaxDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.JsonLogFieldSyntaxResponse != nil {
-			attributes["id"] = types.StringValue(response.JsonLogFieldSyntaxResponse.Id)
-			attributes["type"] = types.StringValue("json")
+			readJsonLogFieldSyntaxResponseDataSource(ctx, response.JsonLogFieldSyntaxResponse, &object, &resp.Diagnostics)
 		}
 		if response.AttributeBasedLogFieldSyntaxResponse != nil {
-			attributes["id"] = types.StringValue(response.AttributeBasedLogFieldSyntaxResponse.Id)
-			attributes["type"] = types.StringValue("attribute-based")
+			readAttributeBasedLogFieldSyntaxResponseDataSource(ctx, response.AttributeBasedLogFieldSyntaxResponse, &object, &resp.Diagnostics)
 		}
 		if response.GenericLogFieldSyntaxResponse != nil {
-			attributes["id"] = types.StringValue(response.GenericLogFieldSyntaxResponse.Id)
-			attributes["type"] = types.StringValue("generic")
+			readGenericLogFieldSyntaxResponseDataSource(ctx, response.GenericLogFieldSyntaxResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
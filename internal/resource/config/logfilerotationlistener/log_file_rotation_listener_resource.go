@@ -29,9 +29,11 @@ var (
 	_ resource.Resource                = &logFileRotationListenerResource{}
 	_ resource.ResourceWithConfigure   = &logFileRotationListenerResource{}
 	_ resource.ResourceWithImportState = &logFileRotationListenerResource{}
+	_ resource.ResourceWithMoveState   = &logFileRotationListenerResource{}
 	_ resource.Resource                = &defaultLogFileRotationListenerResource{}
 	_ resource.ResourceWithConfigure   = &defaultLogFileRotationListenerResource{}
 	_ resource.ResourceWithImportState = &defaultLogFileRotationListenerResource{}
+	_ resource.ResourceWithMoveState   = &defaultLogFileRotationListenerResource{}
 )
 
 // Create a Log File Rotation Listener resource
@@ -576,7 +578,7 @@ func (r *logFileRotationListenerResource) CreateUploadToS3LogFileRotationListene
 
 	addResponse, httpResp, err := r.apiClient.LogFileRotationListenerAPI.AddLogFileRotationListenerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log File Rotation Listener", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log File Rotation Listener", err, httpResp)
 		return nil, err
 	}
 
@@ -610,7 +612,7 @@ func (r *logFileRotationListenerResource) CreateSummarizeLogFileRotationListener
 
 	addResponse, httpResp, err := r.apiClient.LogFileRotationListenerAPI.AddLogFileRotationListenerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log File Rotation Listener", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log File Rotation Listener", err, httpResp)
 		return nil, err
 	}
 
@@ -645,7 +647,7 @@ func (r *logFileRotationListenerResource) CreateCopyLogFileRotationListener(ctx
 
 	addResponse, httpResp, err := r.apiClient.LogFileRotationListenerAPI.AddLogFileRotationListenerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log File Rotation Listener", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log File Rotation Listener", err, httpResp)
 		return nil, err
 	}
 
@@ -680,7 +682,7 @@ func (r *logFileRotationListenerResource) CreateThirdPartyLogFileRotationListene
 
 	addResponse, httpResp, err := r.apiClient.LogFileRotationListenerAPI.AddLogFileRotationListenerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log File Rotation Listener", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log File Rotation Listener", err, httpResp)
 		return nil, err
 	}
 
@@ -733,6 +735,8 @@ func (r *logFileRotationListenerResource) Create(ctx context.Context, req resour
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Log File Rotation Listener", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -757,7 +761,7 @@ func (r *defaultLogFileRotationListenerResource) Create(ctx context.Context, req
 	readResponse, httpResp, err := r.apiClient.LogFileRotationListenerAPI.GetLogFileRotationListener(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Log File Rotation Listener", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Log File Rotation Listener", err, httpResp)
 		return
 	}
 
@@ -792,7 +796,7 @@ func (r *defaultLogFileRotationListenerResource) Create(ctx context.Context, req
 
 		updateResponse, httpResp, err := r.apiClient.LogFileRotationListenerAPI.UpdateLogFileRotationListenerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Log File Rotation Listener", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Log File Rotation Listener", err, httpResp)
 			return
 		}
 
@@ -815,6 +819,8 @@ func (r *defaultLogFileRotationListenerResource) Create(ctx context.Context, req
 		if updateResponse.ThirdPartyLogFileRotationListenerResponse != nil {
 			readThirdPartyLogFileRotationListenerResponse(ctx, updateResponse.ThirdPartyLogFileRotationListenerResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Log File Rotation Listener", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -917,7 +923,7 @@ func updateLogFileRotationListener(ctx context.Context, req resource.UpdateReque
 
 		updateResponse, httpResp, err := apiClient.LogFileRotationListenerAPI.UpdateLogFileRotationListenerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Log File Rotation Listener", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Log File Rotation Listener", err, httpResp)
 			return
 		}
 
@@ -940,6 +946,8 @@ func updateLogFileRotationListener(ctx context.Context, req resource.UpdateReque
 		if updateResponse.ThirdPartyLogFileRotationListenerResponse != nil {
 			readThirdPartyLogFileRotationListenerResponse(ctx, updateResponse.ThirdPartyLogFileRotationListenerResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Log File Rotation Listener", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -987,3 +995,16 @@ func importLogFileRotationListener(ctx context.Context, req resource.ImportState
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *logFileRotationListenerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_log_file_rotation_listener"),
+	}
+}
+
+func (r *defaultLogFileRotationListenerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_log_file_rotation_listener"),
+	}
+}
@@ -49,29 +49,21 @@ func (r *logFileRotationListenersDataSource) Configure(_ context.Context, req da
 }
 
 type logFileRotationListenersDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *logFileRotationListenersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Log File Rotation Listener objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Log File Rotation Listener objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewLogFileRotationListenerDataSource(), "Log File Rotation Listener")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *logFileRotationListenersDataSource) Read(ctx context.Context, req datas
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewLogFileRotationListenerDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.LogFileRotationListenerAPI.ListLogFileRotationListeners(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.LogFileRotationListenerAPI.ListLogFileRotationListenersExecute(listRequest)
@@ -106,33 +104,32 @@ func (r *logFileRotationListenersDataSource) Read(ctx context.Context, req datas
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object logFileRotationListenerDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.UploadToS3LogFileRotationListenerResponse != nil {
-			attributes["id"] = types.StringValue(response.UploadToS3LogFileRotationListenerResponse.Id)
-			attributes["type"] = types.StringValue("upload-to-s3")
+			readUploadToS3LogFileRotationListenerResponseDataSource(ctx, response.UploadToS3LogFileRotationListenerResponse, &object, &resp.Diagnostics)
 		}
 		if response.SummarizeLogFileRotationListenerResponse != nil {
-			attributes["id"] = types.StringValue(response.SummarizeLogFileRotationListenerResponse.Id)
-			attributes["type"] = types.StringValue("summarize")
+			readSummarizeLogFileRotationListenerResponseDataSource(ctx, response.SummarizeLogFileRotationListenerResponse, &object, &resp.Diagnostics)
 		}
 		if response.CopyLogFileRotationListenerResponse != nil {
-			attributes["id"] = types.StringValue(response.CopyLogFileRotationListenerResponse.Id)
-			attributes["type"] = types.StringValue("copy")
+			readCopyLogFileRotationListenerResponseDataSource(ctx, response.CopyLogFileRotationListenerResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyLogFileRotationListenerResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyLogFileRotationListenerResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyLogFileRotationListenerResponseDataSource(ctx, response.ThirdPartyLogFileRotationListenerResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -33,9 +33,11 @@ var (
 	_ resource.Resource                = &logPublisherResource{}
 	_ resource.ResourceWithConfigure   = &logPublisherResource{}
 	_ resource.ResourceWithImportState = &logPublisherResource{}
+	_ resource.ResourceWithMoveState   = &logPublisherResource{}
 	_ resource.Resource                = &defaultLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultLogPublisherResource{}
+	_ resource.ResourceWithMoveState   = &defaultLogPublisherResource{}
 )
 
 // Create a Log Publisher resource
@@ -14631,7 +14633,7 @@ func (r *logPublisherResource) CreateSyslogJsonAuditLogPublisher(ctx context.Con
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -14669,7 +14671,7 @@ func (r *logPublisherResource) CreateSyslogBasedErrorLogPublisher(ctx context.Co
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -14709,7 +14711,7 @@ func (r *logPublisherResource) CreateThirdPartyFileBasedAccessLogPublisher(ctx c
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -14748,7 +14750,7 @@ func (r *logPublisherResource) CreateOperationTimingAccessLogPublisher(ctx conte
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -14787,7 +14789,7 @@ func (r *logPublisherResource) CreateThirdPartyHttpOperationLogPublisher(ctx con
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -14825,7 +14827,7 @@ func (r *logPublisherResource) CreateAdminAlertAccessLogPublisher(ctx context.Co
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -14864,7 +14866,7 @@ func (r *logPublisherResource) CreateFileBasedTraceLogPublisher(ctx context.Cont
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -14904,7 +14906,7 @@ func (r *logPublisherResource) CreateJdbcBasedErrorLogPublisher(ctx context.Cont
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -14944,7 +14946,7 @@ func (r *logPublisherResource) CreateJdbcBasedAccessLogPublisher(ctx context.Con
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -14983,7 +14985,7 @@ func (r *logPublisherResource) CreateCommonLogFileHttpOperationLogPublisher(ctx
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -15024,7 +15026,7 @@ func (r *logPublisherResource) CreateSyslogTextErrorLogPublisher(ctx context.Con
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -15062,7 +15064,7 @@ func (r *logPublisherResource) CreateSyslogBasedAccessLogPublisher(ctx context.C
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -15101,7 +15103,7 @@ func (r *logPublisherResource) CreateFileBasedJsonAuditLogPublisher(ctx context.
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -15140,7 +15142,7 @@ func (r *logPublisherResource) CreateFileBasedDebugLogPublisher(ctx context.Cont
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -15179,7 +15181,7 @@ func (r *logPublisherResource) CreateFileBasedErrorLogPublisher(ctx context.Cont
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -15218,7 +15220,7 @@ func (r *logPublisherResource) CreateThirdPartyErrorLogPublisher(ctx context.Con
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -15259,7 +15261,7 @@ func (r *logPublisherResource) CreateSyslogTextAccessLogPublisher(ctx context.Co
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -15298,7 +15300,7 @@ func (r *logPublisherResource) CreateDetailedHttpOperationLogPublisher(ctx conte
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -15337,7 +15339,7 @@ func (r *logPublisherResource) CreateJsonAccessLogPublisher(ctx context.Context,
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -15376,7 +15378,7 @@ func (r *logPublisherResource) CreateDebugAccessLogPublisher(ctx context.Context
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -15417,7 +15419,7 @@ func (r *logPublisherResource) CreateSyslogJsonHttpOperationLogPublisher(ctx con
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -15456,7 +15458,7 @@ func (r *logPublisherResource) CreateThirdPartyAccessLogPublisher(ctx context.Co
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -15495,7 +15497,7 @@ func (r *logPublisherResource) CreateFileBasedAuditLogPublisher(ctx context.Cont
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -15534,7 +15536,7 @@ func (r *logPublisherResource) CreateJsonErrorLogPublisher(ctx context.Context,
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -15574,7 +15576,7 @@ func (r *logPublisherResource) CreateGroovyScriptedFileBasedAccessLogPublisher(c
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -15614,7 +15616,7 @@ func (r *logPublisherResource) CreateGroovyScriptedFileBasedErrorLogPublisher(ct
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -15655,7 +15657,7 @@ func (r *logPublisherResource) CreateSyslogJsonAccessLogPublisher(ctx context.Co
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -15694,7 +15696,7 @@ func (r *logPublisherResource) CreateGroovyScriptedAccessLogPublisher(ctx contex
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -15734,7 +15736,7 @@ func (r *logPublisherResource) CreateThirdPartyFileBasedErrorLogPublisher(ctx co
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -15772,7 +15774,7 @@ func (r *logPublisherResource) CreateConsoleJsonAuditLogPublisher(ctx context.Co
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -15810,7 +15812,7 @@ func (r *logPublisherResource) CreateConsoleJsonHttpOperationLogPublisher(ctx co
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -15849,7 +15851,7 @@ func (r *logPublisherResource) CreateFileBasedAccessLogPublisher(ctx context.Con
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -15888,7 +15890,7 @@ func (r *logPublisherResource) CreateGroovyScriptedErrorLogPublisher(ctx context
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -15927,7 +15929,7 @@ func (r *logPublisherResource) CreateFileBasedJsonHttpOperationLogPublisher(ctx
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -15968,7 +15970,7 @@ func (r *logPublisherResource) CreateSyslogJsonErrorLogPublisher(ctx context.Con
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -16007,7 +16009,7 @@ func (r *logPublisherResource) CreateGroovyScriptedHttpOperationLogPublisher(ctx
 
 	addResponse, httpResp, err := r.apiClient.LogPublisherAPI.AddLogPublisherExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Publisher", err, httpResp)
 		return nil, err
 	}
 
@@ -16252,6 +16254,8 @@ func (r *logPublisherResource) Create(ctx context.Context, req resource.CreateRe
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Log Publisher", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -16276,7 +16280,7 @@ func (r *defaultLogPublisherResource) Create(ctx context.Context, req resource.C
 	readResponse, httpResp, err := r.apiClient.LogPublisherAPI.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Log Publisher", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Log Publisher", err, httpResp)
 		return
 	}
 
@@ -16413,7 +16417,7 @@ func (r *defaultLogPublisherResource) Create(ctx context.Context, req resource.C
 
 		updateResponse, httpResp, err := r.apiClient.LogPublisherAPI.UpdateLogPublisherExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Log Publisher", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Log Publisher", err, httpResp)
 			return
 		}
 
@@ -16538,6 +16542,8 @@ func (r *defaultLogPublisherResource) Create(ctx context.Context, req resource.C
 		if updateResponse.GroovyScriptedHttpOperationLogPublisherResponse != nil {
 			readGroovyScriptedHttpOperationLogPublisherResponse(ctx, updateResponse.GroovyScriptedHttpOperationLogPublisherResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Log Publisher", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -16742,7 +16748,7 @@ func updateLogPublisher(ctx context.Context, req resource.UpdateRequest, resp *r
 
 		updateResponse, httpResp, err := apiClient.LogPublisherAPI.UpdateLogPublisherExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Log Publisher", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Log Publisher", err, httpResp)
 			return
 		}
 
@@ -16867,6 +16873,8 @@ func updateLogPublisher(ctx context.Context, req resource.UpdateRequest, resp *r
 		if updateResponse.GroovyScriptedHttpOperationLogPublisherResponse != nil {
 			readGroovyScriptedHttpOperationLogPublisherResponse(ctx, updateResponse.GroovyScriptedHttpOperationLogPublisherResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Log Publisher", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -16914,3 +16922,16 @@ func importLogPublisher(ctx context.Context, req resource.ImportStateRequest, re
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *logPublisherResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_log_publisher"),
+	}
+}
+
+func (r *defaultLogPublisherResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_log_publisher"),
+	}
+}
@@ -49,29 +49,21 @@ func (r *logPublishersDataSource) Configure(_ context.Context, req datasource.Co
 }
 
 type logPublishersDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *logPublishersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Log Publisher objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Log Publisher objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewLogPublisherDataSource(), "Log Publisher")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *logPublishersDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewLogPublisherDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.LogPublisherAPI.ListLogPublishers(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.LogPublisherAPI.ListLogPublishersExecute(listRequest)
@@ -106,213 +104,178 @@ func (r *logPublishersDataSource) Read(ctx context.Context, req datasource.ReadR
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object logPublisherDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.SyslogJsonAuditLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.SyslogJsonAuditLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("syslog-json-audit")
+			readSyslogJsonAuditLogPublisherResponseDataSource(ctx, response.SyslogJsonAuditLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.SyslogBasedErrorLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.SyslogBasedErrorLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("syslog-based-error")
+			readSyslogBasedErrorLogPublisherResponseDataSource(ctx, response.SyslogBasedErrorLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyHttpOperationLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyHttpOperationLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("third-party-http-operation")
+			readThirdPartyHttpOperationLogPublisherResponseDataSource(ctx, response.ThirdPartyHttpOperationLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.FileBasedTraceLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.FileBasedTraceLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("file-based-trace")
+			readFileBasedTraceLogPublisherResponseDataSource(ctx, response.FileBasedTraceLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.JdbcBasedAccessLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.JdbcBasedAccessLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("jdbc-based-access")
+			readJdbcBasedAccessLogPublisherResponseDataSource(ctx, response.JdbcBasedAccessLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.SyslogTextErrorLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.SyslogTextErrorLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("syslog-text-error")
+			readSyslogTextErrorLogPublisherResponseDataSource(ctx, response.SyslogTextErrorLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyPolicyDecisionLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyPolicyDecisionLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("third-party-policy-decision")
+			object.Id = types.StringValue(response.ThirdPartyPolicyDecisionLogPublisherResponse.Id)
+			object.Type = types.StringValue("third-party-policy-decision")
 		}
 		if response.SyslogBasedAccessLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.SyslogBasedAccessLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("syslog-based-access")
+			readSyslogBasedAccessLogPublisherResponseDataSource(ctx, response.SyslogBasedAccessLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.FileBasedDebugLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.FileBasedDebugLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("file-based-debug")
+			readFileBasedDebugLogPublisherResponseDataSource(ctx, response.FileBasedDebugLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.ConsoleJsonSyncFailedOpsLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.ConsoleJsonSyncFailedOpsLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("console-json-sync-failed-ops")
+			object.Id = types.StringValue(response.ConsoleJsonSyncFailedOpsLogPublisherResponse.Id)
+			object.Type = types.StringValue("console-json-sync-failed-ops")
 		}
 		if response.FileBasedErrorLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.FileBasedErrorLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("file-based-error")
+			readFileBasedErrorLogPublisherResponseDataSource(ctx, response.FileBasedErrorLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.ConsoleJsonSyncLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.ConsoleJsonSyncLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("console-json-sync")
+			object.Id = types.StringValue(response.ConsoleJsonSyncLogPublisherResponse.Id)
+			object.Type = types.StringValue("console-json-sync")
 		}
 		if response.FileBasedPolicyDecisionLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.FileBasedPolicyDecisionLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("file-based-policy-decision")
+			object.Id = types.StringValue(response.FileBasedPolicyDecisionLogPublisherResponse.Id)
+			object.Type = types.StringValue("file-based-policy-decision")
 		}
 		if response.DebugAccessLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.DebugAccessLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("debug-access")
+			readDebugAccessLogPublisherResponseDataSource(ctx, response.DebugAccessLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.SyncFailedOpsLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.SyncFailedOpsLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("sync-failed-ops")
+			object.Id = types.StringValue(response.SyncFailedOpsLogPublisherResponse.Id)
+			object.Type = types.StringValue("sync-failed-ops")
 		}
 		if response.ThirdPartyAccessLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyAccessLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("third-party-access")
+			readThirdPartyAccessLogPublisherResponseDataSource(ctx, response.ThirdPartyAccessLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.FileBasedAuditLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.FileBasedAuditLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("file-based-audit")
+			readFileBasedAuditLogPublisherResponseDataSource(ctx, response.FileBasedAuditLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.JsonErrorLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.JsonErrorLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("json-error")
+			readJsonErrorLogPublisherResponseDataSource(ctx, response.JsonErrorLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.SyslogJsonSyncLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.SyslogJsonSyncLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("syslog-json-sync")
+			object.Id = types.StringValue(response.SyslogJsonSyncLogPublisherResponse.Id)
+			object.Type = types.StringValue("syslog-json-sync")
 		}
 		if response.GroovyScriptedFileBasedAccessLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.GroovyScriptedFileBasedAccessLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("groovy-scripted-file-based-access")
+			readGroovyScriptedFileBasedAccessLogPublisherResponseDataSource(ctx, response.GroovyScriptedFileBasedAccessLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.SyslogJsonAccessLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.SyslogJsonAccessLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("syslog-json-access")
+			readSyslogJsonAccessLogPublisherResponseDataSource(ctx, response.SyslogJsonAccessLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.SyslogJsonSyncFailedOpsLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.SyslogJsonSyncFailedOpsLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("syslog-json-sync-failed-ops")
+			object.Id = types.StringValue(response.SyslogJsonSyncFailedOpsLogPublisherResponse.Id)
+			object.Type = types.StringValue("syslog-json-sync-failed-ops")
 		}
 		if response.GroovyScriptedAccessLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.GroovyScriptedAccessLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("groovy-scripted-access")
+			readGroovyScriptedAccessLogPublisherResponseDataSource(ctx, response.GroovyScriptedAccessLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.ConsoleJsonHttpOperationLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.ConsoleJsonHttpOperationLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("console-json-http-operation")
+			readConsoleJsonHttpOperationLogPublisherResponseDataSource(ctx, response.ConsoleJsonHttpOperationLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.FileBasedAccessLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.FileBasedAccessLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("file-based-access")
+			readFileBasedAccessLogPublisherResponseDataSource(ctx, response.FileBasedAccessLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.FileBasedSyncLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.FileBasedSyncLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("file-based-sync")
+			object.Id = types.StringValue(response.FileBasedSyncLogPublisherResponse.Id)
+			object.Type = types.StringValue("file-based-sync")
 		}
 		if response.SyslogJsonErrorLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.SyslogJsonErrorLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("syslog-json-error")
+			readSyslogJsonErrorLogPublisherResponseDataSource(ctx, response.SyslogJsonErrorLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyFileBasedAccessLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyFileBasedAccessLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("third-party-file-based-access")
+			readThirdPartyFileBasedAccessLogPublisherResponseDataSource(ctx, response.ThirdPartyFileBasedAccessLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.OperationTimingAccessLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.OperationTimingAccessLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("operation-timing-access")
+			readOperationTimingAccessLogPublisherResponseDataSource(ctx, response.OperationTimingAccessLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.AdminAlertAccessLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.AdminAlertAccessLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("admin-alert-access")
+			readAdminAlertAccessLogPublisherResponseDataSource(ctx, response.AdminAlertAccessLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.JdbcBasedErrorLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.JdbcBasedErrorLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("jdbc-based-error")
+			readJdbcBasedErrorLogPublisherResponseDataSource(ctx, response.JdbcBasedErrorLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.CommonLogFileHttpOperationLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.CommonLogFileHttpOperationLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("common-log-file-http-operation")
+			readCommonLogFileHttpOperationLogPublisherResponseDataSource(ctx, response.CommonLogFileHttpOperationLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.ConsoleJsonErrorLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.ConsoleJsonErrorLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("console-json-error")
+			readConsoleJsonErrorLogPublisherResponseDataSource(ctx, response.ConsoleJsonErrorLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.FileBasedPolicyQueryLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.FileBasedPolicyQueryLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("file-based-policy-query")
+			object.Id = types.StringValue(response.FileBasedPolicyQueryLogPublisherResponse.Id)
+			object.Type = types.StringValue("file-based-policy-query")
 		}
 		if response.FileBasedJsonAuditLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.FileBasedJsonAuditLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("file-based-json-audit")
+			readFileBasedJsonAuditLogPublisherResponseDataSource(ctx, response.FileBasedJsonAuditLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyErrorLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyErrorLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("third-party-error")
+			readThirdPartyErrorLogPublisherResponseDataSource(ctx, response.ThirdPartyErrorLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.SyslogTextAccessLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.SyslogTextAccessLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("syslog-text-access")
+			readSyslogTextAccessLogPublisherResponseDataSource(ctx, response.SyslogTextAccessLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.DetailedHttpOperationLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.DetailedHttpOperationLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("detailed-http-operation")
+			readDetailedHttpOperationLogPublisherResponseDataSource(ctx, response.DetailedHttpOperationLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.JsonAccessLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.JsonAccessLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("json-access")
+			readJsonAccessLogPublisherResponseDataSource(ctx, response.JsonAccessLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.SyslogJsonHttpOperationLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.SyslogJsonHttpOperationLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("syslog-json-http-operation")
+			readSyslogJsonHttpOperationLogPublisherResponseDataSource(ctx, response.SyslogJsonHttpOperationLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.GroovyScriptedFileBasedErrorLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.GroovyScriptedFileBasedErrorLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("groovy-scripted-file-based-error")
+			readGroovyScriptedFileBasedErrorLogPublisherResponseDataSource(ctx, response.GroovyScriptedFileBasedErrorLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyFileBasedErrorLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyFileBasedErrorLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("third-party-file-based-error")
+			readThirdPartyFileBasedErrorLogPublisherResponseDataSource(ctx, response.ThirdPartyFileBasedErrorLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.ConsoleJsonAuditLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.ConsoleJsonAuditLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("console-json-audit")
+			readConsoleJsonAuditLogPublisherResponseDataSource(ctx, response.ConsoleJsonAuditLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.ConsoleJsonAccessLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.ConsoleJsonAccessLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("console-json-access")
+			readConsoleJsonAccessLogPublisherResponseDataSource(ctx, response.ConsoleJsonAccessLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.FileBasedJsonSyncLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.FileBasedJsonSyncLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("file-based-json-sync")
+			object.Id = types.StringValue(response.FileBasedJsonSyncLogPublisherResponse.Id)
+			object.Type = types.StringValue("file-based-json-sync")
 		}
 		if response.GroovyScriptedErrorLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.GroovyScriptedErrorLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("groovy-scripted-error")
+			readGroovyScriptedErrorLogPublisherResponseDataSource(ctx, response.GroovyScriptedErrorLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.FileBasedJsonHttpOperationLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.FileBasedJsonHttpOperationLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("file-based-json-http-operation")
+			readFileBasedJsonHttpOperationLogPublisherResponseDataSource(ctx, response.FileBasedJsonHttpOperationLogPublisherResponse, &object, &resp.Diagnostics)
 		}
 		if response.FileBasedJsonSyncFailedOpsLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.FileBasedJsonSyncFailedOpsLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("file-based-json-sync-failed-ops")
+			object.Id = types.StringValue(response.FileBasedJsonSyncFailedOpsLogPublisherResponse.Id)
+			object.Type = types.StringValue("file-based-json-sync-failed-ops")
 		}
 		if response.GroovyScriptedHttpOperationLogPublisherResponse != nil {
-			attributes["id"] = types.StringValue(response.GroovyScriptedHttpOperationLogPublisherResponse.Id)
-			attributes["type"] = types.StringValue("groovy-scripted-http-operation")
+			readGroovyScriptedHttpOperationLogPublisherResponseDataSource(ctx, response.GroovyScriptedHttpOperationLogPublisherResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -49,29 +49,21 @@ func (r *logRetentionPoliciesDataSource) Configure(_ context.Context, req dataso
 }
 
 type logRetentionPoliciesDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *logRetentionPoliciesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Log Retention Policy objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Log Retention Policy objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewLogRetentionPolicyDataSource(), "Log Retention Policy")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *logRetentionPoliciesDataSource) Read(ctx context.Context, req datasourc
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewLogRetentionPolicyDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.LogRetentionPolicyAPI.ListLogRetentionPolicies(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.LogRetentionPolicyAPI.ListLogRetentionPoliciesExecute(listRequest)
@@ -106,37 +104,35 @@ func (r *logRetentionPoliciesDataSource) Read(ctx context.Context, req datasourc
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object logRetentionPolicyDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.TimeLimitLogRetentionPolicyResponse != nil {
-			attributes["id"] = types.StringValue(response.TimeLimitLogRetentionPolicyResponse.Id)
-			attributes["type"] = types.StringValue("time-limit")
+			readTimeLimitLogRetentionPolicyResponseDataSource(ctx, response.TimeLimitLogRetentionPolicyResponse, &object, &resp.Diagnostics)
 		}
 		if response.NeverDeleteLogRetentionPolicyResponse != nil {
-			attributes["id"] = types.StringValue(response.NeverDeleteLogRetentionPolicyResponse.Id)
-			attributes["type"] = types.StringValue("never-delete")
+			readNeverDeleteLogRetentionPolicyResponseDataSource(ctx, response.NeverDeleteLogRetentionPolicyResponse, &object, &resp.Diagnostics)
 		}
 		if response.FileCountLogRetentionPolicyResponse != nil {
-			attributes["id"] = types.StringValue(response.FileCountLogRetentionPolicyResponse.Id)
-			attributes["type"] = types.StringValue("file-count")
+			readFileCountLogRetentionPolicyResponseDataSource(ctx, response.FileCountLogRetentionPolicyResponse, &object, &resp.Diagnostics)
 		}
 		if response.FreeDiskSpaceLogRetentionPolicyResponse != nil {
-			attributes["id"] = types.StringValue(response.FreeDiskSpaceLogRetentionPolicyResponse.Id)
-			attributes["type"] = types.StringValue("free-disk-space")
+			readFreeDiskSpaceLogRetentionPolicyResponseDataSource(ctx, response.FreeDiskSpaceLogRetentionPolicyResponse, &object, &resp.Diagnostics)
 		}
 		if response.SizeLimitLogRetentionPolicyResponse != nil {
-			attributes["id"] = types.StringValue(response.SizeLimitLogRetentionPolicyResponse.Id)
-			attributes["type"] = types.StringValue("size-limit")
+			readSizeLimitLogRetentionPolicyResponseDataSource(ctx, response.SizeLimitLogRetentionPolicyResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -27,9 +27,11 @@ var (
 	_ resource.Resource                = &logRetentionPolicyResource{}
 	_ resource.ResourceWithConfigure   = &logRetentionPolicyResource{}
 	_ resource.ResourceWithImportState = &logRetentionPolicyResource{}
+	_ resource.ResourceWithMoveState   = &logRetentionPolicyResource{}
 	_ resource.Resource                = &defaultLogRetentionPolicyResource{}
 	_ resource.ResourceWithConfigure   = &defaultLogRetentionPolicyResource{}
 	_ resource.ResourceWithImportState = &defaultLogRetentionPolicyResource{}
+	_ resource.ResourceWithMoveState   = &defaultLogRetentionPolicyResource{}
 )
 
 // Create a Log Retention Policy resource
@@ -353,7 +355,7 @@ func (r *logRetentionPolicyResource) CreateTimeLimitLogRetentionPolicy(ctx conte
 
 	addResponse, httpResp, err := r.apiClient.LogRetentionPolicyAPI.AddLogRetentionPolicyExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Retention Policy", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Retention Policy", err, httpResp)
 		return nil, err
 	}
 
@@ -386,7 +388,7 @@ func (r *logRetentionPolicyResource) CreateNeverDeleteLogRetentionPolicy(ctx con
 
 	addResponse, httpResp, err := r.apiClient.LogRetentionPolicyAPI.AddLogRetentionPolicyExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Retention Policy", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Retention Policy", err, httpResp)
 		return nil, err
 	}
 
@@ -420,7 +422,7 @@ func (r *logRetentionPolicyResource) CreateFileCountLogRetentionPolicy(ctx conte
 
 	addResponse, httpResp, err := r.apiClient.LogRetentionPolicyAPI.AddLogRetentionPolicyExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Retention Policy", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Retention Policy", err, httpResp)
 		return nil, err
 	}
 
@@ -454,7 +456,7 @@ func (r *logRetentionPolicyResource) CreateFreeDiskSpaceLogRetentionPolicy(ctx c
 
 	addResponse, httpResp, err := r.apiClient.LogRetentionPolicyAPI.AddLogRetentionPolicyExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Retention Policy", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Retention Policy", err, httpResp)
 		return nil, err
 	}
 
@@ -488,7 +490,7 @@ func (r *logRetentionPolicyResource) CreateSizeLimitLogRetentionPolicy(ctx conte
 
 	addResponse, httpResp, err := r.apiClient.LogRetentionPolicyAPI.AddLogRetentionPolicyExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Retention Policy", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Retention Policy", err, httpResp)
 		return nil, err
 	}
 
@@ -547,6 +549,8 @@ func (r *logRetentionPolicyResource) Create(ctx context.Context, req resource.Cr
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Log Retention Policy", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -571,7 +575,7 @@ func (r *defaultLogRetentionPolicyResource) Create(ctx context.Context, req reso
 	readResponse, httpResp, err := r.apiClient.LogRetentionPolicyAPI.GetLogRetentionPolicy(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Log Retention Policy", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Log Retention Policy", err, httpResp)
 		return
 	}
 
@@ -609,7 +613,7 @@ func (r *defaultLogRetentionPolicyResource) Create(ctx context.Context, req reso
 
 		updateResponse, httpResp, err := r.apiClient.LogRetentionPolicyAPI.UpdateLogRetentionPolicyExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Log Retention Policy", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Log Retention Policy", err, httpResp)
 			return
 		}
 
@@ -635,6 +639,8 @@ func (r *defaultLogRetentionPolicyResource) Create(ctx context.Context, req reso
 		if updateResponse.SizeLimitLogRetentionPolicyResponse != nil {
 			readSizeLimitLogRetentionPolicyResponse(ctx, updateResponse.SizeLimitLogRetentionPolicyResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Log Retention Policy", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -740,7 +746,7 @@ func updateLogRetentionPolicy(ctx context.Context, req resource.UpdateRequest, r
 
 		updateResponse, httpResp, err := apiClient.LogRetentionPolicyAPI.UpdateLogRetentionPolicyExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Log Retention Policy", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Log Retention Policy", err, httpResp)
 			return
 		}
 
@@ -766,6 +772,8 @@ func updateLogRetentionPolicy(ctx context.Context, req resource.UpdateRequest, r
 		if updateResponse.SizeLimitLogRetentionPolicyResponse != nil {
 			readSizeLimitLogRetentionPolicyResponse(ctx, updateResponse.SizeLimitLogRetentionPolicyResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Log Retention Policy", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -813,3 +821,16 @@ func importLogRetentionPolicy(ctx context.Context, req resource.ImportStateReque
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *logRetentionPolicyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_log_retention_policy"),
+	}
+}
+
+func (r *defaultLogRetentionPolicyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_log_retention_policy"),
+	}
+}
@@ -49,29 +49,21 @@ func (r *logRotationPoliciesDataSource) Configure(_ context.Context, req datasou
 }
 
 type logRotationPoliciesDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *logRotationPoliciesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Log Rotation Policy objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Log Rotation Policy objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewLogRotationPolicyDataSource(), "Log Rotation Policy")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *logRotationPoliciesDataSource) Read(ctx context.Context, req datasource
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewLogRotationPolicyDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.LogRotationPolicyAPI.ListLogRotationPolicies(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.LogRotationPolicyAPI.ListLogRotationPoliciesExecute(listRequest)
@@ -106,33 +104,32 @@ func (r *logRotationPoliciesDataSource) Read(ctx context.Context, req datasource
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object logRotationPolicyDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.TimeLimitLogRotationPolicyResponse != nil {
-			attributes["id"] = types.StringValue(response.TimeLimitLogRotationPolicyResponse.Id)
-			attributes["type"] = types.StringValue("time-limit")
+			readTimeLimitLogRotationPolicyResponseDataSource(ctx, response.TimeLimitLogRotationPolicyResponse, &object, &resp.Diagnostics)
 		}
 		if response.FixedTimeLogRotationPolicyResponse != nil {
-			attributes["id"] = types.StringValue(response.FixedTimeLogRotationPolicyResponse.Id)
-			attributes["type"] = types.StringValue("fixed-time")
+			readFixedTimeLogRotationPolicyResponseDataSource(ctx, response.FixedTimeLogRotationPolicyResponse, &object, &resp.Diagnostics)
 		}
 		if response.NeverRotateLogRotationPolicyResponse != nil {
-			attributes["id"] = types.StringValue(response.NeverRotateLogRotationPolicyResponse.Id)
-			attributes["type"] = types.StringValue("never-rotate")
+			readNeverRotateLogRotationPolicyResponseDataSource(ctx, response.NeverRotateLogRotationPolicyResponse, &object, &resp.Diagnostics)
 		}
 		if response.SizeLimitLogRotationPolicyResponse != nil {
-			attributes["id"] = types.StringValue(response.SizeLimitLogRotationPolicyResponse.Id)
-			attributes["type"] = types.StringValue("size-limit")
+			readSizeLimitLogRotationPolicyResponseDataSource(ctx, response.SizeLimitLogRotationPolicyResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
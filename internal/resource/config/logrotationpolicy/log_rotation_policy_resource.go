@@ -29,9 +29,11 @@ var (
 	_ resource.Resource                = &logRotationPolicyResource{}
 	_ resource.ResourceWithConfigure   = &logRotationPolicyResource{}
 	_ resource.ResourceWithImportState = &logRotationPolicyResource{}
+	_ resource.ResourceWithMoveState   = &logRotationPolicyResource{}
 	_ resource.Resource                = &defaultLogRotationPolicyResource{}
 	_ resource.ResourceWithConfigure   = &defaultLogRotationPolicyResource{}
 	_ resource.ResourceWithImportState = &defaultLogRotationPolicyResource{}
+	_ resource.ResourceWithMoveState   = &defaultLogRotationPolicyResource{}
 )
 
 // Create a Log Rotation Policy resource
@@ -354,7 +356,7 @@ func (r *logRotationPolicyResource) CreateTimeLimitLogRotationPolicy(ctx context
 
 	addResponse, httpResp, err := r.apiClient.LogRotationPolicyAPI.AddLogRotationPolicyExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Rotation Policy", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Rotation Policy", err, httpResp)
 		return nil, err
 	}
 
@@ -390,7 +392,7 @@ func (r *logRotationPolicyResource) CreateFixedTimeLogRotationPolicy(ctx context
 
 	addResponse, httpResp, err := r.apiClient.LogRotationPolicyAPI.AddLogRotationPolicyExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Rotation Policy", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Rotation Policy", err, httpResp)
 		return nil, err
 	}
 
@@ -423,7 +425,7 @@ func (r *logRotationPolicyResource) CreateNeverRotateLogRotationPolicy(ctx conte
 
 	addResponse, httpResp, err := r.apiClient.LogRotationPolicyAPI.AddLogRotationPolicyExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Rotation Policy", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Rotation Policy", err, httpResp)
 		return nil, err
 	}
 
@@ -457,7 +459,7 @@ func (r *logRotationPolicyResource) CreateSizeLimitLogRotationPolicy(ctx context
 
 	addResponse, httpResp, err := r.apiClient.LogRotationPolicyAPI.AddLogRotationPolicyExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Log Rotation Policy", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Log Rotation Policy", err, httpResp)
 		return nil, err
 	}
 
@@ -510,6 +512,8 @@ func (r *logRotationPolicyResource) Create(ctx context.Context, req resource.Cre
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Log Rotation Policy", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -534,7 +538,7 @@ func (r *defaultLogRotationPolicyResource) Create(ctx context.Context, req resou
 	readResponse, httpResp, err := r.apiClient.LogRotationPolicyAPI.GetLogRotationPolicy(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Log Rotation Policy", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Log Rotation Policy", err, httpResp)
 		return
 	}
 
@@ -569,7 +573,7 @@ func (r *defaultLogRotationPolicyResource) Create(ctx context.Context, req resou
 
 		updateResponse, httpResp, err := r.apiClient.LogRotationPolicyAPI.UpdateLogRotationPolicyExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Log Rotation Policy", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Log Rotation Policy", err, httpResp)
 			return
 		}
 
@@ -592,6 +596,8 @@ func (r *defaultLogRotationPolicyResource) Create(ctx context.Context, req resou
 		if updateResponse.SizeLimitLogRotationPolicyResponse != nil {
 			readSizeLimitLogRotationPolicyResponse(ctx, updateResponse.SizeLimitLogRotationPolicyResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Log Rotation Policy", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -694,7 +700,7 @@ func updateLogRotationPolicy(ctx context.Context, req resource.UpdateRequest, re
 
 		updateResponse, httpResp, err := apiClient.LogRotationPolicyAPI.UpdateLogRotationPolicyExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Log Rotation Policy", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Log Rotation Policy", err, httpResp)
 			return
 		}
 
@@ -717,6 +723,8 @@ func updateLogRotationPolicy(ctx context.Context, req resource.UpdateRequest, re
 		if updateResponse.SizeLimitLogRotationPolicyResponse != nil {
 			readSizeLimitLogRotationPolicyResponse(ctx, updateResponse.SizeLimitLogRotationPolicyResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Log Rotation Policy", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -764,3 +772,16 @@ func importLogRotationPolicy(ctx context.Context, req resource.ImportStateReques
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *logRotationPolicyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_log_rotation_policy"),
+	}
+}
+
+func (r *defaultLogRotationPolicyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_log_rotation_policy"),
+	}
+}
@@ -192,7 +192,7 @@ func (r *macSecretKeyResource) Create(ctx context.Context, req resource.CreateRe
 	readResponse, httpResp, err := r.apiClient.MacSecretKeyAPI.GetMacSecretKey(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString(), plan.ServerInstanceName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Mac Secret Key", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Mac Secret Key", err, httpResp)
 		return
 	}
 
@@ -216,7 +216,7 @@ func (r *macSecretKeyResource) Create(ctx context.Context, req resource.CreateRe
 
 		updateResponse, httpResp, err := r.apiClient.MacSecretKeyAPI.UpdateMacSecretKeyExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Mac Secret Key", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Mac Secret Key", err, httpResp)
 			return
 		}
 
@@ -228,6 +228,8 @@ func (r *macSecretKeyResource) Create(ctx context.Context, req resource.CreateRe
 
 		// Read the response
 		readMacSecretKeyResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Mac Secret Key", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -294,7 +296,7 @@ func (r *macSecretKeyResource) Update(ctx context.Context, req resource.UpdateRe
 
 		updateResponse, httpResp, err := r.apiClient.MacSecretKeyAPI.UpdateMacSecretKeyExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Mac Secret Key", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Mac Secret Key", err, httpResp)
 			return
 		}
 
@@ -306,6 +308,8 @@ func (r *macSecretKeyResource) Update(ctx context.Context, req resource.UpdateRe
 
 		// Read the response
 		readMacSecretKeyResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Mac Secret Key", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
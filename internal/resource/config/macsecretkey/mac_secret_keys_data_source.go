@@ -50,9 +50,12 @@ func (r *macSecretKeysDataSource) Configure(_ context.Context, req datasource.Co
 
 type macSecretKeysDataSourceModel struct {
 	Id                 types.String `tfsdk:"id"`
-	Filter             types.String `tfsdk:"filter"`
 	Ids                types.Set    `tfsdk:"ids"`
+	Objects            types.Set    `tfsdk:"objects"`
+	ObjectsById        types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes  types.Bool   `tfsdk:"include_attributes"`
 	ServerInstanceName types.String `tfsdk:"server_instance_name"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
@@ -64,10 +67,6 @@ func (r *macSecretKeysDataSource) Schema(ctx context.Context, req datasource.Sch
 				Description: "Name of the parent Server Instance",
 				Required:    true,
 			},
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
 			"ids": schema.SetAttribute{
 				Description: "Mac Secret Key IDs found in the configuration",
 				Required:    false,
@@ -77,6 +76,8 @@ func (r *macSecretKeysDataSource) Schema(ctx context.Context, req datasource.Sch
 			},
 		},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewMacSecretKeyDataSource(), "Mac Secret Key")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -91,9 +92,15 @@ func (r *macSecretKeysDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewMacSecretKeyDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.MacSecretKeyAPI.ListMacSecretKeys(config.ProviderBasicAuthContext(ctx, r.providerConfig), state.ServerInstanceName.ValueString())
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.MacSecretKeyAPI.ListMacSecretKeysExecute(listRequest)
@@ -110,12 +117,24 @@ func (r *macSecretKeysDataSource) Read(ctx context.Context, req datasource.ReadR
 
 	// Read the response into the state
 	ids := []attr.Value{}
+	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
+		var object macSecretKeyDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
+		readMacSecretKeyResponseDataSource(ctx, &response, &object, &resp.Diagnostics)
+		object.ServerInstanceName = state.ServerInstanceName
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
+		}
 		ids = append(ids, types.StringValue(response.Id))
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 
 	state.Ids, diags = types.SetValue(types.StringType, ids)
 	resp.Diagnostics.Append(diags...)
+	state.Objects, diags = types.SetValue(objectType, objects)
+	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -164,7 +164,7 @@ func (r *matchingRuleResource) Create(ctx context.Context, req resource.CreateRe
 	readResponse, httpResp, err := r.apiClient.MatchingRuleAPI.GetMatchingRule(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Matching Rule", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Matching Rule", err, httpResp)
 		return
 	}
 
@@ -202,7 +202,7 @@ func (r *matchingRuleResource) Create(ctx context.Context, req resource.CreateRe
 
 		updateResponse, httpResp, err := r.apiClient.MatchingRuleAPI.UpdateMatchingRuleExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Matching Rule", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Matching Rule", err, httpResp)
 			return
 		}
 
@@ -228,6 +228,8 @@ func (r *matchingRuleResource) Create(ctx context.Context, req resource.CreateRe
 		if updateResponse.GenericMatchingRuleResponse != nil {
 			readGenericMatchingRuleResponse(ctx, updateResponse.GenericMatchingRuleResponse, &state, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Matching Rule", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -307,7 +309,7 @@ func (r *matchingRuleResource) Update(ctx context.Context, req resource.UpdateRe
 
 		updateResponse, httpResp, err := r.apiClient.MatchingRuleAPI.UpdateMatchingRuleExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Matching Rule", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Matching Rule", err, httpResp)
 			return
 		}
 
@@ -333,6 +335,8 @@ func (r *matchingRuleResource) Update(ctx context.Context, req resource.UpdateRe
 		if updateResponse.GenericMatchingRuleResponse != nil {
 			readGenericMatchingRuleResponse(ctx, updateResponse.GenericMatchingRuleResponse, &state, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Matching Rule", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -49,29 +49,21 @@ func (r *matchingRulesDataSource) Configure(_ context.Context, req datasource.Co
 }
 
 type matchingRulesDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *matchingRulesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Matching Rule objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Matching Rule objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewMatchingRuleDataSource(), "Matching Rule")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *matchingRulesDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewMatchingRuleDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.MatchingRuleAPI.ListMatchingRules(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.MatchingRuleAPI.ListMatchingRulesExecute(listRequest)
@@ -106,37 +104,35 @@ func (r *matchingRulesDataSource) Read(ctx context.Context, req datasource.ReadR
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object matchingRuleDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.EqualityMatchingRuleResponse != nil {
-			attributes["id"] = types.StringValue(response.EqualityMatchingRuleResponse.Id)
-			attributes["type"] = types.StringValue("equality")
+			readEqualityMatchingRuleResponseDataSource(ctx, response.EqualityMatchingRuleResponse, &object, &resp.Diagnostics)
 		}
 		if response.OrderingMatchingRuleResponse != nil {
-			attributes["id"] = types.StringValue(response.OrderingMatchingRuleResponse.Id)
-			attributes["type"] = types.StringValue("ordering")
+			readOrderingMatchingRuleResponseDataSource(ctx, response.OrderingMatchingRuleResponse, &object, &resp.Diagnostics)
 		}
 		if response.SubstringMatchingRuleResponse != nil {
-			attributes["id"] = types.StringValue(response.SubstringMatchingRuleResponse.Id)
-			attributes["type"] = types.StringValue("substring")
+			readSubstringMatchingRuleResponseDataSource(ctx, response.SubstringMatchingRuleResponse, &object, &resp.Diagnostics)
 		}
 		if response.GenericMatchingRuleResponse != nil {
-			attributes["id"] = types.StringValue(response.GenericMatchingRuleResponse.Id)
-			attributes["type"] = types.StringValue("generic")
+			readGenericMatchingRuleResponseDataSource(ctx, response.GenericMatchingRuleResponse, &object, &resp.Diagnostics)
 		}
 		if response.ApproximateMatchingRuleResponse != nil {
-			attributes["id"] = types.StringValue(response.ApproximateMatchingRuleResponse.Id)
-			attributes["type"] = types.StringValue("approximate")
+			readApproximateMatchingRuleResponseDataSource(ctx, response.ApproximateMatchingRuleResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -28,9 +28,11 @@ var (
 	_ resource.Resource                = &monitoringEndpointResource{}
 	_ resource.ResourceWithConfigure   = &monitoringEndpointResource{}
 	_ resource.ResourceWithImportState = &monitoringEndpointResource{}
+	_ resource.ResourceWithMoveState   = &monitoringEndpointResource{}
 	_ resource.Resource                = &defaultMonitoringEndpointResource{}
 	_ resource.ResourceWithConfigure   = &defaultMonitoringEndpointResource{}
 	_ resource.ResourceWithImportState = &defaultMonitoringEndpointResource{}
+	_ resource.ResourceWithMoveState   = &defaultMonitoringEndpointResource{}
 )
 
 // Create a Monitoring Endpoint resource
@@ -258,7 +260,7 @@ func (r *monitoringEndpointResource) CreateStatsdMonitoringEndpoint(ctx context.
 
 	addResponse, httpResp, err := r.apiClient.MonitoringEndpointAPI.AddMonitoringEndpointExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Monitoring Endpoint", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Monitoring Endpoint", err, httpResp)
 		return nil, err
 	}
 
@@ -289,6 +291,8 @@ func (r *monitoringEndpointResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Monitoring Endpoint", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -313,7 +317,7 @@ func (r *defaultMonitoringEndpointResource) Create(ctx context.Context, req reso
 	readResponse, httpResp, err := r.apiClient.MonitoringEndpointAPI.GetMonitoringEndpoint(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Monitoring Endpoint", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Monitoring Endpoint", err, httpResp)
 		return
 	}
 
@@ -337,7 +341,7 @@ func (r *defaultMonitoringEndpointResource) Create(ctx context.Context, req reso
 
 		updateResponse, httpResp, err := r.apiClient.MonitoringEndpointAPI.UpdateMonitoringEndpointExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Monitoring Endpoint", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Monitoring Endpoint", err, httpResp)
 			return
 		}
 
@@ -349,6 +353,8 @@ func (r *defaultMonitoringEndpointResource) Create(ctx context.Context, req reso
 
 		// Read the response
 		readStatsdMonitoringEndpointResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Monitoring Endpoint", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -440,7 +446,7 @@ func updateMonitoringEndpoint(ctx context.Context, req resource.UpdateRequest, r
 
 		updateResponse, httpResp, err := apiClient.MonitoringEndpointAPI.UpdateMonitoringEndpointExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Monitoring Endpoint", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Monitoring Endpoint", err, httpResp)
 			return
 		}
 
@@ -452,6 +458,8 @@ func updateMonitoringEndpoint(ctx context.Context, req resource.UpdateRequest, r
 
 		// Read the response
 		readStatsdMonitoringEndpointResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Monitoring Endpoint", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -499,3 +507,16 @@ func importMonitoringEndpoint(ctx context.Context, req resource.ImportStateReque
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *monitoringEndpointResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_monitoring_endpoint"),
+	}
+}
+
+func (r *defaultMonitoringEndpointResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_monitoring_endpoint"),
+	}
+}
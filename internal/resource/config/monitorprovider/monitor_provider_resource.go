@@ -28,9 +28,11 @@ var (
 	_ resource.Resource                = &monitorProviderResource{}
 	_ resource.ResourceWithConfigure   = &monitorProviderResource{}
 	_ resource.ResourceWithImportState = &monitorProviderResource{}
+	_ resource.ResourceWithMoveState   = &monitorProviderResource{}
 	_ resource.Resource                = &defaultMonitorProviderResource{}
 	_ resource.ResourceWithConfigure   = &defaultMonitorProviderResource{}
 	_ resource.ResourceWithImportState = &defaultMonitorProviderResource{}
+	_ resource.ResourceWithMoveState   = &defaultMonitorProviderResource{}
 )
 
 // Create a Monitor Provider resource
@@ -707,7 +709,7 @@ func (r *monitorProviderResource) CreateEncryptionSettingsDatabaseAccessibilityM
 
 	addResponse, httpResp, err := r.apiClient.MonitorProviderAPI.AddMonitorProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Monitor Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Monitor Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -746,7 +748,7 @@ func (r *monitorProviderResource) CreateThirdPartyMonitorProvider(ctx context.Co
 
 	addResponse, httpResp, err := r.apiClient.MonitorProviderAPI.AddMonitorProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Monitor Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Monitor Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -787,6 +789,8 @@ func (r *monitorProviderResource) Create(ctx context.Context, req resource.Creat
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Monitor Provider", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -811,7 +815,7 @@ func (r *defaultMonitorProviderResource) Create(ctx context.Context, req resourc
 	readResponse, httpResp, err := r.apiClient.MonitorProviderAPI.GetMonitorProvider(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Monitor Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Monitor Provider", err, httpResp)
 		return
 	}
 
@@ -873,7 +877,7 @@ func (r *defaultMonitorProviderResource) Create(ctx context.Context, req resourc
 
 		updateResponse, httpResp, err := r.apiClient.MonitorProviderAPI.UpdateMonitorProviderExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Monitor Provider", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Monitor Provider", err, httpResp)
 			return
 		}
 
@@ -923,6 +927,8 @@ func (r *defaultMonitorProviderResource) Create(ctx context.Context, req resourc
 		if updateResponse.ThirdPartyMonitorProviderResponse != nil {
 			readThirdPartyMonitorProviderResponseDefault(ctx, updateResponse.ThirdPartyMonitorProviderResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Monitor Provider", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -1060,7 +1066,7 @@ func (r *monitorProviderResource) Update(ctx context.Context, req resource.Updat
 
 		updateResponse, httpResp, err := r.apiClient.MonitorProviderAPI.UpdateMonitorProviderExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Monitor Provider", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Monitor Provider", err, httpResp)
 			return
 		}
 
@@ -1077,6 +1083,8 @@ func (r *monitorProviderResource) Update(ctx context.Context, req resource.Updat
 		if updateResponse.ThirdPartyMonitorProviderResponse != nil {
 			readThirdPartyMonitorProviderResponse(ctx, updateResponse.ThirdPartyMonitorProviderResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Monitor Provider", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -1112,7 +1120,7 @@ func (r *defaultMonitorProviderResource) Update(ctx context.Context, req resourc
 
 		updateResponse, httpResp, err := r.apiClient.MonitorProviderAPI.UpdateMonitorProviderExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Monitor Provider", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Monitor Provider", err, httpResp)
 			return
 		}
 
@@ -1162,6 +1170,8 @@ func (r *defaultMonitorProviderResource) Update(ctx context.Context, req resourc
 		if updateResponse.ThirdPartyMonitorProviderResponse != nil {
 			readThirdPartyMonitorProviderResponseDefault(ctx, updateResponse.ThirdPartyMonitorProviderResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Monitor Provider", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -1209,3 +1219,16 @@ func importMonitorProvider(ctx context.Context, req resource.ImportStateRequest,
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *monitorProviderResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_monitor_provider"),
+	}
+}
+
+func (r *defaultMonitorProviderResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_monitor_provider"),
+	}
+}
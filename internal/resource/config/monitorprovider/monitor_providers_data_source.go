@@ -49,29 +49,21 @@ func (r *monitorProvidersDataSource) Configure(_ context.Context, req datasource
 }
 
 type monitorProvidersDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *monitorProvidersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Monitor Provider objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Monitor Provider objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewMonitorProviderDataSource(), "Monitor Provider")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *monitorProvidersDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewMonitorProviderDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.MonitorProviderAPI.ListMonitorProviders(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.MonitorProviderAPI.ListMonitorProvidersExecute(listRequest)
@@ -106,73 +104,63 @@ func (r *monitorProvidersDataSource) Read(ctx context.Context, req datasource.Re
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object monitorProviderDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.MemoryUsageMonitorProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.MemoryUsageMonitorProviderResponse.Id)
-			attributes["type"] = types.StringValue("memory-usage")
+			readMemoryUsageMonitorProviderResponseDataSource(ctx, response.MemoryUsageMonitorProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.StackTraceMonitorProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.StackTraceMonitorProviderResponse.Id)
-			attributes["type"] = types.StringValue("stack-trace")
+			readStackTraceMonitorProviderResponseDataSource(ctx, response.StackTraceMonitorProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.EncryptionSettingsDatabaseAccessibilityMonitorProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.EncryptionSettingsDatabaseAccessibilityMonitorProviderResponse.Id)
-			attributes["type"] = types.StringValue("encryption-settings-database-accessibility")
+			readEncryptionSettingsDatabaseAccessibilityMonitorProviderResponseDataSource(ctx, response.EncryptionSettingsDatabaseAccessibilityMonitorProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.CustomMonitorProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.CustomMonitorProviderResponse.Id)
-			attributes["type"] = types.StringValue("custom")
+			readCustomMonitorProviderResponseDataSource(ctx, response.CustomMonitorProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.EntryCacheMonitorProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.EntryCacheMonitorProviderResponse.Id)
-			attributes["type"] = types.StringValue("entry-cache")
+			object.Id = types.StringValue(response.EntryCacheMonitorProviderResponse.Id)
+			object.Type = types.StringValue("entry-cache")
 		}
 		if response.ActiveOperationsMonitorProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.ActiveOperationsMonitorProviderResponse.Id)
-			attributes["type"] = types.StringValue("active-operations")
+			readActiveOperationsMonitorProviderResponseDataSource(ctx, response.ActiveOperationsMonitorProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.SslContextMonitorProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.SslContextMonitorProviderResponse.Id)
-			attributes["type"] = types.StringValue("ssl-context")
+			readSslContextMonitorProviderResponseDataSource(ctx, response.SslContextMonitorProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.VersionMonitorProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.VersionMonitorProviderResponse.Id)
-			attributes["type"] = types.StringValue("version")
+			readVersionMonitorProviderResponseDataSource(ctx, response.VersionMonitorProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.HostSystemMonitorProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.HostSystemMonitorProviderResponse.Id)
-			attributes["type"] = types.StringValue("host-system")
+			readHostSystemMonitorProviderResponseDataSource(ctx, response.HostSystemMonitorProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.GeneralMonitorProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.GeneralMonitorProviderResponse.Id)
-			attributes["type"] = types.StringValue("general")
+			readGeneralMonitorProviderResponseDataSource(ctx, response.GeneralMonitorProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.DiskSpaceUsageMonitorProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.DiskSpaceUsageMonitorProviderResponse.Id)
-			attributes["type"] = types.StringValue("disk-space-usage")
+			readDiskSpaceUsageMonitorProviderResponseDataSource(ctx, response.DiskSpaceUsageMonitorProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.SystemInfoMonitorProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.SystemInfoMonitorProviderResponse.Id)
-			attributes["type"] = types.StringValue("system-info")
+			readSystemInfoMonitorProviderResponseDataSource(ctx, response.SystemInfoMonitorProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.ClientConnectionMonitorProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.ClientConnectionMonitorProviderResponse.Id)
-			attributes["type"] = types.StringValue("client-connection")
+			readClientConnectionMonitorProviderResponseDataSource(ctx, response.ClientConnectionMonitorProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyMonitorProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyMonitorProviderResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyMonitorProviderResponseDataSource(ctx, response.ThirdPartyMonitorProviderResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -0,0 +1,50 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package config
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Address suffix used by this provider, regardless of which registry hostname it was installed from
+const providerAddressSuffix = "pingidentity/pingdirectory"
+
+// Get a StateMover that moves state from another resource type in this provider into the target resource.
+// The resource type and its default counterpart, for example pingdirectory_backend and pingdirectory_default_backend,
+// manage the same config object and mostly share attribute names, so any attribute found in the source state that is
+// also defined by the target schema is copied over. Attributes that only exist in the source are dropped, and attributes
+// that only exist in the target are left null until the next refresh reads them from PingDirectory.
+func StateMoverFromResourceType(sourceTypeName string) resource.StateMover {
+	return resource.StateMover{
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			// Leave the target state null if this mover doesn't apply, so the framework can try any other movers
+			if req.SourceTypeName != sourceTypeName || !strings.HasSuffix(req.SourceProviderAddress, providerAddressSuffix) {
+				return
+			}
+			if req.SourceRawState == nil {
+				resp.Diagnostics.AddError("Unable to move resource state", "No source state was provided for "+sourceTypeName)
+				return
+			}
+
+			tflog.Debug(ctx, "Moving state from "+req.SourceTypeName+" resource")
+			targetType := resp.TargetState.Schema.Type().TerraformType(ctx)
+			targetValue, err := req.SourceRawState.UnmarshalWithOpts(targetType, tfprotov6.UnmarshalOpts{
+				ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+					IgnoreUndefinedAttributes: true,
+				},
+			})
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to move resource state",
+					"Failed to convert the state of the "+sourceTypeName+" resource: "+err.Error())
+				return
+			}
+			resp.TargetState.Raw = targetValue
+		},
+	}
+}
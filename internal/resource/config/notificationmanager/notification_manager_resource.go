@@ -28,9 +28,11 @@ var (
 	_ resource.Resource                = &notificationManagerResource{}
 	_ resource.ResourceWithConfigure   = &notificationManagerResource{}
 	_ resource.ResourceWithImportState = &notificationManagerResource{}
+	_ resource.ResourceWithMoveState   = &notificationManagerResource{}
 	_ resource.Resource                = &defaultNotificationManagerResource{}
 	_ resource.ResourceWithConfigure   = &defaultNotificationManagerResource{}
 	_ resource.ResourceWithImportState = &defaultNotificationManagerResource{}
+	_ resource.ResourceWithMoveState   = &defaultNotificationManagerResource{}
 )
 
 // Create a Notification Manager resource
@@ -274,7 +276,7 @@ func (r *notificationManagerResource) CreateThirdPartyNotificationManager(ctx co
 
 	addResponse, httpResp, err := r.apiClient.NotificationManagerAPI.AddNotificationManagerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Notification Manager", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Notification Manager", err, httpResp)
 		return nil, err
 	}
 
@@ -305,6 +307,8 @@ func (r *notificationManagerResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Notification Manager", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -329,7 +333,7 @@ func (r *defaultNotificationManagerResource) Create(ctx context.Context, req res
 	readResponse, httpResp, err := r.apiClient.NotificationManagerAPI.GetNotificationManager(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Notification Manager", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Notification Manager", err, httpResp)
 		return
 	}
 
@@ -353,7 +357,7 @@ func (r *defaultNotificationManagerResource) Create(ctx context.Context, req res
 
 		updateResponse, httpResp, err := r.apiClient.NotificationManagerAPI.UpdateNotificationManagerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Notification Manager", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Notification Manager", err, httpResp)
 			return
 		}
 
@@ -365,6 +369,8 @@ func (r *defaultNotificationManagerResource) Create(ctx context.Context, req res
 
 		// Read the response
 		readThirdPartyNotificationManagerResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Notification Manager", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -456,7 +462,7 @@ func updateNotificationManager(ctx context.Context, req resource.UpdateRequest,
 
 		updateResponse, httpResp, err := apiClient.NotificationManagerAPI.UpdateNotificationManagerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Notification Manager", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Notification Manager", err, httpResp)
 			return
 		}
 
@@ -468,6 +474,8 @@ func updateNotificationManager(ctx context.Context, req resource.UpdateRequest,
 
 		// Read the response
 		readThirdPartyNotificationManagerResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Notification Manager", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -515,3 +523,16 @@ func importNotificationManager(ctx context.Context, req resource.ImportStateRequ
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *notificationManagerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_notification_manager"),
+	}
+}
+
+func (r *defaultNotificationManagerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_notification_manager"),
+	}
+}
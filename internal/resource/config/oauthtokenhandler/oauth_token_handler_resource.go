@@ -28,9 +28,11 @@ var (
 	_ resource.Resource                = &oauthTokenHandlerResource{}
 	_ resource.ResourceWithConfigure   = &oauthTokenHandlerResource{}
 	_ resource.ResourceWithImportState = &oauthTokenHandlerResource{}
+	_ resource.ResourceWithMoveState   = &oauthTokenHandlerResource{}
 	_ resource.Resource                = &defaultOauthTokenHandlerResource{}
 	_ resource.ResourceWithConfigure   = &defaultOauthTokenHandlerResource{}
 	_ resource.ResourceWithImportState = &defaultOauthTokenHandlerResource{}
+	_ resource.ResourceWithMoveState   = &defaultOauthTokenHandlerResource{}
 )
 
 // Create a Oauth Token Handler resource
@@ -317,7 +319,7 @@ func (r *oauthTokenHandlerResource) CreateGroovyScriptedOauthTokenHandler(ctx co
 
 	addResponse, httpResp, err := r.apiClient.OauthTokenHandlerAPI.AddOauthTokenHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Oauth Token Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Oauth Token Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -351,7 +353,7 @@ func (r *oauthTokenHandlerResource) CreateThirdPartyOauthTokenHandler(ctx contex
 
 	addResponse, httpResp, err := r.apiClient.OauthTokenHandlerAPI.AddOauthTokenHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Oauth Token Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Oauth Token Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -392,6 +394,8 @@ func (r *oauthTokenHandlerResource) Create(ctx context.Context, req resource.Cre
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Oauth Token Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -416,7 +420,7 @@ func (r *defaultOauthTokenHandlerResource) Create(ctx context.Context, req resou
 	readResponse, httpResp, err := r.apiClient.OauthTokenHandlerAPI.GetOauthTokenHandler(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Oauth Token Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Oauth Token Handler", err, httpResp)
 		return
 	}
 
@@ -445,7 +449,7 @@ func (r *defaultOauthTokenHandlerResource) Create(ctx context.Context, req resou
 
 		updateResponse, httpResp, err := r.apiClient.OauthTokenHandlerAPI.UpdateOauthTokenHandlerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Oauth Token Handler", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Oauth Token Handler", err, httpResp)
 			return
 		}
 
@@ -462,6 +466,8 @@ func (r *defaultOauthTokenHandlerResource) Create(ctx context.Context, req resou
 		if updateResponse.ThirdPartyOauthTokenHandlerResponse != nil {
 			readThirdPartyOauthTokenHandlerResponse(ctx, updateResponse.ThirdPartyOauthTokenHandlerResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Oauth Token Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -558,7 +564,7 @@ func updateOauthTokenHandler(ctx context.Context, req resource.UpdateRequest, re
 
 		updateResponse, httpResp, err := apiClient.OauthTokenHandlerAPI.UpdateOauthTokenHandlerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Oauth Token Handler", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Oauth Token Handler", err, httpResp)
 			return
 		}
 
@@ -575,6 +581,8 @@ func updateOauthTokenHandler(ctx context.Context, req resource.UpdateRequest, re
 		if updateResponse.ThirdPartyOauthTokenHandlerResponse != nil {
 			readThirdPartyOauthTokenHandlerResponse(ctx, updateResponse.ThirdPartyOauthTokenHandlerResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Oauth Token Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -622,3 +630,16 @@ func importOauthTokenHandler(ctx context.Context, req resource.ImportStateReques
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *oauthTokenHandlerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_oauth_token_handler"),
+	}
+}
+
+func (r *defaultOauthTokenHandlerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_oauth_token_handler"),
+	}
+}
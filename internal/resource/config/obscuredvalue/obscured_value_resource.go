@@ -27,9 +27,11 @@ var (
 	_ resource.Resource                = &obscuredValueResource{}
 	_ resource.ResourceWithConfigure   = &obscuredValueResource{}
 	_ resource.ResourceWithImportState = &obscuredValueResource{}
+	_ resource.ResourceWithMoveState   = &obscuredValueResource{}
 	_ resource.Resource                = &defaultObscuredValueResource{}
 	_ resource.ResourceWithConfigure   = &defaultObscuredValueResource{}
 	_ resource.ResourceWithImportState = &defaultObscuredValueResource{}
+	_ resource.ResourceWithMoveState   = &defaultObscuredValueResource{}
 )
 
 // Create a Obscured Value resource
@@ -84,13 +86,15 @@ func (r *defaultObscuredValueResource) Configure(_ context.Context, req resource
 }
 
 type obscuredValueResourceModel struct {
-	Id              types.String `tfsdk:"id"`
-	Name            types.String `tfsdk:"name"`
-	Notifications   types.Set    `tfsdk:"notifications"`
-	RequiredActions types.Set    `tfsdk:"required_actions"`
-	Type            types.String `tfsdk:"type"`
-	Description     types.String `tfsdk:"description"`
-	ObscuredValue   types.String `tfsdk:"obscured_value"`
+	Id                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	Notifications          types.Set    `tfsdk:"notifications"`
+	RequiredActions        types.Set    `tfsdk:"required_actions"`
+	Type                   types.String `tfsdk:"type"`
+	Description            types.String `tfsdk:"description"`
+	ObscuredValue          types.String `tfsdk:"obscured_value"`
+	ObscuredValueWo        types.String `tfsdk:"obscured_value_wo"`
+	ObscuredValueWoVersion types.Int64  `tfsdk:"obscured_value_wo_version"`
 }
 
 // GetSchema defines the schema for the resource.
@@ -121,11 +125,12 @@ func obscuredValueSchema(ctx context.Context, req resource.SchemaRequest, resp *
 			},
 			"obscured_value": schema.StringAttribute{
 				Description: "The value to be stored in an obscured form.",
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
 			},
 		},
 	}
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "obscured_value")
 	if isDefault {
 		typeAttr := schemaDef.Attributes["type"].(schema.StringAttribute)
 		typeAttr.Optional = false
@@ -137,6 +142,11 @@ func obscuredValueSchema(ctx context.Context, req resource.SchemaRequest, resp *
 		schemaDef.Attributes["type"] = typeAttr
 		// Add any default properties and set optional properties to computed where necessary
 		config.SetAttributesToOptionalAndComputedAndRemoveDefaults(&schemaDef, []string{"type"})
+	} else {
+		// Require either the value or its write-only variant when creating a new Obscured Value
+		obscuredValueAttr := schemaDef.Attributes["obscured_value"].(schema.StringAttribute)
+		obscuredValueAttr.Validators = append(obscuredValueAttr.Validators, stringvalidator.ExactlyOneOf(path.MatchRoot("obscured_value_wo")))
+		schemaDef.Attributes["obscured_value"] = obscuredValueAttr
 	}
 	config.AddCommonResourceSchema(&schemaDef, true)
 	resp.Schema = schemaDef
@@ -175,6 +185,9 @@ func (state *obscuredValueResourceModel) setStateValuesNotReturnedByAPI(expected
 	if !expectedValues.ObscuredValue.IsUnknown() {
 		state.ObscuredValue = expectedValues.ObscuredValue
 	}
+	if !expectedValues.ObscuredValueWoVersion.IsUnknown() {
+		state.ObscuredValueWoVersion = expectedValues.ObscuredValueWoVersion
+	}
 }
 
 // Create any update operations necessary to make the state match the plan
@@ -182,12 +195,18 @@ func createObscuredValueOperations(plan obscuredValueResourceModel, state obscur
 	var ops []client.Operation
 	operations.AddStringOperationIfNecessary(&ops, plan.Description, state.Description, "description")
 	operations.AddStringOperationIfNecessary(&ops, plan.ObscuredValue, state.ObscuredValue, "obscured-value")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.ObscuredValueWo, plan.ObscuredValueWoVersion, state.ObscuredValueWoVersion, "obscured-value")
 	return ops
 }
 
 // Create a obscured-value obscured-value
 func (r *obscuredValueResource) CreateObscuredValue(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse, plan obscuredValueResourceModel) (*obscuredValueResourceModel, error) {
-	addRequest := client.NewAddObscuredValueRequest(plan.ObscuredValue.ValueString(),
+	obscuredValue := plan.ObscuredValue.ValueString()
+	// Write-only values are read from the config, since they are not included in the plan
+	if internaltypes.IsNonEmptyString(plan.ObscuredValueWo) {
+		obscuredValue = plan.ObscuredValueWo.ValueString()
+	}
+	addRequest := client.NewAddObscuredValueRequest(obscuredValue,
 		plan.Name.ValueString())
 	addOptionalObscuredValueFields(ctx, addRequest, plan)
 	// Log request JSON
@@ -201,7 +220,7 @@ func (r *obscuredValueResource) CreateObscuredValue(ctx context.Context, req res
 
 	addResponse, httpResp, err := r.apiClient.ObscuredValueAPI.AddObscuredValueExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Obscured Value", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Obscured Value", err, httpResp)
 		return nil, err
 	}
 
@@ -226,6 +245,12 @@ func (r *obscuredValueResource) Create(ctx context.Context, req resource.CreateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	diags = req.Config.GetAttribute(ctx, path.Root("obscured_value_wo"), &plan.ObscuredValueWo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	state, err := r.CreateObscuredValue(ctx, req, resp, plan)
 	if err != nil {
@@ -234,6 +259,8 @@ func (r *obscuredValueResource) Create(ctx context.Context, req resource.CreateR
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Obscured Value", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -254,11 +281,17 @@ func (r *defaultObscuredValueResource) Create(ctx context.Context, req resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	diags = req.Config.GetAttribute(ctx, path.Root("obscured_value_wo"), &plan.ObscuredValueWo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	readResponse, httpResp, err := r.apiClient.ObscuredValueAPI.GetObscuredValue(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Obscured Value", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Obscured Value", err, httpResp)
 		return
 	}
 
@@ -282,7 +315,7 @@ func (r *defaultObscuredValueResource) Create(ctx context.Context, req resource.
 
 		updateResponse, httpResp, err := r.apiClient.ObscuredValueAPI.UpdateObscuredValueExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Obscured Value", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Obscured Value", err, httpResp)
 			return
 		}
 
@@ -294,6 +327,8 @@ func (r *defaultObscuredValueResource) Create(ctx context.Context, req resource.
 
 		// Read the response
 		readObscuredValueResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Obscured Value", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -370,6 +405,12 @@ func updateObscuredValue(ctx context.Context, req resource.UpdateRequest, resp *
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	diags = req.Config.GetAttribute(ctx, path.Root("obscured_value_wo"), &plan.ObscuredValueWo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Get the current state to see how any attributes are changing
 	var state obscuredValueResourceModel
@@ -386,7 +427,7 @@ func updateObscuredValue(ctx context.Context, req resource.UpdateRequest, resp *
 
 		updateResponse, httpResp, err := apiClient.ObscuredValueAPI.UpdateObscuredValueExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Obscured Value", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Obscured Value", err, httpResp)
 			return
 		}
 
@@ -398,6 +439,8 @@ func updateObscuredValue(ctx context.Context, req resource.UpdateRequest, resp *
 
 		// Read the response
 		readObscuredValueResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Obscured Value", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -446,3 +489,16 @@ func importObscuredValue(ctx context.Context, req resource.ImportStateRequest, r
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *obscuredValueResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_obscured_value"),
+	}
+}
+
+func (r *defaultObscuredValueResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_obscured_value"),
+	}
+}
@@ -30,9 +30,11 @@ var (
 	_ resource.Resource                = &otpDeliveryMechanismResource{}
 	_ resource.ResourceWithConfigure   = &otpDeliveryMechanismResource{}
 	_ resource.ResourceWithImportState = &otpDeliveryMechanismResource{}
+	_ resource.ResourceWithMoveState   = &otpDeliveryMechanismResource{}
 	_ resource.Resource                = &defaultOtpDeliveryMechanismResource{}
 	_ resource.ResourceWithConfigure   = &defaultOtpDeliveryMechanismResource{}
 	_ resource.ResourceWithImportState = &defaultOtpDeliveryMechanismResource{}
+	_ resource.ResourceWithMoveState   = &defaultOtpDeliveryMechanismResource{}
 )
 
 // Create a Otp Delivery Mechanism resource
@@ -102,6 +104,8 @@ type otpDeliveryMechanismResourceModel struct {
 	HttpProxyExternalServer           types.String `tfsdk:"http_proxy_external_server"`
 	TwilioAccountSID                  types.String `tfsdk:"twilio_account_sid"`
 	TwilioAuthToken                   types.String `tfsdk:"twilio_auth_token"`
+	TwilioAuthTokenWo                 types.String `tfsdk:"twilio_auth_token_wo"`
+	TwilioAuthTokenWoVersion          types.Int64  `tfsdk:"twilio_auth_token_wo_version"`
 	TwilioAuthTokenPassphraseProvider types.String `tfsdk:"twilio_auth_token_passphrase_provider"`
 	PhoneNumberAttributeType          types.String `tfsdk:"phone_number_attribute_type"`
 	PhoneNumberJSONField              types.String `tfsdk:"phone_number_json_field"`
@@ -243,6 +247,7 @@ func otpDeliveryMechanismSchema(ctx context.Context, req resource.SchemaRequest,
 		extensionClassAttr.PlanModifiers = append(extensionClassAttr.PlanModifiers, stringplanmodifier.RequiresReplace())
 		schemaDef.Attributes["extension_class"] = extensionClassAttr
 	}
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "twilio_auth_token")
 	config.AddCommonResourceSchema(&schemaDef, true)
 	resp.Schema = schemaDef
 }
@@ -316,6 +321,7 @@ func configValidatorsOtpDeliveryMechanism() []resource.ConfigValidator {
 			[]string{"twilio"},
 			resourcevalidator.ExactlyOneOf(
 				path.MatchRoot("twilio_auth_token"),
+				path.MatchRoot("twilio_auth_token_wo"),
 				path.MatchRoot("twilio_auth_token_passphrase_provider"),
 			),
 		),
@@ -334,6 +340,11 @@ func configValidatorsOtpDeliveryMechanism() []resource.ConfigValidator {
 			path.MatchRoot("type"),
 			[]string{"twilio"},
 		),
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("twilio_auth_token_wo"),
+			path.MatchRoot("type"),
+			[]string{"twilio"},
+		),
 		configvalidators.ImpliesOtherAttributeOneOfString(
 			path.MatchRoot("twilio_auth_token_passphrase_provider"),
 			path.MatchRoot("type"),
@@ -442,6 +453,9 @@ func addOptionalTwilioOtpDeliveryMechanismFields(ctx context.Context, addRequest
 	if internaltypes.IsNonEmptyString(plan.TwilioAuthToken) {
 		addRequest.TwilioAuthToken = plan.TwilioAuthToken.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.TwilioAuthTokenWo) {
+		addRequest.TwilioAuthToken = plan.TwilioAuthTokenWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.TwilioAuthTokenPassphraseProvider) {
 		addRequest.TwilioAuthTokenPassphraseProvider = plan.TwilioAuthTokenPassphraseProvider.ValueStringPointer()
@@ -636,6 +650,9 @@ func (state *otpDeliveryMechanismResourceModel) setStateValuesNotReturnedByAPI(e
 	if !expectedValues.TwilioAuthToken.IsUnknown() {
 		state.TwilioAuthToken = expectedValues.TwilioAuthToken
 	}
+	if !expectedValues.TwilioAuthTokenWoVersion.IsUnknown() {
+		state.TwilioAuthTokenWoVersion = expectedValues.TwilioAuthTokenWoVersion
+	}
 }
 
 // Create any update operations necessary to make the state match the plan
@@ -651,6 +668,7 @@ func createOtpDeliveryMechanismOperations(plan otpDeliveryMechanismResourceModel
 	operations.AddStringOperationIfNecessary(&ops, plan.HttpProxyExternalServer, state.HttpProxyExternalServer, "http-proxy-external-server")
 	operations.AddStringOperationIfNecessary(&ops, plan.TwilioAccountSID, state.TwilioAccountSID, "twilio-account-sid")
 	operations.AddStringOperationIfNecessary(&ops, plan.TwilioAuthToken, state.TwilioAuthToken, "twilio-auth-token")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.TwilioAuthTokenWo, plan.TwilioAuthTokenWoVersion, state.TwilioAuthTokenWoVersion, "twilio-auth-token")
 	operations.AddStringOperationIfNecessary(&ops, plan.TwilioAuthTokenPassphraseProvider, state.TwilioAuthTokenPassphraseProvider, "twilio-auth-token-passphrase-provider")
 	operations.AddStringOperationIfNecessary(&ops, plan.PhoneNumberAttributeType, state.PhoneNumberAttributeType, "phone-number-attribute-type")
 	operations.AddStringOperationIfNecessary(&ops, plan.PhoneNumberJSONField, state.PhoneNumberJSONField, "phone-number-json-field")
@@ -685,7 +703,7 @@ func (r *otpDeliveryMechanismResource) CreateTwilioOtpDeliveryMechanism(ctx cont
 
 	addResponse, httpResp, err := r.apiClient.OtpDeliveryMechanismAPI.AddOtpDeliveryMechanismExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Otp Delivery Mechanism", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Otp Delivery Mechanism", err, httpResp)
 		return nil, err
 	}
 
@@ -720,7 +738,7 @@ func (r *otpDeliveryMechanismResource) CreateEmailOtpDeliveryMechanism(ctx conte
 
 	addResponse, httpResp, err := r.apiClient.OtpDeliveryMechanismAPI.AddOtpDeliveryMechanismExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Otp Delivery Mechanism", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Otp Delivery Mechanism", err, httpResp)
 		return nil, err
 	}
 
@@ -755,7 +773,7 @@ func (r *otpDeliveryMechanismResource) CreateThirdPartyOtpDeliveryMechanism(ctx
 
 	addResponse, httpResp, err := r.apiClient.OtpDeliveryMechanismAPI.AddOtpDeliveryMechanismExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Otp Delivery Mechanism", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Otp Delivery Mechanism", err, httpResp)
 		return nil, err
 	}
 
@@ -780,6 +798,11 @@ func (r *otpDeliveryMechanismResource) Create(ctx context.Context, req resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("twilio_auth_token_wo"), &plan.TwilioAuthTokenWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	var state *otpDeliveryMechanismResourceModel
 	var err error
@@ -804,6 +827,8 @@ func (r *otpDeliveryMechanismResource) Create(ctx context.Context, req resource.
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Otp Delivery Mechanism", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -824,11 +849,16 @@ func (r *defaultOtpDeliveryMechanismResource) Create(ctx context.Context, req re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("twilio_auth_token_wo"), &plan.TwilioAuthTokenWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	readResponse, httpResp, err := r.apiClient.OtpDeliveryMechanismAPI.GetOtpDeliveryMechanism(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Otp Delivery Mechanism", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Otp Delivery Mechanism", err, httpResp)
 		return
 	}
 
@@ -860,7 +890,7 @@ func (r *defaultOtpDeliveryMechanismResource) Create(ctx context.Context, req re
 
 		updateResponse, httpResp, err := r.apiClient.OtpDeliveryMechanismAPI.UpdateOtpDeliveryMechanismExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Otp Delivery Mechanism", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Otp Delivery Mechanism", err, httpResp)
 			return
 		}
 
@@ -880,6 +910,8 @@ func (r *defaultOtpDeliveryMechanismResource) Create(ctx context.Context, req re
 		if updateResponse.ThirdPartyOtpDeliveryMechanismResponse != nil {
 			readThirdPartyOtpDeliveryMechanismResponse(ctx, updateResponse.ThirdPartyOtpDeliveryMechanismResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Otp Delivery Mechanism", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -964,6 +996,11 @@ func updateOtpDeliveryMechanism(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("twilio_auth_token_wo"), &plan.TwilioAuthTokenWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Get the current state to see how any attributes are changing
 	var state otpDeliveryMechanismResourceModel
@@ -980,7 +1017,7 @@ func updateOtpDeliveryMechanism(ctx context.Context, req resource.UpdateRequest,
 
 		updateResponse, httpResp, err := apiClient.OtpDeliveryMechanismAPI.UpdateOtpDeliveryMechanismExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Otp Delivery Mechanism", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Otp Delivery Mechanism", err, httpResp)
 			return
 		}
 
@@ -1000,6 +1037,8 @@ func updateOtpDeliveryMechanism(ctx context.Context, req resource.UpdateRequest,
 		if updateResponse.ThirdPartyOtpDeliveryMechanismResponse != nil {
 			readThirdPartyOtpDeliveryMechanismResponse(ctx, updateResponse.ThirdPartyOtpDeliveryMechanismResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Otp Delivery Mechanism", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -1048,3 +1087,16 @@ func importOtpDeliveryMechanism(ctx context.Context, req resource.ImportStateReq
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *otpDeliveryMechanismResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_otp_delivery_mechanism"),
+	}
+}
+
+func (r *defaultOtpDeliveryMechanismResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_otp_delivery_mechanism"),
+	}
+}
@@ -49,29 +49,21 @@ func (r *otpDeliveryMechanismsDataSource) Configure(_ context.Context, req datas
 }
 
 type otpDeliveryMechanismsDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *otpDeliveryMechanismsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Otp Delivery Mechanism objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Otp Delivery Mechanism objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewOtpDeliveryMechanismDataSource(), "Otp Delivery Mechanism")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *otpDeliveryMechanismsDataSource) Read(ctx context.Context, req datasour
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewOtpDeliveryMechanismDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.OtpDeliveryMechanismAPI.ListOtpDeliveryMechanisms(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.OtpDeliveryMechanismAPI.ListOtpDeliveryMechanismsExecute(listRequest)
@@ -106,29 +104,29 @@ func (r *otpDeliveryMechanismsDataSource) Read(ctx context.Context, req datasour
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object otpDeliveryMechanismDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.TwilioOtpDeliveryMechanismResponse != nil {
-			attributes["id"] = types.StringValue(response.TwilioOtpDeliveryMechanismResponse.Id)
-			attributes["type"] = types.StringValue("twilio")
+			readTwilioOtpDeliveryMechanismResponseDataSource(ctx, response.TwilioOtpDeliveryMechanismResponse, &object, &resp.Diagnostics)
 		}
 		if response.EmailOtpDeliveryMechanismResponse != nil {
-			attributes["id"] = types.StringValue(response.EmailOtpDeliveryMechanismResponse.Id)
-			attributes["type"] = types.StringValue("email")
+			readEmailOtpDeliveryMechanismResponseDataSource(ctx, response.EmailOtpDeliveryMechanismResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyOtpDeliveryMechanismResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyOtpDeliveryMechanismResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyOtpDeliveryMechanismResponseDataSource(ctx, response.ThirdPartyOtpDeliveryMechanismResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
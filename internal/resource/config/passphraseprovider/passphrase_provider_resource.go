@@ -5,6 +5,7 @@ package passphraseprovider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -28,9 +29,11 @@ var (
 	_ resource.Resource                = &passphraseProviderResource{}
 	_ resource.ResourceWithConfigure   = &passphraseProviderResource{}
 	_ resource.ResourceWithImportState = &passphraseProviderResource{}
+	_ resource.ResourceWithMoveState   = &passphraseProviderResource{}
 	_ resource.Resource                = &defaultPassphraseProviderResource{}
 	_ resource.ResourceWithConfigure   = &defaultPassphraseProviderResource{}
 	_ resource.ResourceWithImportState = &defaultPassphraseProviderResource{}
+	_ resource.ResourceWithMoveState   = &defaultPassphraseProviderResource{}
 )
 
 // Create a Passphrase Provider resource
@@ -103,6 +106,8 @@ type passphraseProviderResourceModel struct {
 	HttpProxyExternalServer   types.String `tfsdk:"http_proxy_external_server"`
 	SecretName                types.String `tfsdk:"secret_name"`
 	ObscuredValue             types.String `tfsdk:"obscured_value"`
+	ObscuredValueWo           types.String `tfsdk:"obscured_value_wo"`
+	ObscuredValueWoVersion    types.Int64  `tfsdk:"obscured_value_wo_version"`
 	AwsExternalServer         types.String `tfsdk:"aws_external_server"`
 	SecretID                  types.String `tfsdk:"secret_id"`
 	SecretFieldName           types.String `tfsdk:"secret_field_name"`
@@ -277,6 +282,7 @@ func passphraseProviderSchema(ctx context.Context, req resource.SchemaRequest, r
 		extensionClassAttr.PlanModifiers = append(extensionClassAttr.PlanModifiers, stringplanmodifier.RequiresReplace())
 		schemaDef.Attributes["extension_class"] = extensionClassAttr
 	}
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "obscured_value")
 	config.AddCommonResourceSchema(&schemaDef, true)
 	resp.Schema = schemaDef
 }
@@ -346,6 +352,11 @@ func configValidatorsPassphraseProvider() []resource.ConfigValidator {
 			path.MatchRoot("type"),
 			[]string{"obscured-value"},
 		),
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("obscured_value_wo"),
+			path.MatchRoot("type"),
+			[]string{"obscured-value"},
+		),
 		configvalidators.ImpliesOtherAttributeOneOfString(
 			path.MatchRoot("key_vault_uri"),
 			path.MatchRoot("type"),
@@ -416,10 +427,13 @@ func configValidatorsPassphraseProvider() []resource.ConfigValidator {
 			"amazon-secrets-manager",
 			[]path.Expression{path.MatchRoot("aws_external_server"), path.MatchRoot("secret_id"), path.MatchRoot("secret_field_name")},
 		),
-		configvalidators.ValueImpliesAttributeRequired(
+		configvalidators.ImpliesOtherValidator(
 			path.MatchRoot("type"),
-			"obscured-value",
-			[]path.Expression{path.MatchRoot("obscured_value")},
+			[]string{"obscured-value"},
+			resourcevalidator.ExactlyOneOf(
+				path.MatchRoot("obscured_value"),
+				path.MatchRoot("obscured_value_wo"),
+			),
 		),
 		configvalidators.ValueImpliesAttributeRequired(
 			path.MatchRoot("type"),
@@ -758,6 +772,9 @@ func (state *passphraseProviderResourceModel) setStateValuesNotReturnedByAPI(exp
 	if !expectedValues.ObscuredValue.IsUnknown() {
 		state.ObscuredValue = expectedValues.ObscuredValue
 	}
+	if !expectedValues.ObscuredValueWoVersion.IsUnknown() {
+		state.ObscuredValueWoVersion = expectedValues.ObscuredValueWoVersion
+	}
 }
 
 // Create any update operations necessary to make the state match the plan
@@ -776,6 +793,7 @@ func createPassphraseProviderOperations(plan passphraseProviderResourceModel, st
 	operations.AddStringOperationIfNecessary(&ops, plan.HttpProxyExternalServer, state.HttpProxyExternalServer, "http-proxy-external-server")
 	operations.AddStringOperationIfNecessary(&ops, plan.SecretName, state.SecretName, "secret-name")
 	operations.AddStringOperationIfNecessary(&ops, plan.ObscuredValue, state.ObscuredValue, "obscured-value")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.ObscuredValueWo, plan.ObscuredValueWoVersion, state.ObscuredValueWoVersion, "obscured-value")
 	operations.AddStringOperationIfNecessary(&ops, plan.AwsExternalServer, state.AwsExternalServer, "aws-external-server")
 	operations.AddStringOperationIfNecessary(&ops, plan.SecretID, state.SecretID, "secret-id")
 	operations.AddStringOperationIfNecessary(&ops, plan.SecretFieldName, state.SecretFieldName, "secret-field-name")
@@ -807,7 +825,7 @@ func (r *passphraseProviderResource) CreateEnvironmentVariablePassphraseProvider
 
 	addResponse, httpResp, err := r.apiClient.PassphraseProviderAPI.AddPassphraseProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Passphrase Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Passphrase Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -844,7 +862,7 @@ func (r *passphraseProviderResource) CreateAmazonSecretsManagerPassphraseProvide
 
 	addResponse, httpResp, err := r.apiClient.PassphraseProviderAPI.AddPassphraseProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Passphrase Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Passphrase Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -862,8 +880,12 @@ func (r *passphraseProviderResource) CreateAmazonSecretsManagerPassphraseProvide
 
 // Create a obscured-value passphrase-provider
 func (r *passphraseProviderResource) CreateObscuredValuePassphraseProvider(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse, plan passphraseProviderResourceModel) (*passphraseProviderResourceModel, error) {
+	obscuredValue := plan.ObscuredValue.ValueString()
+	if internaltypes.IsNonEmptyString(plan.ObscuredValueWo) {
+		obscuredValue = plan.ObscuredValueWo.ValueString()
+	}
 	addRequest := client.NewAddObscuredValuePassphraseProviderRequest([]client.EnumobscuredValuePassphraseProviderSchemaUrn{client.ENUMOBSCUREDVALUEPASSPHRASEPROVIDERSCHEMAURN_URNPINGIDENTITYSCHEMASCONFIGURATION2_0PASSPHRASE_PROVIDEROBSCURED_VALUE},
-		plan.ObscuredValue.ValueString(),
+		obscuredValue,
 		plan.Enabled.ValueBool(),
 		plan.Name.ValueString())
 	addOptionalObscuredValuePassphraseProviderFields(ctx, addRequest, plan)
@@ -879,7 +901,7 @@ func (r *passphraseProviderResource) CreateObscuredValuePassphraseProvider(ctx c
 
 	addResponse, httpResp, err := r.apiClient.PassphraseProviderAPI.AddPassphraseProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Passphrase Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Passphrase Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -916,7 +938,7 @@ func (r *passphraseProviderResource) CreateAzureKeyVaultPassphraseProvider(ctx c
 
 	addResponse, httpResp, err := r.apiClient.PassphraseProviderAPI.AddPassphraseProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Passphrase Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Passphrase Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -951,7 +973,7 @@ func (r *passphraseProviderResource) CreateFileBasedPassphraseProvider(ctx conte
 
 	addResponse, httpResp, err := r.apiClient.PassphraseProviderAPI.AddPassphraseProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Passphrase Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Passphrase Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -987,7 +1009,7 @@ func (r *passphraseProviderResource) CreateConjurPassphraseProvider(ctx context.
 
 	addResponse, httpResp, err := r.apiClient.PassphraseProviderAPI.AddPassphraseProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Passphrase Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Passphrase Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -1024,7 +1046,7 @@ func (r *passphraseProviderResource) CreateVaultPassphraseProvider(ctx context.C
 
 	addResponse, httpResp, err := r.apiClient.PassphraseProviderAPI.AddPassphraseProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Passphrase Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Passphrase Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -1059,7 +1081,7 @@ func (r *passphraseProviderResource) CreateThirdPartyPassphraseProvider(ctx cont
 
 	addResponse, httpResp, err := r.apiClient.PassphraseProviderAPI.AddPassphraseProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Passphrase Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Passphrase Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -1084,6 +1106,11 @@ func (r *passphraseProviderResource) Create(ctx context.Context, req resource.Cr
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("obscured_value_wo"), &plan.ObscuredValueWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	var state *passphraseProviderResourceModel
 	var err error
@@ -1138,6 +1165,8 @@ func (r *passphraseProviderResource) Create(ctx context.Context, req resource.Cr
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Passphrase Provider", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -1158,11 +1187,16 @@ func (r *defaultPassphraseProviderResource) Create(ctx context.Context, req reso
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("obscured_value_wo"), &plan.ObscuredValueWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	readResponse, httpResp, err := r.apiClient.PassphraseProviderAPI.GetPassphraseProvider(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Passphrase Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Passphrase Provider", err, httpResp)
 		return
 	}
 
@@ -1209,7 +1243,7 @@ func (r *defaultPassphraseProviderResource) Create(ctx context.Context, req reso
 
 		updateResponse, httpResp, err := r.apiClient.PassphraseProviderAPI.UpdatePassphraseProviderExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Passphrase Provider", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Passphrase Provider", err, httpResp)
 			return
 		}
 
@@ -1244,6 +1278,8 @@ func (r *defaultPassphraseProviderResource) Create(ctx context.Context, req reso
 		if updateResponse.ThirdPartyPassphraseProviderResponse != nil {
 			readThirdPartyPassphraseProviderResponse(ctx, updateResponse.ThirdPartyPassphraseProviderResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Passphrase Provider", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -1343,6 +1379,11 @@ func updatePassphraseProvider(ctx context.Context, req resource.UpdateRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("obscured_value_wo"), &plan.ObscuredValueWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Get the current state to see how any attributes are changing
 	var state passphraseProviderResourceModel
@@ -1359,7 +1400,7 @@ func updatePassphraseProvider(ctx context.Context, req resource.UpdateRequest, r
 
 		updateResponse, httpResp, err := apiClient.PassphraseProviderAPI.UpdatePassphraseProviderExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Passphrase Provider", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Passphrase Provider", err, httpResp)
 			return
 		}
 
@@ -1394,6 +1435,8 @@ func updatePassphraseProvider(ctx context.Context, req resource.UpdateRequest, r
 		if updateResponse.ThirdPartyPassphraseProviderResponse != nil {
 			readThirdPartyPassphraseProviderResponse(ctx, updateResponse.ThirdPartyPassphraseProviderResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Passphrase Provider", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -1442,3 +1485,16 @@ func importPassphraseProvider(ctx context.Context, req resource.ImportStateReque
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *passphraseProviderResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_passphrase_provider"),
+	}
+}
+
+func (r *defaultPassphraseProviderResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_passphrase_provider"),
+	}
+}
@@ -49,29 +49,21 @@ func (r *passphraseProvidersDataSource) Configure(_ context.Context, req datasou
 }
 
 type passphraseProvidersDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *passphraseProvidersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Passphrase Provider objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Passphrase Provider objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewPassphraseProviderDataSource(), "Passphrase Provider")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *passphraseProvidersDataSource) Read(ctx context.Context, req datasource
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewPassphraseProviderDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.PassphraseProviderAPI.ListPassphraseProviders(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.PassphraseProviderAPI.ListPassphraseProvidersExecute(listRequest)
@@ -106,49 +104,44 @@ func (r *passphraseProvidersDataSource) Read(ctx context.Context, req datasource
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object passphraseProviderDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.EnvironmentVariablePassphraseProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.EnvironmentVariablePassphraseProviderResponse.Id)
-			attributes["type"] = types.StringValue("environment-variable")
+			readEnvironmentVariablePassphraseProviderResponseDataSource(ctx, response.EnvironmentVariablePassphraseProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.AmazonSecretsManagerPassphraseProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.AmazonSecretsManagerPassphraseProviderResponse.Id)
-			attributes["type"] = types.StringValue("amazon-secrets-manager")
+			readAmazonSecretsManagerPassphraseProviderResponseDataSource(ctx, response.AmazonSecretsManagerPassphraseProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.ObscuredValuePassphraseProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.ObscuredValuePassphraseProviderResponse.Id)
-			attributes["type"] = types.StringValue("obscured-value")
+			readObscuredValuePassphraseProviderResponseDataSource(ctx, response.ObscuredValuePassphraseProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.AzureKeyVaultPassphraseProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.AzureKeyVaultPassphraseProviderResponse.Id)
-			attributes["type"] = types.StringValue("azure-key-vault")
+			readAzureKeyVaultPassphraseProviderResponseDataSource(ctx, response.AzureKeyVaultPassphraseProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.FileBasedPassphraseProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.FileBasedPassphraseProviderResponse.Id)
-			attributes["type"] = types.StringValue("file-based")
+			readFileBasedPassphraseProviderResponseDataSource(ctx, response.FileBasedPassphraseProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.ConjurPassphraseProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.ConjurPassphraseProviderResponse.Id)
-			attributes["type"] = types.StringValue("conjur")
+			readConjurPassphraseProviderResponseDataSource(ctx, response.ConjurPassphraseProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.VaultPassphraseProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.VaultPassphraseProviderResponse.Id)
-			attributes["type"] = types.StringValue("vault")
+			readVaultPassphraseProviderResponseDataSource(ctx, response.VaultPassphraseProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyPassphraseProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyPassphraseProviderResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyPassphraseProviderResponseDataSource(ctx, response.ThirdPartyPassphraseProviderResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -31,9 +31,11 @@ var (
 	_ resource.Resource                = &passThroughAuthenticationHandlerResource{}
 	_ resource.ResourceWithConfigure   = &passThroughAuthenticationHandlerResource{}
 	_ resource.ResourceWithImportState = &passThroughAuthenticationHandlerResource{}
+	_ resource.ResourceWithMoveState   = &passThroughAuthenticationHandlerResource{}
 	_ resource.Resource                = &defaultPassThroughAuthenticationHandlerResource{}
 	_ resource.ResourceWithConfigure   = &defaultPassThroughAuthenticationHandlerResource{}
 	_ resource.ResourceWithImportState = &defaultPassThroughAuthenticationHandlerResource{}
+	_ resource.ResourceWithMoveState   = &defaultPassThroughAuthenticationHandlerResource{}
 )
 
 // Create a Pass Through Authentication Handler resource
@@ -113,6 +115,8 @@ type passThroughAuthenticationHandlerResourceModel struct {
 	AuthURL                                     types.String `tfsdk:"auth_url"`
 	OAuthClientID                               types.String `tfsdk:"oauth_client_id"`
 	OAuthClientSecret                           types.String `tfsdk:"oauth_client_secret"`
+	OAuthClientSecretWo                         types.String `tfsdk:"oauth_client_secret_wo"`
+	OAuthClientSecretWoVersion                  types.Int64  `tfsdk:"oauth_client_secret_wo_version"`
 	OAuthClientSecretPassphraseProvider         types.String `tfsdk:"oauth_client_secret_passphrase_provider"`
 	EnvironmentID                               types.String `tfsdk:"environment_id"`
 	HttpProxyExternalServer                     types.String `tfsdk:"http_proxy_external_server"`
@@ -339,6 +343,7 @@ func passThroughAuthenticationHandlerSchema(ctx context.Context, req resource.Sc
 		extensionClassAttr.PlanModifiers = append(extensionClassAttr.PlanModifiers, stringplanmodifier.RequiresReplace())
 		schemaDef.Attributes["extension_class"] = extensionClassAttr
 	}
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "oauth_client_secret")
 	config.AddCommonResourceSchema(&schemaDef, true)
 	resp.Schema = schemaDef
 }
@@ -443,6 +448,7 @@ func configValidatorsPassThroughAuthenticationHandler() []resource.ConfigValidat
 			[]string{"ping-one"},
 			resourcevalidator.ExactlyOneOf(
 				path.MatchRoot("oauth_client_secret"),
+				path.MatchRoot("oauth_client_secret_wo"),
 				path.MatchRoot("oauth_client_secret_passphrase_provider"),
 			),
 		),
@@ -466,6 +472,11 @@ func configValidatorsPassThroughAuthenticationHandler() []resource.ConfigValidat
 			path.MatchRoot("type"),
 			[]string{"ping-one"},
 		),
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("oauth_client_secret_wo"),
+			path.MatchRoot("type"),
+			[]string{"ping-one"},
+		),
 		configvalidators.ImpliesOtherAttributeOneOfString(
 			path.MatchRoot("oauth_client_secret_passphrase_provider"),
 			path.MatchRoot("type"),
@@ -615,6 +626,9 @@ func addOptionalPingOnePassThroughAuthenticationHandlerFields(ctx context.Contex
 	if internaltypes.IsNonEmptyString(plan.OAuthClientSecret) {
 		addRequest.OAuthClientSecret = plan.OAuthClientSecret.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.OAuthClientSecretWo) {
+		addRequest.OAuthClientSecret = plan.OAuthClientSecretWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.OAuthClientSecretPassphraseProvider) {
 		addRequest.OAuthClientSecretPassphraseProvider = plan.OAuthClientSecretPassphraseProvider.ValueStringPointer()
@@ -947,6 +961,9 @@ func (state *passThroughAuthenticationHandlerResourceModel) setStateValuesNotRet
 	if !expectedValues.OAuthClientSecret.IsUnknown() {
 		state.OAuthClientSecret = expectedValues.OAuthClientSecret
 	}
+	if !expectedValues.OAuthClientSecretWoVersion.IsUnknown() {
+		state.OAuthClientSecretWoVersion = expectedValues.OAuthClientSecretWoVersion
+	}
 }
 
 // Create any update operations necessary to make the state match the plan
@@ -972,6 +989,7 @@ func createPassThroughAuthenticationHandlerOperations(plan passThroughAuthentica
 	operations.AddStringOperationIfNecessary(&ops, plan.AuthURL, state.AuthURL, "auth-url")
 	operations.AddStringOperationIfNecessary(&ops, plan.OAuthClientID, state.OAuthClientID, "oauth-client-id")
 	operations.AddStringOperationIfNecessary(&ops, plan.OAuthClientSecret, state.OAuthClientSecret, "oauth-client-secret")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.OAuthClientSecretWo, plan.OAuthClientSecretWoVersion, state.OAuthClientSecretWoVersion, "oauth-client-secret")
 	operations.AddStringOperationIfNecessary(&ops, plan.OAuthClientSecretPassphraseProvider, state.OAuthClientSecretPassphraseProvider, "oauth-client-secret-passphrase-provider")
 	operations.AddStringOperationIfNecessary(&ops, plan.EnvironmentID, state.EnvironmentID, "environment-id")
 	operations.AddStringOperationIfNecessary(&ops, plan.HttpProxyExternalServer, state.HttpProxyExternalServer, "http-proxy-external-server")
@@ -1016,7 +1034,7 @@ func (r *passThroughAuthenticationHandlerResource) CreatePingOnePassThroughAuthe
 
 	addResponse, httpResp, err := r.apiClient.PassThroughAuthenticationHandlerAPI.AddPassThroughAuthenticationHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Pass Through Authentication Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Pass Through Authentication Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1056,7 +1074,7 @@ func (r *passThroughAuthenticationHandlerResource) CreateLdapPassThroughAuthenti
 
 	addResponse, httpResp, err := r.apiClient.PassThroughAuthenticationHandlerAPI.AddPassThroughAuthenticationHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Pass Through Authentication Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Pass Through Authentication Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1096,7 +1114,7 @@ func (r *passThroughAuthenticationHandlerResource) CreateAggregatePassThroughAut
 
 	addResponse, httpResp, err := r.apiClient.PassThroughAuthenticationHandlerAPI.AddPassThroughAuthenticationHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Pass Through Authentication Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Pass Through Authentication Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1134,7 +1152,7 @@ func (r *passThroughAuthenticationHandlerResource) CreateThirdPartyPassThroughAu
 
 	addResponse, httpResp, err := r.apiClient.PassThroughAuthenticationHandlerAPI.AddPassThroughAuthenticationHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Pass Through Authentication Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Pass Through Authentication Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1159,6 +1177,11 @@ func (r *passThroughAuthenticationHandlerResource) Create(ctx context.Context, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("oauth_client_secret_wo"), &plan.OAuthClientSecretWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	var state *passThroughAuthenticationHandlerResourceModel
 	var err error
@@ -1189,6 +1212,8 @@ func (r *passThroughAuthenticationHandlerResource) Create(ctx context.Context, r
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Pass Through Authentication Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -1209,11 +1234,16 @@ func (r *defaultPassThroughAuthenticationHandlerResource) Create(ctx context.Con
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("oauth_client_secret_wo"), &plan.OAuthClientSecretWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	readResponse, httpResp, err := r.apiClient.PassThroughAuthenticationHandlerAPI.GetPassThroughAuthenticationHandler(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Pass Through Authentication Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Pass Through Authentication Handler", err, httpResp)
 		return
 	}
 
@@ -1248,7 +1278,7 @@ func (r *defaultPassThroughAuthenticationHandlerResource) Create(ctx context.Con
 
 		updateResponse, httpResp, err := r.apiClient.PassThroughAuthenticationHandlerAPI.UpdatePassThroughAuthenticationHandlerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Pass Through Authentication Handler", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Pass Through Authentication Handler", err, httpResp)
 			return
 		}
 
@@ -1271,6 +1301,8 @@ func (r *defaultPassThroughAuthenticationHandlerResource) Create(ctx context.Con
 		if updateResponse.ThirdPartyPassThroughAuthenticationHandlerResponse != nil {
 			readThirdPartyPassThroughAuthenticationHandlerResponse(ctx, updateResponse.ThirdPartyPassThroughAuthenticationHandlerResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Pass Through Authentication Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -1358,6 +1390,11 @@ func updatePassThroughAuthenticationHandler(ctx context.Context, req resource.Up
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("oauth_client_secret_wo"), &plan.OAuthClientSecretWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Get the current state to see how any attributes are changing
 	var state passThroughAuthenticationHandlerResourceModel
@@ -1374,7 +1411,7 @@ func updatePassThroughAuthenticationHandler(ctx context.Context, req resource.Up
 
 		updateResponse, httpResp, err := apiClient.PassThroughAuthenticationHandlerAPI.UpdatePassThroughAuthenticationHandlerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Pass Through Authentication Handler", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Pass Through Authentication Handler", err, httpResp)
 			return
 		}
 
@@ -1397,6 +1434,8 @@ func updatePassThroughAuthenticationHandler(ctx context.Context, req resource.Up
 		if updateResponse.ThirdPartyPassThroughAuthenticationHandlerResponse != nil {
 			readThirdPartyPassThroughAuthenticationHandlerResponse(ctx, updateResponse.ThirdPartyPassThroughAuthenticationHandlerResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Pass Through Authentication Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -1445,3 +1484,16 @@ func importPassThroughAuthenticationHandler(ctx context.Context, req resource.Im
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *passThroughAuthenticationHandlerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_pass_through_authentication_handler"),
+	}
+}
+
+func (r *defaultPassThroughAuthenticationHandlerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_pass_through_authentication_handler"),
+	}
+}
@@ -49,29 +49,21 @@ func (r *passThroughAuthenticationHandlersDataSource) Configure(_ context.Contex
 }
 
 type passThroughAuthenticationHandlersDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *passThroughAuthenticationHandlersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Pass Through Authentication Handler objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Pass Through Authentication Handler objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewPassThroughAuthenticationHandlerDataSource(), "Pass Through Authentication Handler")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *passThroughAuthenticationHandlersDataSource) Read(ctx context.Context,
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewPassThroughAuthenticationHandlerDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.PassThroughAuthenticationHandlerAPI.ListPassThroughAuthenticationHandlers(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.PassThroughAuthenticationHandlerAPI.ListPassThroughAuthenticationHandlersExecute(listRequest)
@@ -106,33 +104,32 @@ func (r *passThroughAuthenticationHandlersDataSource) Read(ctx context.Context,
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object passThroughAuthenticationHandlerDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.PingOnePassThroughAuthenticationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.PingOnePassThroughAuthenticationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("ping-one")
+			readPingOnePassThroughAuthenticationHandlerResponseDataSource(ctx, response.PingOnePassThroughAuthenticationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.LdapPassThroughAuthenticationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.LdapPassThroughAuthenticationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("ldap")
+			readLdapPassThroughAuthenticationHandlerResponseDataSource(ctx, response.LdapPassThroughAuthenticationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.AggregatePassThroughAuthenticationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.AggregatePassThroughAuthenticationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("aggregate")
+			readAggregatePassThroughAuthenticationHandlerResponseDataSource(ctx, response.AggregatePassThroughAuthenticationHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyPassThroughAuthenticationHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyPassThroughAuthenticationHandlerResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyPassThroughAuthenticationHandlerResponseDataSource(ctx, response.ThirdPartyPassThroughAuthenticationHandlerResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
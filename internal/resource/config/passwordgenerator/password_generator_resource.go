@@ -29,9 +29,11 @@ var (
 	_ resource.Resource                = &passwordGeneratorResource{}
 	_ resource.ResourceWithConfigure   = &passwordGeneratorResource{}
 	_ resource.ResourceWithImportState = &passwordGeneratorResource{}
+	_ resource.ResourceWithMoveState   = &passwordGeneratorResource{}
 	_ resource.Resource                = &defaultPasswordGeneratorResource{}
 	_ resource.ResourceWithConfigure   = &defaultPasswordGeneratorResource{}
 	_ resource.ResourceWithImportState = &defaultPasswordGeneratorResource{}
+	_ resource.ResourceWithMoveState   = &defaultPasswordGeneratorResource{}
 )
 
 // Create a Password Generator resource
@@ -540,7 +542,7 @@ func (r *passwordGeneratorResource) CreateRandomPasswordGenerator(ctx context.Co
 
 	addResponse, httpResp, err := r.apiClient.PasswordGeneratorAPI.AddPasswordGeneratorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Generator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Generator", err, httpResp)
 		return nil, err
 	}
 
@@ -575,7 +577,7 @@ func (r *passwordGeneratorResource) CreateGroovyScriptedPasswordGenerator(ctx co
 
 	addResponse, httpResp, err := r.apiClient.PasswordGeneratorAPI.AddPasswordGeneratorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Generator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Generator", err, httpResp)
 		return nil, err
 	}
 
@@ -610,7 +612,7 @@ func (r *passwordGeneratorResource) CreatePassphrasePasswordGenerator(ctx contex
 
 	addResponse, httpResp, err := r.apiClient.PasswordGeneratorAPI.AddPasswordGeneratorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Generator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Generator", err, httpResp)
 		return nil, err
 	}
 
@@ -645,7 +647,7 @@ func (r *passwordGeneratorResource) CreateThirdPartyPasswordGenerator(ctx contex
 
 	addResponse, httpResp, err := r.apiClient.PasswordGeneratorAPI.AddPasswordGeneratorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Generator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Generator", err, httpResp)
 		return nil, err
 	}
 
@@ -698,6 +700,8 @@ func (r *passwordGeneratorResource) Create(ctx context.Context, req resource.Cre
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Password Generator", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -722,7 +726,7 @@ func (r *defaultPasswordGeneratorResource) Create(ctx context.Context, req resou
 	readResponse, httpResp, err := r.apiClient.PasswordGeneratorAPI.GetPasswordGenerator(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Password Generator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Password Generator", err, httpResp)
 		return
 	}
 
@@ -757,7 +761,7 @@ func (r *defaultPasswordGeneratorResource) Create(ctx context.Context, req resou
 
 		updateResponse, httpResp, err := r.apiClient.PasswordGeneratorAPI.UpdatePasswordGeneratorExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Password Generator", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Password Generator", err, httpResp)
 			return
 		}
 
@@ -780,6 +784,8 @@ func (r *defaultPasswordGeneratorResource) Create(ctx context.Context, req resou
 		if updateResponse.ThirdPartyPasswordGeneratorResponse != nil {
 			readThirdPartyPasswordGeneratorResponse(ctx, updateResponse.ThirdPartyPasswordGeneratorResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Password Generator", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -882,7 +888,7 @@ func updatePasswordGenerator(ctx context.Context, req resource.UpdateRequest, re
 
 		updateResponse, httpResp, err := apiClient.PasswordGeneratorAPI.UpdatePasswordGeneratorExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Password Generator", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Password Generator", err, httpResp)
 			return
 		}
 
@@ -905,6 +911,8 @@ func updatePasswordGenerator(ctx context.Context, req resource.UpdateRequest, re
 		if updateResponse.ThirdPartyPasswordGeneratorResponse != nil {
 			readThirdPartyPasswordGeneratorResponse(ctx, updateResponse.ThirdPartyPasswordGeneratorResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Password Generator", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -952,3 +960,16 @@ func importPasswordGenerator(ctx context.Context, req resource.ImportStateReques
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *passwordGeneratorResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_password_generator"),
+	}
+}
+
+func (r *defaultPasswordGeneratorResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_password_generator"),
+	}
+}
@@ -49,29 +49,21 @@ func (r *passwordGeneratorsDataSource) Configure(_ context.Context, req datasour
 }
 
 type passwordGeneratorsDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *passwordGeneratorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Password Generator objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Password Generator objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewPasswordGeneratorDataSource(), "Password Generator")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *passwordGeneratorsDataSource) Read(ctx context.Context, req datasource.
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewPasswordGeneratorDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.PasswordGeneratorAPI.ListPasswordGenerators(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.PasswordGeneratorAPI.ListPasswordGeneratorsExecute(listRequest)
@@ -106,33 +104,32 @@ func (r *passwordGeneratorsDataSource) Read(ctx context.Context, req datasource.
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object passwordGeneratorDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.RandomPasswordGeneratorResponse != nil {
-			attributes["id"] = types.StringValue(response.RandomPasswordGeneratorResponse.Id)
-			attributes["type"] = types.StringValue("random")
+			readRandomPasswordGeneratorResponseDataSource(ctx, response.RandomPasswordGeneratorResponse, &object, &resp.Diagnostics)
 		}
 		if response.PassphrasePasswordGeneratorResponse != nil {
-			attributes["id"] = types.StringValue(response.PassphrasePasswordGeneratorResponse.Id)
-			attributes["type"] = types.StringValue("passphrase")
+			readPassphrasePasswordGeneratorResponseDataSource(ctx, response.PassphrasePasswordGeneratorResponse, &object, &resp.Diagnostics)
 		}
 		if response.GroovyScriptedPasswordGeneratorResponse != nil {
-			attributes["id"] = types.StringValue(response.GroovyScriptedPasswordGeneratorResponse.Id)
-			attributes["type"] = types.StringValue("groovy-scripted")
+			readGroovyScriptedPasswordGeneratorResponseDataSource(ctx, response.GroovyScriptedPasswordGeneratorResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyPasswordGeneratorResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyPasswordGeneratorResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyPasswordGeneratorResponseDataSource(ctx, response.ThirdPartyPasswordGeneratorResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -59,7 +59,7 @@ type passwordPolicyDataSourceModel struct {
 	StateUpdateFailurePolicy                                  types.String `tfsdk:"state_update_failure_policy"`
 	EnableDebug                                               types.Bool   `tfsdk:"enable_debug"`
 	PasswordAttribute                                         types.String `tfsdk:"password_attribute"`
-	DefaultPasswordStorageScheme                              types.Set    `tfsdk:"default_password_storage_scheme"`
+	DefaultPasswordStorageScheme                              types.List   `tfsdk:"default_password_storage_scheme"`
 	DeprecatedPasswordStorageScheme                           types.Set    `tfsdk:"deprecated_password_storage_scheme"`
 	ReEncodePasswordsOnSchemeConfigChange                     types.Bool   `tfsdk:"re_encode_passwords_on_scheme_config_change"`
 	AllowMultiplePasswordValues                               types.Bool   `tfsdk:"allow_multiple_password_values"`
@@ -160,7 +160,7 @@ func (r *passwordPolicyDataSource) Schema(ctx context.Context, req datasource.Sc
 				Optional:    false,
 				Computed:    true,
 			},
-			"default_password_storage_scheme": schema.SetAttribute{
+			"default_password_storage_scheme": schema.ListAttribute{
 				Description: "Specifies the names of the password storage schemes that are used to encode clear-text passwords for this password policy.",
 				Required:    false,
 				Optional:    false,
@@ -456,7 +456,7 @@ func readPasswordPolicyResponseDataSource(ctx context.Context, r *client.Passwor
 		client.StringPointerEnumpasswordPolicyStateUpdateFailurePolicyProp(r.StateUpdateFailurePolicy), false)
 	state.EnableDebug = internaltypes.BoolTypeOrNil(r.EnableDebug)
 	state.PasswordAttribute = types.StringValue(r.PasswordAttribute)
-	state.DefaultPasswordStorageScheme = internaltypes.GetStringSet(r.DefaultPasswordStorageScheme)
+	state.DefaultPasswordStorageScheme = internaltypes.GetStringList(r.DefaultPasswordStorageScheme)
 	state.DeprecatedPasswordStorageScheme = internaltypes.GetStringSet(r.DeprecatedPasswordStorageScheme)
 	state.ReEncodePasswordsOnSchemeConfigChange = internaltypes.BoolTypeOrNil(r.ReEncodePasswordsOnSchemeConfigChange)
 	state.AllowMultiplePasswordValues = internaltypes.BoolTypeOrNil(r.AllowMultiplePasswordValues)
@@ -31,9 +31,11 @@ var (
 	_ resource.Resource                = &passwordPolicyResource{}
 	_ resource.ResourceWithConfigure   = &passwordPolicyResource{}
 	_ resource.ResourceWithImportState = &passwordPolicyResource{}
+	_ resource.ResourceWithMoveState   = &passwordPolicyResource{}
 	_ resource.Resource                = &defaultPasswordPolicyResource{}
 	_ resource.ResourceWithConfigure   = &defaultPasswordPolicyResource{}
 	_ resource.ResourceWithImportState = &defaultPasswordPolicyResource{}
+	_ resource.ResourceWithMoveState   = &defaultPasswordPolicyResource{}
 )
 
 // Create a Password Policy resource
@@ -100,7 +102,7 @@ type passwordPolicyResourceModel struct {
 	StateUpdateFailurePolicy                                  types.String `tfsdk:"state_update_failure_policy"`
 	EnableDebug                                               types.Bool   `tfsdk:"enable_debug"`
 	PasswordAttribute                                         types.String `tfsdk:"password_attribute"`
-	DefaultPasswordStorageScheme                              types.Set    `tfsdk:"default_password_storage_scheme"`
+	DefaultPasswordStorageScheme                              types.List   `tfsdk:"default_password_storage_scheme"`
 	DeprecatedPasswordStorageScheme                           types.Set    `tfsdk:"deprecated_password_storage_scheme"`
 	ReEncodePasswordsOnSchemeConfigChange                     types.Bool   `tfsdk:"re_encode_passwords_on_scheme_config_change"`
 	AllowMultiplePasswordValues                               types.Bool   `tfsdk:"allow_multiple_password_values"`
@@ -211,7 +213,7 @@ func passwordPolicySchema(ctx context.Context, req resource.SchemaRequest, resp
 				Description: "Specifies the attribute type used to hold user passwords.",
 				Required:    true,
 			},
-			"default_password_storage_scheme": schema.SetAttribute{
+			"default_password_storage_scheme": schema.ListAttribute{
 				Description: "Specifies the names of the password storage schemes that are used to encode clear-text passwords for this password policy.",
 				Required:    true,
 				ElementType: types.StringType,
@@ -881,7 +883,7 @@ func readPasswordPolicyResponse(ctx context.Context, r *client.PasswordPolicyRes
 		client.StringPointerEnumpasswordPolicyStateUpdateFailurePolicyProp(r.StateUpdateFailurePolicy), true)
 	state.EnableDebug = internaltypes.BoolTypeOrNil(r.EnableDebug)
 	state.PasswordAttribute = types.StringValue(r.PasswordAttribute)
-	state.DefaultPasswordStorageScheme = internaltypes.GetStringSet(r.DefaultPasswordStorageScheme)
+	state.DefaultPasswordStorageScheme = internaltypes.GetStringList(r.DefaultPasswordStorageScheme)
 	state.DeprecatedPasswordStorageScheme = internaltypes.GetStringSet(r.DeprecatedPasswordStorageScheme)
 	state.ReEncodePasswordsOnSchemeConfigChange = internaltypes.BoolTypeOrNil(r.ReEncodePasswordsOnSchemeConfigChange)
 	state.AllowMultiplePasswordValues = internaltypes.BoolTypeOrNil(r.AllowMultiplePasswordValues)
@@ -969,7 +971,7 @@ func createPasswordPolicyOperations(plan passwordPolicyResourceModel, state pass
 	operations.AddStringOperationIfNecessary(&ops, plan.StateUpdateFailurePolicy, state.StateUpdateFailurePolicy, "state-update-failure-policy")
 	operations.AddBoolOperationIfNecessary(&ops, plan.EnableDebug, state.EnableDebug, "enable-debug")
 	operations.AddStringOperationIfNecessary(&ops, plan.PasswordAttribute, state.PasswordAttribute, "password-attribute")
-	operations.AddStringSetOperationsIfNecessary(&ops, plan.DefaultPasswordStorageScheme, state.DefaultPasswordStorageScheme, "default-password-storage-scheme")
+	operations.AddStringListOperationsIfNecessary(&ops, plan.DefaultPasswordStorageScheme, state.DefaultPasswordStorageScheme, "default-password-storage-scheme")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.DeprecatedPasswordStorageScheme, state.DeprecatedPasswordStorageScheme, "deprecated-password-storage-scheme")
 	operations.AddBoolOperationIfNecessary(&ops, plan.ReEncodePasswordsOnSchemeConfigChange, state.ReEncodePasswordsOnSchemeConfigChange, "re-encode-passwords-on-scheme-config-change")
 	operations.AddBoolOperationIfNecessary(&ops, plan.AllowMultiplePasswordValues, state.AllowMultiplePasswordValues, "allow-multiple-password-values")
@@ -1040,7 +1042,7 @@ func (r *passwordPolicyResource) CreatePasswordPolicy(ctx context.Context, req r
 
 	addResponse, httpResp, err := r.apiClient.PasswordPolicyAPI.AddPasswordPolicyExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Policy", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Policy", err, httpResp)
 		return nil, err
 	}
 
@@ -1071,6 +1073,8 @@ func (r *passwordPolicyResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Password Policy", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -1095,7 +1099,7 @@ func (r *defaultPasswordPolicyResource) Create(ctx context.Context, req resource
 	readResponse, httpResp, err := r.apiClient.PasswordPolicyAPI.GetPasswordPolicy(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Password Policy", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Password Policy", err, httpResp)
 		return
 	}
 
@@ -1119,7 +1123,7 @@ func (r *defaultPasswordPolicyResource) Create(ctx context.Context, req resource
 
 		updateResponse, httpResp, err := r.apiClient.PasswordPolicyAPI.UpdatePasswordPolicyExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Password Policy", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Password Policy", err, httpResp)
 			return
 		}
 
@@ -1131,6 +1135,8 @@ func (r *defaultPasswordPolicyResource) Create(ctx context.Context, req resource
 
 		// Read the response
 		readPasswordPolicyResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Password Policy", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -1222,7 +1228,7 @@ func updatePasswordPolicy(ctx context.Context, req resource.UpdateRequest, resp
 
 		updateResponse, httpResp, err := apiClient.PasswordPolicyAPI.UpdatePasswordPolicyExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Password Policy", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Password Policy", err, httpResp)
 			return
 		}
 
@@ -1234,6 +1240,8 @@ func updatePasswordPolicy(ctx context.Context, req resource.UpdateRequest, resp
 
 		// Read the response
 		readPasswordPolicyResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Password Policy", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -1281,3 +1289,16 @@ func importPasswordPolicy(ctx context.Context, req resource.ImportStateRequest,
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *passwordPolicyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_password_policy"),
+	}
+}
+
+func (r *defaultPasswordPolicyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_password_policy"),
+	}
+}
@@ -30,9 +30,11 @@ var (
 	_ resource.Resource                = &passwordStorageSchemeResource{}
 	_ resource.ResourceWithConfigure   = &passwordStorageSchemeResource{}
 	_ resource.ResourceWithImportState = &passwordStorageSchemeResource{}
+	_ resource.ResourceWithMoveState   = &passwordStorageSchemeResource{}
 	_ resource.Resource                = &defaultPasswordStorageSchemeResource{}
 	_ resource.ResourceWithConfigure   = &defaultPasswordStorageSchemeResource{}
 	_ resource.ResourceWithImportState = &defaultPasswordStorageSchemeResource{}
+	_ resource.ResourceWithMoveState   = &defaultPasswordStorageSchemeResource{}
 )
 
 // Create a Password Storage Scheme resource
@@ -1540,7 +1542,7 @@ func (r *passwordStorageSchemeResource) CreateArgon2PasswordStorageScheme(ctx co
 
 	addResponse, httpResp, err := r.apiClient.PasswordStorageSchemeAPI.AddPasswordStorageSchemeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
 		return nil, err
 	}
 
@@ -1579,7 +1581,7 @@ func (r *passwordStorageSchemeResource) CreateThirdPartyEnhancedPasswordStorageS
 
 	addResponse, httpResp, err := r.apiClient.PasswordStorageSchemeAPI.AddPasswordStorageSchemeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
 		return nil, err
 	}
 
@@ -1617,7 +1619,7 @@ func (r *passwordStorageSchemeResource) CreatePbkdf2PasswordStorageScheme(ctx co
 
 	addResponse, httpResp, err := r.apiClient.PasswordStorageSchemeAPI.AddPasswordStorageSchemeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
 		return nil, err
 	}
 
@@ -1660,7 +1662,7 @@ func (r *passwordStorageSchemeResource) CreateArgon2dPasswordStorageScheme(ctx c
 
 	addResponse, httpResp, err := r.apiClient.PasswordStorageSchemeAPI.AddPasswordStorageSchemeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
 		return nil, err
 	}
 
@@ -1698,7 +1700,7 @@ func (r *passwordStorageSchemeResource) CreateCryptPasswordStorageScheme(ctx con
 
 	addResponse, httpResp, err := r.apiClient.PasswordStorageSchemeAPI.AddPasswordStorageSchemeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
 		return nil, err
 	}
 
@@ -1741,7 +1743,7 @@ func (r *passwordStorageSchemeResource) CreateArgon2iPasswordStorageScheme(ctx c
 
 	addResponse, httpResp, err := r.apiClient.PasswordStorageSchemeAPI.AddPasswordStorageSchemeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
 		return nil, err
 	}
 
@@ -1779,7 +1781,7 @@ func (r *passwordStorageSchemeResource) CreateAes256PasswordStorageScheme(ctx co
 
 	addResponse, httpResp, err := r.apiClient.PasswordStorageSchemeAPI.AddPasswordStorageSchemeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
 		return nil, err
 	}
 
@@ -1817,7 +1819,7 @@ func (r *passwordStorageSchemeResource) CreateBcryptPasswordStorageScheme(ctx co
 
 	addResponse, httpResp, err := r.apiClient.PasswordStorageSchemeAPI.AddPasswordStorageSchemeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
 		return nil, err
 	}
 
@@ -1860,7 +1862,7 @@ func (r *passwordStorageSchemeResource) CreateArgon2idPasswordStorageScheme(ctx
 
 	addResponse, httpResp, err := r.apiClient.PasswordStorageSchemeAPI.AddPasswordStorageSchemeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
 		return nil, err
 	}
 
@@ -1899,7 +1901,7 @@ func (r *passwordStorageSchemeResource) CreateAmazonSecretsManagerPasswordStorag
 
 	addResponse, httpResp, err := r.apiClient.PasswordStorageSchemeAPI.AddPasswordStorageSchemeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
 		return nil, err
 	}
 
@@ -1939,7 +1941,7 @@ func (r *passwordStorageSchemeResource) CreateAzureKeyVaultPasswordStorageScheme
 
 	addResponse, httpResp, err := r.apiClient.PasswordStorageSchemeAPI.AddPasswordStorageSchemeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
 		return nil, err
 	}
 
@@ -1978,7 +1980,7 @@ func (r *passwordStorageSchemeResource) CreateConjurPasswordStorageScheme(ctx co
 
 	addResponse, httpResp, err := r.apiClient.PasswordStorageSchemeAPI.AddPasswordStorageSchemeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
 		return nil, err
 	}
 
@@ -2016,7 +2018,7 @@ func (r *passwordStorageSchemeResource) CreateScryptPasswordStorageScheme(ctx co
 
 	addResponse, httpResp, err := r.apiClient.PasswordStorageSchemeAPI.AddPasswordStorageSchemeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
 		return nil, err
 	}
 
@@ -2055,7 +2057,7 @@ func (r *passwordStorageSchemeResource) CreateVaultPasswordStorageScheme(ctx con
 
 	addResponse, httpResp, err := r.apiClient.PasswordStorageSchemeAPI.AddPasswordStorageSchemeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
 		return nil, err
 	}
 
@@ -2094,7 +2096,7 @@ func (r *passwordStorageSchemeResource) CreateThirdPartyPasswordStorageScheme(ct
 
 	addResponse, httpResp, err := r.apiClient.PasswordStorageSchemeAPI.AddPasswordStorageSchemeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Storage Scheme", err, httpResp)
 		return nil, err
 	}
 
@@ -2213,6 +2215,8 @@ func (r *passwordStorageSchemeResource) Create(ctx context.Context, req resource
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Password Storage Scheme", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -2237,7 +2241,7 @@ func (r *defaultPasswordStorageSchemeResource) Create(ctx context.Context, req r
 	readResponse, httpResp, err := r.apiClient.PasswordStorageSchemeAPI.GetPasswordStorageScheme(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Password Storage Scheme", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Password Storage Scheme", err, httpResp)
 		return
 	}
 
@@ -2344,7 +2348,7 @@ func (r *defaultPasswordStorageSchemeResource) Create(ctx context.Context, req r
 
 		updateResponse, httpResp, err := r.apiClient.PasswordStorageSchemeAPI.UpdatePasswordStorageSchemeExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Password Storage Scheme", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Password Storage Scheme", err, httpResp)
 			return
 		}
 
@@ -2439,6 +2443,8 @@ func (r *defaultPasswordStorageSchemeResource) Create(ctx context.Context, req r
 		if updateResponse.Md5PasswordStorageSchemeResponse != nil {
 			readMd5PasswordStorageSchemeResponse(ctx, updateResponse.Md5PasswordStorageSchemeResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Password Storage Scheme", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -2613,7 +2619,7 @@ func updatePasswordStorageScheme(ctx context.Context, req resource.UpdateRequest
 
 		updateResponse, httpResp, err := apiClient.PasswordStorageSchemeAPI.UpdatePasswordStorageSchemeExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Password Storage Scheme", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Password Storage Scheme", err, httpResp)
 			return
 		}
 
@@ -2708,6 +2714,8 @@ func updatePasswordStorageScheme(ctx context.Context, req resource.UpdateRequest
 		if updateResponse.Md5PasswordStorageSchemeResponse != nil {
 			readMd5PasswordStorageSchemeResponse(ctx, updateResponse.Md5PasswordStorageSchemeResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Password Storage Scheme", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -2755,3 +2763,16 @@ func importPasswordStorageScheme(ctx context.Context, req resource.ImportStateRe
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *passwordStorageSchemeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_password_storage_scheme"),
+	}
+}
+
+func (r *defaultPasswordStorageSchemeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_password_storage_scheme"),
+	}
+}
@@ -49,29 +49,21 @@ func (r *passwordStorageSchemesDataSource) Configure(_ context.Context, req data
 }
 
 type passwordStorageSchemesDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *passwordStorageSchemesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Password Storage Scheme objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Password Storage Scheme objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewPasswordStorageSchemeDataSource(), "Password Storage Scheme")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *passwordStorageSchemesDataSource) Read(ctx context.Context, req datasou
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewPasswordStorageSchemeDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.PasswordStorageSchemeAPI.ListPasswordStorageSchemes(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.PasswordStorageSchemeAPI.ListPasswordStorageSchemesExecute(listRequest)
@@ -106,129 +104,104 @@ func (r *passwordStorageSchemesDataSource) Read(ctx context.Context, req datasou
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object passwordStorageSchemeDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.SaltedSha256PasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.SaltedSha256PasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("salted-sha256")
+			readSaltedSha256PasswordStorageSchemeResponseDataSource(ctx, response.SaltedSha256PasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.Argon2dPasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.Argon2dPasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("argon2d")
+			readArgon2dPasswordStorageSchemeResponseDataSource(ctx, response.Argon2dPasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.CryptPasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.CryptPasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("crypt")
+			readCryptPasswordStorageSchemeResponseDataSource(ctx, response.CryptPasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.Argon2iPasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.Argon2iPasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("argon2i")
+			readArgon2iPasswordStorageSchemeResponseDataSource(ctx, response.Argon2iPasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.Base64PasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.Base64PasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("base64")
+			readBase64PasswordStorageSchemeResponseDataSource(ctx, response.Base64PasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.SaltedMd5PasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.SaltedMd5PasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("salted-md5")
+			readSaltedMd5PasswordStorageSchemeResponseDataSource(ctx, response.SaltedMd5PasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.AesPasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.AesPasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("aes")
+			readAesPasswordStorageSchemeResponseDataSource(ctx, response.AesPasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.Argon2idPasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.Argon2idPasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("argon2id")
+			readArgon2idPasswordStorageSchemeResponseDataSource(ctx, response.Argon2idPasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.VaultPasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.VaultPasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("vault")
+			readVaultPasswordStorageSchemeResponseDataSource(ctx, response.VaultPasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyPasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyPasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyPasswordStorageSchemeResponseDataSource(ctx, response.ThirdPartyPasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.Argon2PasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.Argon2PasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("argon2")
+			readArgon2PasswordStorageSchemeResponseDataSource(ctx, response.Argon2PasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyEnhancedPasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyEnhancedPasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("third-party-enhanced")
+			readThirdPartyEnhancedPasswordStorageSchemeResponseDataSource(ctx, response.ThirdPartyEnhancedPasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.Pbkdf2PasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.Pbkdf2PasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("pbkdf2")
+			readPbkdf2PasswordStorageSchemeResponseDataSource(ctx, response.Pbkdf2PasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.Rc4PasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.Rc4PasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("rc4")
+			readRc4PasswordStorageSchemeResponseDataSource(ctx, response.Rc4PasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.SaltedSha384PasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.SaltedSha384PasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("salted-sha384")
+			readSaltedSha384PasswordStorageSchemeResponseDataSource(ctx, response.SaltedSha384PasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.TripleDesPasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.TripleDesPasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("triple-des")
+			readTripleDesPasswordStorageSchemeResponseDataSource(ctx, response.TripleDesPasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.ClearPasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.ClearPasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("clear")
+			readClearPasswordStorageSchemeResponseDataSource(ctx, response.ClearPasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.Aes256PasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.Aes256PasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("aes-256")
+			readAes256PasswordStorageSchemeResponseDataSource(ctx, response.Aes256PasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.BcryptPasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.BcryptPasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("bcrypt")
+			readBcryptPasswordStorageSchemeResponseDataSource(ctx, response.BcryptPasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.BlowfishPasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.BlowfishPasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("blowfish")
+			readBlowfishPasswordStorageSchemeResponseDataSource(ctx, response.BlowfishPasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.Sha1PasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.Sha1PasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("sha1")
+			readSha1PasswordStorageSchemeResponseDataSource(ctx, response.Sha1PasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.AmazonSecretsManagerPasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.AmazonSecretsManagerPasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("amazon-secrets-manager")
+			readAmazonSecretsManagerPasswordStorageSchemeResponseDataSource(ctx, response.AmazonSecretsManagerPasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.AzureKeyVaultPasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.AzureKeyVaultPasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("azure-key-vault")
+			readAzureKeyVaultPasswordStorageSchemeResponseDataSource(ctx, response.AzureKeyVaultPasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.ConjurPasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.ConjurPasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("conjur")
+			readConjurPasswordStorageSchemeResponseDataSource(ctx, response.ConjurPasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.SaltedSha1PasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.SaltedSha1PasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("salted-sha1")
+			readSaltedSha1PasswordStorageSchemeResponseDataSource(ctx, response.SaltedSha1PasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.SaltedSha512PasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.SaltedSha512PasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("salted-sha512")
+			readSaltedSha512PasswordStorageSchemeResponseDataSource(ctx, response.SaltedSha512PasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.ScryptPasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.ScryptPasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("scrypt")
+			readScryptPasswordStorageSchemeResponseDataSource(ctx, response.ScryptPasswordStorageSchemeResponse, &object, &resp.Diagnostics)
 		}
 		if response.Md5PasswordStorageSchemeResponse != nil {
-			attributes["id"] = types.StringValue(response.Md5PasswordStorageSchemeResponse.Id)
-			attributes["type"] = types.StringValue("md5")
+			readMd5PasswordStorageSchemeResponseDataSource(ctx, response.Md5PasswordStorageSchemeResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -4,6 +4,7 @@ package passwordvalidator
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -32,9 +33,11 @@ var (
 	_ resource.Resource                = &passwordValidatorResource{}
 	_ resource.ResourceWithConfigure   = &passwordValidatorResource{}
 	_ resource.ResourceWithImportState = &passwordValidatorResource{}
+	_ resource.ResourceWithMoveState   = &passwordValidatorResource{}
 	_ resource.Resource                = &defaultPasswordValidatorResource{}
 	_ resource.ResourceWithConfigure   = &defaultPasswordValidatorResource{}
 	_ resource.ResourceWithImportState = &defaultPasswordValidatorResource{}
+	_ resource.ResourceWithMoveState   = &defaultPasswordValidatorResource{}
 )
 
 // Create a Password Validator resource
@@ -142,6 +145,7 @@ type passwordValidatorResourceModel struct {
 	Enabled                                        types.Bool   `tfsdk:"enabled"`
 	ValidatorRequirementDescription                types.String `tfsdk:"validator_requirement_description"`
 	ValidatorFailureMessage                        types.String `tfsdk:"validator_failure_message"`
+	DetachOnDestroy                                types.Bool   `tfsdk:"detach_on_destroy"`
 }
 
 // GetSchema defines the schema for the resource.
@@ -439,6 +443,7 @@ func passwordValidatorSchema(ctx context.Context, req resource.SchemaRequest, re
 		extensionClassAttr.PlanModifiers = append(extensionClassAttr.PlanModifiers, stringplanmodifier.RequiresReplace())
 		schemaDef.Attributes["extension_class"] = extensionClassAttr
 	}
+	config.AddDetachOnDestroySchema(&schemaDef, "Password Validator", isDefault)
 	config.AddCommonResourceSchema(&schemaDef, true)
 	resp.Schema = schemaDef
 }
@@ -2040,6 +2045,11 @@ func readThirdPartyPasswordValidatorResponse(ctx context.Context, r *client.Thir
 	populatePasswordValidatorUnknownValues(state)
 }
 
+// Set any properties that aren't returned by the API in the state, based on some expected value (usually the plan value)
+func (state *passwordValidatorResourceModel) setStateValuesNotReturnedByAPI(expectedValues *passwordValidatorResourceModel) {
+	state.DetachOnDestroy = expectedValues.DetachOnDestroy
+}
+
 // Create any update operations necessary to make the state match the plan
 func createPasswordValidatorOperations(plan passwordValidatorResourceModel, state passwordValidatorResourceModel) []client.Operation {
 	var ops []client.Operation
@@ -2120,7 +2130,7 @@ func (r *passwordValidatorResource) CreateCharacterSetPasswordValidator(ctx cont
 
 	addResponse, httpResp, err := r.apiClient.PasswordValidatorAPI.AddPasswordValidatorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
 		return nil, err
 	}
 
@@ -2159,7 +2169,7 @@ func (r *passwordValidatorResource) CreateSimilarityBasedPasswordValidator(ctx c
 
 	addResponse, httpResp, err := r.apiClient.PasswordValidatorAPI.AddPasswordValidatorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
 		return nil, err
 	}
 
@@ -2198,7 +2208,7 @@ func (r *passwordValidatorResource) CreateAttributeValuePasswordValidator(ctx co
 
 	addResponse, httpResp, err := r.apiClient.PasswordValidatorAPI.AddPasswordValidatorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
 		return nil, err
 	}
 
@@ -2238,7 +2248,7 @@ func (r *passwordValidatorResource) CreateRepeatedCharactersPasswordValidator(ct
 
 	addResponse, httpResp, err := r.apiClient.PasswordValidatorAPI.AddPasswordValidatorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
 		return nil, err
 	}
 
@@ -2277,7 +2287,7 @@ func (r *passwordValidatorResource) CreateDictionaryPasswordValidator(ctx contex
 
 	addResponse, httpResp, err := r.apiClient.PasswordValidatorAPI.AddPasswordValidatorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
 		return nil, err
 	}
 
@@ -2315,7 +2325,7 @@ func (r *passwordValidatorResource) CreateHaystackPasswordValidator(ctx context.
 
 	addResponse, httpResp, err := r.apiClient.PasswordValidatorAPI.AddPasswordValidatorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
 		return nil, err
 	}
 
@@ -2353,7 +2363,7 @@ func (r *passwordValidatorResource) CreateUtf8PasswordValidator(ctx context.Cont
 
 	addResponse, httpResp, err := r.apiClient.PasswordValidatorAPI.AddPasswordValidatorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
 		return nil, err
 	}
 
@@ -2392,7 +2402,7 @@ func (r *passwordValidatorResource) CreateGroovyScriptedPasswordValidator(ctx co
 
 	addResponse, httpResp, err := r.apiClient.PasswordValidatorAPI.AddPasswordValidatorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
 		return nil, err
 	}
 
@@ -2430,7 +2440,7 @@ func (r *passwordValidatorResource) CreatePwnedPasswordsPasswordValidator(ctx co
 
 	addResponse, httpResp, err := r.apiClient.PasswordValidatorAPI.AddPasswordValidatorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
 		return nil, err
 	}
 
@@ -2468,7 +2478,7 @@ func (r *passwordValidatorResource) CreateDisallowedCharactersPasswordValidator(
 
 	addResponse, httpResp, err := r.apiClient.PasswordValidatorAPI.AddPasswordValidatorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
 		return nil, err
 	}
 
@@ -2506,7 +2516,7 @@ func (r *passwordValidatorResource) CreateLengthBasedPasswordValidator(ctx conte
 
 	addResponse, httpResp, err := r.apiClient.PasswordValidatorAPI.AddPasswordValidatorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
 		return nil, err
 	}
 
@@ -2551,7 +2561,7 @@ func (r *passwordValidatorResource) CreateRegularExpressionPasswordValidator(ctx
 
 	addResponse, httpResp, err := r.apiClient.PasswordValidatorAPI.AddPasswordValidatorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
 		return nil, err
 	}
 
@@ -2591,7 +2601,7 @@ func (r *passwordValidatorResource) CreateUniqueCharactersPasswordValidator(ctx
 
 	addResponse, httpResp, err := r.apiClient.PasswordValidatorAPI.AddPasswordValidatorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
 		return nil, err
 	}
 
@@ -2630,7 +2640,7 @@ func (r *passwordValidatorResource) CreateThirdPartyPasswordValidator(ctx contex
 
 	addResponse, httpResp, err := r.apiClient.PasswordValidatorAPI.AddPasswordValidatorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Password Validator", err, httpResp)
 		return nil, err
 	}
 
@@ -2743,6 +2753,10 @@ func (r *passwordValidatorResource) Create(ctx context.Context, req resource.Cre
 		}
 	}
 
+	// Populate Computed attribute values
+	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Password Validator", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -2767,7 +2781,7 @@ func (r *defaultPasswordValidatorResource) Create(ctx context.Context, req resou
 	readResponse, httpResp, err := r.apiClient.PasswordValidatorAPI.GetPasswordValidator(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Password Validator", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Password Validator", err, httpResp)
 		return
 	}
 
@@ -2835,7 +2849,7 @@ func (r *defaultPasswordValidatorResource) Create(ctx context.Context, req resou
 
 		updateResponse, httpResp, err := r.apiClient.PasswordValidatorAPI.UpdatePasswordValidatorExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Password Validator", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Password Validator", err, httpResp)
 			return
 		}
 
@@ -2891,8 +2905,11 @@ func (r *defaultPasswordValidatorResource) Create(ctx context.Context, req resou
 		if updateResponse.ThirdPartyPasswordValidatorResponse != nil {
 			readThirdPartyPasswordValidatorResponse(ctx, updateResponse.ThirdPartyPasswordValidatorResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Password Validator", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
+	state.setStateValuesNotReturnedByAPI(&plan)
 	state.populateAllComputedStringAttributes()
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -3026,7 +3043,7 @@ func updatePasswordValidator(ctx context.Context, req resource.UpdateRequest, re
 
 		updateResponse, httpResp, err := apiClient.PasswordValidatorAPI.UpdatePasswordValidatorExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Password Validator", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Password Validator", err, httpResp)
 			return
 		}
 
@@ -3082,10 +3099,13 @@ func updatePasswordValidator(ctx context.Context, req resource.UpdateRequest, re
 		if updateResponse.ThirdPartyPasswordValidatorResponse != nil {
 			readThirdPartyPasswordValidatorResponse(ctx, updateResponse.ThirdPartyPasswordValidatorResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Password Validator", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
 
+	state.setStateValuesNotReturnedByAPI(&plan)
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -3109,14 +3129,37 @@ func (r *passwordValidatorResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	referencingTypes := passwordValidatorReferencingTypes(r.apiClient, r.providerConfig)
+	if state.DetachOnDestroy.ValueBool() && !config.DetachReferences(ctx, referencingTypes, state.Name.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
 	httpResp, err := r.apiClient.PasswordValidatorAPI.DeletePasswordValidatorExecute(r.apiClient.PasswordValidatorAPI.DeletePasswordValidator(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Name.ValueString()))
 	if err != nil && (httpResp == nil || httpResp.StatusCode != 404) {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting the Password Validator", err, httpResp)
+		// List any config objects that are preventing the delete
+		config.ReportReferences(ctx, referencingTypes, "Password Validator", state.Name.ValueString(), &resp.Diagnostics)
 		return
 	}
 }
 
+// Config object types that can reference a Password Validator
+func passwordValidatorReferencingTypes(apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) []config.ReferencingType {
+	return []config.ReferencingType{
+		{
+			Description: "Password Policy",
+			Properties:  []string{"passwordValidator", "bindPasswordValidator"},
+			List: func(ctx context.Context) ([]map[string]any, *http.Response, error) {
+				return config.ListResponseObjects(apiClient.PasswordPolicyAPI.ListPasswordPolicies(config.ProviderBasicAuthContext(ctx, providerConfig)).Execute())
+			},
+			Update: func(ctx context.Context, id string, ops []client.Operation) (*http.Response, error) {
+				return config.UpdateResult(apiClient.PasswordPolicyAPI.UpdatePasswordPolicy(config.ProviderBasicAuthContext(ctx, providerConfig), id).UpdateRequest(*client.NewUpdateRequest(ops)).Execute())
+			},
+		},
+	}
+}
+
 func (r *passwordValidatorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	importPasswordValidator(ctx, req, resp)
 }
@@ -3129,3 +3172,16 @@ func importPasswordValidator(ctx context.Context, req resource.ImportStateReques
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *passwordValidatorResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_password_validator"),
+	}
+}
+
+func (r *defaultPasswordValidatorResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_password_validator"),
+	}
+}
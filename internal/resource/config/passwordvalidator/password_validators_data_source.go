@@ -49,29 +49,21 @@ func (r *passwordValidatorsDataSource) Configure(_ context.Context, req datasour
 }
 
 type passwordValidatorsDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *passwordValidatorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Password Validator objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Password Validator objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewPasswordValidatorDataSource(), "Password Validator")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *passwordValidatorsDataSource) Read(ctx context.Context, req datasource.
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewPasswordValidatorDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.PasswordValidatorAPI.ListPasswordValidators(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.PasswordValidatorAPI.ListPasswordValidatorsExecute(listRequest)
@@ -106,77 +104,65 @@ func (r *passwordValidatorsDataSource) Read(ctx context.Context, req datasource.
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object passwordValidatorDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.CharacterSetPasswordValidatorResponse != nil {
-			attributes["id"] = types.StringValue(response.CharacterSetPasswordValidatorResponse.Id)
-			attributes["type"] = types.StringValue("character-set")
+			readCharacterSetPasswordValidatorResponseDataSource(ctx, response.CharacterSetPasswordValidatorResponse, &object, &resp.Diagnostics)
 		}
 		if response.SimilarityBasedPasswordValidatorResponse != nil {
-			attributes["id"] = types.StringValue(response.SimilarityBasedPasswordValidatorResponse.Id)
-			attributes["type"] = types.StringValue("similarity-based")
+			readSimilarityBasedPasswordValidatorResponseDataSource(ctx, response.SimilarityBasedPasswordValidatorResponse, &object, &resp.Diagnostics)
 		}
 		if response.AttributeValuePasswordValidatorResponse != nil {
-			attributes["id"] = types.StringValue(response.AttributeValuePasswordValidatorResponse.Id)
-			attributes["type"] = types.StringValue("attribute-value")
+			readAttributeValuePasswordValidatorResponseDataSource(ctx, response.AttributeValuePasswordValidatorResponse, &object, &resp.Diagnostics)
 		}
 		if response.CustomPasswordValidatorResponse != nil {
-			attributes["id"] = types.StringValue(response.CustomPasswordValidatorResponse.Id)
-			attributes["type"] = types.StringValue("custom")
+			readCustomPasswordValidatorResponseDataSource(ctx, response.CustomPasswordValidatorResponse, &object, &resp.Diagnostics)
 		}
 		if response.RepeatedCharactersPasswordValidatorResponse != nil {
-			attributes["id"] = types.StringValue(response.RepeatedCharactersPasswordValidatorResponse.Id)
-			attributes["type"] = types.StringValue("repeated-characters")
+			readRepeatedCharactersPasswordValidatorResponseDataSource(ctx, response.RepeatedCharactersPasswordValidatorResponse, &object, &resp.Diagnostics)
 		}
 		if response.DictionaryPasswordValidatorResponse != nil {
-			attributes["id"] = types.StringValue(response.DictionaryPasswordValidatorResponse.Id)
-			attributes["type"] = types.StringValue("dictionary")
+			readDictionaryPasswordValidatorResponseDataSource(ctx, response.DictionaryPasswordValidatorResponse, &object, &resp.Diagnostics)
 		}
 		if response.HaystackPasswordValidatorResponse != nil {
-			attributes["id"] = types.StringValue(response.HaystackPasswordValidatorResponse.Id)
-			attributes["type"] = types.StringValue("haystack")
+			readHaystackPasswordValidatorResponseDataSource(ctx, response.HaystackPasswordValidatorResponse, &object, &resp.Diagnostics)
 		}
 		if response.Utf8PasswordValidatorResponse != nil {
-			attributes["id"] = types.StringValue(response.Utf8PasswordValidatorResponse.Id)
-			attributes["type"] = types.StringValue("utf-8")
+			readUtf8PasswordValidatorResponseDataSource(ctx, response.Utf8PasswordValidatorResponse, &object, &resp.Diagnostics)
 		}
 		if response.GroovyScriptedPasswordValidatorResponse != nil {
-			attributes["id"] = types.StringValue(response.GroovyScriptedPasswordValidatorResponse.Id)
-			attributes["type"] = types.StringValue("groovy-scripted")
+			readGroovyScriptedPasswordValidatorResponseDataSource(ctx, response.GroovyScriptedPasswordValidatorResponse, &object, &resp.Diagnostics)
 		}
 		if response.PwnedPasswordsPasswordValidatorResponse != nil {
-			attributes["id"] = types.StringValue(response.PwnedPasswordsPasswordValidatorResponse.Id)
-			attributes["type"] = types.StringValue("pwned-passwords")
+			readPwnedPasswordsPasswordValidatorResponseDataSource(ctx, response.PwnedPasswordsPasswordValidatorResponse, &object, &resp.Diagnostics)
 		}
 		if response.DisallowedCharactersPasswordValidatorResponse != nil {
-			attributes["id"] = types.StringValue(response.DisallowedCharactersPasswordValidatorResponse.Id)
-			attributes["type"] = types.StringValue("disallowed-characters")
+			readDisallowedCharactersPasswordValidatorResponseDataSource(ctx, response.DisallowedCharactersPasswordValidatorResponse, &object, &resp.Diagnostics)
 		}
 		if response.LengthBasedPasswordValidatorResponse != nil {
-			attributes["id"] = types.StringValue(response.LengthBasedPasswordValidatorResponse.Id)
-			attributes["type"] = types.StringValue("length-based")
+			readLengthBasedPasswordValidatorResponseDataSource(ctx, response.LengthBasedPasswordValidatorResponse, &object, &resp.Diagnostics)
 		}
 		if response.RegularExpressionPasswordValidatorResponse != nil {
-			attributes["id"] = types.StringValue(response.RegularExpressionPasswordValidatorResponse.Id)
-			attributes["type"] = types.StringValue("regular-expression")
+			readRegularExpressionPasswordValidatorResponseDataSource(ctx, response.RegularExpressionPasswordValidatorResponse, &object, &resp.Diagnostics)
 		}
 		if response.UniqueCharactersPasswordValidatorResponse != nil {
-			attributes["id"] = types.StringValue(response.UniqueCharactersPasswordValidatorResponse.Id)
-			attributes["type"] = types.StringValue("unique-characters")
+			readUniqueCharactersPasswordValidatorResponseDataSource(ctx, response.UniqueCharactersPasswordValidatorResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyPasswordValidatorResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyPasswordValidatorResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyPasswordValidatorResponseDataSource(ctx, response.ThirdPartyPasswordValidatorResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
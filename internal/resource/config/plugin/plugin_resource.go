@@ -32,9 +32,11 @@ var (
 	_ resource.Resource                = &pluginResource{}
 	_ resource.ResourceWithConfigure   = &pluginResource{}
 	_ resource.ResourceWithImportState = &pluginResource{}
+	_ resource.ResourceWithMoveState   = &pluginResource{}
 	_ resource.Resource                = &defaultPluginResource{}
 	_ resource.ResourceWithConfigure   = &defaultPluginResource{}
 	_ resource.ResourceWithImportState = &defaultPluginResource{}
+	_ resource.ResourceWithMoveState   = &defaultPluginResource{}
 )
 
 // Create a Plugin resource
@@ -180,6 +182,8 @@ type pluginResourceModel struct {
 	AuthURL                                              types.String `tfsdk:"auth_url"`
 	OAuthClientID                                        types.String `tfsdk:"oauth_client_id"`
 	OAuthClientSecret                                    types.String `tfsdk:"oauth_client_secret"`
+	OAuthClientSecretWo                                  types.String `tfsdk:"oauth_client_secret_wo"`
+	OAuthClientSecretWoVersion                           types.Int64  `tfsdk:"oauth_client_secret_wo_version"`
 	OAuthClientSecretPassphraseProvider                  types.String `tfsdk:"oauth_client_secret_passphrase_provider"`
 	EnvironmentID                                        types.String `tfsdk:"environment_id"`
 	HttpProxyExternalServer                              types.String `tfsdk:"http_proxy_external_server"`
@@ -307,6 +311,8 @@ type defaultPluginResourceModel struct {
 	PurgeBehavior                                        types.String `tfsdk:"purge_behavior"`
 	LogInterval                                          types.String `tfsdk:"log_interval"`
 	ChangelogPasswordEncryptionKey                       types.String `tfsdk:"changelog_password_encryption_key"`
+	ChangelogPasswordEncryptionKeyWo                     types.String `tfsdk:"changelog_password_encryption_key_wo"`
+	ChangelogPasswordEncryptionKeyWoVersion              types.Int64  `tfsdk:"changelog_password_encryption_key_wo_version"`
 	SuppressIfIdle                                       types.Bool   `tfsdk:"suppress_if_idle"`
 	HeaderPrefixPerColumn                                types.Bool   `tfsdk:"header_prefix_per_column"`
 	EmptyInsteadOfZero                                   types.Bool   `tfsdk:"empty_instead_of_zero"`
@@ -338,6 +344,8 @@ type defaultPluginResourceModel struct {
 	AuthURL                                              types.String `tfsdk:"auth_url"`
 	OAuthClientID                                        types.String `tfsdk:"oauth_client_id"`
 	OAuthClientSecret                                    types.String `tfsdk:"oauth_client_secret"`
+	OAuthClientSecretWo                                  types.String `tfsdk:"oauth_client_secret_wo"`
+	OAuthClientSecretWoVersion                           types.Int64  `tfsdk:"oauth_client_secret_wo_version"`
 	OAuthClientSecretPassphraseProvider                  types.String `tfsdk:"oauth_client_secret_passphrase_provider"`
 	EnvironmentID                                        types.String `tfsdk:"environment_id"`
 	HttpProxyExternalServer                              types.String `tfsdk:"http_proxy_external_server"`
@@ -1369,6 +1377,10 @@ func pluginSchema(ctx context.Context, req resource.SchemaRequest, resp *resourc
 		extensionClassAttr.PlanModifiers = append(extensionClassAttr.PlanModifiers, stringplanmodifier.RequiresReplace())
 		schemaDef.Attributes["extension_class"] = extensionClassAttr
 	}
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "oauth_client_secret")
+	if isDefault {
+		config.AddWriteOnlyAttributeSchema(&schemaDef, "changelog_password_encryption_key")
+	}
 	config.AddCommonResourceSchema(&schemaDef, true)
 	resp.Schema = schemaDef
 }
@@ -4279,6 +4291,7 @@ func configValidatorsPlugin() []resource.ConfigValidator {
 			[]string{"changelog-password-encryption"},
 			resourcevalidator.ExactlyOneOf(
 				path.MatchRoot("changelog_password_encryption_key"),
+				path.MatchRoot("changelog_password_encryption_key_wo"),
 				path.MatchRoot("changelog_password_encryption_key_passphrase_provider"),
 			),
 		),
@@ -4303,6 +4316,7 @@ func configValidatorsPlugin() []resource.ConfigValidator {
 			[]string{"ping-one-pass-through-authentication"},
 			resourcevalidator.ExactlyOneOf(
 				path.MatchRoot("oauth_client_secret"),
+				path.MatchRoot("oauth_client_secret_wo"),
 				path.MatchRoot("oauth_client_secret_passphrase_provider"),
 			),
 		),
@@ -4499,6 +4513,11 @@ func configValidatorsPlugin() []resource.ConfigValidator {
 			path.MatchRoot("resource_type"),
 			[]string{"ping-one-pass-through-authentication"},
 		),
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("oauth_client_secret_wo"),
+			path.MatchRoot("resource_type"),
+			[]string{"ping-one-pass-through-authentication"},
+		),
 		configvalidators.ImpliesOtherAttributeOneOfString(
 			path.MatchRoot("oauth_client_secret_passphrase_provider"),
 			path.MatchRoot("resource_type"),
@@ -5180,6 +5199,11 @@ func (r defaultPluginResource) ConfigValidators(ctx context.Context) []resource.
 			path.MatchRoot("resource_type"),
 			[]string{"changelog-password-encryption"},
 		),
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("changelog_password_encryption_key_wo"),
+			path.MatchRoot("resource_type"),
+			[]string{"changelog-password-encryption"},
+		),
 		configvalidators.ImpliesOtherAttributeOneOfString(
 			path.MatchRoot("changelog_password_encryption_key_passphrase_provider"),
 			path.MatchRoot("resource_type"),
@@ -5472,6 +5496,9 @@ func addOptionalPingOnePassThroughAuthenticationPluginFields(ctx context.Context
 	if internaltypes.IsNonEmptyString(plan.OAuthClientSecret) {
 		addRequest.OAuthClientSecret = plan.OAuthClientSecret.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.OAuthClientSecretWo) {
+		addRequest.OAuthClientSecret = plan.OAuthClientSecretWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.OAuthClientSecretPassphraseProvider) {
 		addRequest.OAuthClientSecretPassphraseProvider = plan.OAuthClientSecretPassphraseProvider.ValueStringPointer()
@@ -8211,15 +8238,24 @@ func (state *defaultPluginResourceModel) setStateValuesNotReturnedByAPI(expected
 	if !expectedValues.OAuthClientSecret.IsUnknown() {
 		state.OAuthClientSecret = expectedValues.OAuthClientSecret
 	}
+	if !expectedValues.OAuthClientSecretWoVersion.IsUnknown() {
+		state.OAuthClientSecretWoVersion = expectedValues.OAuthClientSecretWoVersion
+	}
 	if !expectedValues.ChangelogPasswordEncryptionKey.IsUnknown() {
 		state.ChangelogPasswordEncryptionKey = expectedValues.ChangelogPasswordEncryptionKey
 	}
+	if !expectedValues.ChangelogPasswordEncryptionKeyWoVersion.IsUnknown() {
+		state.ChangelogPasswordEncryptionKeyWoVersion = expectedValues.ChangelogPasswordEncryptionKeyWoVersion
+	}
 }
 
 func (state *pluginResourceModel) setStateValuesNotReturnedByAPI(expectedValues *pluginResourceModel) {
 	if !expectedValues.OAuthClientSecret.IsUnknown() {
 		state.OAuthClientSecret = expectedValues.OAuthClientSecret
 	}
+	if !expectedValues.OAuthClientSecretWoVersion.IsUnknown() {
+		state.OAuthClientSecretWoVersion = expectedValues.OAuthClientSecretWoVersion
+	}
 }
 
 // Create any update operations necessary to make the state match the plan
@@ -8311,6 +8347,7 @@ func createPluginOperations(plan pluginResourceModel, state pluginResourceModel)
 	operations.AddStringOperationIfNecessary(&ops, plan.AuthURL, state.AuthURL, "auth-url")
 	operations.AddStringOperationIfNecessary(&ops, plan.OAuthClientID, state.OAuthClientID, "oauth-client-id")
 	operations.AddStringOperationIfNecessary(&ops, plan.OAuthClientSecret, state.OAuthClientSecret, "oauth-client-secret")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.OAuthClientSecretWo, plan.OAuthClientSecretWoVersion, state.OAuthClientSecretWoVersion, "oauth-client-secret")
 	operations.AddStringOperationIfNecessary(&ops, plan.OAuthClientSecretPassphraseProvider, state.OAuthClientSecretPassphraseProvider, "oauth-client-secret-passphrase-provider")
 	operations.AddStringOperationIfNecessary(&ops, plan.EnvironmentID, state.EnvironmentID, "environment-id")
 	operations.AddStringOperationIfNecessary(&ops, plan.HttpProxyExternalServer, state.HttpProxyExternalServer, "http-proxy-external-server")
@@ -8436,6 +8473,7 @@ func createPluginOperationsDefault(plan defaultPluginResourceModel, state defaul
 	operations.AddStringOperationIfNecessary(&ops, plan.PurgeBehavior, state.PurgeBehavior, "purge-behavior")
 	operations.AddStringOperationIfNecessary(&ops, plan.LogInterval, state.LogInterval, "log-interval")
 	operations.AddStringOperationIfNecessary(&ops, plan.ChangelogPasswordEncryptionKey, state.ChangelogPasswordEncryptionKey, "changelog-password-encryption-key")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.ChangelogPasswordEncryptionKeyWo, plan.ChangelogPasswordEncryptionKeyWoVersion, state.ChangelogPasswordEncryptionKeyWoVersion, "changelog-password-encryption-key")
 	operations.AddBoolOperationIfNecessary(&ops, plan.SuppressIfIdle, state.SuppressIfIdle, "suppress-if-idle")
 	operations.AddBoolOperationIfNecessary(&ops, plan.HeaderPrefixPerColumn, state.HeaderPrefixPerColumn, "header-prefix-per-column")
 	operations.AddBoolOperationIfNecessary(&ops, plan.EmptyInsteadOfZero, state.EmptyInsteadOfZero, "empty-instead-of-zero")
@@ -8467,6 +8505,7 @@ func createPluginOperationsDefault(plan defaultPluginResourceModel, state defaul
 	operations.AddStringOperationIfNecessary(&ops, plan.AuthURL, state.AuthURL, "auth-url")
 	operations.AddStringOperationIfNecessary(&ops, plan.OAuthClientID, state.OAuthClientID, "oauth-client-id")
 	operations.AddStringOperationIfNecessary(&ops, plan.OAuthClientSecret, state.OAuthClientSecret, "oauth-client-secret")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.OAuthClientSecretWo, plan.OAuthClientSecretWoVersion, state.OAuthClientSecretWoVersion, "oauth-client-secret")
 	operations.AddStringOperationIfNecessary(&ops, plan.OAuthClientSecretPassphraseProvider, state.OAuthClientSecretPassphraseProvider, "oauth-client-secret-passphrase-provider")
 	operations.AddStringOperationIfNecessary(&ops, plan.EnvironmentID, state.EnvironmentID, "environment-id")
 	operations.AddStringOperationIfNecessary(&ops, plan.HttpProxyExternalServer, state.HttpProxyExternalServer, "http-proxy-external-server")
@@ -8548,7 +8587,7 @@ func (r *pluginResource) CreateEntryCounterPlugin(ctx context.Context, req resou
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -8586,7 +8625,7 @@ func (r *pluginResource) CreateTraditionalStaticGroupSupportForInvertedStaticGro
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -8626,7 +8665,7 @@ func (r *pluginResource) CreateInternalSearchRatePlugin(ctx context.Context, req
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -8664,7 +8703,7 @@ func (r *pluginResource) CreateModifiablePasswordPolicyStatePlugin(ctx context.C
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -8702,7 +8741,7 @@ func (r *pluginResource) CreateSevenBitCleanPlugin(ctx context.Context, req reso
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -8740,7 +8779,7 @@ func (r *pluginResource) CreateCleanUpExpiredPingfederatePersistentAccessGrantsP
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -8781,7 +8820,7 @@ func (r *pluginResource) CreatePeriodicGcPlugin(ctx context.Context, req resourc
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -8829,7 +8868,7 @@ func (r *pluginResource) CreatePingOnePassThroughAuthenticationPlugin(ctx contex
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -8875,7 +8914,7 @@ func (r *pluginResource) CreateSearchShutdownPlugin(ctx context.Context, req res
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -8914,7 +8953,7 @@ func (r *pluginResource) CreatePeriodicStatsLoggerPlugin(ctx context.Context, re
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -8954,7 +8993,7 @@ func (r *pluginResource) CreatePurgeExpiredDataPlugin(ctx context.Context, req r
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -8992,7 +9031,7 @@ func (r *pluginResource) CreateSubOperationTimingPlugin(ctx context.Context, req
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -9034,7 +9073,7 @@ func (r *pluginResource) CreateThirdPartyPlugin(ctx context.Context, req resourc
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -9075,7 +9114,7 @@ func (r *pluginResource) CreatePassThroughAuthenticationPlugin(ctx context.Conte
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -9115,7 +9154,7 @@ func (r *pluginResource) CreateDnMapperPlugin(ctx context.Context, req resource.
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -9156,7 +9195,7 @@ func (r *pluginResource) CreateReferralOnUpdatePlugin(ctx context.Context, req r
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -9194,7 +9233,7 @@ func (r *pluginResource) CreateSimpleToExternalBindPlugin(ctx context.Context, r
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -9232,7 +9271,7 @@ func (r *pluginResource) CreateSnmpSubagentPlugin(ctx context.Context, req resou
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -9271,7 +9310,7 @@ func (r *pluginResource) CreateCoalesceModificationsPlugin(ctx context.Context,
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -9310,7 +9349,7 @@ func (r *pluginResource) CreateCleanUpInactivePingfederatePersistentSessionsPlug
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -9352,7 +9391,7 @@ func (r *pluginResource) CreateComposedAttributePlugin(ctx context.Context, req
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -9392,7 +9431,7 @@ func (r *pluginResource) CreateAttributeMapperPlugin(ctx context.Context, req re
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -9431,7 +9470,7 @@ func (r *pluginResource) CreateDelayPlugin(ctx context.Context, req resource.Cre
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -9469,7 +9508,7 @@ func (r *pluginResource) CreateCleanUpExpiredPingfederatePersistentSessionsPlugi
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -9511,7 +9550,7 @@ func (r *pluginResource) CreateGroovyScriptedPlugin(ctx context.Context, req res
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -9550,7 +9589,7 @@ func (r *pluginResource) CreatePluggablePassThroughAuthenticationPlugin(ctx cont
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -9591,7 +9630,7 @@ func (r *pluginResource) CreateReferentialIntegrityPlugin(ctx context.Context, r
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -9632,7 +9671,7 @@ func (r *pluginResource) CreateUniqueAttributePlugin(ctx context.Context, req re
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -9670,7 +9709,7 @@ func (r *pluginResource) CreateInvertedStaticGroupReferentialIntegrityPlugin(ctx
 
 	addResponse, httpResp, err := r.apiClient.PluginAPI.AddPluginExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Plugin", err, httpResp)
 		return nil, err
 	}
 
@@ -9695,6 +9734,11 @@ func (r *pluginResource) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("oauth_client_secret_wo"), &plan.OAuthClientSecretWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	var state *pluginResourceModel
 	var err error
@@ -9875,6 +9919,8 @@ func (r *pluginResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Plugin", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -9895,11 +9941,17 @@ func (r *defaultPluginResource) Create(ctx context.Context, req resource.CreateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("changelog_password_encryption_key_wo"), &plan.ChangelogPasswordEncryptionKeyWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("oauth_client_secret_wo"), &plan.OAuthClientSecretWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	readResponse, httpResp, err := r.apiClient.PluginAPI.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Plugin", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Plugin", err, httpResp)
 		return
 	}
 
@@ -10045,7 +10097,7 @@ func (r *defaultPluginResource) Create(ctx context.Context, req resource.CreateR
 
 		updateResponse, httpResp, err := r.apiClient.PluginAPI.UpdatePluginExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Plugin", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Plugin", err, httpResp)
 			return
 		}
 
@@ -10179,6 +10231,8 @@ func (r *defaultPluginResource) Create(ctx context.Context, req resource.CreateR
 		if updateResponse.InvertedStaticGroupReferentialIntegrityPluginResponse != nil {
 			readInvertedStaticGroupReferentialIntegrityPluginResponseDefault(ctx, updateResponse.InvertedStaticGroupReferentialIntegrityPluginResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Plugin", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -10385,6 +10439,11 @@ func (r *pluginResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("oauth_client_secret_wo"), &plan.OAuthClientSecretWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Get the current state to see how any attributes are changing
 	var state pluginResourceModel
@@ -10401,7 +10460,7 @@ func (r *pluginResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 		updateResponse, httpResp, err := r.apiClient.PluginAPI.UpdatePluginExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Plugin", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Plugin", err, httpResp)
 			return
 		}
 
@@ -10499,6 +10558,8 @@ func (r *pluginResource) Update(ctx context.Context, req resource.UpdateRequest,
 		if updateResponse.InvertedStaticGroupReferentialIntegrityPluginResponse != nil {
 			readInvertedStaticGroupReferentialIntegrityPluginResponse(ctx, updateResponse.InvertedStaticGroupReferentialIntegrityPluginResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Plugin", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -10519,6 +10580,12 @@ func (r *defaultPluginResource) Update(ctx context.Context, req resource.UpdateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("changelog_password_encryption_key_wo"), &plan.ChangelogPasswordEncryptionKeyWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("oauth_client_secret_wo"), &plan.OAuthClientSecretWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Get the current state to see how any attributes are changing
 	var state defaultPluginResourceModel
@@ -10535,7 +10602,7 @@ func (r *defaultPluginResource) Update(ctx context.Context, req resource.UpdateR
 
 		updateResponse, httpResp, err := r.apiClient.PluginAPI.UpdatePluginExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Plugin", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Plugin", err, httpResp)
 			return
 		}
 
@@ -10669,6 +10736,8 @@ func (r *defaultPluginResource) Update(ctx context.Context, req resource.UpdateR
 		if updateResponse.InvertedStaticGroupReferentialIntegrityPluginResponse != nil {
 			readInvertedStaticGroupReferentialIntegrityPluginResponseDefault(ctx, updateResponse.InvertedStaticGroupReferentialIntegrityPluginResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Plugin", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -10717,3 +10786,16 @@ func importPlugin(ctx context.Context, req resource.ImportStateRequest, resp *re
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *pluginResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_plugin"),
+	}
+}
+
+func (r *defaultPluginResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_plugin"),
+	}
+}
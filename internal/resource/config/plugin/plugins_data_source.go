@@ -49,29 +49,21 @@ func (r *pluginsDataSource) Configure(_ context.Context, req datasource.Configur
 }
 
 type pluginsDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *pluginsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Plugin objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Plugin objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewPluginDataSource(), "Plugin")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *pluginsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewPluginDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.PluginAPI.ListPlugins(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.PluginAPI.ListPluginsExecute(listRequest)
@@ -106,209 +104,171 @@ func (r *pluginsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object pluginDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.EntryCounterPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.EntryCounterPluginResponse.Id)
-			attributes["type"] = types.StringValue("entry-counter")
+			readEntryCounterPluginResponseDataSource(ctx, response.EntryCounterPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.LastAccessTimePluginResponse != nil {
-			attributes["id"] = types.StringValue(response.LastAccessTimePluginResponse.Id)
-			attributes["type"] = types.StringValue("last-access-time")
+			readLastAccessTimePluginResponseDataSource(ctx, response.LastAccessTimePluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.StatsCollectorPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.StatsCollectorPluginResponse.Id)
-			attributes["type"] = types.StringValue("stats-collector")
+			readStatsCollectorPluginResponseDataSource(ctx, response.StatsCollectorPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.TraditionalStaticGroupSupportForInvertedStaticGroupsPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.TraditionalStaticGroupSupportForInvertedStaticGroupsPluginResponse.Id)
-			attributes["type"] = types.StringValue("traditional-static-group-support-for-inverted-static-groups")
+			readTraditionalStaticGroupSupportForInvertedStaticGroupsPluginResponseDataSource(ctx, response.TraditionalStaticGroupSupportForInvertedStaticGroupsPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.InternalSearchRatePluginResponse != nil {
-			attributes["id"] = types.StringValue(response.InternalSearchRatePluginResponse.Id)
-			attributes["type"] = types.StringValue("internal-search-rate")
+			readInternalSearchRatePluginResponseDataSource(ctx, response.InternalSearchRatePluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.ModifiablePasswordPolicyStatePluginResponse != nil {
-			attributes["id"] = types.StringValue(response.ModifiablePasswordPolicyStatePluginResponse.Id)
-			attributes["type"] = types.StringValue("modifiable-password-policy-state")
+			readModifiablePasswordPolicyStatePluginResponseDataSource(ctx, response.ModifiablePasswordPolicyStatePluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.SevenBitCleanPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.SevenBitCleanPluginResponse.Id)
-			attributes["type"] = types.StringValue("seven-bit-clean")
+			readSevenBitCleanPluginResponseDataSource(ctx, response.SevenBitCleanPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.CleanUpExpiredPingfederatePersistentAccessGrantsPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.CleanUpExpiredPingfederatePersistentAccessGrantsPluginResponse.Id)
-			attributes["type"] = types.StringValue("clean-up-expired-pingfederate-persistent-access-grants")
+			readCleanUpExpiredPingfederatePersistentAccessGrantsPluginResponseDataSource(ctx, response.CleanUpExpiredPingfederatePersistentAccessGrantsPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.PeriodicGcPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.PeriodicGcPluginResponse.Id)
-			attributes["type"] = types.StringValue("periodic-gc")
+			readPeriodicGcPluginResponseDataSource(ctx, response.PeriodicGcPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.PingOnePassThroughAuthenticationPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.PingOnePassThroughAuthenticationPluginResponse.Id)
-			attributes["type"] = types.StringValue("ping-one-pass-through-authentication")
+			readPingOnePassThroughAuthenticationPluginResponseDataSource(ctx, response.PingOnePassThroughAuthenticationPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.SecretKeyDeleteAlertPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.SecretKeyDeleteAlertPluginResponse.Id)
-			attributes["type"] = types.StringValue("secret-key-delete-alert")
+			object.Id = types.StringValue(response.SecretKeyDeleteAlertPluginResponse.Id)
+			object.ResourceType = types.StringValue("secret-key-delete-alert")
 		}
 		if response.ChangelogPasswordEncryptionPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.ChangelogPasswordEncryptionPluginResponse.Id)
-			attributes["type"] = types.StringValue("changelog-password-encryption")
+			readChangelogPasswordEncryptionPluginResponseDataSource(ctx, response.ChangelogPasswordEncryptionPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.GloballyUniqueAttributePluginResponse != nil {
-			attributes["id"] = types.StringValue(response.GloballyUniqueAttributePluginResponse.Id)
-			attributes["type"] = types.StringValue("globally-unique-attribute")
+			object.Id = types.StringValue(response.GloballyUniqueAttributePluginResponse.Id)
+			object.ResourceType = types.StringValue("globally-unique-attribute")
 		}
 		if response.ProcessingTimeHistogramPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.ProcessingTimeHistogramPluginResponse.Id)
-			attributes["type"] = types.StringValue("processing-time-histogram")
+			readProcessingTimeHistogramPluginResponseDataSource(ctx, response.ProcessingTimeHistogramPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.SearchShutdownPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.SearchShutdownPluginResponse.Id)
-			attributes["type"] = types.StringValue("search-shutdown")
+			readSearchShutdownPluginResponseDataSource(ctx, response.SearchShutdownPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.PeriodicStatsLoggerPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.PeriodicStatsLoggerPluginResponse.Id)
-			attributes["type"] = types.StringValue("periodic-stats-logger")
+			readPeriodicStatsLoggerPluginResponseDataSource(ctx, response.PeriodicStatsLoggerPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.PurgeExpiredDataPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.PurgeExpiredDataPluginResponse.Id)
-			attributes["type"] = types.StringValue("purge-expired-data")
+			readPurgeExpiredDataPluginResponseDataSource(ctx, response.PurgeExpiredDataPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.ChangeSubscriptionNotificationPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.ChangeSubscriptionNotificationPluginResponse.Id)
-			attributes["type"] = types.StringValue("change-subscription-notification")
+			readChangeSubscriptionNotificationPluginResponseDataSource(ctx, response.ChangeSubscriptionNotificationPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.LdapAttributeDescriptionListPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.LdapAttributeDescriptionListPluginResponse.Id)
-			attributes["type"] = types.StringValue("ldap-attribute-description-list")
+			object.Id = types.StringValue(response.LdapAttributeDescriptionListPluginResponse.Id)
+			object.ResourceType = types.StringValue("ldap-attribute-description-list")
 		}
 		if response.SubOperationTimingPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.SubOperationTimingPluginResponse.Id)
-			attributes["type"] = types.StringValue("sub-operation-timing")
+			readSubOperationTimingPluginResponseDataSource(ctx, response.SubOperationTimingPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyPluginResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyPluginResponseDataSource(ctx, response.ThirdPartyPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.EncryptAttributeValuesPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.EncryptAttributeValuesPluginResponse.Id)
-			attributes["type"] = types.StringValue("encrypt-attribute-values")
+			readEncryptAttributeValuesPluginResponseDataSource(ctx, response.EncryptAttributeValuesPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.PassThroughAuthenticationPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.PassThroughAuthenticationPluginResponse.Id)
-			attributes["type"] = types.StringValue("pass-through-authentication")
+			readPassThroughAuthenticationPluginResponseDataSource(ctx, response.PassThroughAuthenticationPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.GlobalReferentialIntegrityPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.GlobalReferentialIntegrityPluginResponse.Id)
-			attributes["type"] = types.StringValue("global-referential-integrity")
+			object.Id = types.StringValue(response.GlobalReferentialIntegrityPluginResponse.Id)
+			object.ResourceType = types.StringValue("global-referential-integrity")
 		}
 		if response.DnMapperPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.DnMapperPluginResponse.Id)
-			attributes["type"] = types.StringValue("dn-mapper")
+			readDnMapperPluginResponseDataSource(ctx, response.DnMapperPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.MonitorHistoryPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.MonitorHistoryPluginResponse.Id)
-			attributes["type"] = types.StringValue("monitor-history")
+			readMonitorHistoryPluginResponseDataSource(ctx, response.MonitorHistoryPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.ReferralOnUpdatePluginResponse != nil {
-			attributes["id"] = types.StringValue(response.ReferralOnUpdatePluginResponse.Id)
-			attributes["type"] = types.StringValue("referral-on-update")
+			readReferralOnUpdatePluginResponseDataSource(ctx, response.ReferralOnUpdatePluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.SimpleToExternalBindPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.SimpleToExternalBindPluginResponse.Id)
-			attributes["type"] = types.StringValue("simple-to-external-bind")
+			readSimpleToExternalBindPluginResponseDataSource(ctx, response.SimpleToExternalBindPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.CustomPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.CustomPluginResponse.Id)
-			attributes["type"] = types.StringValue("custom")
+			readCustomPluginResponseDataSource(ctx, response.CustomPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.SnmpSubagentPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.SnmpSubagentPluginResponse.Id)
-			attributes["type"] = types.StringValue("snmp-subagent")
+			readSnmpSubagentPluginResponseDataSource(ctx, response.SnmpSubagentPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.CoalesceModificationsPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.CoalesceModificationsPluginResponse.Id)
-			attributes["type"] = types.StringValue("coalesce-modifications")
+			readCoalesceModificationsPluginResponseDataSource(ctx, response.CoalesceModificationsPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.PasswordPolicyImportPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.PasswordPolicyImportPluginResponse.Id)
-			attributes["type"] = types.StringValue("password-policy-import")
+			readPasswordPolicyImportPluginResponseDataSource(ctx, response.PasswordPolicyImportPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.ProfilerPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.ProfilerPluginResponse.Id)
-			attributes["type"] = types.StringValue("profiler")
+			readProfilerPluginResponseDataSource(ctx, response.ProfilerPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.EntryUuidPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.EntryUuidPluginResponse.Id)
-			attributes["type"] = types.StringValue("entry-uuid")
+			object.Id = types.StringValue(response.EntryUuidPluginResponse.Id)
+			object.ResourceType = types.StringValue("entry-uuid")
 		}
 		if response.CleanUpInactivePingfederatePersistentSessionsPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.CleanUpInactivePingfederatePersistentSessionsPluginResponse.Id)
-			attributes["type"] = types.StringValue("clean-up-inactive-pingfederate-persistent-sessions")
+			readCleanUpInactivePingfederatePersistentSessionsPluginResponseDataSource(ctx, response.CleanUpInactivePingfederatePersistentSessionsPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.ComposedAttributePluginResponse != nil {
-			attributes["id"] = types.StringValue(response.ComposedAttributePluginResponse.Id)
-			attributes["type"] = types.StringValue("composed-attribute")
+			readComposedAttributePluginResponseDataSource(ctx, response.ComposedAttributePluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.LdapResultCodeTrackerPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.LdapResultCodeTrackerPluginResponse.Id)
-			attributes["type"] = types.StringValue("ldap-result-code-tracker")
+			readLdapResultCodeTrackerPluginResponseDataSource(ctx, response.LdapResultCodeTrackerPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.AttributeMapperPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.AttributeMapperPluginResponse.Id)
-			attributes["type"] = types.StringValue("attribute-mapper")
+			readAttributeMapperPluginResponseDataSource(ctx, response.AttributeMapperPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.DelayPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.DelayPluginResponse.Id)
-			attributes["type"] = types.StringValue("delay")
+			readDelayPluginResponseDataSource(ctx, response.DelayPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.PreUpdateConfigPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.PreUpdateConfigPluginResponse.Id)
-			attributes["type"] = types.StringValue("pre-update-config")
+			object.Id = types.StringValue(response.PreUpdateConfigPluginResponse.Id)
+			object.ResourceType = types.StringValue("pre-update-config")
 		}
 		if response.CleanUpExpiredPingfederatePersistentSessionsPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.CleanUpExpiredPingfederatePersistentSessionsPluginResponse.Id)
-			attributes["type"] = types.StringValue("clean-up-expired-pingfederate-persistent-sessions")
+			readCleanUpExpiredPingfederatePersistentSessionsPluginResponseDataSource(ctx, response.CleanUpExpiredPingfederatePersistentSessionsPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.GroovyScriptedPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.GroovyScriptedPluginResponse.Id)
-			attributes["type"] = types.StringValue("groovy-scripted")
+			readGroovyScriptedPluginResponseDataSource(ctx, response.GroovyScriptedPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.LastModPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.LastModPluginResponse.Id)
-			attributes["type"] = types.StringValue("last-mod")
+			readLastModPluginResponseDataSource(ctx, response.LastModPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.PluggablePassThroughAuthenticationPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.PluggablePassThroughAuthenticationPluginResponse.Id)
-			attributes["type"] = types.StringValue("pluggable-pass-through-authentication")
+			readPluggablePassThroughAuthenticationPluginResponseDataSource(ctx, response.PluggablePassThroughAuthenticationPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.ReferentialIntegrityPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.ReferentialIntegrityPluginResponse.Id)
-			attributes["type"] = types.StringValue("referential-integrity")
+			readReferentialIntegrityPluginResponseDataSource(ctx, response.ReferentialIntegrityPluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.UniqueAttributePluginResponse != nil {
-			attributes["id"] = types.StringValue(response.UniqueAttributePluginResponse.Id)
-			attributes["type"] = types.StringValue("unique-attribute")
+			readUniqueAttributePluginResponseDataSource(ctx, response.UniqueAttributePluginResponse, &object, &resp.Diagnostics)
 		}
 		if response.SnmpMasterAgentPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.SnmpMasterAgentPluginResponse.Id)
-			attributes["type"] = types.StringValue("snmp-master-agent")
+			object.Id = types.StringValue(response.SnmpMasterAgentPluginResponse.Id)
+			object.ResourceType = types.StringValue("snmp-master-agent")
 		}
 		if response.InvertedStaticGroupReferentialIntegrityPluginResponse != nil {
-			attributes["id"] = types.StringValue(response.InvertedStaticGroupReferentialIntegrityPluginResponse.Id)
-			attributes["type"] = types.StringValue("inverted-static-group-referential-integrity")
+			readInvertedStaticGroupReferentialIntegrityPluginResponseDataSource(ctx, response.InvertedStaticGroupReferentialIntegrityPluginResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -665,7 +665,7 @@ func (r *pluginRootResource) Create(ctx context.Context, req resource.CreateRequ
 	readResponse, httpResp, err := r.apiClient.PluginRootAPI.GetPluginRoot(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig)).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Plugin Root", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Plugin Root", err, httpResp)
 		return
 	}
 
@@ -689,7 +689,7 @@ func (r *pluginRootResource) Create(ctx context.Context, req resource.CreateRequ
 
 		updateResponse, httpResp, err := r.apiClient.PluginRootAPI.UpdatePluginRootExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Plugin Root", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Plugin Root", err, httpResp)
 			return
 		}
 
@@ -701,6 +701,8 @@ func (r *pluginRootResource) Create(ctx context.Context, req resource.CreateRequ
 
 		// Read the response
 		readPluginRootResponse(ctx, updateResponse, &state, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Plugin Root", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -766,7 +768,7 @@ func (r *pluginRootResource) Update(ctx context.Context, req resource.UpdateRequ
 
 		updateResponse, httpResp, err := r.apiClient.PluginRootAPI.UpdatePluginRootExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Plugin Root", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Plugin Root", err, httpResp)
 			return
 		}
 
@@ -778,6 +780,8 @@ func (r *pluginRootResource) Update(ctx context.Context, req resource.UpdateRequ
 
 		// Read the response
 		readPluginRootResponse(ctx, updateResponse, &state, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Plugin Root", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -29,9 +29,11 @@ var (
 	_ resource.Resource                = &postLdifExportTaskProcessorResource{}
 	_ resource.ResourceWithConfigure   = &postLdifExportTaskProcessorResource{}
 	_ resource.ResourceWithImportState = &postLdifExportTaskProcessorResource{}
+	_ resource.ResourceWithMoveState   = &postLdifExportTaskProcessorResource{}
 	_ resource.Resource                = &defaultPostLdifExportTaskProcessorResource{}
 	_ resource.ResourceWithConfigure   = &defaultPostLdifExportTaskProcessorResource{}
 	_ resource.ResourceWithImportState = &defaultPostLdifExportTaskProcessorResource{}
+	_ resource.ResourceWithMoveState   = &defaultPostLdifExportTaskProcessorResource{}
 )
 
 // Create a Post Ldif Export Task Processor resource
@@ -449,7 +451,7 @@ func (r *postLdifExportTaskProcessorResource) CreateUploadToS3PostLdifExportTask
 
 	addResponse, httpResp, err := r.apiClient.PostLdifExportTaskProcessorAPI.AddPostLdifExportTaskProcessorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Post Ldif Export Task Processor", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Post Ldif Export Task Processor", err, httpResp)
 		return nil, err
 	}
 
@@ -484,7 +486,7 @@ func (r *postLdifExportTaskProcessorResource) CreateThirdPartyPostLdifExportTask
 
 	addResponse, httpResp, err := r.apiClient.PostLdifExportTaskProcessorAPI.AddPostLdifExportTaskProcessorExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Post Ldif Export Task Processor", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Post Ldif Export Task Processor", err, httpResp)
 		return nil, err
 	}
 
@@ -525,6 +527,8 @@ func (r *postLdifExportTaskProcessorResource) Create(ctx context.Context, req re
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Post Ldif Export Task Processor", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -549,7 +553,7 @@ func (r *defaultPostLdifExportTaskProcessorResource) Create(ctx context.Context,
 	readResponse, httpResp, err := r.apiClient.PostLdifExportTaskProcessorAPI.GetPostLdifExportTaskProcessor(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Post Ldif Export Task Processor", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Post Ldif Export Task Processor", err, httpResp)
 		return
 	}
 
@@ -578,7 +582,7 @@ func (r *defaultPostLdifExportTaskProcessorResource) Create(ctx context.Context,
 
 		updateResponse, httpResp, err := r.apiClient.PostLdifExportTaskProcessorAPI.UpdatePostLdifExportTaskProcessorExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Post Ldif Export Task Processor", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Post Ldif Export Task Processor", err, httpResp)
 			return
 		}
 
@@ -595,6 +599,8 @@ func (r *defaultPostLdifExportTaskProcessorResource) Create(ctx context.Context,
 		if updateResponse.ThirdPartyPostLdifExportTaskProcessorResponse != nil {
 			readThirdPartyPostLdifExportTaskProcessorResponse(ctx, updateResponse.ThirdPartyPostLdifExportTaskProcessorResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Post Ldif Export Task Processor", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -691,7 +697,7 @@ func updatePostLdifExportTaskProcessor(ctx context.Context, req resource.UpdateR
 
 		updateResponse, httpResp, err := apiClient.PostLdifExportTaskProcessorAPI.UpdatePostLdifExportTaskProcessorExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Post Ldif Export Task Processor", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Post Ldif Export Task Processor", err, httpResp)
 			return
 		}
 
@@ -708,6 +714,8 @@ func updatePostLdifExportTaskProcessor(ctx context.Context, req resource.UpdateR
 		if updateResponse.ThirdPartyPostLdifExportTaskProcessorResponse != nil {
 			readThirdPartyPostLdifExportTaskProcessorResponse(ctx, updateResponse.ThirdPartyPostLdifExportTaskProcessorResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Post Ldif Export Task Processor", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -755,3 +763,16 @@ func importPostLdifExportTaskProcessor(ctx context.Context, req resource.ImportS
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *postLdifExportTaskProcessorResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_post_ldif_export_task_processor"),
+	}
+}
+
+func (r *defaultPostLdifExportTaskProcessorResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_post_ldif_export_task_processor"),
+	}
+}
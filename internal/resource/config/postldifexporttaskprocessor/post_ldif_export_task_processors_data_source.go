@@ -50,29 +50,21 @@ func (r *postLdifExportTaskProcessorsDataSource) Configure(_ context.Context, re
 }
 
 type postLdifExportTaskProcessorsDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *postLdifExportTaskProcessorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Post Ldif Export Task Processor objects in the server configuration. Supported in PingDirectory product version 10.0.0.0+.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Post Ldif Export Task Processor objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewPostLdifExportTaskProcessorDataSource(), "Post Ldif Export Task Processor")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -95,9 +87,15 @@ func (r *postLdifExportTaskProcessorsDataSource) Read(ctx context.Context, req d
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewPostLdifExportTaskProcessorDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.PostLdifExportTaskProcessorAPI.ListPostLdifExportTaskProcessors(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.PostLdifExportTaskProcessorAPI.ListPostLdifExportTaskProcessorsExecute(listRequest)
@@ -115,25 +113,26 @@ func (r *postLdifExportTaskProcessorsDataSource) Read(ctx context.Context, req d
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object postLdifExportTaskProcessorDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.UploadToS3PostLdifExportTaskProcessorResponse != nil {
-			attributes["id"] = types.StringValue(response.UploadToS3PostLdifExportTaskProcessorResponse.Id)
-			attributes["type"] = types.StringValue("upload-to-s3")
+			readUploadToS3PostLdifExportTaskProcessorResponseDataSource(ctx, response.UploadToS3PostLdifExportTaskProcessorResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyPostLdifExportTaskProcessorResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyPostLdifExportTaskProcessorResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyPostLdifExportTaskProcessorResponseDataSource(ctx, response.ThirdPartyPostLdifExportTaskProcessorResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -28,9 +28,11 @@ var (
 	_ resource.Resource                = &prometheusMonitorAttributeMetricResource{}
 	_ resource.ResourceWithConfigure   = &prometheusMonitorAttributeMetricResource{}
 	_ resource.ResourceWithImportState = &prometheusMonitorAttributeMetricResource{}
+	_ resource.ResourceWithMoveState   = &prometheusMonitorAttributeMetricResource{}
 	_ resource.Resource                = &defaultPrometheusMonitorAttributeMetricResource{}
 	_ resource.ResourceWithConfigure   = &defaultPrometheusMonitorAttributeMetricResource{}
 	_ resource.ResourceWithImportState = &defaultPrometheusMonitorAttributeMetricResource{}
+	_ resource.ResourceWithMoveState   = &defaultPrometheusMonitorAttributeMetricResource{}
 )
 
 // Create a Prometheus Monitor Attribute Metric resource
@@ -288,7 +290,7 @@ func (r *prometheusMonitorAttributeMetricResource) CreatePrometheusMonitorAttrib
 
 	addResponse, httpResp, err := r.apiClient.PrometheusMonitorAttributeMetricAPI.AddPrometheusMonitorAttributeMetricExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Prometheus Monitor Attribute Metric", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Prometheus Monitor Attribute Metric", err, httpResp)
 		return nil, err
 	}
 
@@ -321,6 +323,8 @@ func (r *prometheusMonitorAttributeMetricResource) Create(ctx context.Context, r
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Prometheus Monitor Attribute Metric", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -345,7 +349,7 @@ func (r *defaultPrometheusMonitorAttributeMetricResource) Create(ctx context.Con
 	readResponse, httpResp, err := r.apiClient.PrometheusMonitorAttributeMetricAPI.GetPrometheusMonitorAttributeMetric(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.MetricName.ValueString(), plan.HttpServletExtensionName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Prometheus Monitor Attribute Metric", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Prometheus Monitor Attribute Metric", err, httpResp)
 		return
 	}
 
@@ -369,7 +373,7 @@ func (r *defaultPrometheusMonitorAttributeMetricResource) Create(ctx context.Con
 
 		updateResponse, httpResp, err := r.apiClient.PrometheusMonitorAttributeMetricAPI.UpdatePrometheusMonitorAttributeMetricExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Prometheus Monitor Attribute Metric", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Prometheus Monitor Attribute Metric", err, httpResp)
 			return
 		}
 
@@ -381,6 +385,8 @@ func (r *defaultPrometheusMonitorAttributeMetricResource) Create(ctx context.Con
 
 		// Read the response
 		readPrometheusMonitorAttributeMetricResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Prometheus Monitor Attribute Metric", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -473,7 +479,7 @@ func updatePrometheusMonitorAttributeMetric(ctx context.Context, req resource.Up
 
 		updateResponse, httpResp, err := apiClient.PrometheusMonitorAttributeMetricAPI.UpdatePrometheusMonitorAttributeMetricExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Prometheus Monitor Attribute Metric", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Prometheus Monitor Attribute Metric", err, httpResp)
 			return
 		}
 
@@ -485,6 +491,8 @@ func updatePrometheusMonitorAttributeMetric(ctx context.Context, req resource.Up
 
 		// Read the response
 		readPrometheusMonitorAttributeMetricResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Prometheus Monitor Attribute Metric", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -539,3 +547,16 @@ func importPrometheusMonitorAttributeMetric(ctx context.Context, req resource.Im
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("http_servlet_extension_name"), split[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metric_name"), split[1])...)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *prometheusMonitorAttributeMetricResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_prometheus_monitor_attribute_metric"),
+	}
+}
+
+func (r *defaultPrometheusMonitorAttributeMetricResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_prometheus_monitor_attribute_metric"),
+	}
+}
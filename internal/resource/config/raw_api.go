@@ -0,0 +1,85 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Send a request to a PingDirectory HTTP API endpoint that the generated client doesn't cover, using the HTTP client
+// and credentials configured for the provider. The path is relative to the server's HTTPS host, like
+// "/config/backends/userRoot". The request body, if not nil, is sent as JSON. The response body is returned when
+// the request succeeds. On failure the response body is left readable so the error can be passed to ReportHttpError.
+func SendRawRequest(ctx context.Context, providerConfig internaltypes.ProviderConfiguration, apiClient *client.APIClient, method, path string, body any) ([]byte, *http.Response, error) {
+	return sendRawRequest(ctx, providerConfig, apiClient, method, path, body, "application/json", true)
+}
+
+// Send a request like SendRawRequest, but without logging the request body. Use this when the body contains
+// sensitive values such as passwords.
+func SendSensitiveRawRequest(ctx context.Context, providerConfig internaltypes.ProviderConfiguration, apiClient *client.APIClient, method, path string, body any) ([]byte, *http.Response, error) {
+	return sendRawRequest(ctx, providerConfig, apiClient, method, path, body, "application/json", false)
+}
+
+// Send a GET request to a PingDirectory HTTP endpoint that doesn't return JSON, such as the Prometheus metrics
+// endpoint, with the given Accept header. Errors are handled the same way as with SendRawRequest.
+func SendRawGetRequest(ctx context.Context, providerConfig internaltypes.ProviderConfiguration, apiClient *client.APIClient, path, accept string) ([]byte, *http.Response, error) {
+	return sendRawRequest(ctx, providerConfig, apiClient, http.MethodGet, path, nil, accept, true)
+}
+
+func sendRawRequest(ctx context.Context, providerConfig internaltypes.ProviderConfiguration, apiClient *client.APIClient, method, path string, body any, accept string, logRequestBody bool) ([]byte, *http.Response, error) {
+	var requestBody io.Reader
+	if body != nil {
+		bodyJson, err := json.Marshal(body)
+		if err != nil {
+			return nil, nil, err
+		}
+		if logRequestBody {
+			tflog.Debug(ctx, method+" "+path+" request: "+string(bodyJson))
+		} else {
+			tflog.Debug(ctx, method+" "+path+" request body not logged because it contains sensitive values")
+		}
+		requestBody = bytes.NewReader(bodyJson)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(providerConfig.HttpsHost, "/")+path, requestBody)
+	if err != nil {
+		return nil, nil, err
+	}
+	request.SetBasicAuth(providerConfig.Username, providerConfig.Password)
+	request.Header.Set("Accept", accept)
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	apiConfig := apiClient.GetConfig()
+	request.Header.Set("User-Agent", apiConfig.UserAgent)
+
+	httpClient := apiConfig.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	httpResp, err := httpClient.Do(request)
+	if err != nil {
+		return nil, httpResp, err
+	}
+	responseBody, err := io.ReadAll(httpResp.Body)
+	httpResp.Body.Close()
+	if err != nil {
+		return nil, httpResp, err
+	}
+	httpResp.Body = io.NopCloser(bytes.NewReader(responseBody))
+	tflog.Debug(ctx, method+" "+path+" response: "+string(responseBody))
+	if httpResp.StatusCode >= 300 {
+		return nil, httpResp, fmt.Errorf("%s %s returned %s", method, path, httpResp.Status)
+	}
+	return responseBody, httpResp, nil
+}
@@ -33,9 +33,11 @@ var (
 	_ resource.Resource                = &recurringTaskResource{}
 	_ resource.ResourceWithConfigure   = &recurringTaskResource{}
 	_ resource.ResourceWithImportState = &recurringTaskResource{}
+	_ resource.ResourceWithMoveState   = &recurringTaskResource{}
 	_ resource.Resource                = &defaultRecurringTaskResource{}
 	_ resource.ResourceWithConfigure   = &defaultRecurringTaskResource{}
 	_ resource.ResourceWithImportState = &defaultRecurringTaskResource{}
+	_ resource.ResourceWithMoveState   = &defaultRecurringTaskResource{}
 )
 
 // Create a Recurring Task resource
@@ -2751,7 +2753,7 @@ func (r *recurringTaskResource) CreateGenerateServerProfileRecurringTask(ctx con
 
 	addResponse, httpResp, err := r.apiClient.RecurringTaskAPI.AddRecurringTaskExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Recurring Task", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Recurring Task", err, httpResp)
 		return nil, err
 	}
 
@@ -2788,7 +2790,7 @@ func (r *recurringTaskResource) CreateLeaveLockdownModeRecurringTask(ctx context
 
 	addResponse, httpResp, err := r.apiClient.RecurringTaskAPI.AddRecurringTaskExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Recurring Task", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Recurring Task", err, httpResp)
 		return nil, err
 	}
 
@@ -2825,7 +2827,7 @@ func (r *recurringTaskResource) CreateBackupRecurringTask(ctx context.Context, r
 
 	addResponse, httpResp, err := r.apiClient.RecurringTaskAPI.AddRecurringTaskExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Recurring Task", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Recurring Task", err, httpResp)
 		return nil, err
 	}
 
@@ -2862,7 +2864,7 @@ func (r *recurringTaskResource) CreateDelayRecurringTask(ctx context.Context, re
 
 	addResponse, httpResp, err := r.apiClient.RecurringTaskAPI.AddRecurringTaskExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Recurring Task", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Recurring Task", err, httpResp)
 		return nil, err
 	}
 
@@ -2903,7 +2905,7 @@ func (r *recurringTaskResource) CreateStaticallyDefinedRecurringTask(ctx context
 
 	addResponse, httpResp, err := r.apiClient.RecurringTaskAPI.AddRecurringTaskExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Recurring Task", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Recurring Task", err, httpResp)
 		return nil, err
 	}
 
@@ -2941,7 +2943,7 @@ func (r *recurringTaskResource) CreateCollectSupportDataRecurringTask(ctx contex
 
 	addResponse, httpResp, err := r.apiClient.RecurringTaskAPI.AddRecurringTaskExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Recurring Task", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Recurring Task", err, httpResp)
 		return nil, err
 	}
 
@@ -2978,7 +2980,7 @@ func (r *recurringTaskResource) CreateLdifExportRecurringTask(ctx context.Contex
 
 	addResponse, httpResp, err := r.apiClient.RecurringTaskAPI.AddRecurringTaskExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Recurring Task", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Recurring Task", err, httpResp)
 		return nil, err
 	}
 
@@ -3015,7 +3017,7 @@ func (r *recurringTaskResource) CreateEnterLockdownModeRecurringTask(ctx context
 
 	addResponse, httpResp, err := r.apiClient.RecurringTaskAPI.AddRecurringTaskExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Recurring Task", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Recurring Task", err, httpResp)
 		return nil, err
 	}
 
@@ -3052,7 +3054,7 @@ func (r *recurringTaskResource) CreateAuditDataSecurityRecurringTask(ctx context
 
 	addResponse, httpResp, err := r.apiClient.RecurringTaskAPI.AddRecurringTaskExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Recurring Task", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Recurring Task", err, httpResp)
 		return nil, err
 	}
 
@@ -3090,7 +3092,7 @@ func (r *recurringTaskResource) CreateExecRecurringTask(ctx context.Context, req
 
 	addResponse, httpResp, err := r.apiClient.RecurringTaskAPI.AddRecurringTaskExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Recurring Task", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Recurring Task", err, httpResp)
 		return nil, err
 	}
 
@@ -3135,7 +3137,7 @@ func (r *recurringTaskResource) CreateFileRetentionRecurringTask(ctx context.Con
 
 	addResponse, httpResp, err := r.apiClient.RecurringTaskAPI.AddRecurringTaskExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Recurring Task", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Recurring Task", err, httpResp)
 		return nil, err
 	}
 
@@ -3173,7 +3175,7 @@ func (r *recurringTaskResource) CreateThirdPartyRecurringTask(ctx context.Contex
 
 	addResponse, httpResp, err := r.apiClient.RecurringTaskAPI.AddRecurringTaskExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Recurring Task", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Recurring Task", err, httpResp)
 		return nil, err
 	}
 
@@ -3274,6 +3276,8 @@ func (r *recurringTaskResource) Create(ctx context.Context, req resource.CreateR
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Recurring Task", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -3298,7 +3302,7 @@ func (r *defaultRecurringTaskResource) Create(ctx context.Context, req resource.
 	readResponse, httpResp, err := r.apiClient.RecurringTaskAPI.GetRecurringTask(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Recurring Task", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Recurring Task", err, httpResp)
 		return
 	}
 
@@ -3357,7 +3361,7 @@ func (r *defaultRecurringTaskResource) Create(ctx context.Context, req resource.
 
 		updateResponse, httpResp, err := r.apiClient.RecurringTaskAPI.UpdateRecurringTaskExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Recurring Task", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Recurring Task", err, httpResp)
 			return
 		}
 
@@ -3404,6 +3408,8 @@ func (r *defaultRecurringTaskResource) Create(ctx context.Context, req resource.
 		if updateResponse.ThirdPartyRecurringTaskResponse != nil {
 			readThirdPartyRecurringTaskResponse(ctx, updateResponse.ThirdPartyRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Recurring Task", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -3530,7 +3536,7 @@ func updateRecurringTask(ctx context.Context, req resource.UpdateRequest, resp *
 
 		updateResponse, httpResp, err := apiClient.RecurringTaskAPI.UpdateRecurringTaskExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Recurring Task", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Recurring Task", err, httpResp)
 			return
 		}
 
@@ -3577,6 +3583,8 @@ func updateRecurringTask(ctx context.Context, req resource.UpdateRequest, resp *
 		if updateResponse.ThirdPartyRecurringTaskResponse != nil {
 			readThirdPartyRecurringTaskResponse(ctx, updateResponse.ThirdPartyRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Recurring Task", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -3624,3 +3632,16 @@ func importRecurringTask(ctx context.Context, req resource.ImportStateRequest, r
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *recurringTaskResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_recurring_task"),
+	}
+}
+
+func (r *defaultRecurringTaskResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_recurring_task"),
+	}
+}
@@ -49,29 +49,21 @@ func (r *recurringTasksDataSource) Configure(_ context.Context, req datasource.C
 }
 
 type recurringTasksDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *recurringTasksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Recurring Task objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Recurring Task objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewRecurringTaskDataSource(), "Recurring Task")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *recurringTasksDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewRecurringTaskDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.RecurringTaskAPI.ListRecurringTasks(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.RecurringTaskAPI.ListRecurringTasksExecute(listRequest)
@@ -106,65 +104,56 @@ func (r *recurringTasksDataSource) Read(ctx context.Context, req datasource.Read
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object recurringTaskDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.GenerateServerProfileRecurringTaskResponse != nil {
-			attributes["id"] = types.StringValue(response.GenerateServerProfileRecurringTaskResponse.Id)
-			attributes["type"] = types.StringValue("generate-server-profile")
+			readGenerateServerProfileRecurringTaskResponseDataSource(ctx, response.GenerateServerProfileRecurringTaskResponse, &object, &resp.Diagnostics)
 		}
 		if response.LeaveLockdownModeRecurringTaskResponse != nil {
-			attributes["id"] = types.StringValue(response.LeaveLockdownModeRecurringTaskResponse.Id)
-			attributes["type"] = types.StringValue("leave-lockdown-mode")
+			readLeaveLockdownModeRecurringTaskResponseDataSource(ctx, response.LeaveLockdownModeRecurringTaskResponse, &object, &resp.Diagnostics)
 		}
 		if response.BackupRecurringTaskResponse != nil {
-			attributes["id"] = types.StringValue(response.BackupRecurringTaskResponse.Id)
-			attributes["type"] = types.StringValue("backup")
+			readBackupRecurringTaskResponseDataSource(ctx, response.BackupRecurringTaskResponse, &object, &resp.Diagnostics)
 		}
 		if response.StaticallyDefinedRecurringTaskResponse != nil {
-			attributes["id"] = types.StringValue(response.StaticallyDefinedRecurringTaskResponse.Id)
-			attributes["type"] = types.StringValue("statically-defined")
+			readStaticallyDefinedRecurringTaskResponseDataSource(ctx, response.StaticallyDefinedRecurringTaskResponse, &object, &resp.Diagnostics)
 		}
 		if response.CollectSupportDataRecurringTaskResponse != nil {
-			attributes["id"] = types.StringValue(response.CollectSupportDataRecurringTaskResponse.Id)
-			attributes["type"] = types.StringValue("collect-support-data")
+			readCollectSupportDataRecurringTaskResponseDataSource(ctx, response.CollectSupportDataRecurringTaskResponse, &object, &resp.Diagnostics)
 		}
 		if response.AuditDataSecurityRecurringTaskResponse != nil {
-			attributes["id"] = types.StringValue(response.AuditDataSecurityRecurringTaskResponse.Id)
-			attributes["type"] = types.StringValue("audit-data-security")
+			readAuditDataSecurityRecurringTaskResponseDataSource(ctx, response.AuditDataSecurityRecurringTaskResponse, &object, &resp.Diagnostics)
 		}
 		if response.DelayRecurringTaskResponse != nil {
-			attributes["id"] = types.StringValue(response.DelayRecurringTaskResponse.Id)
-			attributes["type"] = types.StringValue("delay")
+			readDelayRecurringTaskResponseDataSource(ctx, response.DelayRecurringTaskResponse, &object, &resp.Diagnostics)
 		}
 		if response.LdifExportRecurringTaskResponse != nil {
-			attributes["id"] = types.StringValue(response.LdifExportRecurringTaskResponse.Id)
-			attributes["type"] = types.StringValue("ldif-export")
+			readLdifExportRecurringTaskResponseDataSource(ctx, response.LdifExportRecurringTaskResponse, &object, &resp.Diagnostics)
 		}
 		if response.EnterLockdownModeRecurringTaskResponse != nil {
-			attributes["id"] = types.StringValue(response.EnterLockdownModeRecurringTaskResponse.Id)
-			attributes["type"] = types.StringValue("enter-lockdown-mode")
+			readEnterLockdownModeRecurringTaskResponseDataSource(ctx, response.EnterLockdownModeRecurringTaskResponse, &object, &resp.Diagnostics)
 		}
 		if response.ExecRecurringTaskResponse != nil {
-			attributes["id"] = types.StringValue(response.ExecRecurringTaskResponse.Id)
-			attributes["type"] = types.StringValue("exec")
+			readExecRecurringTaskResponseDataSource(ctx, response.ExecRecurringTaskResponse, &object, &resp.Diagnostics)
 		}
 		if response.FileRetentionRecurringTaskResponse != nil {
-			attributes["id"] = types.StringValue(response.FileRetentionRecurringTaskResponse.Id)
-			attributes["type"] = types.StringValue("file-retention")
+			readFileRetentionRecurringTaskResponseDataSource(ctx, response.FileRetentionRecurringTaskResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyRecurringTaskResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyRecurringTaskResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyRecurringTaskResponseDataSource(ctx, response.ThirdPartyRecurringTaskResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
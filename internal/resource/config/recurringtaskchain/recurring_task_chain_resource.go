@@ -30,9 +30,11 @@ var (
 	_ resource.Resource                = &recurringTaskChainResource{}
 	_ resource.ResourceWithConfigure   = &recurringTaskChainResource{}
 	_ resource.ResourceWithImportState = &recurringTaskChainResource{}
+	_ resource.ResourceWithMoveState   = &recurringTaskChainResource{}
 	_ resource.Resource                = &defaultRecurringTaskChainResource{}
 	_ resource.ResourceWithConfigure   = &defaultRecurringTaskChainResource{}
 	_ resource.ResourceWithImportState = &defaultRecurringTaskChainResource{}
+	_ resource.ResourceWithMoveState   = &defaultRecurringTaskChainResource{}
 )
 
 // Create a Recurring Task Chain resource
@@ -379,7 +381,7 @@ func (r *recurringTaskChainResource) CreateRecurringTaskChain(ctx context.Contex
 
 	addResponse, httpResp, err := r.apiClient.RecurringTaskChainAPI.AddRecurringTaskChainExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Recurring Task Chain", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Recurring Task Chain", err, httpResp)
 		return nil, err
 	}
 
@@ -410,6 +412,8 @@ func (r *recurringTaskChainResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Recurring Task Chain", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -434,7 +438,7 @@ func (r *defaultRecurringTaskChainResource) Create(ctx context.Context, req reso
 	readResponse, httpResp, err := r.apiClient.RecurringTaskChainAPI.GetRecurringTaskChain(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Recurring Task Chain", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Recurring Task Chain", err, httpResp)
 		return
 	}
 
@@ -458,7 +462,7 @@ func (r *defaultRecurringTaskChainResource) Create(ctx context.Context, req reso
 
 		updateResponse, httpResp, err := r.apiClient.RecurringTaskChainAPI.UpdateRecurringTaskChainExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Recurring Task Chain", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Recurring Task Chain", err, httpResp)
 			return
 		}
 
@@ -470,6 +474,8 @@ func (r *defaultRecurringTaskChainResource) Create(ctx context.Context, req reso
 
 		// Read the response
 		readRecurringTaskChainResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Recurring Task Chain", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -561,7 +567,7 @@ func updateRecurringTaskChain(ctx context.Context, req resource.UpdateRequest, r
 
 		updateResponse, httpResp, err := apiClient.RecurringTaskChainAPI.UpdateRecurringTaskChainExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Recurring Task Chain", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Recurring Task Chain", err, httpResp)
 			return
 		}
 
@@ -573,6 +579,8 @@ func updateRecurringTaskChain(ctx context.Context, req resource.UpdateRequest, r
 
 		// Read the response
 		readRecurringTaskChainResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Recurring Task Chain", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -620,3 +628,16 @@ func importRecurringTaskChain(ctx context.Context, req resource.ImportStateReque
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *recurringTaskChainResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_recurring_task_chain"),
+	}
+}
+
+func (r *defaultRecurringTaskChainResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_recurring_task_chain"),
+	}
+}
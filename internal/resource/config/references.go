@@ -0,0 +1,181 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/operations"
+)
+
+// A type of config object that can reference other config objects
+type ReferencingType struct {
+	// Description of the type, such as "Password Policy"
+	Description string
+	// Properties of the type that can hold references, as named in Config API JSON, such as "passwordValidator"
+	Properties []string
+	// List the config objects of this type. Singleton types return a single object.
+	List func(ctx context.Context) ([]map[string]any, *http.Response, error)
+	// Apply operations to a config object of this type. The id is empty for singleton types.
+	Update func(ctx context.Context, id string, ops []client.Operation) (*http.Response, error)
+}
+
+// A config object property that references another config object
+type ConfigReference struct {
+	Type        ReferencingType
+	Id          string
+	Property    string
+	MultiValued bool
+}
+
+// Describe the referencing object, such as `Password Policy "Default Password Policy" (property password-validator)`
+func (r ConfigReference) String() string {
+	description := r.Type.Description
+	if r.Id != "" {
+		description += " \"" + r.Id + "\""
+	}
+	return description + " (property " + PropertyJsonNameToConfigName(r.Property) + ")"
+}
+
+// Convert a list response from the Config API to generic JSON objects. Accepts the results of a list request
+// directly, like ListResponseObjects(listRequest.Execute()).
+func ListResponseObjects[T json.Marshaler](listResponse T, httpResp *http.Response, err error) ([]map[string]any, *http.Response, error) {
+	if err != nil {
+		return nil, httpResp, err
+	}
+	responseJson, err := listResponse.MarshalJSON()
+	if err != nil {
+		return nil, httpResp, err
+	}
+	var list struct {
+		Resources []map[string]any `json:"Resources"`
+	}
+	err = json.Unmarshal(responseJson, &list)
+	return list.Resources, httpResp, err
+}
+
+// Convert the response for a singleton config object to generic JSON, as a list containing the single object
+func SingletonResponseObjects[T json.Marshaler](response T, httpResp *http.Response, err error) ([]map[string]any, *http.Response, error) {
+	if err != nil {
+		return nil, httpResp, err
+	}
+	responseJson, err := response.MarshalJSON()
+	if err != nil {
+		return nil, httpResp, err
+	}
+	var object map[string]any
+	err = json.Unmarshal(responseJson, &object)
+	return []map[string]any{object}, httpResp, err
+}
+
+// Discard the response body of an update request, like UpdateResult(updateRequest.Execute())
+func UpdateResult[T any](_ T, httpResp *http.Response, err error) (*http.Response, error) {
+	return httpResp, err
+}
+
+// Find config objects that reference the named config object
+func FindReferences(ctx context.Context, referencingTypes []ReferencingType, name string, diagnostics *diag.Diagnostics) []ConfigReference {
+	var references []ConfigReference
+	for _, referencingType := range referencingTypes {
+		objects, httpResp, err := referencingType.List(ctx)
+		if err != nil {
+			ReportHttpErrorAsWarning(ctx, diagnostics, "An error occurred while searching for references in the "+referencingType.Description+" config objects", err, httpResp)
+			continue
+		}
+		for _, object := range objects {
+			id, _ := object["id"].(string)
+			for _, property := range referencingType.Properties {
+				switch value := object[property].(type) {
+				case string:
+					if value == name {
+						references = append(references, ConfigReference{referencingType, id, property, false})
+					}
+				case []any:
+					for _, element := range value {
+						if element == name {
+							references = append(references, ConfigReference{referencingType, id, property, true})
+							break
+						}
+					}
+				}
+			}
+		}
+	}
+	return references
+}
+
+// Add a diagnostic listing the config objects that still reference the named config object, if any are found.
+// Meant to be called after a failed delete.
+func ReportReferences(ctx context.Context, referencingTypes []ReferencingType, objectDescription, name string, diagnostics *diag.Diagnostics) {
+	references := FindReferences(ctx, referencingTypes, name, diagnostics)
+	if len(references) == 0 {
+		return
+	}
+
+	descriptions := make([]string, len(references))
+	for i, reference := range references {
+		descriptions[i] = "  - " + reference.String()
+	}
+	diagnostics.AddError("The "+objectDescription+" \""+name+"\" is still referenced by other config objects",
+		"Remove the references from the following config objects, or set detach_on_destroy to true to have the provider remove them before deleting:\n"+
+			strings.Join(descriptions, "\n"))
+}
+
+// Remove any references to the named config object. Returns false if any reference could not be removed.
+func DetachReferences(ctx context.Context, referencingTypes []ReferencingType, name string, diagnostics *diag.Diagnostics) bool {
+	references := FindReferences(ctx, referencingTypes, name, diagnostics)
+	for _, reference := range references {
+		configProperty := PropertyJsonNameToConfigName(reference.Property)
+		path := configProperty
+		if reference.MultiValued {
+			path = operations.RemoveMultiValuedAttributePath(configProperty, name)
+		}
+		ops := []client.Operation{*client.NewOperation(client.ENUMOPERATION_REMOVE, path)}
+		tflog.Info(ctx, "Removing reference to \""+name+"\" from "+reference.String())
+		httpResp, err := reference.Type.Update(ctx, reference.Id, ops)
+		if err != nil {
+			ReportHttpError(ctx, diagnostics, "An error occurred while removing the reference from "+reference.String(), err, httpResp)
+			return false
+		}
+	}
+	return true
+}
+
+// Change any references to the named config object so that they refer to another config object instead. Returns false
+// if any reference could not be changed.
+func RepointReferences(ctx context.Context, referencingTypes []ReferencingType, name, newName string, diagnostics *diag.Diagnostics) bool {
+	references := FindReferences(ctx, referencingTypes, name, diagnostics)
+	for _, reference := range references {
+		configProperty := PropertyJsonNameToConfigName(reference.Property)
+		var ops []client.Operation
+		if reference.MultiValued {
+			removeOp := client.NewOperation(client.ENUMOPERATION_REMOVE, operations.RemoveMultiValuedAttributePath(configProperty, name))
+			addOp := client.NewOperation(client.ENUMOPERATION_ADD, configProperty)
+			addOp.SetValue(newName)
+			ops = []client.Operation{*removeOp, *addOp}
+		} else {
+			replaceOp := client.NewOperation(client.ENUMOPERATION_REPLACE, configProperty)
+			replaceOp.SetValue(newName)
+			ops = []client.Operation{*replaceOp}
+		}
+		tflog.Info(ctx, "Changing reference to \""+name+"\" to \""+newName+"\" in "+reference.String())
+		httpResp, err := reference.Type.Update(ctx, reference.Id, ops)
+		if err != nil {
+			ReportHttpError(ctx, diagnostics, "An error occurred while changing the reference in "+reference.String(), err, httpResp)
+			return false
+		}
+	}
+	return true
+}
+
+// Convert a property name from Config API JSON ("passwordValidator") to the name used in
+// Config API operations ("password-validator")
+func PropertyJsonNameToConfigName(property string) string {
+	return strings.ReplaceAll(PropertyToAttributeName(property), "_", "-")
+}
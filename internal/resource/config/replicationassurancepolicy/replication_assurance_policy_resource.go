@@ -28,9 +28,11 @@ var (
 	_ resource.Resource                = &replicationAssurancePolicyResource{}
 	_ resource.ResourceWithConfigure   = &replicationAssurancePolicyResource{}
 	_ resource.ResourceWithImportState = &replicationAssurancePolicyResource{}
+	_ resource.ResourceWithMoveState   = &replicationAssurancePolicyResource{}
 	_ resource.Resource                = &defaultReplicationAssurancePolicyResource{}
 	_ resource.ResourceWithConfigure   = &defaultReplicationAssurancePolicyResource{}
 	_ resource.ResourceWithImportState = &defaultReplicationAssurancePolicyResource{}
+	_ resource.ResourceWithMoveState   = &defaultReplicationAssurancePolicyResource{}
 )
 
 // Create a Replication Assurance Policy resource
@@ -295,7 +297,7 @@ func (r *replicationAssurancePolicyResource) CreateReplicationAssurancePolicy(ct
 
 	addResponse, httpResp, err := r.apiClient.ReplicationAssurancePolicyAPI.AddReplicationAssurancePolicyExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Replication Assurance Policy", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Replication Assurance Policy", err, httpResp)
 		return nil, err
 	}
 
@@ -326,6 +328,8 @@ func (r *replicationAssurancePolicyResource) Create(ctx context.Context, req res
 		return
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Replication Assurance Policy", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -350,7 +354,7 @@ func (r *defaultReplicationAssurancePolicyResource) Create(ctx context.Context,
 	readResponse, httpResp, err := r.apiClient.ReplicationAssurancePolicyAPI.GetReplicationAssurancePolicy(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Replication Assurance Policy", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Replication Assurance Policy", err, httpResp)
 		return
 	}
 
@@ -374,7 +378,7 @@ func (r *defaultReplicationAssurancePolicyResource) Create(ctx context.Context,
 
 		updateResponse, httpResp, err := r.apiClient.ReplicationAssurancePolicyAPI.UpdateReplicationAssurancePolicyExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Replication Assurance Policy", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Replication Assurance Policy", err, httpResp)
 			return
 		}
 
@@ -386,6 +390,8 @@ func (r *defaultReplicationAssurancePolicyResource) Create(ctx context.Context,
 
 		// Read the response
 		readReplicationAssurancePolicyResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Replication Assurance Policy", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -477,7 +483,7 @@ func updateReplicationAssurancePolicy(ctx context.Context, req resource.UpdateRe
 
 		updateResponse, httpResp, err := apiClient.ReplicationAssurancePolicyAPI.UpdateReplicationAssurancePolicyExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Replication Assurance Policy", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Replication Assurance Policy", err, httpResp)
 			return
 		}
 
@@ -489,6 +495,8 @@ func updateReplicationAssurancePolicy(ctx context.Context, req resource.UpdateRe
 
 		// Read the response
 		readReplicationAssurancePolicyResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Replication Assurance Policy", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -536,3 +544,16 @@ func importReplicationAssurancePolicy(ctx context.Context, req resource.ImportSt
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *replicationAssurancePolicyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_replication_assurance_policy"),
+	}
+}
+
+func (r *defaultReplicationAssurancePolicyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_replication_assurance_policy"),
+	}
+}
@@ -265,7 +265,7 @@ func (r *replicationDomainResource) Create(ctx context.Context, req resource.Cre
 	readResponse, httpResp, err := r.apiClient.ReplicationDomainAPI.GetReplicationDomain(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString(), plan.SynchronizationProviderName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Replication Domain", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Replication Domain", err, httpResp)
 		return
 	}
 
@@ -289,7 +289,7 @@ func (r *replicationDomainResource) Create(ctx context.Context, req resource.Cre
 
 		updateResponse, httpResp, err := r.apiClient.ReplicationDomainAPI.UpdateReplicationDomainExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Replication Domain", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Replication Domain", err, httpResp)
 			return
 		}
 
@@ -301,6 +301,8 @@ func (r *replicationDomainResource) Create(ctx context.Context, req resource.Cre
 
 		// Read the response
 		readReplicationDomainResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Replication Domain", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -367,7 +369,7 @@ func (r *replicationDomainResource) Update(ctx context.Context, req resource.Upd
 
 		updateResponse, httpResp, err := r.apiClient.ReplicationDomainAPI.UpdateReplicationDomainExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Replication Domain", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Replication Domain", err, httpResp)
 			return
 		}
 
@@ -379,6 +381,8 @@ func (r *replicationDomainResource) Update(ctx context.Context, req resource.Upd
 
 		// Read the response
 		readReplicationDomainResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Replication Domain", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -340,7 +340,7 @@ func (r *replicationServerResource) Create(ctx context.Context, req resource.Cre
 	readResponse, httpResp, err := r.apiClient.ReplicationServerAPI.GetReplicationServer(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.SynchronizationProviderName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Replication Server", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Replication Server", err, httpResp)
 		return
 	}
 
@@ -364,7 +364,7 @@ func (r *replicationServerResource) Create(ctx context.Context, req resource.Cre
 
 		updateResponse, httpResp, err := r.apiClient.ReplicationServerAPI.UpdateReplicationServerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Replication Server", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Replication Server", err, httpResp)
 			return
 		}
 
@@ -376,6 +376,8 @@ func (r *replicationServerResource) Create(ctx context.Context, req resource.Cre
 
 		// Read the response
 		readReplicationServerResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Replication Server", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -442,7 +444,7 @@ func (r *replicationServerResource) Update(ctx context.Context, req resource.Upd
 
 		updateResponse, httpResp, err := r.apiClient.ReplicationServerAPI.UpdateReplicationServerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Replication Server", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Replication Server", err, httpResp)
 			return
 		}
 
@@ -454,6 +456,8 @@ func (r *replicationServerResource) Update(ctx context.Context, req resource.Upd
 
 		// Read the response
 		readReplicationServerResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Replication Server", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -54,10 +54,10 @@ type requestCriteriaDataSourceModel struct {
 	Type                                   types.String `tfsdk:"type"`
 	ExtensionClass                         types.String `tfsdk:"extension_class"`
 	ExtensionArgument                      types.Set    `tfsdk:"extension_argument"`
-	AllIncludedRequestCriteria             types.Set    `tfsdk:"all_included_request_criteria"`
-	AnyIncludedRequestCriteria             types.Set    `tfsdk:"any_included_request_criteria"`
-	NotAllIncludedRequestCriteria          types.Set    `tfsdk:"not_all_included_request_criteria"`
-	NoneIncludedRequestCriteria            types.Set    `tfsdk:"none_included_request_criteria"`
+	AllIncludedRequestCriteria             types.List   `tfsdk:"all_included_request_criteria"`
+	AnyIncludedRequestCriteria             types.List   `tfsdk:"any_included_request_criteria"`
+	NotAllIncludedRequestCriteria          types.List   `tfsdk:"not_all_included_request_criteria"`
+	NoneIncludedRequestCriteria            types.List   `tfsdk:"none_included_request_criteria"`
 	OperationType                          types.Set    `tfsdk:"operation_type"`
 	OperationOrigin                        types.Set    `tfsdk:"operation_origin"`
 	ConnectionCriteria                     types.String `tfsdk:"connection_criteria"`
@@ -113,28 +113,28 @@ func (r *requestCriteriaDataSource) Schema(ctx context.Context, req datasource.S
 				Computed:    true,
 				ElementType: types.StringType,
 			},
-			"all_included_request_criteria": schema.SetAttribute{
+			"all_included_request_criteria": schema.ListAttribute{
 				Description: "Specifies a request criteria object that must match the associated operation request in order to match the aggregate request criteria. If one or more all-included request criteria objects are provided, then an operation request must match all of them in order to match the aggregate request criteria.",
 				Required:    false,
 				Optional:    false,
 				Computed:    true,
 				ElementType: types.StringType,
 			},
-			"any_included_request_criteria": schema.SetAttribute{
+			"any_included_request_criteria": schema.ListAttribute{
 				Description: "Specifies a request criteria object that may match the associated operation request in order to the this aggregate request criteria. If one or more any-included request criteria objects are provided, then an operation request must match at least one of them in order to match the aggregate request criteria.",
 				Required:    false,
 				Optional:    false,
 				Computed:    true,
 				ElementType: types.StringType,
 			},
-			"not_all_included_request_criteria": schema.SetAttribute{
+			"not_all_included_request_criteria": schema.ListAttribute{
 				Description: "Specifies a request criteria object that should not match the associated operation request in order to match the aggregate request criteria. If one or more not-all-included request criteria objects are provided, then an operation request must not match all of them (that is, it may match zero or more of them, but it must not match all of them) in order to match the aggregate request criteria.",
 				Required:    false,
 				Optional:    false,
 				Computed:    true,
 				ElementType: types.StringType,
 			},
-			"none_included_request_criteria": schema.SetAttribute{
+			"none_included_request_criteria": schema.ListAttribute{
 				Description: "Specifies a request criteria object that must not match the associated operation request in order to match the aggregate request criteria. If one or more none-included request criteria objects are provided, then an operation request must not match any of them in order to match the aggregate request criteria.",
 				Required:    false,
 				Optional:    false,
@@ -404,10 +404,10 @@ func readAggregateRequestCriteriaResponseDataSource(ctx context.Context, r *clie
 	state.Type = types.StringValue("aggregate")
 	state.Id = types.StringValue(r.Id)
 	state.Name = types.StringValue(r.Id)
-	state.AllIncludedRequestCriteria = internaltypes.GetStringSet(r.AllIncludedRequestCriteria)
-	state.AnyIncludedRequestCriteria = internaltypes.GetStringSet(r.AnyIncludedRequestCriteria)
-	state.NotAllIncludedRequestCriteria = internaltypes.GetStringSet(r.NotAllIncludedRequestCriteria)
-	state.NoneIncludedRequestCriteria = internaltypes.GetStringSet(r.NoneIncludedRequestCriteria)
+	state.AllIncludedRequestCriteria = internaltypes.GetStringList(r.AllIncludedRequestCriteria)
+	state.AnyIncludedRequestCriteria = internaltypes.GetStringList(r.AnyIncludedRequestCriteria)
+	state.NotAllIncludedRequestCriteria = internaltypes.GetStringList(r.NotAllIncludedRequestCriteria)
+	state.NoneIncludedRequestCriteria = internaltypes.GetStringList(r.NoneIncludedRequestCriteria)
 	state.Description = internaltypes.StringTypeOrNil(r.Description, false)
 }
 
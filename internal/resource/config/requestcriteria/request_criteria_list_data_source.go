@@ -49,29 +49,21 @@ func (r *requestCriteriaListDataSource) Configure(_ context.Context, req datasou
 }
 
 type requestCriteriaListDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *requestCriteriaListDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Request Criteria objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Request Criteria objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewRequestCriteriaDataSource(), "Request Criteria")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *requestCriteriaListDataSource) Read(ctx context.Context, req datasource
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewRequestCriteriaDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.RequestCriteriaAPI.ListRequestCriteria(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.RequestCriteriaAPI.ListRequestCriteriaExecute(listRequest)
@@ -106,33 +104,32 @@ func (r *requestCriteriaListDataSource) Read(ctx context.Context, req datasource
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object requestCriteriaDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.SimpleRequestCriteriaResponse != nil {
-			attributes["id"] = types.StringValue(response.SimpleRequestCriteriaResponse.Id)
-			attributes["type"] = types.StringValue("simple")
+			readSimpleRequestCriteriaResponseDataSource(ctx, response.SimpleRequestCriteriaResponse, &object, &resp.Diagnostics)
 		}
 		if response.RootDseRequestCriteriaResponse != nil {
-			attributes["id"] = types.StringValue(response.RootDseRequestCriteriaResponse.Id)
-			attributes["type"] = types.StringValue("root-dse")
+			readRootDseRequestCriteriaResponseDataSource(ctx, response.RootDseRequestCriteriaResponse, &object, &resp.Diagnostics)
 		}
 		if response.AggregateRequestCriteriaResponse != nil {
-			attributes["id"] = types.StringValue(response.AggregateRequestCriteriaResponse.Id)
-			attributes["type"] = types.StringValue("aggregate")
+			readAggregateRequestCriteriaResponseDataSource(ctx, response.AggregateRequestCriteriaResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyRequestCriteriaResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyRequestCriteriaResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyRequestCriteriaResponseDataSource(ctx, response.ThirdPartyRequestCriteriaResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -29,9 +29,11 @@ var (
 	_ resource.Resource                = &requestCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &requestCriteriaResource{}
 	_ resource.ResourceWithImportState = &requestCriteriaResource{}
+	_ resource.ResourceWithMoveState   = &requestCriteriaResource{}
 	_ resource.Resource                = &defaultRequestCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &defaultRequestCriteriaResource{}
 	_ resource.ResourceWithImportState = &defaultRequestCriteriaResource{}
+	_ resource.ResourceWithMoveState   = &defaultRequestCriteriaResource{}
 )
 
 // Create a Request Criteria resource
@@ -93,10 +95,10 @@ type requestCriteriaResourceModel struct {
 	Type                                   types.String `tfsdk:"type"`
 	ExtensionClass                         types.String `tfsdk:"extension_class"`
 	ExtensionArgument                      types.Set    `tfsdk:"extension_argument"`
-	AllIncludedRequestCriteria             types.Set    `tfsdk:"all_included_request_criteria"`
-	AnyIncludedRequestCriteria             types.Set    `tfsdk:"any_included_request_criteria"`
-	NotAllIncludedRequestCriteria          types.Set    `tfsdk:"not_all_included_request_criteria"`
-	NoneIncludedRequestCriteria            types.Set    `tfsdk:"none_included_request_criteria"`
+	AllIncludedRequestCriteria             types.List   `tfsdk:"all_included_request_criteria"`
+	AnyIncludedRequestCriteria             types.List   `tfsdk:"any_included_request_criteria"`
+	NotAllIncludedRequestCriteria          types.List   `tfsdk:"not_all_included_request_criteria"`
+	NoneIncludedRequestCriteria            types.List   `tfsdk:"none_included_request_criteria"`
 	OperationType                          types.Set    `tfsdk:"operation_type"`
 	OperationOrigin                        types.Set    `tfsdk:"operation_origin"`
 	ConnectionCriteria                     types.String `tfsdk:"connection_criteria"`
@@ -162,32 +164,32 @@ func requestCriteriaSchema(ctx context.Context, req resource.SchemaRequest, resp
 				Default:     internaltypes.EmptySetDefault(types.StringType),
 				ElementType: types.StringType,
 			},
-			"all_included_request_criteria": schema.SetAttribute{
+			"all_included_request_criteria": schema.ListAttribute{
 				Description: "Specifies a request criteria object that must match the associated operation request in order to match the aggregate request criteria. If one or more all-included request criteria objects are provided, then an operation request must match all of them in order to match the aggregate request criteria.",
 				Optional:    true,
 				Computed:    true,
-				Default:     internaltypes.EmptySetDefault(types.StringType),
+				Default:     internaltypes.EmptyListDefault(types.StringType),
 				ElementType: types.StringType,
 			},
-			"any_included_request_criteria": schema.SetAttribute{
+			"any_included_request_criteria": schema.ListAttribute{
 				Description: "Specifies a request criteria object that may match the associated operation request in order to the this aggregate request criteria. If one or more any-included request criteria objects are provided, then an operation request must match at least one of them in order to match the aggregate request criteria.",
 				Optional:    true,
 				Computed:    true,
-				Default:     internaltypes.EmptySetDefault(types.StringType),
+				Default:     internaltypes.EmptyListDefault(types.StringType),
 				ElementType: types.StringType,
 			},
-			"not_all_included_request_criteria": schema.SetAttribute{
+			"not_all_included_request_criteria": schema.ListAttribute{
 				Description: "Specifies a request criteria object that should not match the associated operation request in order to match the aggregate request criteria. If one or more not-all-included request criteria objects are provided, then an operation request must not match all of them (that is, it may match zero or more of them, but it must not match all of them) in order to match the aggregate request criteria.",
 				Optional:    true,
 				Computed:    true,
-				Default:     internaltypes.EmptySetDefault(types.StringType),
+				Default:     internaltypes.EmptyListDefault(types.StringType),
 				ElementType: types.StringType,
 			},
-			"none_included_request_criteria": schema.SetAttribute{
+			"none_included_request_criteria": schema.ListAttribute{
 				Description: "Specifies a request criteria object that must not match the associated operation request in order to match the aggregate request criteria. If one or more none-included request criteria objects are provided, then an operation request must not match any of them in order to match the aggregate request criteria.",
 				Optional:    true,
 				Computed:    true,
-				Default:     internaltypes.EmptySetDefault(types.StringType),
+				Default:     internaltypes.EmptyListDefault(types.StringType),
 				ElementType: types.StringType,
 			},
 			"operation_type": schema.SetAttribute{
@@ -940,7 +942,7 @@ func populateRequestCriteriaUnknownValues(model *requestCriteriaResourceModel) {
 		model.NoneIncludedTargetEntryGroupDN, _ = types.SetValue(types.StringType, []attr.Value{})
 	}
 	if model.AnyIncludedRequestCriteria.IsUnknown() || model.AnyIncludedRequestCriteria.IsNull() {
-		model.AnyIncludedRequestCriteria, _ = types.SetValue(types.StringType, []attr.Value{})
+		model.AnyIncludedRequestCriteria, _ = types.ListValue(types.StringType, []attr.Value{})
 	}
 	if model.ExcludedTargetEntryDN.IsUnknown() || model.ExcludedTargetEntryDN.IsNull() {
 		model.ExcludedTargetEntryDN, _ = types.SetValue(types.StringType, []attr.Value{})
@@ -949,7 +951,7 @@ func populateRequestCriteriaUnknownValues(model *requestCriteriaResourceModel) {
 		model.IncludedTargetEntryDN, _ = types.SetValue(types.StringType, []attr.Value{})
 	}
 	if model.NoneIncludedRequestCriteria.IsUnknown() || model.NoneIncludedRequestCriteria.IsNull() {
-		model.NoneIncludedRequestCriteria, _ = types.SetValue(types.StringType, []attr.Value{})
+		model.NoneIncludedRequestCriteria, _ = types.ListValue(types.StringType, []attr.Value{})
 	}
 	if model.NotAllIncludedRequestControl.IsUnknown() || model.NotAllIncludedRequestControl.IsNull() {
 		model.NotAllIncludedRequestControl, _ = types.SetValue(types.StringType, []attr.Value{})
@@ -970,10 +972,10 @@ func populateRequestCriteriaUnknownValues(model *requestCriteriaResourceModel) {
 		model.IncludedTargetSASLMechanism, _ = types.SetValue(types.StringType, []attr.Value{})
 	}
 	if model.AllIncludedRequestCriteria.IsUnknown() || model.AllIncludedRequestCriteria.IsNull() {
-		model.AllIncludedRequestCriteria, _ = types.SetValue(types.StringType, []attr.Value{})
+		model.AllIncludedRequestCriteria, _ = types.ListValue(types.StringType, []attr.Value{})
 	}
 	if model.NotAllIncludedRequestCriteria.IsUnknown() || model.NotAllIncludedRequestCriteria.IsNull() {
-		model.NotAllIncludedRequestCriteria, _ = types.SetValue(types.StringType, []attr.Value{})
+		model.NotAllIncludedRequestCriteria, _ = types.ListValue(types.StringType, []attr.Value{})
 	}
 	if model.ExcludedTargetAttribute.IsUnknown() || model.ExcludedTargetAttribute.IsNull() {
 		model.ExcludedTargetAttribute, _ = types.SetValue(types.StringType, []attr.Value{})
@@ -1098,10 +1100,10 @@ func readAggregateRequestCriteriaResponse(ctx context.Context, r *client.Aggrega
 	state.Type = types.StringValue("aggregate")
 	state.Id = types.StringValue(r.Id)
 	state.Name = types.StringValue(r.Id)
-	state.AllIncludedRequestCriteria = internaltypes.GetStringSet(r.AllIncludedRequestCriteria)
-	state.AnyIncludedRequestCriteria = internaltypes.GetStringSet(r.AnyIncludedRequestCriteria)
-	state.NotAllIncludedRequestCriteria = internaltypes.GetStringSet(r.NotAllIncludedRequestCriteria)
-	state.NoneIncludedRequestCriteria = internaltypes.GetStringSet(r.NoneIncludedRequestCriteria)
+	state.AllIncludedRequestCriteria = internaltypes.GetStringList(r.AllIncludedRequestCriteria)
+	state.AnyIncludedRequestCriteria = internaltypes.GetStringList(r.AnyIncludedRequestCriteria)
+	state.NotAllIncludedRequestCriteria = internaltypes.GetStringList(r.NotAllIncludedRequestCriteria)
+	state.NoneIncludedRequestCriteria = internaltypes.GetStringList(r.NoneIncludedRequestCriteria)
 	state.Description = internaltypes.StringTypeOrNil(r.Description, internaltypes.IsEmptyString(expectedValues.Description))
 	state.Notifications, state.RequiredActions = config.ReadMessages(ctx, r.Urnpingidentityschemasconfigurationmessages20, diagnostics)
 	populateRequestCriteriaUnknownValues(state)
@@ -1124,10 +1126,10 @@ func createRequestCriteriaOperations(plan requestCriteriaResourceModel, state re
 	var ops []client.Operation
 	operations.AddStringOperationIfNecessary(&ops, plan.ExtensionClass, state.ExtensionClass, "extension-class")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.ExtensionArgument, state.ExtensionArgument, "extension-argument")
-	operations.AddStringSetOperationsIfNecessary(&ops, plan.AllIncludedRequestCriteria, state.AllIncludedRequestCriteria, "all-included-request-criteria")
-	operations.AddStringSetOperationsIfNecessary(&ops, plan.AnyIncludedRequestCriteria, state.AnyIncludedRequestCriteria, "any-included-request-criteria")
-	operations.AddStringSetOperationsIfNecessary(&ops, plan.NotAllIncludedRequestCriteria, state.NotAllIncludedRequestCriteria, "not-all-included-request-criteria")
-	operations.AddStringSetOperationsIfNecessary(&ops, plan.NoneIncludedRequestCriteria, state.NoneIncludedRequestCriteria, "none-included-request-criteria")
+	operations.AddStringListOperationsIfNecessary(&ops, plan.AllIncludedRequestCriteria, state.AllIncludedRequestCriteria, "all-included-request-criteria")
+	operations.AddStringListOperationsIfNecessary(&ops, plan.AnyIncludedRequestCriteria, state.AnyIncludedRequestCriteria, "any-included-request-criteria")
+	operations.AddStringListOperationsIfNecessary(&ops, plan.NotAllIncludedRequestCriteria, state.NotAllIncludedRequestCriteria, "not-all-included-request-criteria")
+	operations.AddStringListOperationsIfNecessary(&ops, plan.NoneIncludedRequestCriteria, state.NoneIncludedRequestCriteria, "none-included-request-criteria")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.OperationType, state.OperationType, "operation-type")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.OperationOrigin, state.OperationOrigin, "operation-origin")
 	operations.AddStringOperationIfNecessary(&ops, plan.ConnectionCriteria, state.ConnectionCriteria, "connection-criteria")
@@ -1181,7 +1183,7 @@ func (r *requestCriteriaResource) CreateRootDseRequestCriteria(ctx context.Conte
 
 	addResponse, httpResp, err := r.apiClient.RequestCriteriaAPI.AddRequestCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Request Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Request Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -1218,7 +1220,7 @@ func (r *requestCriteriaResource) CreateSimpleRequestCriteria(ctx context.Contex
 
 	addResponse, httpResp, err := r.apiClient.RequestCriteriaAPI.AddRequestCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Request Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Request Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -1255,7 +1257,7 @@ func (r *requestCriteriaResource) CreateAggregateRequestCriteria(ctx context.Con
 
 	addResponse, httpResp, err := r.apiClient.RequestCriteriaAPI.AddRequestCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Request Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Request Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -1293,7 +1295,7 @@ func (r *requestCriteriaResource) CreateThirdPartyRequestCriteria(ctx context.Co
 
 	addResponse, httpResp, err := r.apiClient.RequestCriteriaAPI.AddRequestCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Request Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Request Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -1346,6 +1348,8 @@ func (r *requestCriteriaResource) Create(ctx context.Context, req resource.Creat
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Request Criteria", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -1370,7 +1374,7 @@ func (r *defaultRequestCriteriaResource) Create(ctx context.Context, req resourc
 	readResponse, httpResp, err := r.apiClient.RequestCriteriaAPI.GetRequestCriteria(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Request Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Request Criteria", err, httpResp)
 		return
 	}
 
@@ -1405,7 +1409,7 @@ func (r *defaultRequestCriteriaResource) Create(ctx context.Context, req resourc
 
 		updateResponse, httpResp, err := r.apiClient.RequestCriteriaAPI.UpdateRequestCriteriaExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Request Criteria", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Request Criteria", err, httpResp)
 			return
 		}
 
@@ -1428,6 +1432,8 @@ func (r *defaultRequestCriteriaResource) Create(ctx context.Context, req resourc
 		if updateResponse.ThirdPartyRequestCriteriaResponse != nil {
 			readThirdPartyRequestCriteriaResponse(ctx, updateResponse.ThirdPartyRequestCriteriaResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Request Criteria", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -1530,7 +1536,7 @@ func updateRequestCriteria(ctx context.Context, req resource.UpdateRequest, resp
 
 		updateResponse, httpResp, err := apiClient.RequestCriteriaAPI.UpdateRequestCriteriaExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Request Criteria", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Request Criteria", err, httpResp)
 			return
 		}
 
@@ -1553,6 +1559,8 @@ func updateRequestCriteria(ctx context.Context, req resource.UpdateRequest, resp
 		if updateResponse.ThirdPartyRequestCriteriaResponse != nil {
 			readThirdPartyRequestCriteriaResponse(ctx, updateResponse.ThirdPartyRequestCriteriaResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Request Criteria", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -1600,3 +1608,16 @@ func importRequestCriteria(ctx context.Context, req resource.ImportStateRequest,
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *requestCriteriaResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_request_criteria"),
+	}
+}
+
+func (r *defaultRequestCriteriaResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_request_criteria"),
+	}
+}
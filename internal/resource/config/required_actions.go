@@ -0,0 +1,103 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+type requiredActionModel struct {
+	Property types.String `tfsdk:"property"`
+	Type     types.String `tfsdk:"type"`
+	Synopsis types.String `tfsdk:"synopsis"`
+}
+
+// Report the required actions returned by the Configuration API when applying a config object,
+// based on the provider required_actions_policy
+func HandleRequiredActions(ctx context.Context, providerConfig internaltypes.ProviderConfiguration, resourceName string, id types.String, requiredActions types.Set, diagnostics *diag.Diagnostics) {
+	if requiredActions.IsNull() || requiredActions.IsUnknown() || len(requiredActions.Elements()) == 0 {
+		return
+	}
+
+	var actions []requiredActionModel
+	diags := requiredActions.ElementsAs(ctx, &actions, false)
+	diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if id.ValueString() != "" {
+		resourceName += " \"" + id.ValueString() + "\""
+	}
+
+	switch providerConfig.RequiredActionsPolicy {
+	case internaltypes.RequiredActionsPolicyError:
+		for _, action := range actions {
+			diagnostics.AddError("Configuration API RequiredAction",
+				fmt.Sprintf("The changes to %s were applied, but the server reported a required action: %s. "+
+					"Perform the action, or set the provider required_actions_policy to \"warn\" to allow the apply to succeed.",
+					resourceName, describeRequiredAction(action)))
+		}
+	case internaltypes.RequiredActionsPolicyCollect:
+		if providerConfig.RequiredActions == nil {
+			return
+		}
+		descriptions := make([]string, len(actions))
+		for i, action := range actions {
+			descriptions[i] = describeRequiredAction(action)
+		}
+		providerConfig.RequiredActions.Add(resourceName, descriptions)
+		addRequiredActionsSummary(providerConfig.RequiredActions, diagnostics)
+	default:
+		for _, action := range actions {
+			diagnostics.AddWarning("Configuration API RequiredAction", requiredActionJson(action))
+		}
+	}
+}
+
+// Terraform has no hook for the end of an apply, so each resource that reports required actions adds a summary
+// of everything collected so far. The summary from the last applied resource is the complete list. The count in
+// the summary line prevents Terraform from consolidating these warnings into the first one reported.
+func addRequiredActionsSummary(collector *internaltypes.RequiredActionsCollector, diagnostics *diag.Diagnostics) {
+	resources, actions := collector.Resources()
+	var detail strings.Builder
+	total := 0
+	for _, resource := range resources {
+		detail.WriteString(resource + ":\n")
+		for _, action := range actions[resource] {
+			detail.WriteString("  - " + action + "\n")
+			total++
+		}
+	}
+	diagnostics.AddWarning(fmt.Sprintf("Configuration API required actions summary (%d actions on %d resources)", total, len(resources)),
+		strings.TrimSuffix(detail.String(), "\n"))
+}
+
+func describeRequiredAction(action requiredActionModel) string {
+	description := action.Type.ValueString() + ": " + action.Synopsis.ValueString()
+	if action.Property.ValueString() != "" {
+		description += " (property " + action.Property.ValueString() + ")"
+	}
+	return description
+}
+
+// Format the action the same way the Configuration API returns it
+func requiredActionJson(action requiredActionModel) string {
+	apiAction := client.MetaUrnPingidentitySchemasConfigurationMessages20RequiredActionsInner{
+		Property: action.Property.ValueStringPointer(),
+		Type:     action.Type.ValueString(),
+		Synopsis: action.Synopsis.ValueString(),
+	}
+	actionJson, err := apiAction.MarshalJSON()
+	if err != nil {
+		return describeRequiredAction(action)
+	}
+	return string(actionJson)
+}
@@ -29,9 +29,11 @@ var (
 	_ resource.Resource                = &restResourceTypeResource{}
 	_ resource.ResourceWithConfigure   = &restResourceTypeResource{}
 	_ resource.ResourceWithImportState = &restResourceTypeResource{}
+	_ resource.ResourceWithMoveState   = &restResourceTypeResource{}
 	_ resource.Resource                = &defaultRestResourceTypeResource{}
 	_ resource.ResourceWithConfigure   = &defaultRestResourceTypeResource{}
 	_ resource.ResourceWithImportState = &defaultRestResourceTypeResource{}
+	_ resource.ResourceWithMoveState   = &defaultRestResourceTypeResource{}
 )
 
 // Create a Rest Resource Type resource
@@ -743,7 +745,7 @@ func (r *restResourceTypeResource) CreateUserRestResourceType(ctx context.Contex
 
 	addResponse, httpResp, err := r.apiClient.RestResourceTypeAPI.AddRestResourceTypeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Rest Resource Type", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Rest Resource Type", err, httpResp)
 		return nil, err
 	}
 
@@ -780,7 +782,7 @@ func (r *restResourceTypeResource) CreateGenericRestResourceType(ctx context.Con
 
 	addResponse, httpResp, err := r.apiClient.RestResourceTypeAPI.AddRestResourceTypeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Rest Resource Type", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Rest Resource Type", err, httpResp)
 		return nil, err
 	}
 
@@ -817,7 +819,7 @@ func (r *restResourceTypeResource) CreateGroupRestResourceType(ctx context.Conte
 
 	addResponse, httpResp, err := r.apiClient.RestResourceTypeAPI.AddRestResourceTypeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Rest Resource Type", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Rest Resource Type", err, httpResp)
 		return nil, err
 	}
 
@@ -864,6 +866,8 @@ func (r *restResourceTypeResource) Create(ctx context.Context, req resource.Crea
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Rest Resource Type", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -888,7 +892,7 @@ func (r *defaultRestResourceTypeResource) Create(ctx context.Context, req resour
 	readResponse, httpResp, err := r.apiClient.RestResourceTypeAPI.GetRestResourceType(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Rest Resource Type", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Rest Resource Type", err, httpResp)
 		return
 	}
 
@@ -920,7 +924,7 @@ func (r *defaultRestResourceTypeResource) Create(ctx context.Context, req resour
 
 		updateResponse, httpResp, err := r.apiClient.RestResourceTypeAPI.UpdateRestResourceTypeExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Rest Resource Type", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Rest Resource Type", err, httpResp)
 			return
 		}
 
@@ -940,6 +944,8 @@ func (r *defaultRestResourceTypeResource) Create(ctx context.Context, req resour
 		if updateResponse.GroupRestResourceTypeResponse != nil {
 			readGroupRestResourceTypeResponse(ctx, updateResponse.GroupRestResourceTypeResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Rest Resource Type", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -1039,7 +1045,7 @@ func updateRestResourceType(ctx context.Context, req resource.UpdateRequest, res
 
 		updateResponse, httpResp, err := apiClient.RestResourceTypeAPI.UpdateRestResourceTypeExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Rest Resource Type", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Rest Resource Type", err, httpResp)
 			return
 		}
 
@@ -1059,6 +1065,8 @@ func updateRestResourceType(ctx context.Context, req resource.UpdateRequest, res
 		if updateResponse.GroupRestResourceTypeResponse != nil {
 			readGroupRestResourceTypeResponse(ctx, updateResponse.GroupRestResourceTypeResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Rest Resource Type", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -1106,3 +1114,16 @@ func importRestResourceType(ctx context.Context, req resource.ImportStateRequest
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *restResourceTypeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_rest_resource_type"),
+	}
+}
+
+func (r *defaultRestResourceTypeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_rest_resource_type"),
+	}
+}
@@ -28,9 +28,11 @@ var (
 	_ resource.Resource                = &resultCodeMapResource{}
 	_ resource.ResourceWithConfigure   = &resultCodeMapResource{}
 	_ resource.ResourceWithImportState = &resultCodeMapResource{}
+	_ resource.ResourceWithMoveState   = &resultCodeMapResource{}
 	_ resource.Resource                = &defaultResultCodeMapResource{}
 	_ resource.ResourceWithConfigure   = &defaultResultCodeMapResource{}
 	_ resource.ResourceWithImportState = &defaultResultCodeMapResource{}
+	_ resource.ResourceWithMoveState   = &defaultResultCodeMapResource{}
 )
 
 // Create a Result Code Map resource
@@ -231,7 +233,7 @@ func (r *resultCodeMapResource) CreateResultCodeMap(ctx context.Context, req res
 
 	addResponse, httpResp, err := r.apiClient.ResultCodeMapAPI.AddResultCodeMapExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Result Code Map", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Result Code Map", err, httpResp)
 		return nil, err
 	}
 
@@ -262,6 +264,8 @@ func (r *resultCodeMapResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Result Code Map", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -286,7 +290,7 @@ func (r *defaultResultCodeMapResource) Create(ctx context.Context, req resource.
 	readResponse, httpResp, err := r.apiClient.ResultCodeMapAPI.GetResultCodeMap(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Result Code Map", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Result Code Map", err, httpResp)
 		return
 	}
 
@@ -310,7 +314,7 @@ func (r *defaultResultCodeMapResource) Create(ctx context.Context, req resource.
 
 		updateResponse, httpResp, err := r.apiClient.ResultCodeMapAPI.UpdateResultCodeMapExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Result Code Map", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Result Code Map", err, httpResp)
 			return
 		}
 
@@ -322,6 +326,8 @@ func (r *defaultResultCodeMapResource) Create(ctx context.Context, req resource.
 
 		// Read the response
 		readResultCodeMapResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Result Code Map", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -413,7 +419,7 @@ func updateResultCodeMap(ctx context.Context, req resource.UpdateRequest, resp *
 
 		updateResponse, httpResp, err := apiClient.ResultCodeMapAPI.UpdateResultCodeMapExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Result Code Map", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Result Code Map", err, httpResp)
 			return
 		}
 
@@ -425,6 +431,8 @@ func updateResultCodeMap(ctx context.Context, req resource.UpdateRequest, resp *
 
 		// Read the response
 		readResultCodeMapResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Result Code Map", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -472,3 +480,16 @@ func importResultCodeMap(ctx context.Context, req resource.ImportStateRequest, r
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *resultCodeMapResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_result_code_map"),
+	}
+}
+
+func (r *defaultResultCodeMapResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_result_code_map"),
+	}
+}
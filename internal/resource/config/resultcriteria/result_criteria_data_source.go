@@ -61,10 +61,10 @@ type resultCriteriaDataSourceModel struct {
 	ResponseDelayedByAssurance        types.String `tfsdk:"response_delayed_by_assurance"`
 	AssuranceBehaviorAlteredByControl types.String `tfsdk:"assurance_behavior_altered_by_control"`
 	AssuranceSatisfied                types.String `tfsdk:"assurance_satisfied"`
-	AllIncludedResultCriteria         types.Set    `tfsdk:"all_included_result_criteria"`
-	AnyIncludedResultCriteria         types.Set    `tfsdk:"any_included_result_criteria"`
-	NotAllIncludedResultCriteria      types.Set    `tfsdk:"not_all_included_result_criteria"`
-	NoneIncludedResultCriteria        types.Set    `tfsdk:"none_included_result_criteria"`
+	AllIncludedResultCriteria         types.List   `tfsdk:"all_included_result_criteria"`
+	AnyIncludedResultCriteria         types.List   `tfsdk:"any_included_result_criteria"`
+	NotAllIncludedResultCriteria      types.List   `tfsdk:"not_all_included_result_criteria"`
+	NoneIncludedResultCriteria        types.List   `tfsdk:"none_included_result_criteria"`
 	RequestCriteria                   types.String `tfsdk:"request_criteria"`
 	ResultCodeCriteria                types.String `tfsdk:"result_code_criteria"`
 	ResultCodeValue                   types.Set    `tfsdk:"result_code_value"`
@@ -172,28 +172,28 @@ func (r *resultCriteriaDataSource) Schema(ctx context.Context, req datasource.Sc
 				Optional:    false,
 				Computed:    true,
 			},
-			"all_included_result_criteria": schema.SetAttribute{
+			"all_included_result_criteria": schema.ListAttribute{
 				Description: "Specifies a result criteria object that must match the associated operation result in order to match the aggregate result criteria. If one or more all-included result criteria objects are provided, then an operation result must match all of them in order to match the aggregate result criteria.",
 				Required:    false,
 				Optional:    false,
 				Computed:    true,
 				ElementType: types.StringType,
 			},
-			"any_included_result_criteria": schema.SetAttribute{
+			"any_included_result_criteria": schema.ListAttribute{
 				Description: "Specifies a result criteria object that may match the associated operation result in order to match the aggregate result criteria. If one or more any-included result criteria objects are provided, then an operation result must match at least one of them in order to match the aggregate result criteria.",
 				Required:    false,
 				Optional:    false,
 				Computed:    true,
 				ElementType: types.StringType,
 			},
-			"not_all_included_result_criteria": schema.SetAttribute{
+			"not_all_included_result_criteria": schema.ListAttribute{
 				Description: "Specifies a result criteria object that should not match the associated operation result in order to match the aggregate result criteria. If one or more not-all-included result criteria objects are provided, then an operation result must not match all of them (that is, it may match zero or more of them, but it must not match all of them) in order to match the aggregate result criteria.",
 				Required:    false,
 				Optional:    false,
 				Computed:    true,
 				ElementType: types.StringType,
 			},
-			"none_included_result_criteria": schema.SetAttribute{
+			"none_included_result_criteria": schema.ListAttribute{
 				Description: "Specifies a result criteria object that must not match the associated operation result in order to match the aggregate result criteria. If one or more none-included result criteria objects are provided, then an operation result must not match any of them in order to match the aggregate result criteria.",
 				Required:    false,
 				Optional:    false,
@@ -520,10 +520,10 @@ func readAggregateResultCriteriaResponseDataSource(ctx context.Context, r *clien
 	state.Type = types.StringValue("aggregate")
 	state.Id = types.StringValue(r.Id)
 	state.Name = types.StringValue(r.Id)
-	state.AllIncludedResultCriteria = internaltypes.GetStringSet(r.AllIncludedResultCriteria)
-	state.AnyIncludedResultCriteria = internaltypes.GetStringSet(r.AnyIncludedResultCriteria)
-	state.NotAllIncludedResultCriteria = internaltypes.GetStringSet(r.NotAllIncludedResultCriteria)
-	state.NoneIncludedResultCriteria = internaltypes.GetStringSet(r.NoneIncludedResultCriteria)
+	state.AllIncludedResultCriteria = internaltypes.GetStringList(r.AllIncludedResultCriteria)
+	state.AnyIncludedResultCriteria = internaltypes.GetStringList(r.AnyIncludedResultCriteria)
+	state.NotAllIncludedResultCriteria = internaltypes.GetStringList(r.NotAllIncludedResultCriteria)
+	state.NoneIncludedResultCriteria = internaltypes.GetStringList(r.NoneIncludedResultCriteria)
 	state.Description = internaltypes.StringTypeOrNil(r.Description, false)
 }
 
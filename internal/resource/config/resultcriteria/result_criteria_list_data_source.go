@@ -49,29 +49,21 @@ func (r *resultCriteriaListDataSource) Configure(_ context.Context, req datasour
 }
 
 type resultCriteriaListDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *resultCriteriaListDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Result Criteria objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Result Criteria objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewResultCriteriaDataSource(), "Result Criteria")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *resultCriteriaListDataSource) Read(ctx context.Context, req datasource.
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewResultCriteriaDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.ResultCriteriaAPI.ListResultCriteria(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.ResultCriteriaAPI.ListResultCriteriaExecute(listRequest)
@@ -106,37 +104,35 @@ func (r *resultCriteriaListDataSource) Read(ctx context.Context, req datasource.
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object resultCriteriaDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.SimpleResultCriteriaResponse != nil {
-			attributes["id"] = types.StringValue(response.SimpleResultCriteriaResponse.Id)
-			attributes["type"] = types.StringValue("simple")
+			readSimpleResultCriteriaResponseDataSource(ctx, response.SimpleResultCriteriaResponse, &object, &resp.Diagnostics)
 		}
 		if response.SuccessfulBindResultCriteriaResponse != nil {
-			attributes["id"] = types.StringValue(response.SuccessfulBindResultCriteriaResponse.Id)
-			attributes["type"] = types.StringValue("successful-bind")
+			readSuccessfulBindResultCriteriaResponseDataSource(ctx, response.SuccessfulBindResultCriteriaResponse, &object, &resp.Diagnostics)
 		}
 		if response.AggregateResultCriteriaResponse != nil {
-			attributes["id"] = types.StringValue(response.AggregateResultCriteriaResponse.Id)
-			attributes["type"] = types.StringValue("aggregate")
+			readAggregateResultCriteriaResponseDataSource(ctx, response.AggregateResultCriteriaResponse, &object, &resp.Diagnostics)
 		}
 		if response.ReplicationAssuranceResultCriteriaResponse != nil {
-			attributes["id"] = types.StringValue(response.ReplicationAssuranceResultCriteriaResponse.Id)
-			attributes["type"] = types.StringValue("replication-assurance")
+			readReplicationAssuranceResultCriteriaResponseDataSource(ctx, response.ReplicationAssuranceResultCriteriaResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyResultCriteriaResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyResultCriteriaResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyResultCriteriaResponseDataSource(ctx, response.ThirdPartyResultCriteriaResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
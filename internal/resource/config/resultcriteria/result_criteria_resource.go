@@ -28,9 +28,11 @@ var (
 	_ resource.Resource                = &resultCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &resultCriteriaResource{}
 	_ resource.ResourceWithImportState = &resultCriteriaResource{}
+	_ resource.ResourceWithMoveState   = &resultCriteriaResource{}
 	_ resource.Resource                = &defaultResultCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &defaultResultCriteriaResource{}
 	_ resource.ResourceWithImportState = &defaultResultCriteriaResource{}
+	_ resource.ResourceWithMoveState   = &defaultResultCriteriaResource{}
 )
 
 // Create a Result Criteria resource
@@ -99,10 +101,10 @@ type resultCriteriaResourceModel struct {
 	ResponseDelayedByAssurance        types.String `tfsdk:"response_delayed_by_assurance"`
 	AssuranceBehaviorAlteredByControl types.String `tfsdk:"assurance_behavior_altered_by_control"`
 	AssuranceSatisfied                types.String `tfsdk:"assurance_satisfied"`
-	AllIncludedResultCriteria         types.Set    `tfsdk:"all_included_result_criteria"`
-	AnyIncludedResultCriteria         types.Set    `tfsdk:"any_included_result_criteria"`
-	NotAllIncludedResultCriteria      types.Set    `tfsdk:"not_all_included_result_criteria"`
-	NoneIncludedResultCriteria        types.Set    `tfsdk:"none_included_result_criteria"`
+	AllIncludedResultCriteria         types.List   `tfsdk:"all_included_result_criteria"`
+	AnyIncludedResultCriteria         types.List   `tfsdk:"any_included_result_criteria"`
+	NotAllIncludedResultCriteria      types.List   `tfsdk:"not_all_included_result_criteria"`
+	NoneIncludedResultCriteria        types.List   `tfsdk:"none_included_result_criteria"`
 	RequestCriteria                   types.String `tfsdk:"request_criteria"`
 	ResultCodeCriteria                types.String `tfsdk:"result_code_criteria"`
 	ResultCodeValue                   types.Set    `tfsdk:"result_code_value"`
@@ -228,32 +230,32 @@ func resultCriteriaSchema(ctx context.Context, req resource.SchemaRequest, resp
 					stringvalidator.OneOf([]string{"any", "both-satisfied", "either-satisfied", "at-least-local-satisfied", "at-least-remote-satisfied", "only-local-satisfied", "only-remote-satisfied", "either-not-satisfied", "at-least-local-not-satisfied", "at-least-remote-not-satisfied", "neither-satisfied"}...),
 				},
 			},
-			"all_included_result_criteria": schema.SetAttribute{
+			"all_included_result_criteria": schema.ListAttribute{
 				Description: "Specifies a result criteria object that must match the associated operation result in order to match the aggregate result criteria. If one or more all-included result criteria objects are provided, then an operation result must match all of them in order to match the aggregate result criteria.",
 				Optional:    true,
 				Computed:    true,
-				Default:     internaltypes.EmptySetDefault(types.StringType),
+				Default:     internaltypes.EmptyListDefault(types.StringType),
 				ElementType: types.StringType,
 			},
-			"any_included_result_criteria": schema.SetAttribute{
+			"any_included_result_criteria": schema.ListAttribute{
 				Description: "Specifies a result criteria object that may match the associated operation result in order to match the aggregate result criteria. If one or more any-included result criteria objects are provided, then an operation result must match at least one of them in order to match the aggregate result criteria.",
 				Optional:    true,
 				Computed:    true,
-				Default:     internaltypes.EmptySetDefault(types.StringType),
+				Default:     internaltypes.EmptyListDefault(types.StringType),
 				ElementType: types.StringType,
 			},
-			"not_all_included_result_criteria": schema.SetAttribute{
+			"not_all_included_result_criteria": schema.ListAttribute{
 				Description: "Specifies a result criteria object that should not match the associated operation result in order to match the aggregate result criteria. If one or more not-all-included result criteria objects are provided, then an operation result must not match all of them (that is, it may match zero or more of them, but it must not match all of them) in order to match the aggregate result criteria.",
 				Optional:    true,
 				Computed:    true,
-				Default:     internaltypes.EmptySetDefault(types.StringType),
+				Default:     internaltypes.EmptyListDefault(types.StringType),
 				ElementType: types.StringType,
 			},
-			"none_included_result_criteria": schema.SetAttribute{
+			"none_included_result_criteria": schema.ListAttribute{
 				Description: "Specifies a result criteria object that must not match the associated operation result in order to match the aggregate result criteria. If one or more none-included result criteria objects are provided, then an operation result must not match any of them in order to match the aggregate result criteria.",
 				Optional:    true,
 				Computed:    true,
-				Default:     internaltypes.EmptySetDefault(types.StringType),
+				Default:     internaltypes.EmptyListDefault(types.StringType),
 				ElementType: types.StringType,
 			},
 			"request_criteria": schema.StringAttribute{
@@ -1447,7 +1449,7 @@ func populateResultCriteriaUnknownValues(model *resultCriteriaResourceModel) {
 		model.NoneIncludedResponseControl, _ = types.SetValue(types.StringType, []attr.Value{})
 	}
 	if model.NotAllIncludedResultCriteria.IsUnknown() || model.NotAllIncludedResultCriteria.IsNull() {
-		model.NotAllIncludedResultCriteria, _ = types.SetValue(types.StringType, []attr.Value{})
+		model.NotAllIncludedResultCriteria, _ = types.ListValue(types.StringType, []attr.Value{})
 	}
 	if model.AnyIncludedAuthzUserGroupDN.IsUnknown() || model.AnyIncludedAuthzUserGroupDN.IsNull() {
 		model.AnyIncludedAuthzUserGroupDN, _ = types.SetValue(types.StringType, []attr.Value{})
@@ -1468,7 +1470,7 @@ func populateResultCriteriaUnknownValues(model *resultCriteriaResourceModel) {
 		model.ResultCodeValue, _ = types.SetValue(types.StringType, []attr.Value{})
 	}
 	if model.AnyIncludedResultCriteria.IsUnknown() || model.AnyIncludedResultCriteria.IsNull() {
-		model.AnyIncludedResultCriteria, _ = types.SetValue(types.StringType, []attr.Value{})
+		model.AnyIncludedResultCriteria, _ = types.ListValue(types.StringType, []attr.Value{})
 	}
 	if model.NotAllIncludedResponseControl.IsUnknown() || model.NotAllIncludedResponseControl.IsNull() {
 		model.NotAllIncludedResponseControl, _ = types.SetValue(types.StringType, []attr.Value{})
@@ -1495,7 +1497,7 @@ func populateResultCriteriaUnknownValues(model *resultCriteriaResourceModel) {
 		model.IncludedUserFilter, _ = types.SetValue(types.StringType, []attr.Value{})
 	}
 	if model.AllIncludedResultCriteria.IsUnknown() || model.AllIncludedResultCriteria.IsNull() {
-		model.AllIncludedResultCriteria, _ = types.SetValue(types.StringType, []attr.Value{})
+		model.AllIncludedResultCriteria, _ = types.ListValue(types.StringType, []attr.Value{})
 	}
 	if model.IncludedUserBaseDN.IsUnknown() || model.IncludedUserBaseDN.IsNull() {
 		model.IncludedUserBaseDN, _ = types.SetValue(types.StringType, []attr.Value{})
@@ -1507,7 +1509,7 @@ func populateResultCriteriaUnknownValues(model *resultCriteriaResourceModel) {
 		model.UsedPrivilege, _ = types.SetValue(types.StringType, []attr.Value{})
 	}
 	if model.NoneIncludedResultCriteria.IsUnknown() || model.NoneIncludedResultCriteria.IsNull() {
-		model.NoneIncludedResultCriteria, _ = types.SetValue(types.StringType, []attr.Value{})
+		model.NoneIncludedResultCriteria, _ = types.ListValue(types.StringType, []attr.Value{})
 	}
 	if model.AnyIncludedResponseControl.IsUnknown() || model.AnyIncludedResponseControl.IsNull() {
 		model.AnyIncludedResponseControl, _ = types.SetValue(types.StringType, []attr.Value{})
@@ -1670,10 +1672,10 @@ func readAggregateResultCriteriaResponse(ctx context.Context, r *client.Aggregat
 	state.Type = types.StringValue("aggregate")
 	state.Id = types.StringValue(r.Id)
 	state.Name = types.StringValue(r.Id)
-	state.AllIncludedResultCriteria = internaltypes.GetStringSet(r.AllIncludedResultCriteria)
-	state.AnyIncludedResultCriteria = internaltypes.GetStringSet(r.AnyIncludedResultCriteria)
-	state.NotAllIncludedResultCriteria = internaltypes.GetStringSet(r.NotAllIncludedResultCriteria)
-	state.NoneIncludedResultCriteria = internaltypes.GetStringSet(r.NoneIncludedResultCriteria)
+	state.AllIncludedResultCriteria = internaltypes.GetStringList(r.AllIncludedResultCriteria)
+	state.AnyIncludedResultCriteria = internaltypes.GetStringList(r.AnyIncludedResultCriteria)
+	state.NotAllIncludedResultCriteria = internaltypes.GetStringList(r.NotAllIncludedResultCriteria)
+	state.NoneIncludedResultCriteria = internaltypes.GetStringList(r.NoneIncludedResultCriteria)
 	state.Description = internaltypes.StringTypeOrNil(r.Description, internaltypes.IsEmptyString(expectedValues.Description))
 	state.Notifications, state.RequiredActions = config.ReadMessages(ctx, r.Urnpingidentityschemasconfigurationmessages20, diagnostics)
 	populateResultCriteriaUnknownValues(state)
@@ -1728,10 +1730,10 @@ func createResultCriteriaOperations(plan resultCriteriaResourceModel, state resu
 	operations.AddStringOperationIfNecessary(&ops, plan.ResponseDelayedByAssurance, state.ResponseDelayedByAssurance, "response-delayed-by-assurance")
 	operations.AddStringOperationIfNecessary(&ops, plan.AssuranceBehaviorAlteredByControl, state.AssuranceBehaviorAlteredByControl, "assurance-behavior-altered-by-control")
 	operations.AddStringOperationIfNecessary(&ops, plan.AssuranceSatisfied, state.AssuranceSatisfied, "assurance-satisfied")
-	operations.AddStringSetOperationsIfNecessary(&ops, plan.AllIncludedResultCriteria, state.AllIncludedResultCriteria, "all-included-result-criteria")
-	operations.AddStringSetOperationsIfNecessary(&ops, plan.AnyIncludedResultCriteria, state.AnyIncludedResultCriteria, "any-included-result-criteria")
-	operations.AddStringSetOperationsIfNecessary(&ops, plan.NotAllIncludedResultCriteria, state.NotAllIncludedResultCriteria, "not-all-included-result-criteria")
-	operations.AddStringSetOperationsIfNecessary(&ops, plan.NoneIncludedResultCriteria, state.NoneIncludedResultCriteria, "none-included-result-criteria")
+	operations.AddStringListOperationsIfNecessary(&ops, plan.AllIncludedResultCriteria, state.AllIncludedResultCriteria, "all-included-result-criteria")
+	operations.AddStringListOperationsIfNecessary(&ops, plan.AnyIncludedResultCriteria, state.AnyIncludedResultCriteria, "any-included-result-criteria")
+	operations.AddStringListOperationsIfNecessary(&ops, plan.NotAllIncludedResultCriteria, state.NotAllIncludedResultCriteria, "not-all-included-result-criteria")
+	operations.AddStringListOperationsIfNecessary(&ops, plan.NoneIncludedResultCriteria, state.NoneIncludedResultCriteria, "none-included-result-criteria")
 	operations.AddStringOperationIfNecessary(&ops, plan.RequestCriteria, state.RequestCriteria, "request-criteria")
 	operations.AddStringOperationIfNecessary(&ops, plan.ResultCodeCriteria, state.ResultCodeCriteria, "result-code-criteria")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.ResultCodeValue, state.ResultCodeValue, "result-code-value")
@@ -1793,7 +1795,7 @@ func (r *resultCriteriaResource) CreateSuccessfulBindResultCriteria(ctx context.
 
 	addResponse, httpResp, err := r.apiClient.ResultCriteriaAPI.AddResultCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Result Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Result Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -1830,7 +1832,7 @@ func (r *resultCriteriaResource) CreateSimpleResultCriteria(ctx context.Context,
 
 	addResponse, httpResp, err := r.apiClient.ResultCriteriaAPI.AddResultCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Result Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Result Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -1867,7 +1869,7 @@ func (r *resultCriteriaResource) CreateAggregateResultCriteria(ctx context.Conte
 
 	addResponse, httpResp, err := r.apiClient.ResultCriteriaAPI.AddResultCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Result Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Result Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -1904,7 +1906,7 @@ func (r *resultCriteriaResource) CreateReplicationAssuranceResultCriteria(ctx co
 
 	addResponse, httpResp, err := r.apiClient.ResultCriteriaAPI.AddResultCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Result Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Result Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -1942,7 +1944,7 @@ func (r *resultCriteriaResource) CreateThirdPartyResultCriteria(ctx context.Cont
 
 	addResponse, httpResp, err := r.apiClient.ResultCriteriaAPI.AddResultCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Result Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Result Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -2001,6 +2003,8 @@ func (r *resultCriteriaResource) Create(ctx context.Context, req resource.Create
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Result Criteria", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -2025,7 +2029,7 @@ func (r *defaultResultCriteriaResource) Create(ctx context.Context, req resource
 	readResponse, httpResp, err := r.apiClient.ResultCriteriaAPI.GetResultCriteria(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Result Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Result Criteria", err, httpResp)
 		return
 	}
 
@@ -2063,7 +2067,7 @@ func (r *defaultResultCriteriaResource) Create(ctx context.Context, req resource
 
 		updateResponse, httpResp, err := r.apiClient.ResultCriteriaAPI.UpdateResultCriteriaExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Result Criteria", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Result Criteria", err, httpResp)
 			return
 		}
 
@@ -2089,6 +2093,8 @@ func (r *defaultResultCriteriaResource) Create(ctx context.Context, req resource
 		if updateResponse.ThirdPartyResultCriteriaResponse != nil {
 			readThirdPartyResultCriteriaResponse(ctx, updateResponse.ThirdPartyResultCriteriaResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Result Criteria", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -2194,7 +2200,7 @@ func updateResultCriteria(ctx context.Context, req resource.UpdateRequest, resp
 
 		updateResponse, httpResp, err := apiClient.ResultCriteriaAPI.UpdateResultCriteriaExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Result Criteria", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Result Criteria", err, httpResp)
 			return
 		}
 
@@ -2220,6 +2226,8 @@ func updateResultCriteria(ctx context.Context, req resource.UpdateRequest, resp
 		if updateResponse.ThirdPartyResultCriteriaResponse != nil {
 			readThirdPartyResultCriteriaResponse(ctx, updateResponse.ThirdPartyResultCriteriaResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Result Criteria", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -2267,3 +2275,16 @@ func importResultCriteria(ctx context.Context, req resource.ImportStateRequest,
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *resultCriteriaResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_result_criteria"),
+	}
+}
+
+func (r *defaultResultCriteriaResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_result_criteria"),
+	}
+}
@@ -129,7 +129,7 @@ func (r *rootDnResource) Create(ctx context.Context, req resource.CreateRequest,
 	readResponse, httpResp, err := r.apiClient.RootDnAPI.GetRootDn(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig)).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Root Dn", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Root Dn", err, httpResp)
 		return
 	}
 
@@ -153,7 +153,7 @@ func (r *rootDnResource) Create(ctx context.Context, req resource.CreateRequest,
 
 		updateResponse, httpResp, err := r.apiClient.RootDnAPI.UpdateRootDnExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Root Dn", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Root Dn", err, httpResp)
 			return
 		}
 
@@ -165,6 +165,8 @@ func (r *rootDnResource) Create(ctx context.Context, req resource.CreateRequest,
 
 		// Read the response
 		readRootDnResponse(ctx, updateResponse, &state, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Root Dn", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -230,7 +232,7 @@ func (r *rootDnResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 		updateResponse, httpResp, err := r.apiClient.RootDnAPI.UpdateRootDnExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Root Dn", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Root Dn", err, httpResp)
 			return
 		}
 
@@ -242,6 +244,8 @@ func (r *rootDnResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 		// Read the response
 		readRootDnResponse(ctx, updateResponse, &state, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Root Dn", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
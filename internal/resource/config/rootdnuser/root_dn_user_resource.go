@@ -29,9 +29,11 @@ var (
 	_ resource.Resource                = &rootDnUserResource{}
 	_ resource.ResourceWithConfigure   = &rootDnUserResource{}
 	_ resource.ResourceWithImportState = &rootDnUserResource{}
+	_ resource.ResourceWithMoveState   = &rootDnUserResource{}
 	_ resource.Resource                = &defaultRootDnUserResource{}
 	_ resource.ResourceWithConfigure   = &defaultRootDnUserResource{}
 	_ resource.ResourceWithImportState = &defaultRootDnUserResource{}
+	_ resource.ResourceWithMoveState   = &defaultRootDnUserResource{}
 )
 
 // Create a Root Dn User resource
@@ -94,6 +96,8 @@ type rootDnUserResourceModel struct {
 	AlternateBindDN                types.Set    `tfsdk:"alternate_bind_dn"`
 	Description                    types.String `tfsdk:"description"`
 	Password                       types.String `tfsdk:"password"`
+	PasswordWo                     types.String `tfsdk:"password_wo"`
+	PasswordWoVersion              types.Int64  `tfsdk:"password_wo_version"`
 	FirstName                      types.Set    `tfsdk:"first_name"`
 	LastName                       types.Set    `tfsdk:"last_name"`
 	UserID                         types.String `tfsdk:"user_id"`
@@ -160,7 +164,7 @@ func rootDnUserSchema(ctx context.Context, req resource.SchemaRequest, resp *res
 				Optional:    true,
 			},
 			"password": schema.StringAttribute{
-				Description: "Specifies the user's password. This is stored in the userPassword LDAP attribute. To set a pre-hashed value, the account making the change must have the bypass-pw-policy privilege.",
+				Description: "Specifies the user's password. This is stored in the userPassword LDAP attribute. To set a pre-hashed value, the account making the change must have the bypass-pw-policy privilege. The provider detects password changes made outside of Terraform by comparing the encoded password on the server, so the server encoding the same password again, such as after a storage scheme change, is also reported as a change and the configured password is applied again.",
 				Optional:    true,
 				Sensitive:   true,
 			},
@@ -360,6 +364,7 @@ func rootDnUserSchema(ctx context.Context, req resource.SchemaRequest, resp *res
 			},
 		},
 	}
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "password")
 	if isDefault {
 		typeAttr := schemaDef.Attributes["type"].(schema.StringAttribute)
 		typeAttr.Optional = false
@@ -391,6 +396,10 @@ func addOptionalRootDnUserFields(ctx context.Context, addRequest *client.AddRoot
 	if internaltypes.IsNonEmptyString(plan.Password) {
 		addRequest.Password = plan.Password.ValueStringPointer()
 	}
+	// Write-only values are read from the config, since they are not included in the plan
+	if internaltypes.IsNonEmptyString(plan.PasswordWo) {
+		addRequest.Password = plan.PasswordWo.ValueStringPointer()
+	}
 	if internaltypes.IsDefined(plan.FirstName) {
 		var slice []string
 		plan.FirstName.ElementsAs(ctx, &slice, false)
@@ -608,6 +617,9 @@ func (state *rootDnUserResourceModel) setStateValuesNotReturnedByAPI(expectedVal
 	if !expectedValues.Password.IsUnknown() {
 		state.Password = expectedValues.Password
 	}
+	if !expectedValues.PasswordWoVersion.IsUnknown() {
+		state.PasswordWoVersion = expectedValues.PasswordWoVersion
+	}
 }
 
 // Create any update operations necessary to make the state match the plan
@@ -616,6 +628,7 @@ func createRootDnUserOperations(plan rootDnUserResourceModel, state rootDnUserRe
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.AlternateBindDN, state.AlternateBindDN, "alternate-bind-dn")
 	operations.AddStringOperationIfNecessary(&ops, plan.Description, state.Description, "description")
 	operations.AddStringOperationIfNecessary(&ops, plan.Password, state.Password, "password")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.PasswordWo, plan.PasswordWoVersion, state.PasswordWoVersion, "password")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.FirstName, state.FirstName, "first-name")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.LastName, state.LastName, "last-name")
 	operations.AddStringOperationIfNecessary(&ops, plan.UserID, state.UserID, "user-id")
@@ -668,7 +681,7 @@ func (r *rootDnUserResource) CreateRootDnUser(ctx context.Context, req resource.
 
 	addResponse, httpResp, err := r.apiClient.RootDnUserAPI.AddRootDnUserExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Root Dn User", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Root Dn User", err, httpResp)
 		return nil, err
 	}
 
@@ -678,6 +691,11 @@ func (r *rootDnUserResource) CreateRootDnUser(ctx context.Context, req resource.
 		tflog.Debug(ctx, "Add response: "+string(responseJson))
 	}
 
+	// Record the applied secret so that changes made outside of Terraform can be detected
+	if addRequest.Password != nil {
+		config.RecordSecretFingerprint(ctx, resp.Private, "password", *addRequest.Password, config.EncodedPasswordEvidence(addResponse.Password), &resp.Diagnostics)
+	}
+
 	// Read the response into the state
 	var state rootDnUserResourceModel
 	readRootDnUserResponse(ctx, addResponse, &state, &plan, &resp.Diagnostics)
@@ -693,6 +711,12 @@ func (r *rootDnUserResource) Create(ctx context.Context, req resource.CreateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	diags = req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	state, err := r.CreateRootDnUser(ctx, req, resp, plan)
 	if err != nil {
@@ -701,6 +725,8 @@ func (r *rootDnUserResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Root Dn User", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -721,11 +747,17 @@ func (r *defaultRootDnUserResource) Create(ctx context.Context, req resource.Cre
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	diags = req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	readResponse, httpResp, err := r.apiClient.RootDnUserAPI.GetRootDnUser(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Root Dn User", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Root Dn User", err, httpResp)
 		return
 	}
 
@@ -749,7 +781,7 @@ func (r *defaultRootDnUserResource) Create(ctx context.Context, req resource.Cre
 
 		updateResponse, httpResp, err := r.apiClient.RootDnUserAPI.UpdateRootDnUserExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Root Dn User", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Root Dn User", err, httpResp)
 			return
 		}
 
@@ -761,6 +793,12 @@ func (r *defaultRootDnUserResource) Create(ctx context.Context, req resource.Cre
 
 		// Read the response
 		readRootDnUserResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+		// Record the applied secret so that changes made outside of Terraform can be detected
+		if password, ok := operations.GetReplacedValue(ops, "password"); ok {
+			config.RecordSecretFingerprint(ctx, resp.Private, "password", password, config.EncodedPasswordEvidence(updateResponse.Password), &resp.Diagnostics)
+		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Root Dn User", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -811,6 +849,12 @@ func readRootDnUser(ctx context.Context, req resource.ReadRequest, resp *resourc
 	// Read the response into the state
 	readRootDnUserResponse(ctx, readResponse, &state, &state, &resp.Diagnostics)
 
+	// Plan to apply the secret again if it was changed outside of Terraform
+	if config.SecretChangedOutsideTerraform(ctx, req.Private, "password", config.EncodedPasswordEvidence(readResponse.Password), &resp.Diagnostics) {
+		state.Password = types.StringNull()
+		state.PasswordWoVersion = types.Int64Null()
+	}
+
 	if isDefault {
 		state.populateAllComputedStringAttributes()
 	}
@@ -837,6 +881,12 @@ func updateRootDnUser(ctx context.Context, req resource.UpdateRequest, resp *res
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	diags = req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Get the current state to see how any attributes are changing
 	var state rootDnUserResourceModel
@@ -853,7 +903,7 @@ func updateRootDnUser(ctx context.Context, req resource.UpdateRequest, resp *res
 
 		updateResponse, httpResp, err := apiClient.RootDnUserAPI.UpdateRootDnUserExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Root Dn User", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Root Dn User", err, httpResp)
 			return
 		}
 
@@ -865,6 +915,12 @@ func updateRootDnUser(ctx context.Context, req resource.UpdateRequest, resp *res
 
 		// Read the response
 		readRootDnUserResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+		// Record the applied secret so that changes made outside of Terraform can be detected
+		if password, ok := operations.GetReplacedValue(ops, "password"); ok {
+			config.RecordSecretFingerprint(ctx, resp.Private, "password", password, config.EncodedPasswordEvidence(updateResponse.Password), &resp.Diagnostics)
+		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Root Dn User", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -913,3 +969,16 @@ func importRootDnUser(ctx context.Context, req resource.ImportStateRequest, resp
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *rootDnUserResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_root_dn_user"),
+	}
+}
+
+func (r *defaultRootDnUserResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_root_dn_user"),
+	}
+}
@@ -163,7 +163,7 @@ func (r *rootDseBackendResource) Create(ctx context.Context, req resource.Create
 	readResponse, httpResp, err := r.apiClient.RootDseBackendAPI.GetRootDseBackend(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig)).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Root Dse Backend", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Root Dse Backend", err, httpResp)
 		return
 	}
 
@@ -187,7 +187,7 @@ func (r *rootDseBackendResource) Create(ctx context.Context, req resource.Create
 
 		updateResponse, httpResp, err := r.apiClient.RootDseBackendAPI.UpdateRootDseBackendExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Root Dse Backend", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Root Dse Backend", err, httpResp)
 			return
 		}
 
@@ -199,6 +199,8 @@ func (r *rootDseBackendResource) Create(ctx context.Context, req resource.Create
 
 		// Read the response
 		readRootDseBackendResponse(ctx, updateResponse, &state, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Root Dse Backend", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -264,7 +266,7 @@ func (r *rootDseBackendResource) Update(ctx context.Context, req resource.Update
 
 		updateResponse, httpResp, err := r.apiClient.RootDseBackendAPI.UpdateRootDseBackendExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Root Dse Backend", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Root Dse Backend", err, httpResp)
 			return
 		}
 
@@ -276,6 +278,8 @@ func (r *rootDseBackendResource) Update(ctx context.Context, req resource.Update
 
 		// Read the response
 		readRootDseBackendResponse(ctx, updateResponse, &state, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Root Dse Backend", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -30,9 +30,11 @@ var (
 	_ resource.Resource                = &saslMechanismHandlerResource{}
 	_ resource.ResourceWithConfigure   = &saslMechanismHandlerResource{}
 	_ resource.ResourceWithImportState = &saslMechanismHandlerResource{}
+	_ resource.ResourceWithMoveState   = &saslMechanismHandlerResource{}
 	_ resource.Resource                = &defaultSaslMechanismHandlerResource{}
 	_ resource.ResourceWithConfigure   = &defaultSaslMechanismHandlerResource{}
 	_ resource.ResourceWithImportState = &defaultSaslMechanismHandlerResource{}
+	_ resource.ResourceWithMoveState   = &defaultSaslMechanismHandlerResource{}
 )
 
 // Create a Sasl Mechanism Handler resource
@@ -139,6 +141,8 @@ type defaultSaslMechanismHandlerResourceModel struct {
 	CertificateMapper                            types.String `tfsdk:"certificate_mapper"`
 	YubikeyClientID                              types.String `tfsdk:"yubikey_client_id"`
 	YubikeyAPIKey                                types.String `tfsdk:"yubikey_api_key"`
+	YubikeyAPIKeyWo                              types.String `tfsdk:"yubikey_api_key_wo"`
+	YubikeyAPIKeyWoVersion                       types.Int64  `tfsdk:"yubikey_api_key_wo_version"`
 	YubikeyAPIKeyPassphraseProvider              types.String `tfsdk:"yubikey_api_key_passphrase_provider"`
 	YubikeyValidationServerBaseURL               types.Set    `tfsdk:"yubikey_validation_server_base_url"`
 	HttpProxyExternalServer                      types.String `tfsdk:"http_proxy_external_server"`
@@ -374,6 +378,9 @@ func saslMechanismHandlerSchema(ctx context.Context, req resource.SchemaRequest,
 		extensionClassAttr.PlanModifiers = append(extensionClassAttr.PlanModifiers, stringplanmodifier.RequiresReplace())
 		schemaDef.Attributes["extension_class"] = extensionClassAttr
 	}
+	if isDefault {
+		config.AddWriteOnlyAttributeSchema(&schemaDef, "yubikey_api_key")
+	}
 	config.AddCommonResourceSchema(&schemaDef, true)
 	resp.Schema = schemaDef
 }
@@ -594,6 +601,11 @@ func (r defaultSaslMechanismHandlerResource) ConfigValidators(ctx context.Contex
 			path.MatchRoot("type"),
 			[]string{"unboundid-yubikey-otp"},
 		),
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("yubikey_api_key_wo"),
+			path.MatchRoot("type"),
+			[]string{"unboundid-yubikey-otp"},
+		),
 		configvalidators.ImpliesOtherAttributeOneOfString(
 			path.MatchRoot("yubikey_api_key_passphrase_provider"),
 			path.MatchRoot("type"),
@@ -1109,6 +1121,9 @@ func (state *defaultSaslMechanismHandlerResourceModel) setStateValuesNotReturned
 	if !expectedValues.YubikeyAPIKey.IsUnknown() {
 		state.YubikeyAPIKey = expectedValues.YubikeyAPIKey
 	}
+	if !expectedValues.YubikeyAPIKeyWoVersion.IsUnknown() {
+		state.YubikeyAPIKeyWoVersion = expectedValues.YubikeyAPIKeyWoVersion
+	}
 }
 
 // Create any update operations necessary to make the state match the plan
@@ -1159,6 +1174,7 @@ func createSaslMechanismHandlerOperationsDefault(plan defaultSaslMechanismHandle
 	operations.AddStringOperationIfNecessary(&ops, plan.CertificateMapper, state.CertificateMapper, "certificate-mapper")
 	operations.AddStringOperationIfNecessary(&ops, plan.YubikeyClientID, state.YubikeyClientID, "yubikey-client-id")
 	operations.AddStringOperationIfNecessary(&ops, plan.YubikeyAPIKey, state.YubikeyAPIKey, "yubikey-api-key")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.YubikeyAPIKeyWo, plan.YubikeyAPIKeyWoVersion, state.YubikeyAPIKeyWoVersion, "yubikey-api-key")
 	operations.AddStringOperationIfNecessary(&ops, plan.YubikeyAPIKeyPassphraseProvider, state.YubikeyAPIKeyPassphraseProvider, "yubikey-api-key-passphrase-provider")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.YubikeyValidationServerBaseURL, state.YubikeyValidationServerBaseURL, "yubikey-validation-server-base-url")
 	operations.AddStringOperationIfNecessary(&ops, plan.HttpProxyExternalServer, state.HttpProxyExternalServer, "http-proxy-external-server")
@@ -1200,7 +1216,7 @@ func (r *saslMechanismHandlerResource) CreateUnboundidMsChapV2SaslMechanismHandl
 
 	addResponse, httpResp, err := r.apiClient.SaslMechanismHandlerAPI.AddSaslMechanismHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Sasl Mechanism Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Sasl Mechanism Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1239,7 +1255,7 @@ func (r *saslMechanismHandlerResource) CreateUnboundidDeliveredOtpSaslMechanismH
 
 	addResponse, httpResp, err := r.apiClient.SaslMechanismHandlerAPI.AddSaslMechanismHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Sasl Mechanism Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Sasl Mechanism Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1277,7 +1293,7 @@ func (r *saslMechanismHandlerResource) CreateOauthBearerSaslMechanismHandler(ctx
 
 	addResponse, httpResp, err := r.apiClient.SaslMechanismHandlerAPI.AddSaslMechanismHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Sasl Mechanism Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Sasl Mechanism Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1316,7 +1332,7 @@ func (r *saslMechanismHandlerResource) CreateThirdPartySaslMechanismHandler(ctx
 
 	addResponse, httpResp, err := r.apiClient.SaslMechanismHandlerAPI.AddSaslMechanismHandlerExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Sasl Mechanism Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Sasl Mechanism Handler", err, httpResp)
 		return nil, err
 	}
 
@@ -1369,6 +1385,8 @@ func (r *saslMechanismHandlerResource) Create(ctx context.Context, req resource.
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Sasl Mechanism Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -1389,11 +1407,16 @@ func (r *defaultSaslMechanismHandlerResource) Create(ctx context.Context, req re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("yubikey_api_key_wo"), &plan.YubikeyAPIKeyWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	readResponse, httpResp, err := r.apiClient.SaslMechanismHandlerAPI.GetSaslMechanismHandler(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Sasl Mechanism Handler", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Sasl Mechanism Handler", err, httpResp)
 		return
 	}
 
@@ -1458,7 +1481,7 @@ func (r *defaultSaslMechanismHandlerResource) Create(ctx context.Context, req re
 
 		updateResponse, httpResp, err := r.apiClient.SaslMechanismHandlerAPI.UpdateSaslMechanismHandlerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Sasl Mechanism Handler", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Sasl Mechanism Handler", err, httpResp)
 			return
 		}
 
@@ -1511,6 +1534,8 @@ func (r *defaultSaslMechanismHandlerResource) Create(ctx context.Context, req re
 		if updateResponse.ThirdPartySaslMechanismHandlerResponse != nil {
 			readThirdPartySaslMechanismHandlerResponseDefault(ctx, updateResponse.ThirdPartySaslMechanismHandlerResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Sasl Mechanism Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -1652,7 +1677,7 @@ func (r *saslMechanismHandlerResource) Update(ctx context.Context, req resource.
 
 		updateResponse, httpResp, err := r.apiClient.SaslMechanismHandlerAPI.UpdateSaslMechanismHandlerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Sasl Mechanism Handler", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Sasl Mechanism Handler", err, httpResp)
 			return
 		}
 
@@ -1675,6 +1700,8 @@ func (r *saslMechanismHandlerResource) Update(ctx context.Context, req resource.
 		if updateResponse.ThirdPartySaslMechanismHandlerResponse != nil {
 			readThirdPartySaslMechanismHandlerResponse(ctx, updateResponse.ThirdPartySaslMechanismHandlerResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Sasl Mechanism Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -1694,6 +1721,11 @@ func (r *defaultSaslMechanismHandlerResource) Update(ctx context.Context, req re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("yubikey_api_key_wo"), &plan.YubikeyAPIKeyWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Get the current state to see how any attributes are changing
 	var state defaultSaslMechanismHandlerResourceModel
@@ -1710,7 +1742,7 @@ func (r *defaultSaslMechanismHandlerResource) Update(ctx context.Context, req re
 
 		updateResponse, httpResp, err := r.apiClient.SaslMechanismHandlerAPI.UpdateSaslMechanismHandlerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Sasl Mechanism Handler", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Sasl Mechanism Handler", err, httpResp)
 			return
 		}
 
@@ -1763,6 +1795,8 @@ func (r *defaultSaslMechanismHandlerResource) Update(ctx context.Context, req re
 		if updateResponse.ThirdPartySaslMechanismHandlerResponse != nil {
 			readThirdPartySaslMechanismHandlerResponseDefault(ctx, updateResponse.ThirdPartySaslMechanismHandlerResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Sasl Mechanism Handler", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -1810,3 +1844,16 @@ func importSaslMechanismHandler(ctx context.Context, req resource.ImportStateReq
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *saslMechanismHandlerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_sasl_mechanism_handler"),
+	}
+}
+
+func (r *defaultSaslMechanismHandlerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_sasl_mechanism_handler"),
+	}
+}
@@ -49,29 +49,21 @@ func (r *saslMechanismHandlersDataSource) Configure(_ context.Context, req datas
 }
 
 type saslMechanismHandlersDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *saslMechanismHandlersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Sasl Mechanism Handler objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Sasl Mechanism Handler objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewSaslMechanismHandlerDataSource(), "Sasl Mechanism Handler")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *saslMechanismHandlersDataSource) Read(ctx context.Context, req datasour
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewSaslMechanismHandlerDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.SaslMechanismHandlerAPI.ListSaslMechanismHandlers(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.SaslMechanismHandlerAPI.ListSaslMechanismHandlersExecute(listRequest)
@@ -106,81 +104,70 @@ func (r *saslMechanismHandlersDataSource) Read(ctx context.Context, req datasour
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object saslMechanismHandlerDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.UnboundidMsChapV2SaslMechanismHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.UnboundidMsChapV2SaslMechanismHandlerResponse.Id)
-			attributes["type"] = types.StringValue("unboundid-ms-chap-v2")
+			readUnboundidMsChapV2SaslMechanismHandlerResponseDataSource(ctx, response.UnboundidMsChapV2SaslMechanismHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.UnboundidTotpSaslMechanismHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.UnboundidTotpSaslMechanismHandlerResponse.Id)
-			attributes["type"] = types.StringValue("unboundid-totp")
+			readUnboundidTotpSaslMechanismHandlerResponseDataSource(ctx, response.UnboundidTotpSaslMechanismHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.UnboundidInterServerSaslMechanismHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.UnboundidInterServerSaslMechanismHandlerResponse.Id)
-			attributes["type"] = types.StringValue("unboundid-inter-server")
+			object.Id = types.StringValue(response.UnboundidInterServerSaslMechanismHandlerResponse.Id)
+			object.Type = types.StringValue("unboundid-inter-server")
 		}
 		if response.PingIdentityInterServerSaslMechanismHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.PingIdentityInterServerSaslMechanismHandlerResponse.Id)
-			attributes["type"] = types.StringValue("ping-identity-inter-server")
+			object.Id = types.StringValue(response.PingIdentityInterServerSaslMechanismHandlerResponse.Id)
+			object.Type = types.StringValue("ping-identity-inter-server")
 		}
 		if response.UnboundidYubikeyOtpSaslMechanismHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.UnboundidYubikeyOtpSaslMechanismHandlerResponse.Id)
-			attributes["type"] = types.StringValue("unboundid-yubikey-otp")
+			readUnboundidYubikeyOtpSaslMechanismHandlerResponseDataSource(ctx, response.UnboundidYubikeyOtpSaslMechanismHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.ExternalSaslMechanismHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.ExternalSaslMechanismHandlerResponse.Id)
-			attributes["type"] = types.StringValue("external")
+			readExternalSaslMechanismHandlerResponseDataSource(ctx, response.ExternalSaslMechanismHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.DigestMd5SaslMechanismHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.DigestMd5SaslMechanismHandlerResponse.Id)
-			attributes["type"] = types.StringValue("digest-md5")
+			readDigestMd5SaslMechanismHandlerResponseDataSource(ctx, response.DigestMd5SaslMechanismHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.PlainSaslMechanismHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.PlainSaslMechanismHandlerResponse.Id)
-			attributes["type"] = types.StringValue("plain")
+			readPlainSaslMechanismHandlerResponseDataSource(ctx, response.PlainSaslMechanismHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.UnboundidDeliveredOtpSaslMechanismHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.UnboundidDeliveredOtpSaslMechanismHandlerResponse.Id)
-			attributes["type"] = types.StringValue("unboundid-delivered-otp")
+			readUnboundidDeliveredOtpSaslMechanismHandlerResponseDataSource(ctx, response.UnboundidDeliveredOtpSaslMechanismHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.UnboundidExternalAuthSaslMechanismHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.UnboundidExternalAuthSaslMechanismHandlerResponse.Id)
-			attributes["type"] = types.StringValue("unboundid-external-auth")
+			readUnboundidExternalAuthSaslMechanismHandlerResponseDataSource(ctx, response.UnboundidExternalAuthSaslMechanismHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.AnonymousSaslMechanismHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.AnonymousSaslMechanismHandlerResponse.Id)
-			attributes["type"] = types.StringValue("anonymous")
+			readAnonymousSaslMechanismHandlerResponseDataSource(ctx, response.AnonymousSaslMechanismHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.CramMd5SaslMechanismHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.CramMd5SaslMechanismHandlerResponse.Id)
-			attributes["type"] = types.StringValue("cram-md5")
+			readCramMd5SaslMechanismHandlerResponseDataSource(ctx, response.CramMd5SaslMechanismHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.OauthBearerSaslMechanismHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.OauthBearerSaslMechanismHandlerResponse.Id)
-			attributes["type"] = types.StringValue("oauth-bearer")
+			readOauthBearerSaslMechanismHandlerResponseDataSource(ctx, response.OauthBearerSaslMechanismHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.UnboundidCertificatePlusPasswordSaslMechanismHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.UnboundidCertificatePlusPasswordSaslMechanismHandlerResponse.Id)
-			attributes["type"] = types.StringValue("unboundid-certificate-plus-password")
+			readUnboundidCertificatePlusPasswordSaslMechanismHandlerResponseDataSource(ctx, response.UnboundidCertificatePlusPasswordSaslMechanismHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.GssapiSaslMechanismHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.GssapiSaslMechanismHandlerResponse.Id)
-			attributes["type"] = types.StringValue("gssapi")
+			readGssapiSaslMechanismHandlerResponseDataSource(ctx, response.GssapiSaslMechanismHandlerResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartySaslMechanismHandlerResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartySaslMechanismHandlerResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartySaslMechanismHandlerResponseDataSource(ctx, response.ThirdPartySaslMechanismHandlerResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
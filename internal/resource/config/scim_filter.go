@@ -0,0 +1,225 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	scimAttrPathRegex = regexp.MustCompile(`^(?:urn:[A-Za-z0-9.:_-]+:)?[A-Za-z][A-Za-z0-9_-]*(?:\.[A-Za-z][A-Za-z0-9_-]*)?`)
+	scimNumberRegex   = regexp.MustCompile(`^-?(?:0|[1-9][0-9]*)(?:\.[0-9]+)?(?:[eE][+-]?[0-9]+)?`)
+)
+
+// SCIM comparison operators that take a value
+var scimComparisonOperators = []string{"eq", "ne", "co", "sw", "ew", "gt", "lt", "ge", "le"}
+
+// Parser for SCIM filters, as described in section 3.4.2.2 of RFC 7644. The parser only checks the syntax of the filter.
+type scimFilterParser struct {
+	filter string
+	pos    int
+}
+
+// Check that a SCIM filter is syntactically valid
+func ParseScimFilter(filter string) error {
+	p := &scimFilterParser{filter: filter}
+	if err := p.parseOr(false); err != nil {
+		return err
+	}
+	p.skipSpace()
+	if p.pos < len(p.filter) {
+		return p.errorf("unexpected %q", p.remaining(10))
+	}
+	return nil
+}
+
+func (p *scimFilterParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("invalid SCIM filter at position %d: %s", p.pos+1, fmt.Sprintf(format, args...))
+}
+
+func (p *scimFilterParser) remaining(max int) string {
+	rest := p.filter[p.pos:]
+	if len(rest) > max {
+		rest = rest[:max] + "..."
+	}
+	return rest
+}
+
+func (p *scimFilterParser) skipSpace() {
+	for p.pos < len(p.filter) && p.filter[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+// Consume the given keyword if it is next in the filter, case-insensitively and followed by a delimiter
+func (p *scimFilterParser) acceptKeyword(keyword string) bool {
+	p.skipSpace()
+	end := p.pos + len(keyword)
+	if end > len(p.filter) || !strings.EqualFold(p.filter[p.pos:end], keyword) {
+		return false
+	}
+	if end < len(p.filter) && strings.IndexByte(" ()[]", p.filter[end]) < 0 {
+		return false
+	}
+	p.pos = end
+	return true
+}
+
+func (p *scimFilterParser) accept(c byte) bool {
+	p.skipSpace()
+	if p.pos < len(p.filter) && p.filter[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// Parse expressions joined by "or". The inValuePath flag disallows nested value paths.
+func (p *scimFilterParser) parseOr(inValuePath bool) error {
+	if err := p.parseAnd(inValuePath); err != nil {
+		return err
+	}
+	for p.acceptKeyword("or") {
+		if err := p.parseAnd(inValuePath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Parse expressions joined by "and", which binds more tightly than "or"
+func (p *scimFilterParser) parseAnd(inValuePath bool) error {
+	if err := p.parseExpression(inValuePath); err != nil {
+		return err
+	}
+	for p.acceptKeyword("and") {
+		if err := p.parseExpression(inValuePath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Parse a negation, a grouped filter, a value path, or an attribute expression
+func (p *scimFilterParser) parseExpression(inValuePath bool) error {
+	p.skipSpace()
+	if p.pos >= len(p.filter) {
+		return p.errorf("unexpected end of filter")
+	}
+	if p.acceptKeyword("not") {
+		if !p.accept('(') {
+			return p.errorf("expected \"(\" after \"not\"")
+		}
+		return p.parseGroupRemainder(inValuePath)
+	}
+	if p.accept('(') {
+		return p.parseGroupRemainder(inValuePath)
+	}
+
+	attrPath := scimAttrPathRegex.FindString(p.filter[p.pos:])
+	if attrPath == "" {
+		return p.errorf("expected an attribute name, found %q", p.remaining(10))
+	}
+	p.pos += len(attrPath)
+
+	if p.accept('[') {
+		if inValuePath {
+			return p.errorf("value paths can't be nested")
+		}
+		if err := p.parseOr(true); err != nil {
+			return err
+		}
+		if !p.accept(']') {
+			return p.errorf("expected \"]\" to close the value path for %q", attrPath)
+		}
+		return nil
+	}
+
+	if p.acceptKeyword("pr") {
+		return nil
+	}
+	for _, operator := range scimComparisonOperators {
+		if p.acceptKeyword(operator) {
+			return p.parseValue()
+		}
+	}
+	return p.errorf("expected an operator after %q, found %q", attrPath, p.remaining(10))
+}
+
+// Parse the rest of a parenthesized filter, after the opening parenthesis
+func (p *scimFilterParser) parseGroupRemainder(inValuePath bool) error {
+	if err := p.parseOr(inValuePath); err != nil {
+		return err
+	}
+	if !p.accept(')') {
+		return p.errorf("expected \")\"")
+	}
+	return nil
+}
+
+// Parse a comparison value: a JSON string, a number, true, false, or null
+func (p *scimFilterParser) parseValue() error {
+	p.skipSpace()
+	if p.pos >= len(p.filter) {
+		return p.errorf("expected a value")
+	}
+	if p.filter[p.pos] == '"' {
+		end := p.pos + 1
+		for end < len(p.filter) && p.filter[end] != '"' {
+			if p.filter[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		if end >= len(p.filter) {
+			return p.errorf("unterminated string")
+		}
+		var value string
+		if err := json.Unmarshal([]byte(p.filter[p.pos:end+1]), &value); err != nil {
+			return p.errorf("invalid string %s", p.filter[p.pos:end+1])
+		}
+		p.pos = end + 1
+		return nil
+	}
+	for _, keyword := range []string{"true", "false", "null"} {
+		if p.acceptKeyword(keyword) {
+			return nil
+		}
+	}
+	if number := scimNumberRegex.FindString(p.filter[p.pos:]); number != "" {
+		p.pos += len(number)
+		return nil
+	}
+	return p.errorf("expected a value, found %q. String values must be enclosed in double quotes", p.remaining(10))
+}
+
+// Validator that checks SCIM filter syntax at plan time
+type scimFilterValidator struct{}
+
+func (v scimFilterValidator) Description(_ context.Context) string {
+	return "value must be a valid SCIM filter"
+}
+
+func (v scimFilterValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v scimFilterValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := ParseScimFilter(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid SCIM filter", err.Error())
+	}
+}
+
+// Get a validator that checks SCIM filter syntax
+func ScimFilterValidator() validator.String {
+	return scimFilterValidator{}
+}
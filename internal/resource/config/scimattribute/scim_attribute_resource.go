@@ -29,9 +29,11 @@ var (
 	_ resource.Resource                = &scimAttributeResource{}
 	_ resource.ResourceWithConfigure   = &scimAttributeResource{}
 	_ resource.ResourceWithImportState = &scimAttributeResource{}
+	_ resource.ResourceWithMoveState   = &scimAttributeResource{}
 	_ resource.Resource                = &defaultScimAttributeResource{}
 	_ resource.ResourceWithConfigure   = &defaultScimAttributeResource{}
 	_ resource.ResourceWithImportState = &defaultScimAttributeResource{}
+	_ resource.ResourceWithMoveState   = &defaultScimAttributeResource{}
 )
 
 // Create a Scim Attribute resource
@@ -357,7 +359,7 @@ func (r *scimAttributeResource) CreateScimAttribute(ctx context.Context, req res
 
 	addResponse, httpResp, err := r.apiClient.ScimAttributeAPI.AddScimAttributeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Scim Attribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Scim Attribute", err, httpResp)
 		return nil, err
 	}
 
@@ -390,6 +392,8 @@ func (r *scimAttributeResource) Create(ctx context.Context, req resource.CreateR
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Scim Attribute", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -414,7 +418,7 @@ func (r *defaultScimAttributeResource) Create(ctx context.Context, req resource.
 	readResponse, httpResp, err := r.apiClient.ScimAttributeAPI.GetScimAttribute(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString(), plan.ScimSchemaName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Scim Attribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Scim Attribute", err, httpResp)
 		return
 	}
 
@@ -438,7 +442,7 @@ func (r *defaultScimAttributeResource) Create(ctx context.Context, req resource.
 
 		updateResponse, httpResp, err := r.apiClient.ScimAttributeAPI.UpdateScimAttributeExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Scim Attribute", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Scim Attribute", err, httpResp)
 			return
 		}
 
@@ -450,6 +454,8 @@ func (r *defaultScimAttributeResource) Create(ctx context.Context, req resource.
 
 		// Read the response
 		readScimAttributeResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Scim Attribute", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -542,7 +548,7 @@ func updateScimAttribute(ctx context.Context, req resource.UpdateRequest, resp *
 
 		updateResponse, httpResp, err := apiClient.ScimAttributeAPI.UpdateScimAttributeExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Scim Attribute", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Scim Attribute", err, httpResp)
 			return
 		}
 
@@ -554,6 +560,8 @@ func updateScimAttribute(ctx context.Context, req resource.UpdateRequest, resp *
 
 		// Read the response
 		readScimAttributeResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Scim Attribute", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -608,3 +616,16 @@ func importScimAttribute(ctx context.Context, req resource.ImportStateRequest, r
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scim_schema_name"), split[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), split[1])...)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *scimAttributeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_scim_attribute"),
+	}
+}
+
+func (r *defaultScimAttributeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_scim_attribute"),
+	}
+}
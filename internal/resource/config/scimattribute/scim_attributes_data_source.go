@@ -49,10 +49,13 @@ func (r *scimAttributesDataSource) Configure(_ context.Context, req datasource.C
 }
 
 type scimAttributesDataSourceModel struct {
-	Id             types.String `tfsdk:"id"`
-	Filter         types.String `tfsdk:"filter"`
-	Ids            types.Set    `tfsdk:"ids"`
-	ScimSchemaName types.String `tfsdk:"scim_schema_name"`
+	Id                types.String `tfsdk:"id"`
+	Ids               types.Set    `tfsdk:"ids"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	ScimSchemaName    types.String `tfsdk:"scim_schema_name"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
@@ -64,10 +67,6 @@ func (r *scimAttributesDataSource) Schema(ctx context.Context, req datasource.Sc
 				Description: "Name of the parent SCIM Schema",
 				Required:    true,
 			},
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
 			"ids": schema.SetAttribute{
 				Description: "Scim Attribute IDs found in the configuration",
 				Required:    false,
@@ -77,6 +76,8 @@ func (r *scimAttributesDataSource) Schema(ctx context.Context, req datasource.Sc
 			},
 		},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewScimAttributeDataSource(), "Scim Attribute")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -91,9 +92,15 @@ func (r *scimAttributesDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewScimAttributeDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.ScimAttributeAPI.ListScimAttributes(config.ProviderBasicAuthContext(ctx, r.providerConfig), state.ScimSchemaName.ValueString())
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.ScimAttributeAPI.ListScimAttributesExecute(listRequest)
@@ -110,12 +117,24 @@ func (r *scimAttributesDataSource) Read(ctx context.Context, req datasource.Read
 
 	// Read the response into the state
 	ids := []attr.Value{}
+	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
+		var object scimAttributeDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
+		readScimAttributeResponseDataSource(ctx, &response, &object, &resp.Diagnostics)
+		object.ScimSchemaName = state.ScimSchemaName
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
+		}
 		ids = append(ids, types.StringValue(response.Id))
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 
 	state.Ids, diags = types.SetValue(types.StringType, ids)
 	resp.Diagnostics.Append(diags...)
+	state.Objects, diags = types.SetValue(objectType, objects)
+	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
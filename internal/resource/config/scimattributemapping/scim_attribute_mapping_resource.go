@@ -29,9 +29,11 @@ var (
 	_ resource.Resource                = &scimAttributeMappingResource{}
 	_ resource.ResourceWithConfigure   = &scimAttributeMappingResource{}
 	_ resource.ResourceWithImportState = &scimAttributeMappingResource{}
+	_ resource.ResourceWithMoveState   = &scimAttributeMappingResource{}
 	_ resource.Resource                = &defaultScimAttributeMappingResource{}
 	_ resource.ResourceWithConfigure   = &defaultScimAttributeMappingResource{}
 	_ resource.ResourceWithImportState = &defaultScimAttributeMappingResource{}
+	_ resource.ResourceWithMoveState   = &defaultScimAttributeMappingResource{}
 )
 
 // Create a Scim Attribute Mapping resource
@@ -270,7 +272,7 @@ func (r *scimAttributeMappingResource) CreateScimAttributeMapping(ctx context.Co
 
 	addResponse, httpResp, err := r.apiClient.ScimAttributeMappingAPI.AddScimAttributeMappingExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Scim Attribute Mapping", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Scim Attribute Mapping", err, httpResp)
 		return nil, err
 	}
 
@@ -303,6 +305,8 @@ func (r *scimAttributeMappingResource) Create(ctx context.Context, req resource.
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Scim Attribute Mapping", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -327,7 +331,7 @@ func (r *defaultScimAttributeMappingResource) Create(ctx context.Context, req re
 	readResponse, httpResp, err := r.apiClient.ScimAttributeMappingAPI.GetScimAttributeMapping(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString(), plan.ScimResourceTypeName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Scim Attribute Mapping", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Scim Attribute Mapping", err, httpResp)
 		return
 	}
 
@@ -351,7 +355,7 @@ func (r *defaultScimAttributeMappingResource) Create(ctx context.Context, req re
 
 		updateResponse, httpResp, err := r.apiClient.ScimAttributeMappingAPI.UpdateScimAttributeMappingExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Scim Attribute Mapping", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Scim Attribute Mapping", err, httpResp)
 			return
 		}
 
@@ -363,6 +367,8 @@ func (r *defaultScimAttributeMappingResource) Create(ctx context.Context, req re
 
 		// Read the response
 		readScimAttributeMappingResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Scim Attribute Mapping", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -455,7 +461,7 @@ func updateScimAttributeMapping(ctx context.Context, req resource.UpdateRequest,
 
 		updateResponse, httpResp, err := apiClient.ScimAttributeMappingAPI.UpdateScimAttributeMappingExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Scim Attribute Mapping", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Scim Attribute Mapping", err, httpResp)
 			return
 		}
 
@@ -467,6 +473,8 @@ func updateScimAttributeMapping(ctx context.Context, req resource.UpdateRequest,
 
 		// Read the response
 		readScimAttributeMappingResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Scim Attribute Mapping", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -521,3 +529,16 @@ func importScimAttributeMapping(ctx context.Context, req resource.ImportStateReq
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scim_resource_type_name"), split[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), split[1])...)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *scimAttributeMappingResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_scim_attribute_mapping"),
+	}
+}
+
+func (r *defaultScimAttributeMappingResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_scim_attribute_mapping"),
+	}
+}
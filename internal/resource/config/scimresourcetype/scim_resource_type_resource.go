@@ -30,9 +30,11 @@ var (
 	_ resource.Resource                = &scimResourceTypeResource{}
 	_ resource.ResourceWithConfigure   = &scimResourceTypeResource{}
 	_ resource.ResourceWithImportState = &scimResourceTypeResource{}
+	_ resource.ResourceWithMoveState   = &scimResourceTypeResource{}
 	_ resource.Resource                = &defaultScimResourceTypeResource{}
 	_ resource.ResourceWithConfigure   = &defaultScimResourceTypeResource{}
 	_ resource.ResourceWithImportState = &defaultScimResourceTypeResource{}
+	_ resource.ResourceWithMoveState   = &defaultScimResourceTypeResource{}
 )
 
 // Create a Scim Resource Type resource
@@ -658,7 +660,7 @@ func (r *scimResourceTypeResource) CreateLdapPassThroughScimResourceType(ctx con
 
 	addResponse, httpResp, err := r.apiClient.ScimResourceTypeAPI.AddScimResourceTypeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Scim Resource Type", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Scim Resource Type", err, httpResp)
 		return nil, err
 	}
 
@@ -698,7 +700,7 @@ func (r *scimResourceTypeResource) CreateMappingScimResourceType(ctx context.Con
 
 	addResponse, httpResp, err := r.apiClient.ScimResourceTypeAPI.AddScimResourceTypeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Scim Resource Type", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Scim Resource Type", err, httpResp)
 		return nil, err
 	}
 
@@ -738,7 +740,7 @@ func (r *scimResourceTypeResource) CreateLdapMappingScimResourceType(ctx context
 
 	addResponse, httpResp, err := r.apiClient.ScimResourceTypeAPI.AddScimResourceTypeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Scim Resource Type", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Scim Resource Type", err, httpResp)
 		return nil, err
 	}
 
@@ -785,6 +787,8 @@ func (r *scimResourceTypeResource) Create(ctx context.Context, req resource.Crea
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Scim Resource Type", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -809,7 +813,7 @@ func (r *defaultScimResourceTypeResource) Create(ctx context.Context, req resour
 	readResponse, httpResp, err := r.apiClient.ScimResourceTypeAPI.GetScimResourceType(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Scim Resource Type", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Scim Resource Type", err, httpResp)
 		return
 	}
 
@@ -841,7 +845,7 @@ func (r *defaultScimResourceTypeResource) Create(ctx context.Context, req resour
 
 		updateResponse, httpResp, err := r.apiClient.ScimResourceTypeAPI.UpdateScimResourceTypeExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Scim Resource Type", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Scim Resource Type", err, httpResp)
 			return
 		}
 
@@ -861,6 +865,8 @@ func (r *defaultScimResourceTypeResource) Create(ctx context.Context, req resour
 		if updateResponse.LdapMappingScimResourceTypeResponse != nil {
 			readLdapMappingScimResourceTypeResponse(ctx, updateResponse.LdapMappingScimResourceTypeResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Scim Resource Type", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -960,7 +966,7 @@ func updateScimResourceType(ctx context.Context, req resource.UpdateRequest, res
 
 		updateResponse, httpResp, err := apiClient.ScimResourceTypeAPI.UpdateScimResourceTypeExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Scim Resource Type", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Scim Resource Type", err, httpResp)
 			return
 		}
 
@@ -980,6 +986,8 @@ func updateScimResourceType(ctx context.Context, req resource.UpdateRequest, res
 		if updateResponse.LdapMappingScimResourceTypeResponse != nil {
 			readLdapMappingScimResourceTypeResponse(ctx, updateResponse.LdapMappingScimResourceTypeResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Scim Resource Type", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -1027,3 +1035,16 @@ func importScimResourceType(ctx context.Context, req resource.ImportStateRequest
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *scimResourceTypeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_scim_resource_type"),
+	}
+}
+
+func (r *defaultScimResourceTypeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_scim_resource_type"),
+	}
+}
@@ -49,29 +49,21 @@ func (r *scimResourceTypesDataSource) Configure(_ context.Context, req datasourc
 }
 
 type scimResourceTypesDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *scimResourceTypesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Scim Resource Type objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Scim Resource Type objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewScimResourceTypeDataSource(), "Scim Resource Type")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *scimResourceTypesDataSource) Read(ctx context.Context, req datasource.R
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewScimResourceTypeDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.ScimResourceTypeAPI.ListScimResourceTypes(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.ScimResourceTypeAPI.ListScimResourceTypesExecute(listRequest)
@@ -106,33 +104,33 @@ func (r *scimResourceTypesDataSource) Read(ctx context.Context, req datasource.R
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object scimResourceTypeDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.LdapPassThroughScimResourceTypeResponse != nil {
-			attributes["id"] = types.StringValue(response.LdapPassThroughScimResourceTypeResponse.Id)
-			attributes["type"] = types.StringValue("ldap-pass-through")
+			readLdapPassThroughScimResourceTypeResponseDataSource(ctx, response.LdapPassThroughScimResourceTypeResponse, &object, &resp.Diagnostics)
 		}
 		if response.MappingScimResourceTypeResponse != nil {
-			attributes["id"] = types.StringValue(response.MappingScimResourceTypeResponse.Id)
-			attributes["type"] = types.StringValue("mapping")
+			readMappingScimResourceTypeResponseDataSource(ctx, response.MappingScimResourceTypeResponse, &object, &resp.Diagnostics)
 		}
 		if response.LdapMappingScimResourceTypeResponse != nil {
-			attributes["id"] = types.StringValue(response.LdapMappingScimResourceTypeResponse.Id)
-			attributes["type"] = types.StringValue("ldap-mapping")
+			readLdapMappingScimResourceTypeResponseDataSource(ctx, response.LdapMappingScimResourceTypeResponse, &object, &resp.Diagnostics)
 		}
 		if response.PassThroughScimResourceTypeResponse != nil {
-			attributes["id"] = types.StringValue(response.PassThroughScimResourceTypeResponse.Id)
-			attributes["type"] = types.StringValue("pass-through")
+			object.Id = types.StringValue(response.PassThroughScimResourceTypeResponse.Id)
+			object.Type = types.StringValue("pass-through")
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -27,9 +27,11 @@ var (
 	_ resource.Resource                = &scimSchemaResource{}
 	_ resource.ResourceWithConfigure   = &scimSchemaResource{}
 	_ resource.ResourceWithImportState = &scimSchemaResource{}
+	_ resource.ResourceWithMoveState   = &scimSchemaResource{}
 	_ resource.Resource                = &defaultScimSchemaResource{}
 	_ resource.ResourceWithConfigure   = &defaultScimSchemaResource{}
 	_ resource.ResourceWithImportState = &defaultScimSchemaResource{}
+	_ resource.ResourceWithMoveState   = &defaultScimSchemaResource{}
 )
 
 // Create a Scim Schema resource
@@ -213,7 +215,7 @@ func (r *scimSchemaResource) CreateScimSchema(ctx context.Context, req resource.
 
 	addResponse, httpResp, err := r.apiClient.ScimSchemaAPI.AddScimSchemaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Scim Schema", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Scim Schema", err, httpResp)
 		return nil, err
 	}
 
@@ -244,6 +246,8 @@ func (r *scimSchemaResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Scim Schema", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -268,7 +272,7 @@ func (r *defaultScimSchemaResource) Create(ctx context.Context, req resource.Cre
 	readResponse, httpResp, err := r.apiClient.ScimSchemaAPI.GetScimSchema(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.SchemaURN.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Scim Schema", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Scim Schema", err, httpResp)
 		return
 	}
 
@@ -292,7 +296,7 @@ func (r *defaultScimSchemaResource) Create(ctx context.Context, req resource.Cre
 
 		updateResponse, httpResp, err := r.apiClient.ScimSchemaAPI.UpdateScimSchemaExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Scim Schema", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Scim Schema", err, httpResp)
 			return
 		}
 
@@ -304,6 +308,8 @@ func (r *defaultScimSchemaResource) Create(ctx context.Context, req resource.Cre
 
 		// Read the response
 		readScimSchemaResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Scim Schema", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -395,7 +401,7 @@ func updateScimSchema(ctx context.Context, req resource.UpdateRequest, resp *res
 
 		updateResponse, httpResp, err := apiClient.ScimSchemaAPI.UpdateScimSchemaExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Scim Schema", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Scim Schema", err, httpResp)
 			return
 		}
 
@@ -407,6 +413,8 @@ func updateScimSchema(ctx context.Context, req resource.UpdateRequest, resp *res
 
 		// Read the response
 		readScimSchemaResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Scim Schema", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -454,3 +462,16 @@ func importScimSchema(ctx context.Context, req resource.ImportStateRequest, resp
 	// Retrieve import ID and save to schema_urn attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("schema_urn"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *scimSchemaResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_scim_schema"),
+	}
+}
+
+func (r *defaultScimSchemaResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_scim_schema"),
+	}
+}
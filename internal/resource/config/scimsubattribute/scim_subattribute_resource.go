@@ -29,9 +29,11 @@ var (
 	_ resource.Resource                = &scimSubattributeResource{}
 	_ resource.ResourceWithConfigure   = &scimSubattributeResource{}
 	_ resource.ResourceWithImportState = &scimSubattributeResource{}
+	_ resource.ResourceWithMoveState   = &scimSubattributeResource{}
 	_ resource.Resource                = &defaultScimSubattributeResource{}
 	_ resource.ResourceWithConfigure   = &defaultScimSubattributeResource{}
 	_ resource.ResourceWithImportState = &defaultScimSubattributeResource{}
+	_ resource.ResourceWithMoveState   = &defaultScimSubattributeResource{}
 )
 
 // Create a Scim Subattribute resource
@@ -351,7 +353,7 @@ func (r *scimSubattributeResource) CreateScimSubattribute(ctx context.Context, r
 
 	addResponse, httpResp, err := r.apiClient.ScimSubattributeAPI.AddScimSubattributeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Scim Subattribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Scim Subattribute", err, httpResp)
 		return nil, err
 	}
 
@@ -384,6 +386,8 @@ func (r *scimSubattributeResource) Create(ctx context.Context, req resource.Crea
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Scim Subattribute", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -408,7 +412,7 @@ func (r *defaultScimSubattributeResource) Create(ctx context.Context, req resour
 	readResponse, httpResp, err := r.apiClient.ScimSubattributeAPI.GetScimSubattribute(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString(), plan.ScimAttributeName.ValueString(), plan.ScimSchemaName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Scim Subattribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Scim Subattribute", err, httpResp)
 		return
 	}
 
@@ -432,7 +436,7 @@ func (r *defaultScimSubattributeResource) Create(ctx context.Context, req resour
 
 		updateResponse, httpResp, err := r.apiClient.ScimSubattributeAPI.UpdateScimSubattributeExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Scim Subattribute", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Scim Subattribute", err, httpResp)
 			return
 		}
 
@@ -444,6 +448,8 @@ func (r *defaultScimSubattributeResource) Create(ctx context.Context, req resour
 
 		// Read the response
 		readScimSubattributeResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Scim Subattribute", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -536,7 +542,7 @@ func updateScimSubattribute(ctx context.Context, req resource.UpdateRequest, res
 
 		updateResponse, httpResp, err := apiClient.ScimSubattributeAPI.UpdateScimSubattributeExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Scim Subattribute", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Scim Subattribute", err, httpResp)
 			return
 		}
 
@@ -548,6 +554,8 @@ func updateScimSubattribute(ctx context.Context, req resource.UpdateRequest, res
 
 		// Read the response
 		readScimSubattributeResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Scim Subattribute", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -603,3 +611,16 @@ func importScimSubattribute(ctx context.Context, req resource.ImportStateRequest
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scim_attribute_name"), split[1])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), split[2])...)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *scimSubattributeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_scim_subattribute"),
+	}
+}
+
+func (r *defaultScimSubattributeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_scim_subattribute"),
+	}
+}
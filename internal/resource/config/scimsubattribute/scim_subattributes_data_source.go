@@ -50,10 +50,13 @@ func (r *scimSubattributesDataSource) Configure(_ context.Context, req datasourc
 
 type scimSubattributesDataSourceModel struct {
 	Id                types.String `tfsdk:"id"`
-	Filter            types.String `tfsdk:"filter"`
 	Ids               types.Set    `tfsdk:"ids"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
 	ScimAttributeName types.String `tfsdk:"scim_attribute_name"`
 	ScimSchemaName    types.String `tfsdk:"scim_schema_name"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
@@ -69,10 +72,6 @@ func (r *scimSubattributesDataSource) Schema(ctx context.Context, req datasource
 				Description: "Name of the parent SCIM Schema",
 				Required:    true,
 			},
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
 			"ids": schema.SetAttribute{
 				Description: "Scim Subattribute IDs found in the configuration",
 				Required:    false,
@@ -82,6 +81,8 @@ func (r *scimSubattributesDataSource) Schema(ctx context.Context, req datasource
 			},
 		},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewScimSubattributeDataSource(), "Scim Subattribute")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -96,9 +97,15 @@ func (r *scimSubattributesDataSource) Read(ctx context.Context, req datasource.R
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewScimSubattributeDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.ScimSubattributeAPI.ListScimSubattributes(config.ProviderBasicAuthContext(ctx, r.providerConfig), state.ScimAttributeName.ValueString(), state.ScimSchemaName.ValueString())
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.ScimSubattributeAPI.ListScimSubattributesExecute(listRequest)
@@ -115,12 +122,25 @@ func (r *scimSubattributesDataSource) Read(ctx context.Context, req datasource.R
 
 	// Read the response into the state
 	ids := []attr.Value{}
+	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
+		var object scimSubattributeDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
+		readScimSubattributeResponseDataSource(ctx, &response, &object, &resp.Diagnostics)
+		object.ScimAttributeName = state.ScimAttributeName
+		object.ScimSchemaName = state.ScimSchemaName
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
+		}
 		ids = append(ids, types.StringValue(response.Id))
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 
 	state.Ids, diags = types.SetValue(types.StringType, ids)
 	resp.Diagnostics.Append(diags...)
+	state.Objects, diags = types.SetValue(objectType, objects)
+	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -54,10 +54,10 @@ type searchEntryCriteriaDataSourceModel struct {
 	Type                              types.String `tfsdk:"type"`
 	ExtensionClass                    types.String `tfsdk:"extension_class"`
 	ExtensionArgument                 types.Set    `tfsdk:"extension_argument"`
-	AllIncludedSearchEntryCriteria    types.Set    `tfsdk:"all_included_search_entry_criteria"`
-	AnyIncludedSearchEntryCriteria    types.Set    `tfsdk:"any_included_search_entry_criteria"`
-	NotAllIncludedSearchEntryCriteria types.Set    `tfsdk:"not_all_included_search_entry_criteria"`
-	NoneIncludedSearchEntryCriteria   types.Set    `tfsdk:"none_included_search_entry_criteria"`
+	AllIncludedSearchEntryCriteria    types.List   `tfsdk:"all_included_search_entry_criteria"`
+	AnyIncludedSearchEntryCriteria    types.List   `tfsdk:"any_included_search_entry_criteria"`
+	NotAllIncludedSearchEntryCriteria types.List   `tfsdk:"not_all_included_search_entry_criteria"`
+	NoneIncludedSearchEntryCriteria   types.List   `tfsdk:"none_included_search_entry_criteria"`
 	RequestCriteria                   types.String `tfsdk:"request_criteria"`
 	AllIncludedEntryControl           types.Set    `tfsdk:"all_included_entry_control"`
 	AnyIncludedEntryControl           types.Set    `tfsdk:"any_included_entry_control"`
@@ -100,28 +100,28 @@ func (r *searchEntryCriteriaDataSource) Schema(ctx context.Context, req datasour
 				Computed:    true,
 				ElementType: types.StringType,
 			},
-			"all_included_search_entry_criteria": schema.SetAttribute{
+			"all_included_search_entry_criteria": schema.ListAttribute{
 				Description: "Specifies a search entry criteria object that must match the associated search result entry in order to match the aggregate search entry criteria. If one or more all-included search entry criteria objects are provided, then a search result entry must match all of them in order to match the aggregate search entry criteria.",
 				Required:    false,
 				Optional:    false,
 				Computed:    true,
 				ElementType: types.StringType,
 			},
-			"any_included_search_entry_criteria": schema.SetAttribute{
+			"any_included_search_entry_criteria": schema.ListAttribute{
 				Description: "Specifies a search entry criteria object that may match the associated search result entry in order to match the aggregate search entry criteria. If one or more any-included search entry criteria objects are provided, then a search result entry must match at least one of them in order to match the aggregate search entry criteria.",
 				Required:    false,
 				Optional:    false,
 				Computed:    true,
 				ElementType: types.StringType,
 			},
-			"not_all_included_search_entry_criteria": schema.SetAttribute{
+			"not_all_included_search_entry_criteria": schema.ListAttribute{
 				Description: "Specifies a search entry criteria object that should not match the associated search result entry in order to match the aggregate search entry criteria. If one or more not-all-included search entry criteria objects are provided, then a search result entry must not match all of them (that is, it may match zero or more of them, but it must not match all of them) in order to match the aggregate search entry criteria.",
 				Required:    false,
 				Optional:    false,
 				Computed:    true,
 				ElementType: types.StringType,
 			},
-			"none_included_search_entry_criteria": schema.SetAttribute{
+			"none_included_search_entry_criteria": schema.ListAttribute{
 				Description: "Specifies a search entry criteria object that must not match the associated search result entry in order to match the aggregate search entry criteria. If one or more none-included search entry criteria objects are provided, then a search result entry must not match any of them in order to match the aggregate search entry criteria.",
 				Required:    false,
 				Optional:    false,
@@ -272,10 +272,10 @@ func readAggregateSearchEntryCriteriaResponseDataSource(ctx context.Context, r *
 	state.Type = types.StringValue("aggregate")
 	state.Id = types.StringValue(r.Id)
 	state.Name = types.StringValue(r.Id)
-	state.AllIncludedSearchEntryCriteria = internaltypes.GetStringSet(r.AllIncludedSearchEntryCriteria)
-	state.AnyIncludedSearchEntryCriteria = internaltypes.GetStringSet(r.AnyIncludedSearchEntryCriteria)
-	state.NotAllIncludedSearchEntryCriteria = internaltypes.GetStringSet(r.NotAllIncludedSearchEntryCriteria)
-	state.NoneIncludedSearchEntryCriteria = internaltypes.GetStringSet(r.NoneIncludedSearchEntryCriteria)
+	state.AllIncludedSearchEntryCriteria = internaltypes.GetStringList(r.AllIncludedSearchEntryCriteria)
+	state.AnyIncludedSearchEntryCriteria = internaltypes.GetStringList(r.AnyIncludedSearchEntryCriteria)
+	state.NotAllIncludedSearchEntryCriteria = internaltypes.GetStringList(r.NotAllIncludedSearchEntryCriteria)
+	state.NoneIncludedSearchEntryCriteria = internaltypes.GetStringList(r.NoneIncludedSearchEntryCriteria)
 	state.Description = internaltypes.StringTypeOrNil(r.Description, false)
 }
 
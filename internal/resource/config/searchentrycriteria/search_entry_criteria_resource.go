@@ -28,9 +28,11 @@ var (
 	_ resource.Resource                = &searchEntryCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &searchEntryCriteriaResource{}
 	_ resource.ResourceWithImportState = &searchEntryCriteriaResource{}
+	_ resource.ResourceWithMoveState   = &searchEntryCriteriaResource{}
 	_ resource.Resource                = &defaultSearchEntryCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &defaultSearchEntryCriteriaResource{}
 	_ resource.ResourceWithImportState = &defaultSearchEntryCriteriaResource{}
+	_ resource.ResourceWithMoveState   = &defaultSearchEntryCriteriaResource{}
 )
 
 // Create a Search Entry Criteria resource
@@ -92,10 +94,10 @@ type searchEntryCriteriaResourceModel struct {
 	Type                              types.String `tfsdk:"type"`
 	ExtensionClass                    types.String `tfsdk:"extension_class"`
 	ExtensionArgument                 types.Set    `tfsdk:"extension_argument"`
-	AllIncludedSearchEntryCriteria    types.Set    `tfsdk:"all_included_search_entry_criteria"`
-	AnyIncludedSearchEntryCriteria    types.Set    `tfsdk:"any_included_search_entry_criteria"`
-	NotAllIncludedSearchEntryCriteria types.Set    `tfsdk:"not_all_included_search_entry_criteria"`
-	NoneIncludedSearchEntryCriteria   types.Set    `tfsdk:"none_included_search_entry_criteria"`
+	AllIncludedSearchEntryCriteria    types.List   `tfsdk:"all_included_search_entry_criteria"`
+	AnyIncludedSearchEntryCriteria    types.List   `tfsdk:"any_included_search_entry_criteria"`
+	NotAllIncludedSearchEntryCriteria types.List   `tfsdk:"not_all_included_search_entry_criteria"`
+	NoneIncludedSearchEntryCriteria   types.List   `tfsdk:"none_included_search_entry_criteria"`
 	RequestCriteria                   types.String `tfsdk:"request_criteria"`
 	AllIncludedEntryControl           types.Set    `tfsdk:"all_included_entry_control"`
 	AnyIncludedEntryControl           types.Set    `tfsdk:"any_included_entry_control"`
@@ -148,32 +150,32 @@ func searchEntryCriteriaSchema(ctx context.Context, req resource.SchemaRequest,
 				Default:     internaltypes.EmptySetDefault(types.StringType),
 				ElementType: types.StringType,
 			},
-			"all_included_search_entry_criteria": schema.SetAttribute{
+			"all_included_search_entry_criteria": schema.ListAttribute{
 				Description: "Specifies a search entry criteria object that must match the associated search result entry in order to match the aggregate search entry criteria. If one or more all-included search entry criteria objects are provided, then a search result entry must match all of them in order to match the aggregate search entry criteria.",
 				Optional:    true,
 				Computed:    true,
-				Default:     internaltypes.EmptySetDefault(types.StringType),
+				Default:     internaltypes.EmptyListDefault(types.StringType),
 				ElementType: types.StringType,
 			},
-			"any_included_search_entry_criteria": schema.SetAttribute{
+			"any_included_search_entry_criteria": schema.ListAttribute{
 				Description: "Specifies a search entry criteria object that may match the associated search result entry in order to match the aggregate search entry criteria. If one or more any-included search entry criteria objects are provided, then a search result entry must match at least one of them in order to match the aggregate search entry criteria.",
 				Optional:    true,
 				Computed:    true,
-				Default:     internaltypes.EmptySetDefault(types.StringType),
+				Default:     internaltypes.EmptyListDefault(types.StringType),
 				ElementType: types.StringType,
 			},
-			"not_all_included_search_entry_criteria": schema.SetAttribute{
+			"not_all_included_search_entry_criteria": schema.ListAttribute{
 				Description: "Specifies a search entry criteria object that should not match the associated search result entry in order to match the aggregate search entry criteria. If one or more not-all-included search entry criteria objects are provided, then a search result entry must not match all of them (that is, it may match zero or more of them, but it must not match all of them) in order to match the aggregate search entry criteria.",
 				Optional:    true,
 				Computed:    true,
-				Default:     internaltypes.EmptySetDefault(types.StringType),
+				Default:     internaltypes.EmptyListDefault(types.StringType),
 				ElementType: types.StringType,
 			},
-			"none_included_search_entry_criteria": schema.SetAttribute{
+			"none_included_search_entry_criteria": schema.ListAttribute{
 				Description: "Specifies a search entry criteria object that must not match the associated search result entry in order to match the aggregate search entry criteria. If one or more none-included search entry criteria objects are provided, then a search result entry must not match any of them in order to match the aggregate search entry criteria.",
 				Optional:    true,
 				Computed:    true,
-				Default:     internaltypes.EmptySetDefault(types.StringType),
+				Default:     internaltypes.EmptyListDefault(types.StringType),
 				ElementType: types.StringType,
 			},
 			"request_criteria": schema.StringAttribute{
@@ -563,7 +565,7 @@ func populateSearchEntryCriteriaUnknownValues(model *searchEntryCriteriaResource
 		model.ExcludedEntryBaseDN, _ = types.SetValue(types.StringType, []attr.Value{})
 	}
 	if model.AnyIncludedSearchEntryCriteria.IsUnknown() || model.AnyIncludedSearchEntryCriteria.IsNull() {
-		model.AnyIncludedSearchEntryCriteria, _ = types.SetValue(types.StringType, []attr.Value{})
+		model.AnyIncludedSearchEntryCriteria, _ = types.ListValue(types.StringType, []attr.Value{})
 	}
 	if model.AnyIncludedEntryFilter.IsUnknown() || model.AnyIncludedEntryFilter.IsNull() {
 		model.AnyIncludedEntryFilter, _ = types.SetValue(types.StringType, []attr.Value{})
@@ -575,7 +577,7 @@ func populateSearchEntryCriteriaUnknownValues(model *searchEntryCriteriaResource
 		model.IncludedEntryBaseDN, _ = types.SetValue(types.StringType, []attr.Value{})
 	}
 	if model.NoneIncludedSearchEntryCriteria.IsUnknown() || model.NoneIncludedSearchEntryCriteria.IsNull() {
-		model.NoneIncludedSearchEntryCriteria, _ = types.SetValue(types.StringType, []attr.Value{})
+		model.NoneIncludedSearchEntryCriteria, _ = types.ListValue(types.StringType, []attr.Value{})
 	}
 	if model.ExtensionArgument.IsUnknown() || model.ExtensionArgument.IsNull() {
 		model.ExtensionArgument, _ = types.SetValue(types.StringType, []attr.Value{})
@@ -584,7 +586,7 @@ func populateSearchEntryCriteriaUnknownValues(model *searchEntryCriteriaResource
 		model.AnyIncludedEntryControl, _ = types.SetValue(types.StringType, []attr.Value{})
 	}
 	if model.AllIncludedSearchEntryCriteria.IsUnknown() || model.AllIncludedSearchEntryCriteria.IsNull() {
-		model.AllIncludedSearchEntryCriteria, _ = types.SetValue(types.StringType, []attr.Value{})
+		model.AllIncludedSearchEntryCriteria, _ = types.ListValue(types.StringType, []attr.Value{})
 	}
 	if model.AllIncludedEntryFilter.IsUnknown() || model.AllIncludedEntryFilter.IsNull() {
 		model.AllIncludedEntryFilter, _ = types.SetValue(types.StringType, []attr.Value{})
@@ -608,7 +610,7 @@ func populateSearchEntryCriteriaUnknownValues(model *searchEntryCriteriaResource
 		model.AnyIncludedEntryGroupDN, _ = types.SetValue(types.StringType, []attr.Value{})
 	}
 	if model.NotAllIncludedSearchEntryCriteria.IsUnknown() || model.NotAllIncludedSearchEntryCriteria.IsNull() {
-		model.NotAllIncludedSearchEntryCriteria, _ = types.SetValue(types.StringType, []attr.Value{})
+		model.NotAllIncludedSearchEntryCriteria, _ = types.ListValue(types.StringType, []attr.Value{})
 	}
 	if model.NoneIncludedEntryGroupDN.IsUnknown() || model.NoneIncludedEntryGroupDN.IsNull() {
 		model.NoneIncludedEntryGroupDN, _ = types.SetValue(types.StringType, []attr.Value{})
@@ -658,10 +660,10 @@ func readAggregateSearchEntryCriteriaResponse(ctx context.Context, r *client.Agg
 	state.Type = types.StringValue("aggregate")
 	state.Id = types.StringValue(r.Id)
 	state.Name = types.StringValue(r.Id)
-	state.AllIncludedSearchEntryCriteria = internaltypes.GetStringSet(r.AllIncludedSearchEntryCriteria)
-	state.AnyIncludedSearchEntryCriteria = internaltypes.GetStringSet(r.AnyIncludedSearchEntryCriteria)
-	state.NotAllIncludedSearchEntryCriteria = internaltypes.GetStringSet(r.NotAllIncludedSearchEntryCriteria)
-	state.NoneIncludedSearchEntryCriteria = internaltypes.GetStringSet(r.NoneIncludedSearchEntryCriteria)
+	state.AllIncludedSearchEntryCriteria = internaltypes.GetStringList(r.AllIncludedSearchEntryCriteria)
+	state.AnyIncludedSearchEntryCriteria = internaltypes.GetStringList(r.AnyIncludedSearchEntryCriteria)
+	state.NotAllIncludedSearchEntryCriteria = internaltypes.GetStringList(r.NotAllIncludedSearchEntryCriteria)
+	state.NoneIncludedSearchEntryCriteria = internaltypes.GetStringList(r.NoneIncludedSearchEntryCriteria)
 	state.Description = internaltypes.StringTypeOrNil(r.Description, internaltypes.IsEmptyString(expectedValues.Description))
 	state.Notifications, state.RequiredActions = config.ReadMessages(ctx, r.Urnpingidentityschemasconfigurationmessages20, diagnostics)
 	populateSearchEntryCriteriaUnknownValues(state)
@@ -684,10 +686,10 @@ func createSearchEntryCriteriaOperations(plan searchEntryCriteriaResourceModel,
 	var ops []client.Operation
 	operations.AddStringOperationIfNecessary(&ops, plan.ExtensionClass, state.ExtensionClass, "extension-class")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.ExtensionArgument, state.ExtensionArgument, "extension-argument")
-	operations.AddStringSetOperationsIfNecessary(&ops, plan.AllIncludedSearchEntryCriteria, state.AllIncludedSearchEntryCriteria, "all-included-search-entry-criteria")
-	operations.AddStringSetOperationsIfNecessary(&ops, plan.AnyIncludedSearchEntryCriteria, state.AnyIncludedSearchEntryCriteria, "any-included-search-entry-criteria")
-	operations.AddStringSetOperationsIfNecessary(&ops, plan.NotAllIncludedSearchEntryCriteria, state.NotAllIncludedSearchEntryCriteria, "not-all-included-search-entry-criteria")
-	operations.AddStringSetOperationsIfNecessary(&ops, plan.NoneIncludedSearchEntryCriteria, state.NoneIncludedSearchEntryCriteria, "none-included-search-entry-criteria")
+	operations.AddStringListOperationsIfNecessary(&ops, plan.AllIncludedSearchEntryCriteria, state.AllIncludedSearchEntryCriteria, "all-included-search-entry-criteria")
+	operations.AddStringListOperationsIfNecessary(&ops, plan.AnyIncludedSearchEntryCriteria, state.AnyIncludedSearchEntryCriteria, "any-included-search-entry-criteria")
+	operations.AddStringListOperationsIfNecessary(&ops, plan.NotAllIncludedSearchEntryCriteria, state.NotAllIncludedSearchEntryCriteria, "not-all-included-search-entry-criteria")
+	operations.AddStringListOperationsIfNecessary(&ops, plan.NoneIncludedSearchEntryCriteria, state.NoneIncludedSearchEntryCriteria, "none-included-search-entry-criteria")
 	operations.AddStringOperationIfNecessary(&ops, plan.RequestCriteria, state.RequestCriteria, "request-criteria")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.AllIncludedEntryControl, state.AllIncludedEntryControl, "all-included-entry-control")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.AnyIncludedEntryControl, state.AnyIncludedEntryControl, "any-included-entry-control")
@@ -724,7 +726,7 @@ func (r *searchEntryCriteriaResource) CreateSimpleSearchEntryCriteria(ctx contex
 
 	addResponse, httpResp, err := r.apiClient.SearchEntryCriteriaAPI.AddSearchEntryCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Search Entry Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Search Entry Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -757,7 +759,7 @@ func (r *searchEntryCriteriaResource) CreateAggregateSearchEntryCriteria(ctx con
 
 	addResponse, httpResp, err := r.apiClient.SearchEntryCriteriaAPI.AddSearchEntryCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Search Entry Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Search Entry Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -791,7 +793,7 @@ func (r *searchEntryCriteriaResource) CreateThirdPartySearchEntryCriteria(ctx co
 
 	addResponse, httpResp, err := r.apiClient.SearchEntryCriteriaAPI.AddSearchEntryCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Search Entry Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Search Entry Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -838,6 +840,8 @@ func (r *searchEntryCriteriaResource) Create(ctx context.Context, req resource.C
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Search Entry Criteria", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -862,7 +866,7 @@ func (r *defaultSearchEntryCriteriaResource) Create(ctx context.Context, req res
 	readResponse, httpResp, err := r.apiClient.SearchEntryCriteriaAPI.GetSearchEntryCriteria(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Search Entry Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Search Entry Criteria", err, httpResp)
 		return
 	}
 
@@ -894,7 +898,7 @@ func (r *defaultSearchEntryCriteriaResource) Create(ctx context.Context, req res
 
 		updateResponse, httpResp, err := r.apiClient.SearchEntryCriteriaAPI.UpdateSearchEntryCriteriaExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Search Entry Criteria", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Search Entry Criteria", err, httpResp)
 			return
 		}
 
@@ -914,6 +918,8 @@ func (r *defaultSearchEntryCriteriaResource) Create(ctx context.Context, req res
 		if updateResponse.ThirdPartySearchEntryCriteriaResponse != nil {
 			readThirdPartySearchEntryCriteriaResponse(ctx, updateResponse.ThirdPartySearchEntryCriteriaResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Search Entry Criteria", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -1013,7 +1019,7 @@ func updateSearchEntryCriteria(ctx context.Context, req resource.UpdateRequest,
 
 		updateResponse, httpResp, err := apiClient.SearchEntryCriteriaAPI.UpdateSearchEntryCriteriaExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Search Entry Criteria", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Search Entry Criteria", err, httpResp)
 			return
 		}
 
@@ -1033,6 +1039,8 @@ func updateSearchEntryCriteria(ctx context.Context, req resource.UpdateRequest,
 		if updateResponse.ThirdPartySearchEntryCriteriaResponse != nil {
 			readThirdPartySearchEntryCriteriaResponse(ctx, updateResponse.ThirdPartySearchEntryCriteriaResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Search Entry Criteria", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -1080,3 +1088,16 @@ func importSearchEntryCriteria(ctx context.Context, req resource.ImportStateRequ
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *searchEntryCriteriaResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_search_entry_criteria"),
+	}
+}
+
+func (r *defaultSearchEntryCriteriaResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_search_entry_criteria"),
+	}
+}
@@ -54,10 +54,10 @@ type searchReferenceCriteriaDataSourceModel struct {
 	Type                                  types.String `tfsdk:"type"`
 	ExtensionClass                        types.String `tfsdk:"extension_class"`
 	ExtensionArgument                     types.Set    `tfsdk:"extension_argument"`
-	AllIncludedSearchReferenceCriteria    types.Set    `tfsdk:"all_included_search_reference_criteria"`
-	AnyIncludedSearchReferenceCriteria    types.Set    `tfsdk:"any_included_search_reference_criteria"`
-	NotAllIncludedSearchReferenceCriteria types.Set    `tfsdk:"not_all_included_search_reference_criteria"`
-	NoneIncludedSearchReferenceCriteria   types.Set    `tfsdk:"none_included_search_reference_criteria"`
+	AllIncludedSearchReferenceCriteria    types.List   `tfsdk:"all_included_search_reference_criteria"`
+	AnyIncludedSearchReferenceCriteria    types.List   `tfsdk:"any_included_search_reference_criteria"`
+	NotAllIncludedSearchReferenceCriteria types.List   `tfsdk:"not_all_included_search_reference_criteria"`
+	NoneIncludedSearchReferenceCriteria   types.List   `tfsdk:"none_included_search_reference_criteria"`
 	RequestCriteria                       types.String `tfsdk:"request_criteria"`
 	AllIncludedReferenceControl           types.Set    `tfsdk:"all_included_reference_control"`
 	AnyIncludedReferenceControl           types.Set    `tfsdk:"any_included_reference_control"`
@@ -90,28 +90,28 @@ func (r *searchReferenceCriteriaDataSource) Schema(ctx context.Context, req data
 				Computed:    true,
 				ElementType: types.StringType,
 			},
-			"all_included_search_reference_criteria": schema.SetAttribute{
+			"all_included_search_reference_criteria": schema.ListAttribute{
 				Description: "Specifies a search reference criteria object that must match the associated search result reference in order to match the aggregate search reference criteria. If one or more all-included search reference criteria objects are provided, then a search result reference must match all of them in order to match the aggregate search reference criteria.",
 				Required:    false,
 				Optional:    false,
 				Computed:    true,
 				ElementType: types.StringType,
 			},
-			"any_included_search_reference_criteria": schema.SetAttribute{
+			"any_included_search_reference_criteria": schema.ListAttribute{
 				Description: "Specifies a search reference criteria object that may match the associated search result reference in order to match the aggregate search reference criteria. If one or more any-included search reference criteria objects are provided, then a search result reference must match at least one of them in order to match the aggregate search reference criteria.",
 				Required:    false,
 				Optional:    false,
 				Computed:    true,
 				ElementType: types.StringType,
 			},
-			"not_all_included_search_reference_criteria": schema.SetAttribute{
+			"not_all_included_search_reference_criteria": schema.ListAttribute{
 				Description: "Specifies a search reference criteria object that should not match the associated search result reference in order to match the aggregate search reference criteria. If one or more not-all-included search reference criteria objects are provided, then a search result reference must not match all of them (that is, it may match zero or more of them, but it must not match all of them) in order to match the aggregate search reference criteria.",
 				Required:    false,
 				Optional:    false,
 				Computed:    true,
 				ElementType: types.StringType,
 			},
-			"none_included_search_reference_criteria": schema.SetAttribute{
+			"none_included_search_reference_criteria": schema.ListAttribute{
 				Description: "Specifies a search reference criteria object that must not match the associated search result reference in order to match the aggregate search reference criteria. If one or more none-included search reference criteria objects are provided, then a search result reference must not match any of them in order to match the aggregate search reference criteria.",
 				Required:    false,
 				Optional:    false,
@@ -182,10 +182,10 @@ func readAggregateSearchReferenceCriteriaResponseDataSource(ctx context.Context,
 	state.Type = types.StringValue("aggregate")
 	state.Id = types.StringValue(r.Id)
 	state.Name = types.StringValue(r.Id)
-	state.AllIncludedSearchReferenceCriteria = internaltypes.GetStringSet(r.AllIncludedSearchReferenceCriteria)
-	state.AnyIncludedSearchReferenceCriteria = internaltypes.GetStringSet(r.AnyIncludedSearchReferenceCriteria)
-	state.NotAllIncludedSearchReferenceCriteria = internaltypes.GetStringSet(r.NotAllIncludedSearchReferenceCriteria)
-	state.NoneIncludedSearchReferenceCriteria = internaltypes.GetStringSet(r.NoneIncludedSearchReferenceCriteria)
+	state.AllIncludedSearchReferenceCriteria = internaltypes.GetStringList(r.AllIncludedSearchReferenceCriteria)
+	state.AnyIncludedSearchReferenceCriteria = internaltypes.GetStringList(r.AnyIncludedSearchReferenceCriteria)
+	state.NotAllIncludedSearchReferenceCriteria = internaltypes.GetStringList(r.NotAllIncludedSearchReferenceCriteria)
+	state.NoneIncludedSearchReferenceCriteria = internaltypes.GetStringList(r.NoneIncludedSearchReferenceCriteria)
 	state.Description = internaltypes.StringTypeOrNil(r.Description, false)
 }
 
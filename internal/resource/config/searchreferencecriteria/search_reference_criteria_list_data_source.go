@@ -49,29 +49,21 @@ func (r *searchReferenceCriteriaListDataSource) Configure(_ context.Context, req
 }
 
 type searchReferenceCriteriaListDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *searchReferenceCriteriaListDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Search Reference Criteria objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Search Reference Criteria objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewSearchReferenceCriteriaDataSource(), "Search Reference Criteria")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *searchReferenceCriteriaListDataSource) Read(ctx context.Context, req da
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewSearchReferenceCriteriaDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.SearchReferenceCriteriaAPI.ListSearchReferenceCriteria(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.SearchReferenceCriteriaAPI.ListSearchReferenceCriteriaExecute(listRequest)
@@ -106,29 +104,29 @@ func (r *searchReferenceCriteriaListDataSource) Read(ctx context.Context, req da
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object searchReferenceCriteriaDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.SimpleSearchReferenceCriteriaResponse != nil {
-			attributes["id"] = types.StringValue(response.SimpleSearchReferenceCriteriaResponse.Id)
-			attributes["type"] = types.StringValue("simple")
+			readSimpleSearchReferenceCriteriaResponseDataSource(ctx, response.SimpleSearchReferenceCriteriaResponse, &object, &resp.Diagnostics)
 		}
 		if response.AggregateSearchReferenceCriteriaResponse != nil {
-			attributes["id"] = types.StringValue(response.AggregateSearchReferenceCriteriaResponse.Id)
-			attributes["type"] = types.StringValue("aggregate")
+			readAggregateSearchReferenceCriteriaResponseDataSource(ctx, response.AggregateSearchReferenceCriteriaResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartySearchReferenceCriteriaResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartySearchReferenceCriteriaResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartySearchReferenceCriteriaResponseDataSource(ctx, response.ThirdPartySearchReferenceCriteriaResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
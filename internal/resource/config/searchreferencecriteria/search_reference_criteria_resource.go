@@ -28,9 +28,11 @@ var (
 	_ resource.Resource                = &searchReferenceCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &searchReferenceCriteriaResource{}
 	_ resource.ResourceWithImportState = &searchReferenceCriteriaResource{}
+	_ resource.ResourceWithMoveState   = &searchReferenceCriteriaResource{}
 	_ resource.Resource                = &defaultSearchReferenceCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &defaultSearchReferenceCriteriaResource{}
 	_ resource.ResourceWithImportState = &defaultSearchReferenceCriteriaResource{}
+	_ resource.ResourceWithMoveState   = &defaultSearchReferenceCriteriaResource{}
 )
 
 // Create a Search Reference Criteria resource
@@ -92,10 +94,10 @@ type searchReferenceCriteriaResourceModel struct {
 	Type                                  types.String `tfsdk:"type"`
 	ExtensionClass                        types.String `tfsdk:"extension_class"`
 	ExtensionArgument                     types.Set    `tfsdk:"extension_argument"`
-	AllIncludedSearchReferenceCriteria    types.Set    `tfsdk:"all_included_search_reference_criteria"`
-	AnyIncludedSearchReferenceCriteria    types.Set    `tfsdk:"any_included_search_reference_criteria"`
-	NotAllIncludedSearchReferenceCriteria types.Set    `tfsdk:"not_all_included_search_reference_criteria"`
-	NoneIncludedSearchReferenceCriteria   types.Set    `tfsdk:"none_included_search_reference_criteria"`
+	AllIncludedSearchReferenceCriteria    types.List   `tfsdk:"all_included_search_reference_criteria"`
+	AnyIncludedSearchReferenceCriteria    types.List   `tfsdk:"any_included_search_reference_criteria"`
+	NotAllIncludedSearchReferenceCriteria types.List   `tfsdk:"not_all_included_search_reference_criteria"`
+	NoneIncludedSearchReferenceCriteria   types.List   `tfsdk:"none_included_search_reference_criteria"`
 	RequestCriteria                       types.String `tfsdk:"request_criteria"`
 	AllIncludedReferenceControl           types.Set    `tfsdk:"all_included_reference_control"`
 	AnyIncludedReferenceControl           types.Set    `tfsdk:"any_included_reference_control"`
@@ -138,32 +140,32 @@ func searchReferenceCriteriaSchema(ctx context.Context, req resource.SchemaReque
 				Default:     internaltypes.EmptySetDefault(types.StringType),
 				ElementType: types.StringType,
 			},
-			"all_included_search_reference_criteria": schema.SetAttribute{
+			"all_included_search_reference_criteria": schema.ListAttribute{
 				Description: "Specifies a search reference criteria object that must match the associated search result reference in order to match the aggregate search reference criteria. If one or more all-included search reference criteria objects are provided, then a search result reference must match all of them in order to match the aggregate search reference criteria.",
 				Optional:    true,
 				Computed:    true,
-				Default:     internaltypes.EmptySetDefault(types.StringType),
+				Default:     internaltypes.EmptyListDefault(types.StringType),
 				ElementType: types.StringType,
 			},
-			"any_included_search_reference_criteria": schema.SetAttribute{
+			"any_included_search_reference_criteria": schema.ListAttribute{
 				Description: "Specifies a search reference criteria object that may match the associated search result reference in order to match the aggregate search reference criteria. If one or more any-included search reference criteria objects are provided, then a search result reference must match at least one of them in order to match the aggregate search reference criteria.",
 				Optional:    true,
 				Computed:    true,
-				Default:     internaltypes.EmptySetDefault(types.StringType),
+				Default:     internaltypes.EmptyListDefault(types.StringType),
 				ElementType: types.StringType,
 			},
-			"not_all_included_search_reference_criteria": schema.SetAttribute{
+			"not_all_included_search_reference_criteria": schema.ListAttribute{
 				Description: "Specifies a search reference criteria object that should not match the associated search result reference in order to match the aggregate search reference criteria. If one or more not-all-included search reference criteria objects are provided, then a search result reference must not match all of them (that is, it may match zero or more of them, but it must not match all of them) in order to match the aggregate search reference criteria.",
 				Optional:    true,
 				Computed:    true,
-				Default:     internaltypes.EmptySetDefault(types.StringType),
+				Default:     internaltypes.EmptyListDefault(types.StringType),
 				ElementType: types.StringType,
 			},
-			"none_included_search_reference_criteria": schema.SetAttribute{
+			"none_included_search_reference_criteria": schema.ListAttribute{
 				Description: "Specifies a search reference criteria object that must not match the associated search result reference in order to match the aggregate search reference criteria. If one or more none-included search reference criteria objects are provided, then a search result reference must not match any of them in order to match the aggregate search reference criteria.",
 				Optional:    true,
 				Computed:    true,
-				Default:     internaltypes.EmptySetDefault(types.StringType),
+				Default:     internaltypes.EmptyListDefault(types.StringType),
 				ElementType: types.StringType,
 			},
 			"request_criteria": schema.StringAttribute{
@@ -380,10 +382,10 @@ func populateSearchReferenceCriteriaUnknownValues(model *searchReferenceCriteria
 		model.AllIncludedReferenceControl, _ = types.SetValue(types.StringType, []attr.Value{})
 	}
 	if model.AnyIncludedSearchReferenceCriteria.IsUnknown() || model.AnyIncludedSearchReferenceCriteria.IsNull() {
-		model.AnyIncludedSearchReferenceCriteria, _ = types.SetValue(types.StringType, []attr.Value{})
+		model.AnyIncludedSearchReferenceCriteria, _ = types.ListValue(types.StringType, []attr.Value{})
 	}
 	if model.NotAllIncludedSearchReferenceCriteria.IsUnknown() || model.NotAllIncludedSearchReferenceCriteria.IsNull() {
-		model.NotAllIncludedSearchReferenceCriteria, _ = types.SetValue(types.StringType, []attr.Value{})
+		model.NotAllIncludedSearchReferenceCriteria, _ = types.ListValue(types.StringType, []attr.Value{})
 	}
 	if model.NoneIncludedReferenceControl.IsUnknown() || model.NoneIncludedReferenceControl.IsNull() {
 		model.NoneIncludedReferenceControl, _ = types.SetValue(types.StringType, []attr.Value{})
@@ -395,13 +397,13 @@ func populateSearchReferenceCriteriaUnknownValues(model *searchReferenceCriteria
 		model.NotAllIncludedReferenceControl, _ = types.SetValue(types.StringType, []attr.Value{})
 	}
 	if model.NoneIncludedSearchReferenceCriteria.IsUnknown() || model.NoneIncludedSearchReferenceCriteria.IsNull() {
-		model.NoneIncludedSearchReferenceCriteria, _ = types.SetValue(types.StringType, []attr.Value{})
+		model.NoneIncludedSearchReferenceCriteria, _ = types.ListValue(types.StringType, []attr.Value{})
 	}
 	if model.AnyIncludedReferenceControl.IsUnknown() || model.AnyIncludedReferenceControl.IsNull() {
 		model.AnyIncludedReferenceControl, _ = types.SetValue(types.StringType, []attr.Value{})
 	}
 	if model.AllIncludedSearchReferenceCriteria.IsUnknown() || model.AllIncludedSearchReferenceCriteria.IsNull() {
-		model.AllIncludedSearchReferenceCriteria, _ = types.SetValue(types.StringType, []attr.Value{})
+		model.AllIncludedSearchReferenceCriteria, _ = types.ListValue(types.StringType, []attr.Value{})
 	}
 }
 
@@ -438,10 +440,10 @@ func readAggregateSearchReferenceCriteriaResponse(ctx context.Context, r *client
 	state.Type = types.StringValue("aggregate")
 	state.Id = types.StringValue(r.Id)
 	state.Name = types.StringValue(r.Id)
-	state.AllIncludedSearchReferenceCriteria = internaltypes.GetStringSet(r.AllIncludedSearchReferenceCriteria)
-	state.AnyIncludedSearchReferenceCriteria = internaltypes.GetStringSet(r.AnyIncludedSearchReferenceCriteria)
-	state.NotAllIncludedSearchReferenceCriteria = internaltypes.GetStringSet(r.NotAllIncludedSearchReferenceCriteria)
-	state.NoneIncludedSearchReferenceCriteria = internaltypes.GetStringSet(r.NoneIncludedSearchReferenceCriteria)
+	state.AllIncludedSearchReferenceCriteria = internaltypes.GetStringList(r.AllIncludedSearchReferenceCriteria)
+	state.AnyIncludedSearchReferenceCriteria = internaltypes.GetStringList(r.AnyIncludedSearchReferenceCriteria)
+	state.NotAllIncludedSearchReferenceCriteria = internaltypes.GetStringList(r.NotAllIncludedSearchReferenceCriteria)
+	state.NoneIncludedSearchReferenceCriteria = internaltypes.GetStringList(r.NoneIncludedSearchReferenceCriteria)
 	state.Description = internaltypes.StringTypeOrNil(r.Description, internaltypes.IsEmptyString(expectedValues.Description))
 	state.Notifications, state.RequiredActions = config.ReadMessages(ctx, r.Urnpingidentityschemasconfigurationmessages20, diagnostics)
 	populateSearchReferenceCriteriaUnknownValues(state)
@@ -464,10 +466,10 @@ func createSearchReferenceCriteriaOperations(plan searchReferenceCriteriaResourc
 	var ops []client.Operation
 	operations.AddStringOperationIfNecessary(&ops, plan.ExtensionClass, state.ExtensionClass, "extension-class")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.ExtensionArgument, state.ExtensionArgument, "extension-argument")
-	operations.AddStringSetOperationsIfNecessary(&ops, plan.AllIncludedSearchReferenceCriteria, state.AllIncludedSearchReferenceCriteria, "all-included-search-reference-criteria")
-	operations.AddStringSetOperationsIfNecessary(&ops, plan.AnyIncludedSearchReferenceCriteria, state.AnyIncludedSearchReferenceCriteria, "any-included-search-reference-criteria")
-	operations.AddStringSetOperationsIfNecessary(&ops, plan.NotAllIncludedSearchReferenceCriteria, state.NotAllIncludedSearchReferenceCriteria, "not-all-included-search-reference-criteria")
-	operations.AddStringSetOperationsIfNecessary(&ops, plan.NoneIncludedSearchReferenceCriteria, state.NoneIncludedSearchReferenceCriteria, "none-included-search-reference-criteria")
+	operations.AddStringListOperationsIfNecessary(&ops, plan.AllIncludedSearchReferenceCriteria, state.AllIncludedSearchReferenceCriteria, "all-included-search-reference-criteria")
+	operations.AddStringListOperationsIfNecessary(&ops, plan.AnyIncludedSearchReferenceCriteria, state.AnyIncludedSearchReferenceCriteria, "any-included-search-reference-criteria")
+	operations.AddStringListOperationsIfNecessary(&ops, plan.NotAllIncludedSearchReferenceCriteria, state.NotAllIncludedSearchReferenceCriteria, "not-all-included-search-reference-criteria")
+	operations.AddStringListOperationsIfNecessary(&ops, plan.NoneIncludedSearchReferenceCriteria, state.NoneIncludedSearchReferenceCriteria, "none-included-search-reference-criteria")
 	operations.AddStringOperationIfNecessary(&ops, plan.RequestCriteria, state.RequestCriteria, "request-criteria")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.AllIncludedReferenceControl, state.AllIncludedReferenceControl, "all-included-reference-control")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.AnyIncludedReferenceControl, state.AnyIncludedReferenceControl, "any-included-reference-control")
@@ -494,7 +496,7 @@ func (r *searchReferenceCriteriaResource) CreateSimpleSearchReferenceCriteria(ct
 
 	addResponse, httpResp, err := r.apiClient.SearchReferenceCriteriaAPI.AddSearchReferenceCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Search Reference Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Search Reference Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -527,7 +529,7 @@ func (r *searchReferenceCriteriaResource) CreateAggregateSearchReferenceCriteria
 
 	addResponse, httpResp, err := r.apiClient.SearchReferenceCriteriaAPI.AddSearchReferenceCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Search Reference Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Search Reference Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -561,7 +563,7 @@ func (r *searchReferenceCriteriaResource) CreateThirdPartySearchReferenceCriteri
 
 	addResponse, httpResp, err := r.apiClient.SearchReferenceCriteriaAPI.AddSearchReferenceCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Search Reference Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Search Reference Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -608,6 +610,8 @@ func (r *searchReferenceCriteriaResource) Create(ctx context.Context, req resour
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Search Reference Criteria", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -632,7 +636,7 @@ func (r *defaultSearchReferenceCriteriaResource) Create(ctx context.Context, req
 	readResponse, httpResp, err := r.apiClient.SearchReferenceCriteriaAPI.GetSearchReferenceCriteria(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Search Reference Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Search Reference Criteria", err, httpResp)
 		return
 	}
 
@@ -664,7 +668,7 @@ func (r *defaultSearchReferenceCriteriaResource) Create(ctx context.Context, req
 
 		updateResponse, httpResp, err := r.apiClient.SearchReferenceCriteriaAPI.UpdateSearchReferenceCriteriaExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Search Reference Criteria", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Search Reference Criteria", err, httpResp)
 			return
 		}
 
@@ -684,6 +688,8 @@ func (r *defaultSearchReferenceCriteriaResource) Create(ctx context.Context, req
 		if updateResponse.ThirdPartySearchReferenceCriteriaResponse != nil {
 			readThirdPartySearchReferenceCriteriaResponse(ctx, updateResponse.ThirdPartySearchReferenceCriteriaResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Search Reference Criteria", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -783,7 +789,7 @@ func updateSearchReferenceCriteria(ctx context.Context, req resource.UpdateReque
 
 		updateResponse, httpResp, err := apiClient.SearchReferenceCriteriaAPI.UpdateSearchReferenceCriteriaExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Search Reference Criteria", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Search Reference Criteria", err, httpResp)
 			return
 		}
 
@@ -803,6 +809,8 @@ func updateSearchReferenceCriteria(ctx context.Context, req resource.UpdateReque
 		if updateResponse.ThirdPartySearchReferenceCriteriaResponse != nil {
 			readThirdPartySearchReferenceCriteriaResponse(ctx, updateResponse.ThirdPartySearchReferenceCriteriaResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Search Reference Criteria", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -850,3 +858,16 @@ func importSearchReferenceCriteria(ctx context.Context, req resource.ImportState
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *searchReferenceCriteriaResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_search_reference_criteria"),
+	}
+}
+
+func (r *defaultSearchReferenceCriteriaResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_search_reference_criteria"),
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package config
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Private state key used to store fingerprints of secrets applied by the provider
+const secretFingerprintsPrivateStateKey = "secret_fingerprints"
+
+// Private state data stored by the framework for a resource
+type PrivateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// Salted fingerprint of a secret applied by the provider, along with a salted fingerprint of the server-side
+// evidence that was observed right after the secret was applied. The secret itself is never stored.
+type secretFingerprint struct {
+	Salt     string `json:"salt"`
+	Secret   string `json:"secret"`
+	Evidence string `json:"evidence"`
+}
+
+func saltedHash(salt, value string) string {
+	hash := sha256.Sum256([]byte(salt + value))
+	return hex.EncodeToString(hash[:])
+}
+
+func readSecretFingerprints(ctx context.Context, private PrivateState, diagnostics *diag.Diagnostics) map[string]secretFingerprint {
+	fingerprints := map[string]secretFingerprint{}
+	data, diags := private.GetKey(ctx, secretFingerprintsPrivateStateKey)
+	diagnostics.Append(diags...)
+	if len(data) == 0 {
+		return fingerprints
+	}
+	err := json.Unmarshal(data, &fingerprints)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to read secret fingerprints from private state: "+err.Error())
+		return map[string]secretFingerprint{}
+	}
+	return fingerprints
+}
+
+// Record a fingerprint of a secret that was just applied to PingDirectory, along with the server-side evidence returned
+// in the response. If the server doesn't expose any evidence for the secret, nothing is recorded.
+func RecordSecretFingerprint(ctx context.Context, private PrivateState, attributeName, secret string, evidence *string, diagnostics *diag.Diagnostics) {
+	if secret == "" || evidence == nil || *evidence == "" {
+		return
+	}
+
+	saltBytes := make([]byte, 16)
+	_, err := rand.Read(saltBytes)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to generate salt for secret fingerprint: "+err.Error())
+		return
+	}
+	salt := hex.EncodeToString(saltBytes)
+
+	fingerprints := readSecretFingerprints(ctx, private, diagnostics)
+	fingerprints[attributeName] = secretFingerprint{
+		Salt:     salt,
+		Secret:   saltedHash(salt, secret),
+		Evidence: saltedHash(salt, *evidence),
+	}
+	data, err := json.Marshal(fingerprints)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to write secret fingerprints to private state: "+err.Error())
+		return
+	}
+	diagnostics.Append(private.SetKey(ctx, secretFingerprintsPrivateStateKey, data)...)
+}
+
+// Determine if a secret previously applied by the provider has been changed outside of Terraform, by comparing the
+// current server-side evidence with the evidence recorded when the secret was applied. A warning is added when a change is found.
+func SecretChangedOutsideTerraform(ctx context.Context, private PrivateState, attributeName string, evidence *string, diagnostics *diag.Diagnostics) bool {
+	if evidence == nil || *evidence == "" {
+		return false
+	}
+
+	fingerprint, ok := readSecretFingerprints(ctx, private, diagnostics)[attributeName]
+	if !ok || fingerprint.Evidence == saltedHash(fingerprint.Salt, *evidence) {
+		return false
+	}
+
+	tflog.Warn(ctx, "Secret attribute '"+attributeName+"' was changed outside of Terraform")
+	diagnostics.AddWarning("Secret changed outside of Terraform",
+		"The '"+attributeName+"' value applied by Terraform no longer matches the value on the PingDirectory server. The configured value will be applied again.")
+	return true
+}
+
+// Get the evidence for a password of a Root DN User or Topology Admin User. The Configuration API doesn't expose
+// when the password was last changed, so the encoded password returned by the server is used instead. The server
+// may encode the same password again, for example after the storage scheme of the password policy changes, which
+// is then reported as a change made outside of Terraform and the configured password is applied again.
+func EncodedPasswordEvidence(encodedPassword *string) *string {
+	return encodedPassword
+}
+
+// Get the public key of the first certificate in a PEM-encoded certificate chain, to use as evidence for the associated private key
+func CertificatePublicKeyEvidence(certificateChain *string) *string {
+	if certificateChain == nil {
+		return nil
+	}
+	block, _ := pem.Decode([]byte(*certificateChain))
+	if block == nil {
+		return nil
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil
+	}
+	publicKey := base64.StdEncoding.EncodeToString(cert.RawSubjectPublicKeyInfo)
+	return &publicKey
+}
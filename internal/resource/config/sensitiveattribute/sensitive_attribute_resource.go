@@ -28,9 +28,11 @@ var (
 	_ resource.Resource                = &sensitiveAttributeResource{}
 	_ resource.ResourceWithConfigure   = &sensitiveAttributeResource{}
 	_ resource.ResourceWithImportState = &sensitiveAttributeResource{}
+	_ resource.ResourceWithMoveState   = &sensitiveAttributeResource{}
 	_ resource.Resource                = &defaultSensitiveAttributeResource{}
 	_ resource.ResourceWithConfigure   = &defaultSensitiveAttributeResource{}
 	_ resource.ResourceWithImportState = &defaultSensitiveAttributeResource{}
+	_ resource.ResourceWithMoveState   = &defaultSensitiveAttributeResource{}
 )
 
 // Create a Sensitive Attribute resource
@@ -331,7 +333,7 @@ func (r *sensitiveAttributeResource) CreateSensitiveAttribute(ctx context.Contex
 
 	addResponse, httpResp, err := r.apiClient.SensitiveAttributeAPI.AddSensitiveAttributeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Sensitive Attribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Sensitive Attribute", err, httpResp)
 		return nil, err
 	}
 
@@ -362,6 +364,8 @@ func (r *sensitiveAttributeResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Sensitive Attribute", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -386,7 +390,7 @@ func (r *defaultSensitiveAttributeResource) Create(ctx context.Context, req reso
 	readResponse, httpResp, err := r.apiClient.SensitiveAttributeAPI.GetSensitiveAttribute(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Sensitive Attribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Sensitive Attribute", err, httpResp)
 		return
 	}
 
@@ -410,7 +414,7 @@ func (r *defaultSensitiveAttributeResource) Create(ctx context.Context, req reso
 
 		updateResponse, httpResp, err := r.apiClient.SensitiveAttributeAPI.UpdateSensitiveAttributeExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Sensitive Attribute", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Sensitive Attribute", err, httpResp)
 			return
 		}
 
@@ -422,6 +426,8 @@ func (r *defaultSensitiveAttributeResource) Create(ctx context.Context, req reso
 
 		// Read the response
 		readSensitiveAttributeResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Sensitive Attribute", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -513,7 +519,7 @@ func updateSensitiveAttribute(ctx context.Context, req resource.UpdateRequest, r
 
 		updateResponse, httpResp, err := apiClient.SensitiveAttributeAPI.UpdateSensitiveAttributeExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Sensitive Attribute", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Sensitive Attribute", err, httpResp)
 			return
 		}
 
@@ -525,6 +531,8 @@ func updateSensitiveAttribute(ctx context.Context, req resource.UpdateRequest, r
 
 		// Read the response
 		readSensitiveAttributeResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Sensitive Attribute", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -572,3 +580,16 @@ func importSensitiveAttribute(ctx context.Context, req resource.ImportStateReque
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *sensitiveAttributeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_sensitive_attribute"),
+	}
+}
+
+func (r *defaultSensitiveAttributeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_sensitive_attribute"),
+	}
+}
@@ -27,9 +27,11 @@ var (
 	_ resource.Resource                = &serverGroupResource{}
 	_ resource.ResourceWithConfigure   = &serverGroupResource{}
 	_ resource.ResourceWithImportState = &serverGroupResource{}
+	_ resource.ResourceWithMoveState   = &serverGroupResource{}
 	_ resource.Resource                = &defaultServerGroupResource{}
 	_ resource.ResourceWithConfigure   = &defaultServerGroupResource{}
 	_ resource.ResourceWithImportState = &defaultServerGroupResource{}
+	_ resource.ResourceWithMoveState   = &defaultServerGroupResource{}
 )
 
 // Create a Server Group resource
@@ -179,7 +181,7 @@ func (r *serverGroupResource) CreateServerGroup(ctx context.Context, req resourc
 
 	addResponse, httpResp, err := r.apiClient.ServerGroupAPI.AddServerGroupExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Server Group", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Server Group", err, httpResp)
 		return nil, err
 	}
 
@@ -210,6 +212,8 @@ func (r *serverGroupResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Server Group", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -234,7 +238,7 @@ func (r *defaultServerGroupResource) Create(ctx context.Context, req resource.Cr
 	readResponse, httpResp, err := r.apiClient.ServerGroupAPI.GetServerGroup(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Server Group", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Server Group", err, httpResp)
 		return
 	}
 
@@ -258,7 +262,7 @@ func (r *defaultServerGroupResource) Create(ctx context.Context, req resource.Cr
 
 		updateResponse, httpResp, err := r.apiClient.ServerGroupAPI.UpdateServerGroupExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Server Group", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Server Group", err, httpResp)
 			return
 		}
 
@@ -270,6 +274,8 @@ func (r *defaultServerGroupResource) Create(ctx context.Context, req resource.Cr
 
 		// Read the response
 		readServerGroupResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Server Group", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -356,7 +362,7 @@ func updateServerGroup(ctx context.Context, req resource.UpdateRequest, resp *re
 
 		updateResponse, httpResp, err := apiClient.ServerGroupAPI.UpdateServerGroupExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Server Group", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Server Group", err, httpResp)
 			return
 		}
 
@@ -368,6 +374,8 @@ func updateServerGroup(ctx context.Context, req resource.UpdateRequest, resp *re
 
 		// Read the response
 		readServerGroupResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Server Group", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -415,3 +423,16 @@ func importServerGroup(ctx context.Context, req resource.ImportStateRequest, res
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *serverGroupResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_server_group"),
+	}
+}
+
+func (r *defaultServerGroupResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_server_group"),
+	}
+}
@@ -652,7 +652,7 @@ func (r *serverInstanceResource) Create(ctx context.Context, req resource.Create
 	readResponse, httpResp, err := r.apiClient.ServerInstanceAPI.GetServerInstance(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Server Instance", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Server Instance", err, httpResp)
 		return
 	}
 
@@ -690,7 +690,7 @@ func (r *serverInstanceResource) Create(ctx context.Context, req resource.Create
 
 		updateResponse, httpResp, err := r.apiClient.ServerInstanceAPI.UpdateServerInstanceExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Server Instance", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Server Instance", err, httpResp)
 			return
 		}
 
@@ -716,6 +716,8 @@ func (r *serverInstanceResource) Create(ctx context.Context, req resource.Create
 		if updateResponse.SyncServerInstanceResponse != nil {
 			readSyncServerInstanceResponse(ctx, updateResponse.SyncServerInstanceResponse, &state, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Server Instance", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -795,7 +797,7 @@ func (r *serverInstanceResource) Update(ctx context.Context, req resource.Update
 
 		updateResponse, httpResp, err := r.apiClient.ServerInstanceAPI.UpdateServerInstanceExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Server Instance", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Server Instance", err, httpResp)
 			return
 		}
 
@@ -821,6 +823,8 @@ func (r *serverInstanceResource) Update(ctx context.Context, req resource.Update
 		if updateResponse.SyncServerInstanceResponse != nil {
 			readSyncServerInstanceResponse(ctx, updateResponse.SyncServerInstanceResponse, &state, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Server Instance", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -49,29 +49,21 @@ func (r *serverInstancesDataSource) Configure(_ context.Context, req datasource.
 }
 
 type serverInstancesDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *serverInstancesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Server Instance objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Server Instance objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewServerInstanceDataSource(), "Server Instance")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *serverInstancesDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewServerInstanceDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.ServerInstanceAPI.ListServerInstances(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.ServerInstanceAPI.ListServerInstancesExecute(listRequest)
@@ -106,37 +104,35 @@ func (r *serverInstancesDataSource) Read(ctx context.Context, req datasource.Rea
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object serverInstanceDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.MetricsEngineServerInstanceResponse != nil {
-			attributes["id"] = types.StringValue(response.MetricsEngineServerInstanceResponse.Id)
-			attributes["type"] = types.StringValue("metrics-engine")
+			readMetricsEngineServerInstanceResponseDataSource(ctx, response.MetricsEngineServerInstanceResponse, &object, &resp.Diagnostics)
 		}
 		if response.ProxyServerInstanceResponse != nil {
-			attributes["id"] = types.StringValue(response.ProxyServerInstanceResponse.Id)
-			attributes["type"] = types.StringValue("proxy")
+			readProxyServerInstanceResponseDataSource(ctx, response.ProxyServerInstanceResponse, &object, &resp.Diagnostics)
 		}
 		if response.AuthorizeServerInstanceResponse != nil {
-			attributes["id"] = types.StringValue(response.AuthorizeServerInstanceResponse.Id)
-			attributes["type"] = types.StringValue("authorize")
+			readAuthorizeServerInstanceResponseDataSource(ctx, response.AuthorizeServerInstanceResponse, &object, &resp.Diagnostics)
 		}
 		if response.DirectoryServerInstanceResponse != nil {
-			attributes["id"] = types.StringValue(response.DirectoryServerInstanceResponse.Id)
-			attributes["type"] = types.StringValue("directory")
+			readDirectoryServerInstanceResponseDataSource(ctx, response.DirectoryServerInstanceResponse, &object, &resp.Diagnostics)
 		}
 		if response.SyncServerInstanceResponse != nil {
-			attributes["id"] = types.StringValue(response.SyncServerInstanceResponse.Id)
-			attributes["type"] = types.StringValue("sync")
+			readSyncServerInstanceResponseDataSource(ctx, response.SyncServerInstanceResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -246,7 +246,7 @@ func (r *serverInstanceListenerResource) Create(ctx context.Context, req resourc
 	readResponse, httpResp, err := r.apiClient.ServerInstanceListenerAPI.GetServerInstanceListener(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString(), plan.ServerInstanceName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Server Instance Listener", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Server Instance Listener", err, httpResp)
 		return
 	}
 
@@ -275,7 +275,7 @@ func (r *serverInstanceListenerResource) Create(ctx context.Context, req resourc
 
 		updateResponse, httpResp, err := r.apiClient.ServerInstanceListenerAPI.UpdateServerInstanceListenerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Server Instance Listener", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Server Instance Listener", err, httpResp)
 			return
 		}
 
@@ -292,6 +292,8 @@ func (r *serverInstanceListenerResource) Create(ctx context.Context, req resourc
 		if updateResponse.HttpServerInstanceListenerResponse != nil {
 			readHttpServerInstanceListenerResponse(ctx, updateResponse.HttpServerInstanceListenerResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Server Instance Listener", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -363,7 +365,7 @@ func (r *serverInstanceListenerResource) Update(ctx context.Context, req resourc
 
 		updateResponse, httpResp, err := r.apiClient.ServerInstanceListenerAPI.UpdateServerInstanceListenerExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Server Instance Listener", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Server Instance Listener", err, httpResp)
 			return
 		}
 
@@ -380,6 +382,8 @@ func (r *serverInstanceListenerResource) Update(ctx context.Context, req resourc
 		if updateResponse.HttpServerInstanceListenerResponse != nil {
 			readHttpServerInstanceListenerResponse(ctx, updateResponse.HttpServerInstanceListenerResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Server Instance Listener", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
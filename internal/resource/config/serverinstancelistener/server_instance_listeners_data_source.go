@@ -50,9 +50,11 @@ func (r *serverInstanceListenersDataSource) Configure(_ context.Context, req dat
 
 type serverInstanceListenersDataSourceModel struct {
 	Id                 types.String `tfsdk:"id"`
-	Filter             types.String `tfsdk:"filter"`
 	Objects            types.Set    `tfsdk:"objects"`
+	ObjectsById        types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes  types.Bool   `tfsdk:"include_attributes"`
 	ServerInstanceName types.String `tfsdk:"server_instance_name"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
@@ -64,19 +66,10 @@ func (r *serverInstanceListenersDataSource) Schema(ctx context.Context, req data
 				Description: "Name of the parent Server Instance",
 				Required:    true,
 			},
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Server Instance Listener objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
 		},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewServerInstanceListenerDataSource(), "Server Instance Listener")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -91,9 +84,15 @@ func (r *serverInstanceListenersDataSource) Read(ctx context.Context, req dataso
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewServerInstanceListenerDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.ServerInstanceListenerAPI.ListServerInstanceListeners(config.ProviderBasicAuthContext(ctx, r.providerConfig), state.ServerInstanceName.ValueString())
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.ServerInstanceListenerAPI.ListServerInstanceListenersExecute(listRequest)
@@ -111,25 +110,27 @@ func (r *serverInstanceListenersDataSource) Read(ctx context.Context, req dataso
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object serverInstanceListenerDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.LdapServerInstanceListenerResponse != nil {
-			attributes["id"] = types.StringValue(response.LdapServerInstanceListenerResponse.Id)
-			attributes["type"] = types.StringValue("ldap")
+			readLdapServerInstanceListenerResponseDataSource(ctx, response.LdapServerInstanceListenerResponse, &object, &resp.Diagnostics)
 		}
 		if response.HttpServerInstanceListenerResponse != nil {
-			attributes["id"] = types.StringValue(response.HttpServerInstanceListenerResponse.Id)
-			attributes["type"] = types.StringValue("http")
+			readHttpServerInstanceListenerResponseDataSource(ctx, response.HttpServerInstanceListenerResponse, &object, &resp.Diagnostics)
+		}
+		object.ServerInstanceName = state.ServerInstanceName
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
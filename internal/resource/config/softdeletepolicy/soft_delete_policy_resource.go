@@ -27,9 +27,11 @@ var (
 	_ resource.Resource                = &softDeletePolicyResource{}
 	_ resource.ResourceWithConfigure   = &softDeletePolicyResource{}
 	_ resource.ResourceWithImportState = &softDeletePolicyResource{}
+	_ resource.ResourceWithMoveState   = &softDeletePolicyResource{}
 	_ resource.Resource                = &defaultSoftDeletePolicyResource{}
 	_ resource.ResourceWithConfigure   = &defaultSoftDeletePolicyResource{}
 	_ resource.ResourceWithImportState = &defaultSoftDeletePolicyResource{}
+	_ resource.ResourceWithMoveState   = &defaultSoftDeletePolicyResource{}
 )
 
 // Create a Soft Delete Policy resource
@@ -236,7 +238,7 @@ func (r *softDeletePolicyResource) CreateSoftDeletePolicy(ctx context.Context, r
 
 	addResponse, httpResp, err := r.apiClient.SoftDeletePolicyAPI.AddSoftDeletePolicyExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Soft Delete Policy", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Soft Delete Policy", err, httpResp)
 		return nil, err
 	}
 
@@ -267,6 +269,8 @@ func (r *softDeletePolicyResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Soft Delete Policy", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -291,7 +295,7 @@ func (r *defaultSoftDeletePolicyResource) Create(ctx context.Context, req resour
 	readResponse, httpResp, err := r.apiClient.SoftDeletePolicyAPI.GetSoftDeletePolicy(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Soft Delete Policy", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Soft Delete Policy", err, httpResp)
 		return
 	}
 
@@ -315,7 +319,7 @@ func (r *defaultSoftDeletePolicyResource) Create(ctx context.Context, req resour
 
 		updateResponse, httpResp, err := r.apiClient.SoftDeletePolicyAPI.UpdateSoftDeletePolicyExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Soft Delete Policy", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Soft Delete Policy", err, httpResp)
 			return
 		}
 
@@ -327,6 +331,8 @@ func (r *defaultSoftDeletePolicyResource) Create(ctx context.Context, req resour
 
 		// Read the response
 		readSoftDeletePolicyResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Soft Delete Policy", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -418,7 +424,7 @@ func updateSoftDeletePolicy(ctx context.Context, req resource.UpdateRequest, res
 
 		updateResponse, httpResp, err := apiClient.SoftDeletePolicyAPI.UpdateSoftDeletePolicyExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Soft Delete Policy", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Soft Delete Policy", err, httpResp)
 			return
 		}
 
@@ -430,6 +436,8 @@ func updateSoftDeletePolicy(ctx context.Context, req resource.UpdateRequest, res
 
 		// Read the response
 		readSoftDeletePolicyResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Soft Delete Policy", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -477,3 +485,16 @@ func importSoftDeletePolicy(ctx context.Context, req resource.ImportStateRequest
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *softDeletePolicyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_soft_delete_policy"),
+	}
+}
+
+func (r *defaultSoftDeletePolicyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_soft_delete_policy"),
+	}
+}
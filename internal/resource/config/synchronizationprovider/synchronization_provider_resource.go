@@ -173,7 +173,7 @@ func (r *synchronizationProviderResource) Create(ctx context.Context, req resour
 	readResponse, httpResp, err := r.apiClient.SynchronizationProviderAPI.GetSynchronizationProvider(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Synchronization Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Synchronization Provider", err, httpResp)
 		return
 	}
 
@@ -202,7 +202,7 @@ func (r *synchronizationProviderResource) Create(ctx context.Context, req resour
 
 		updateResponse, httpResp, err := r.apiClient.SynchronizationProviderAPI.UpdateSynchronizationProviderExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Synchronization Provider", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Synchronization Provider", err, httpResp)
 			return
 		}
 
@@ -219,6 +219,8 @@ func (r *synchronizationProviderResource) Create(ctx context.Context, req resour
 		if updateResponse.CustomSynchronizationProviderResponse != nil {
 			readCustomSynchronizationProviderResponse(ctx, updateResponse.CustomSynchronizationProviderResponse, &state, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Synchronization Provider", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -289,7 +291,7 @@ func (r *synchronizationProviderResource) Update(ctx context.Context, req resour
 
 		updateResponse, httpResp, err := r.apiClient.SynchronizationProviderAPI.UpdateSynchronizationProviderExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Synchronization Provider", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Synchronization Provider", err, httpResp)
 			return
 		}
 
@@ -306,6 +308,8 @@ func (r *synchronizationProviderResource) Update(ctx context.Context, req resour
 		if updateResponse.CustomSynchronizationProviderResponse != nil {
 			readCustomSynchronizationProviderResponse(ctx, updateResponse.CustomSynchronizationProviderResponse, &state, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Synchronization Provider", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -30,9 +30,11 @@ var (
 	_ resource.Resource                = &tokenClaimValidationResource{}
 	_ resource.ResourceWithConfigure   = &tokenClaimValidationResource{}
 	_ resource.ResourceWithImportState = &tokenClaimValidationResource{}
+	_ resource.ResourceWithMoveState   = &tokenClaimValidationResource{}
 	_ resource.Resource                = &defaultTokenClaimValidationResource{}
 	_ resource.ResourceWithConfigure   = &defaultTokenClaimValidationResource{}
 	_ resource.ResourceWithImportState = &defaultTokenClaimValidationResource{}
+	_ resource.ResourceWithMoveState   = &defaultTokenClaimValidationResource{}
 )
 
 // Create a Token Claim Validation resource
@@ -357,7 +359,7 @@ func (r *tokenClaimValidationResource) CreateStringArrayTokenClaimValidation(ctx
 
 	addResponse, httpResp, err := r.apiClient.TokenClaimValidationAPI.AddTokenClaimValidationExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Token Claim Validation", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Token Claim Validation", err, httpResp)
 		return nil, err
 	}
 
@@ -397,7 +399,7 @@ func (r *tokenClaimValidationResource) CreateBooleanTokenClaimValidation(ctx con
 
 	addResponse, httpResp, err := r.apiClient.TokenClaimValidationAPI.AddTokenClaimValidationExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Token Claim Validation", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Token Claim Validation", err, httpResp)
 		return nil, err
 	}
 
@@ -434,7 +436,7 @@ func (r *tokenClaimValidationResource) CreateStringTokenClaimValidation(ctx cont
 
 	addResponse, httpResp, err := r.apiClient.TokenClaimValidationAPI.AddTokenClaimValidationExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Token Claim Validation", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Token Claim Validation", err, httpResp)
 		return nil, err
 	}
 
@@ -483,6 +485,8 @@ func (r *tokenClaimValidationResource) Create(ctx context.Context, req resource.
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Token Claim Validation", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -507,7 +511,7 @@ func (r *defaultTokenClaimValidationResource) Create(ctx context.Context, req re
 	readResponse, httpResp, err := r.apiClient.TokenClaimValidationAPI.GetTokenClaimValidation(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString(), plan.IdTokenValidatorName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Token Claim Validation", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Token Claim Validation", err, httpResp)
 		return
 	}
 
@@ -539,7 +543,7 @@ func (r *defaultTokenClaimValidationResource) Create(ctx context.Context, req re
 
 		updateResponse, httpResp, err := r.apiClient.TokenClaimValidationAPI.UpdateTokenClaimValidationExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Token Claim Validation", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Token Claim Validation", err, httpResp)
 			return
 		}
 
@@ -559,6 +563,8 @@ func (r *defaultTokenClaimValidationResource) Create(ctx context.Context, req re
 		if updateResponse.StringTokenClaimValidationResponse != nil {
 			readStringTokenClaimValidationResponse(ctx, updateResponse.StringTokenClaimValidationResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Token Claim Validation", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -659,7 +665,7 @@ func updateTokenClaimValidation(ctx context.Context, req resource.UpdateRequest,
 
 		updateResponse, httpResp, err := apiClient.TokenClaimValidationAPI.UpdateTokenClaimValidationExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Token Claim Validation", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Token Claim Validation", err, httpResp)
 			return
 		}
 
@@ -679,6 +685,8 @@ func updateTokenClaimValidation(ctx context.Context, req resource.UpdateRequest,
 		if updateResponse.StringTokenClaimValidationResponse != nil {
 			readStringTokenClaimValidationResponse(ctx, updateResponse.StringTokenClaimValidationResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Token Claim Validation", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -733,3 +741,16 @@ func importTokenClaimValidation(ctx context.Context, req resource.ImportStateReq
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id_token_validator_name"), split[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), split[1])...)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *tokenClaimValidationResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_token_claim_validation"),
+	}
+}
+
+func (r *defaultTokenClaimValidationResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_token_claim_validation"),
+	}
+}
@@ -50,9 +50,11 @@ func (r *tokenClaimValidationsDataSource) Configure(_ context.Context, req datas
 
 type tokenClaimValidationsDataSourceModel struct {
 	Id                   types.String `tfsdk:"id"`
-	Filter               types.String `tfsdk:"filter"`
 	Objects              types.Set    `tfsdk:"objects"`
+	ObjectsById          types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes    types.Bool   `tfsdk:"include_attributes"`
 	IdTokenValidatorName types.String `tfsdk:"id_token_validator_name"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
@@ -64,19 +66,10 @@ func (r *tokenClaimValidationsDataSource) Schema(ctx context.Context, req dataso
 				Description: "Name of the parent ID Token Validator",
 				Required:    true,
 			},
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Token Claim Validation objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
 		},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewTokenClaimValidationDataSource(), "Token Claim Validation")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -91,9 +84,15 @@ func (r *tokenClaimValidationsDataSource) Read(ctx context.Context, req datasour
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewTokenClaimValidationDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.TokenClaimValidationAPI.ListTokenClaimValidations(config.ProviderBasicAuthContext(ctx, r.providerConfig), state.IdTokenValidatorName.ValueString())
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.TokenClaimValidationAPI.ListTokenClaimValidationsExecute(listRequest)
@@ -111,29 +110,30 @@ func (r *tokenClaimValidationsDataSource) Read(ctx context.Context, req datasour
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object tokenClaimValidationDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.StringArrayTokenClaimValidationResponse != nil {
-			attributes["id"] = types.StringValue(response.StringArrayTokenClaimValidationResponse.Id)
-			attributes["type"] = types.StringValue("string-array")
+			readStringArrayTokenClaimValidationResponseDataSource(ctx, response.StringArrayTokenClaimValidationResponse, &object, &resp.Diagnostics)
 		}
 		if response.BooleanTokenClaimValidationResponse != nil {
-			attributes["id"] = types.StringValue(response.BooleanTokenClaimValidationResponse.Id)
-			attributes["type"] = types.StringValue("boolean")
+			readBooleanTokenClaimValidationResponseDataSource(ctx, response.BooleanTokenClaimValidationResponse, &object, &resp.Diagnostics)
 		}
 		if response.StringTokenClaimValidationResponse != nil {
-			attributes["id"] = types.StringValue(response.StringTokenClaimValidationResponse.Id)
-			attributes["type"] = types.StringValue("string")
+			readStringTokenClaimValidationResponseDataSource(ctx, response.StringTokenClaimValidationResponse, &object, &resp.Diagnostics)
+		}
+		object.IdTokenValidatorName = state.IdTokenValidatorName
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -29,9 +29,11 @@ var (
 	_ resource.Resource                = &topologyAdminUserResource{}
 	_ resource.ResourceWithConfigure   = &topologyAdminUserResource{}
 	_ resource.ResourceWithImportState = &topologyAdminUserResource{}
+	_ resource.ResourceWithMoveState   = &topologyAdminUserResource{}
 	_ resource.Resource                = &defaultTopologyAdminUserResource{}
 	_ resource.ResourceWithConfigure   = &defaultTopologyAdminUserResource{}
 	_ resource.ResourceWithImportState = &defaultTopologyAdminUserResource{}
+	_ resource.ResourceWithMoveState   = &defaultTopologyAdminUserResource{}
 )
 
 // Create a Topology Admin User resource
@@ -94,6 +96,8 @@ type topologyAdminUserResourceModel struct {
 	AlternateBindDN                types.Set    `tfsdk:"alternate_bind_dn"`
 	Description                    types.String `tfsdk:"description"`
 	Password                       types.String `tfsdk:"password"`
+	PasswordWo                     types.String `tfsdk:"password_wo"`
+	PasswordWoVersion              types.Int64  `tfsdk:"password_wo_version"`
 	FirstName                      types.Set    `tfsdk:"first_name"`
 	LastName                       types.Set    `tfsdk:"last_name"`
 	UserID                         types.String `tfsdk:"user_id"`
@@ -160,7 +164,7 @@ func topologyAdminUserSchema(ctx context.Context, req resource.SchemaRequest, re
 				Optional:    true,
 			},
 			"password": schema.StringAttribute{
-				Description: "Specifies the user's password. This is stored in the userPassword LDAP attribute. To set a pre-hashed value, the account making the change must have the bypass-pw-policy privilege.",
+				Description: "Specifies the user's password. This is stored in the userPassword LDAP attribute. To set a pre-hashed value, the account making the change must have the bypass-pw-policy privilege. The provider detects password changes made outside of Terraform by comparing the encoded password on the server, so the server encoding the same password again, such as after a storage scheme change, is also reported as a change and the configured password is applied again.",
 				Optional:    true,
 				Sensitive:   true,
 			},
@@ -360,6 +364,7 @@ func topologyAdminUserSchema(ctx context.Context, req resource.SchemaRequest, re
 			},
 		},
 	}
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "password")
 	if isDefault {
 		typeAttr := schemaDef.Attributes["type"].(schema.StringAttribute)
 		typeAttr.Optional = false
@@ -391,6 +396,10 @@ func addOptionalTopologyAdminUserFields(ctx context.Context, addRequest *client.
 	if internaltypes.IsNonEmptyString(plan.Password) {
 		addRequest.Password = plan.Password.ValueStringPointer()
 	}
+	// Write-only values are read from the config, since they are not included in the plan
+	if internaltypes.IsNonEmptyString(plan.PasswordWo) {
+		addRequest.Password = plan.PasswordWo.ValueStringPointer()
+	}
 	if internaltypes.IsDefined(plan.FirstName) {
 		var slice []string
 		plan.FirstName.ElementsAs(ctx, &slice, false)
@@ -608,6 +617,9 @@ func (state *topologyAdminUserResourceModel) setStateValuesNotReturnedByAPI(expe
 	if !expectedValues.Password.IsUnknown() {
 		state.Password = expectedValues.Password
 	}
+	if !expectedValues.PasswordWoVersion.IsUnknown() {
+		state.PasswordWoVersion = expectedValues.PasswordWoVersion
+	}
 }
 
 // Create any update operations necessary to make the state match the plan
@@ -616,6 +628,7 @@ func createTopologyAdminUserOperations(plan topologyAdminUserResourceModel, stat
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.AlternateBindDN, state.AlternateBindDN, "alternate-bind-dn")
 	operations.AddStringOperationIfNecessary(&ops, plan.Description, state.Description, "description")
 	operations.AddStringOperationIfNecessary(&ops, plan.Password, state.Password, "password")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.PasswordWo, plan.PasswordWoVersion, state.PasswordWoVersion, "password")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.FirstName, state.FirstName, "first-name")
 	operations.AddStringSetOperationsIfNecessary(&ops, plan.LastName, state.LastName, "last-name")
 	operations.AddStringOperationIfNecessary(&ops, plan.UserID, state.UserID, "user-id")
@@ -668,7 +681,7 @@ func (r *topologyAdminUserResource) CreateTopologyAdminUser(ctx context.Context,
 
 	addResponse, httpResp, err := r.apiClient.TopologyAdminUserAPI.AddTopologyAdminUserExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Topology Admin User", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Topology Admin User", err, httpResp)
 		return nil, err
 	}
 
@@ -678,6 +691,11 @@ func (r *topologyAdminUserResource) CreateTopologyAdminUser(ctx context.Context,
 		tflog.Debug(ctx, "Add response: "+string(responseJson))
 	}
 
+	// Record the applied secret so that changes made outside of Terraform can be detected
+	if addRequest.Password != nil {
+		config.RecordSecretFingerprint(ctx, resp.Private, "password", *addRequest.Password, config.EncodedPasswordEvidence(addResponse.Password), &resp.Diagnostics)
+	}
+
 	// Read the response into the state
 	var state topologyAdminUserResourceModel
 	readTopologyAdminUserResponse(ctx, addResponse, &state, &plan, &resp.Diagnostics)
@@ -693,6 +711,12 @@ func (r *topologyAdminUserResource) Create(ctx context.Context, req resource.Cre
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	diags = req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	state, err := r.CreateTopologyAdminUser(ctx, req, resp, plan)
 	if err != nil {
@@ -701,6 +725,8 @@ func (r *topologyAdminUserResource) Create(ctx context.Context, req resource.Cre
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Topology Admin User", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -721,11 +747,17 @@ func (r *defaultTopologyAdminUserResource) Create(ctx context.Context, req resou
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	diags = req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	readResponse, httpResp, err := r.apiClient.TopologyAdminUserAPI.GetTopologyAdminUser(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Topology Admin User", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Topology Admin User", err, httpResp)
 		return
 	}
 
@@ -749,7 +781,7 @@ func (r *defaultTopologyAdminUserResource) Create(ctx context.Context, req resou
 
 		updateResponse, httpResp, err := r.apiClient.TopologyAdminUserAPI.UpdateTopologyAdminUserExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Topology Admin User", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Topology Admin User", err, httpResp)
 			return
 		}
 
@@ -761,6 +793,12 @@ func (r *defaultTopologyAdminUserResource) Create(ctx context.Context, req resou
 
 		// Read the response
 		readTopologyAdminUserResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+		// Record the applied secret so that changes made outside of Terraform can be detected
+		if password, ok := operations.GetReplacedValue(ops, "password"); ok {
+			config.RecordSecretFingerprint(ctx, resp.Private, "password", password, config.EncodedPasswordEvidence(updateResponse.Password), &resp.Diagnostics)
+		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Topology Admin User", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -811,6 +849,12 @@ func readTopologyAdminUser(ctx context.Context, req resource.ReadRequest, resp *
 	// Read the response into the state
 	readTopologyAdminUserResponse(ctx, readResponse, &state, &state, &resp.Diagnostics)
 
+	// Plan to apply the secret again if it was changed outside of Terraform
+	if config.SecretChangedOutsideTerraform(ctx, req.Private, "password", config.EncodedPasswordEvidence(readResponse.Password), &resp.Diagnostics) {
+		state.Password = types.StringNull()
+		state.PasswordWoVersion = types.Int64Null()
+	}
+
 	if isDefault {
 		state.populateAllComputedStringAttributes()
 	}
@@ -837,6 +881,12 @@ func updateTopologyAdminUser(ctx context.Context, req resource.UpdateRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	diags = req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Get the current state to see how any attributes are changing
 	var state topologyAdminUserResourceModel
@@ -853,7 +903,7 @@ func updateTopologyAdminUser(ctx context.Context, req resource.UpdateRequest, re
 
 		updateResponse, httpResp, err := apiClient.TopologyAdminUserAPI.UpdateTopologyAdminUserExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Topology Admin User", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Topology Admin User", err, httpResp)
 			return
 		}
 
@@ -865,6 +915,12 @@ func updateTopologyAdminUser(ctx context.Context, req resource.UpdateRequest, re
 
 		// Read the response
 		readTopologyAdminUserResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+		// Record the applied secret so that changes made outside of Terraform can be detected
+		if password, ok := operations.GetReplacedValue(ops, "password"); ok {
+			config.RecordSecretFingerprint(ctx, resp.Private, "password", password, config.EncodedPasswordEvidence(updateResponse.Password), &resp.Diagnostics)
+		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Topology Admin User", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -913,3 +969,16 @@ func importTopologyAdminUser(ctx context.Context, req resource.ImportStateReques
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *topologyAdminUserResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_topology_admin_user"),
+	}
+}
+
+func (r *defaultTopologyAdminUserResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_topology_admin_user"),
+	}
+}
@@ -49,10 +49,11 @@ func (r *trustedCertificateDataSource) Configure(_ context.Context, req datasour
 }
 
 type trustedCertificateDataSourceModel struct {
-	Id          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Type        types.String `tfsdk:"type"`
-	Certificate types.String `tfsdk:"certificate"`
+	Id                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Type               types.String `tfsdk:"type"`
+	Certificate        types.String `tfsdk:"certificate"`
+	CertificateDetails types.Object `tfsdk:"certificate_details"`
 }
 
 // GetSchema defines the schema for the datasource.
@@ -74,6 +75,7 @@ func (r *trustedCertificateDataSource) Schema(ctx context.Context, req datasourc
 			},
 		},
 	}
+	config.AddCertificateDetailsDataSourceSchema(&schemaDef, "certificate", false)
 	config.AddCommonDataSourceSchema(&schemaDef, true)
 	resp.Schema = schemaDef
 }
@@ -84,6 +86,7 @@ func readTrustedCertificateResponseDataSource(ctx context.Context, r *client.Tru
 	state.Id = types.StringValue(r.Id)
 	state.Name = types.StringValue(r.Id)
 	state.Certificate = types.StringValue(r.Certificate)
+	state.CertificateDetails, _ = config.ReadCertificateDetails(ctx, &r.Certificate, diagnostics)
 }
 
 // Read resource information
@@ -27,9 +27,13 @@ var (
 	_ resource.Resource                = &trustedCertificateResource{}
 	_ resource.ResourceWithConfigure   = &trustedCertificateResource{}
 	_ resource.ResourceWithImportState = &trustedCertificateResource{}
+	_ resource.ResourceWithMoveState   = &trustedCertificateResource{}
+	_ resource.ResourceWithModifyPlan  = &trustedCertificateResource{}
 	_ resource.Resource                = &defaultTrustedCertificateResource{}
 	_ resource.ResourceWithConfigure   = &defaultTrustedCertificateResource{}
 	_ resource.ResourceWithImportState = &defaultTrustedCertificateResource{}
+	_ resource.ResourceWithMoveState   = &defaultTrustedCertificateResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultTrustedCertificateResource{}
 )
 
 // Create a Trusted Certificate resource
@@ -84,12 +88,14 @@ func (r *defaultTrustedCertificateResource) Configure(_ context.Context, req res
 }
 
 type trustedCertificateResourceModel struct {
-	Id              types.String `tfsdk:"id"`
-	Name            types.String `tfsdk:"name"`
-	Notifications   types.Set    `tfsdk:"notifications"`
-	RequiredActions types.Set    `tfsdk:"required_actions"`
-	Type            types.String `tfsdk:"type"`
-	Certificate     types.String `tfsdk:"certificate"`
+	Id                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Notifications         types.Set    `tfsdk:"notifications"`
+	RequiredActions       types.Set    `tfsdk:"required_actions"`
+	Type                  types.String `tfsdk:"type"`
+	Certificate           types.String `tfsdk:"certificate"`
+	CertificateDetails    types.Object `tfsdk:"certificate_details"`
+	ExpirationWarningDays types.Int64  `tfsdk:"expiration_warning_days"`
 }
 
 // GetSchema defines the schema for the resource.
@@ -132,6 +138,7 @@ func trustedCertificateSchema(ctx context.Context, req resource.SchemaRequest, r
 		// Add any default properties and set optional properties to computed where necessary
 		config.SetAttributesToOptionalAndComputedAndRemoveDefaults(&schemaDef, []string{"type"})
 	}
+	config.AddCertificateDetailsSchema(&schemaDef, "certificate", false)
 	config.AddCommonResourceSchema(&schemaDef, true)
 	resp.Schema = schemaDef
 }
@@ -153,9 +160,26 @@ func readTrustedCertificateResponse(ctx context.Context, r *client.TrustedCertif
 	state.Id = types.StringValue(r.Id)
 	state.Name = types.StringValue(r.Id)
 	state.Certificate = types.StringValue(r.Certificate)
+	state.CertificateDetails, _ = config.ReadCertificateDetails(ctx, &r.Certificate, diagnostics)
 	state.Notifications, state.RequiredActions = config.ReadMessages(ctx, r.Urnpingidentityschemasconfigurationmessages20, diagnostics)
 }
 
+// Set any properties that aren't returned by the API in the state, based on some expected value (usually the plan value)
+func (state *trustedCertificateResourceModel) setStateValuesNotReturnedByAPI(expectedValues *trustedCertificateResourceModel) {
+	if !expectedValues.ExpirationWarningDays.IsUnknown() {
+		state.ExpirationWarningDays = expectedValues.ExpirationWarningDays
+	}
+}
+
+// Parse the planned certificate and warn if it expires soon
+func (r *trustedCertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.PlanCertificateDetails(ctx, req, resp, "certificate", false)
+}
+
+func (r *defaultTrustedCertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.PlanCertificateDetails(ctx, req, resp, "certificate", false)
+}
+
 // Create any update operations necessary to make the state match the plan
 func createTrustedCertificateOperations(plan trustedCertificateResourceModel, state trustedCertificateResourceModel) []client.Operation {
 	var ops []client.Operation
@@ -179,7 +203,7 @@ func (r *trustedCertificateResource) CreateTrustedCertificate(ctx context.Contex
 
 	addResponse, httpResp, err := r.apiClient.TrustedCertificateAPI.AddTrustedCertificateExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Trusted Certificate", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Trusted Certificate", err, httpResp)
 		return nil, err
 	}
 
@@ -210,6 +234,10 @@ func (r *trustedCertificateResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	// Populate Computed attribute values
+	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Trusted Certificate", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -234,7 +262,7 @@ func (r *defaultTrustedCertificateResource) Create(ctx context.Context, req reso
 	readResponse, httpResp, err := r.apiClient.TrustedCertificateAPI.GetTrustedCertificate(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Trusted Certificate", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Trusted Certificate", err, httpResp)
 		return
 	}
 
@@ -258,7 +286,7 @@ func (r *defaultTrustedCertificateResource) Create(ctx context.Context, req reso
 
 		updateResponse, httpResp, err := r.apiClient.TrustedCertificateAPI.UpdateTrustedCertificateExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Trusted Certificate", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Trusted Certificate", err, httpResp)
 			return
 		}
 
@@ -270,8 +298,11 @@ func (r *defaultTrustedCertificateResource) Create(ctx context.Context, req reso
 
 		// Read the response
 		readTrustedCertificateResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Trusted Certificate", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
+	state.setStateValuesNotReturnedByAPI(&plan)
 	state.populateAllComputedStringAttributes()
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -361,7 +392,7 @@ func updateTrustedCertificate(ctx context.Context, req resource.UpdateRequest, r
 
 		updateResponse, httpResp, err := apiClient.TrustedCertificateAPI.UpdateTrustedCertificateExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Trusted Certificate", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Trusted Certificate", err, httpResp)
 			return
 		}
 
@@ -373,10 +404,13 @@ func updateTrustedCertificate(ctx context.Context, req resource.UpdateRequest, r
 
 		// Read the response
 		readTrustedCertificateResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Trusted Certificate", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
 
+	state.setStateValuesNotReturnedByAPI(&plan)
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -420,3 +454,16 @@ func importTrustedCertificate(ctx context.Context, req resource.ImportStateReque
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *trustedCertificateResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_trusted_certificate"),
+	}
+}
+
+func (r *defaultTrustedCertificateResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_trusted_certificate"),
+	}
+}
@@ -30,9 +30,11 @@ var (
 	_ resource.Resource                = &trustManagerProviderResource{}
 	_ resource.ResourceWithConfigure   = &trustManagerProviderResource{}
 	_ resource.ResourceWithImportState = &trustManagerProviderResource{}
+	_ resource.ResourceWithMoveState   = &trustManagerProviderResource{}
 	_ resource.Resource                = &defaultTrustManagerProviderResource{}
 	_ resource.ResourceWithConfigure   = &defaultTrustManagerProviderResource{}
 	_ resource.ResourceWithImportState = &defaultTrustManagerProviderResource{}
+	_ resource.ResourceWithMoveState   = &defaultTrustManagerProviderResource{}
 )
 
 // Create a Trust Manager Provider resource
@@ -98,6 +100,8 @@ type trustManagerProviderResourceModel struct {
 	TrustStoreType                  types.String `tfsdk:"trust_store_type"`
 	EnableTrustManagerCaching       types.Bool   `tfsdk:"enable_trust_manager_caching"`
 	TrustStorePin                   types.String `tfsdk:"trust_store_pin"`
+	TrustStorePinWo                 types.String `tfsdk:"trust_store_pin_wo"`
+	TrustStorePinWoVersion          types.Int64  `tfsdk:"trust_store_pin_wo_version"`
 	TrustStorePinFile               types.String `tfsdk:"trust_store_pin_file"`
 	TrustStorePinPassphraseProvider types.String `tfsdk:"trust_store_pin_passphrase_provider"`
 	Enabled                         types.Bool   `tfsdk:"enabled"`
@@ -195,6 +199,7 @@ func trustManagerProviderSchema(ctx context.Context, req resource.SchemaRequest,
 		extensionClassAttr.PlanModifiers = append(extensionClassAttr.PlanModifiers, stringplanmodifier.RequiresReplace())
 		schemaDef.Attributes["extension_class"] = extensionClassAttr
 	}
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "trust_store_pin")
 	config.AddCommonResourceSchema(&schemaDef, true)
 	resp.Schema = schemaDef
 }
@@ -309,6 +314,11 @@ func configValidatorsTrustManagerProvider() []resource.ConfigValidator {
 			path.MatchRoot("type"),
 			[]string{"file-based"},
 		),
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("trust_store_pin_wo"),
+			path.MatchRoot("type"),
+			[]string{"file-based"},
+		),
 		configvalidators.ImpliesOtherAttributeOneOfString(
 			path.MatchRoot("trust_store_pin_file"),
 			path.MatchRoot("type"),
@@ -372,6 +382,9 @@ func addOptionalFileBasedTrustManagerProviderFields(ctx context.Context, addRequ
 	if internaltypes.IsNonEmptyString(plan.TrustStorePin) {
 		addRequest.TrustStorePin = plan.TrustStorePin.ValueStringPointer()
 	}
+	if internaltypes.IsNonEmptyString(plan.TrustStorePinWo) {
+		addRequest.TrustStorePin = plan.TrustStorePinWo.ValueStringPointer()
+	}
 	// Empty strings are treated as equivalent to null
 	if internaltypes.IsNonEmptyString(plan.TrustStorePinFile) {
 		addRequest.TrustStorePinFile = plan.TrustStorePinFile.ValueStringPointer()
@@ -486,6 +499,9 @@ func (state *trustManagerProviderResourceModel) setStateValuesNotReturnedByAPI(e
 	if !expectedValues.TrustStorePin.IsUnknown() {
 		state.TrustStorePin = expectedValues.TrustStorePin
 	}
+	if !expectedValues.TrustStorePinWoVersion.IsUnknown() {
+		state.TrustStorePinWoVersion = expectedValues.TrustStorePinWoVersion
+	}
 }
 
 // Create any update operations necessary to make the state match the plan
@@ -497,6 +513,7 @@ func createTrustManagerProviderOperations(plan trustManagerProviderResourceModel
 	operations.AddStringOperationIfNecessary(&ops, plan.TrustStoreType, state.TrustStoreType, "trust-store-type")
 	operations.AddBoolOperationIfNecessary(&ops, plan.EnableTrustManagerCaching, state.EnableTrustManagerCaching, "enable-trust-manager-caching")
 	operations.AddStringOperationIfNecessary(&ops, plan.TrustStorePin, state.TrustStorePin, "trust-store-pin")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.TrustStorePinWo, plan.TrustStorePinWoVersion, state.TrustStorePinWoVersion, "trust-store-pin")
 	operations.AddStringOperationIfNecessary(&ops, plan.TrustStorePinFile, state.TrustStorePinFile, "trust-store-pin-file")
 	operations.AddStringOperationIfNecessary(&ops, plan.TrustStorePinPassphraseProvider, state.TrustStorePinPassphraseProvider, "trust-store-pin-passphrase-provider")
 	operations.AddBoolOperationIfNecessary(&ops, plan.Enabled, state.Enabled, "enabled")
@@ -522,7 +539,7 @@ func (r *trustManagerProviderResource) CreateBlindTrustManagerProvider(ctx conte
 
 	addResponse, httpResp, err := r.apiClient.TrustManagerProviderAPI.AddTrustManagerProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Trust Manager Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Trust Manager Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -557,7 +574,7 @@ func (r *trustManagerProviderResource) CreateFileBasedTrustManagerProvider(ctx c
 
 	addResponse, httpResp, err := r.apiClient.TrustManagerProviderAPI.AddTrustManagerProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Trust Manager Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Trust Manager Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -591,7 +608,7 @@ func (r *trustManagerProviderResource) CreateJvmDefaultTrustManagerProvider(ctx
 
 	addResponse, httpResp, err := r.apiClient.TrustManagerProviderAPI.AddTrustManagerProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Trust Manager Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Trust Manager Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -626,7 +643,7 @@ func (r *trustManagerProviderResource) CreateThirdPartyTrustManagerProvider(ctx
 
 	addResponse, httpResp, err := r.apiClient.TrustManagerProviderAPI.AddTrustManagerProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Trust Manager Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Trust Manager Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -651,6 +668,11 @@ func (r *trustManagerProviderResource) Create(ctx context.Context, req resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("trust_store_pin_wo"), &plan.TrustStorePinWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	var state *trustManagerProviderResourceModel
 	var err error
@@ -681,6 +703,8 @@ func (r *trustManagerProviderResource) Create(ctx context.Context, req resource.
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Trust Manager Provider", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -701,11 +725,16 @@ func (r *defaultTrustManagerProviderResource) Create(ctx context.Context, req re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("trust_store_pin_wo"), &plan.TrustStorePinWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	readResponse, httpResp, err := r.apiClient.TrustManagerProviderAPI.GetTrustManagerProvider(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Trust Manager Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Trust Manager Provider", err, httpResp)
 		return
 	}
 
@@ -740,7 +769,7 @@ func (r *defaultTrustManagerProviderResource) Create(ctx context.Context, req re
 
 		updateResponse, httpResp, err := r.apiClient.TrustManagerProviderAPI.UpdateTrustManagerProviderExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Trust Manager Provider", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Trust Manager Provider", err, httpResp)
 			return
 		}
 
@@ -763,6 +792,8 @@ func (r *defaultTrustManagerProviderResource) Create(ctx context.Context, req re
 		if updateResponse.ThirdPartyTrustManagerProviderResponse != nil {
 			readThirdPartyTrustManagerProviderResponse(ctx, updateResponse.ThirdPartyTrustManagerProviderResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Trust Manager Provider", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -850,6 +881,11 @@ func updateTrustManagerProvider(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("trust_store_pin_wo"), &plan.TrustStorePinWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Get the current state to see how any attributes are changing
 	var state trustManagerProviderResourceModel
@@ -866,7 +902,7 @@ func updateTrustManagerProvider(ctx context.Context, req resource.UpdateRequest,
 
 		updateResponse, httpResp, err := apiClient.TrustManagerProviderAPI.UpdateTrustManagerProviderExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Trust Manager Provider", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Trust Manager Provider", err, httpResp)
 			return
 		}
 
@@ -889,6 +925,8 @@ func updateTrustManagerProvider(ctx context.Context, req resource.UpdateRequest,
 		if updateResponse.ThirdPartyTrustManagerProviderResponse != nil {
 			readThirdPartyTrustManagerProviderResponse(ctx, updateResponse.ThirdPartyTrustManagerProviderResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Trust Manager Provider", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -937,3 +975,16 @@ func importTrustManagerProvider(ctx context.Context, req resource.ImportStateReq
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *trustManagerProviderResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_trust_manager_provider"),
+	}
+}
+
+func (r *defaultTrustManagerProviderResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_trust_manager_provider"),
+	}
+}
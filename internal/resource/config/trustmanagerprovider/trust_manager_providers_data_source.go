@@ -49,29 +49,21 @@ func (r *trustManagerProvidersDataSource) Configure(_ context.Context, req datas
 }
 
 type trustManagerProvidersDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *trustManagerProvidersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Trust Manager Provider objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Trust Manager Provider objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewTrustManagerProviderDataSource(), "Trust Manager Provider")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *trustManagerProvidersDataSource) Read(ctx context.Context, req datasour
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewTrustManagerProviderDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.TrustManagerProviderAPI.ListTrustManagerProviders(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.TrustManagerProviderAPI.ListTrustManagerProvidersExecute(listRequest)
@@ -106,33 +104,32 @@ func (r *trustManagerProvidersDataSource) Read(ctx context.Context, req datasour
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object trustManagerProviderDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.BlindTrustManagerProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.BlindTrustManagerProviderResponse.Id)
-			attributes["type"] = types.StringValue("blind")
+			readBlindTrustManagerProviderResponseDataSource(ctx, response.BlindTrustManagerProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.JvmDefaultTrustManagerProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.JvmDefaultTrustManagerProviderResponse.Id)
-			attributes["type"] = types.StringValue("jvm-default")
+			readJvmDefaultTrustManagerProviderResponseDataSource(ctx, response.JvmDefaultTrustManagerProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.FileBasedTrustManagerProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.FileBasedTrustManagerProviderResponse.Id)
-			attributes["type"] = types.StringValue("file-based")
+			readFileBasedTrustManagerProviderResponseDataSource(ctx, response.FileBasedTrustManagerProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyTrustManagerProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyTrustManagerProviderResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyTrustManagerProviderResponseDataSource(ctx, response.ThirdPartyTrustManagerProviderResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -49,29 +49,21 @@ func (r *uncachedAttributeCriteriaListDataSource) Configure(_ context.Context, r
 }
 
 type uncachedAttributeCriteriaListDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *uncachedAttributeCriteriaListDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Uncached Attribute Criteria objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Uncached Attribute Criteria objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewUncachedAttributeCriteriaDataSource(), "Uncached Attribute Criteria")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *uncachedAttributeCriteriaListDataSource) Read(ctx context.Context, req
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewUncachedAttributeCriteriaDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.UncachedAttributeCriteriaAPI.ListUncachedAttributeCriteria(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.UncachedAttributeCriteriaAPI.ListUncachedAttributeCriteriaExecute(listRequest)
@@ -106,33 +104,32 @@ func (r *uncachedAttributeCriteriaListDataSource) Read(ctx context.Context, req
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object uncachedAttributeCriteriaDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.DefaultUncachedAttributeCriteriaResponse != nil {
-			attributes["id"] = types.StringValue(response.DefaultUncachedAttributeCriteriaResponse.Id)
-			attributes["type"] = types.StringValue("default")
+			readDefaultUncachedAttributeCriteriaResponseDataSource(ctx, response.DefaultUncachedAttributeCriteriaResponse, &object, &resp.Diagnostics)
 		}
 		if response.SimpleUncachedAttributeCriteriaResponse != nil {
-			attributes["id"] = types.StringValue(response.SimpleUncachedAttributeCriteriaResponse.Id)
-			attributes["type"] = types.StringValue("simple")
+			readSimpleUncachedAttributeCriteriaResponseDataSource(ctx, response.SimpleUncachedAttributeCriteriaResponse, &object, &resp.Diagnostics)
 		}
 		if response.GroovyScriptedUncachedAttributeCriteriaResponse != nil {
-			attributes["id"] = types.StringValue(response.GroovyScriptedUncachedAttributeCriteriaResponse.Id)
-			attributes["type"] = types.StringValue("groovy-scripted")
+			readGroovyScriptedUncachedAttributeCriteriaResponseDataSource(ctx, response.GroovyScriptedUncachedAttributeCriteriaResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyUncachedAttributeCriteriaResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyUncachedAttributeCriteriaResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyUncachedAttributeCriteriaResponseDataSource(ctx, response.ThirdPartyUncachedAttributeCriteriaResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -29,9 +29,11 @@ var (
 	_ resource.Resource                = &uncachedAttributeCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &uncachedAttributeCriteriaResource{}
 	_ resource.ResourceWithImportState = &uncachedAttributeCriteriaResource{}
+	_ resource.ResourceWithMoveState   = &uncachedAttributeCriteriaResource{}
 	_ resource.Resource                = &defaultUncachedAttributeCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &defaultUncachedAttributeCriteriaResource{}
 	_ resource.ResourceWithImportState = &defaultUncachedAttributeCriteriaResource{}
+	_ resource.ResourceWithMoveState   = &defaultUncachedAttributeCriteriaResource{}
 )
 
 // Create a Uncached Attribute Criteria resource
@@ -479,7 +481,7 @@ func (r *uncachedAttributeCriteriaResource) CreateDefaultUncachedAttributeCriter
 
 	addResponse, httpResp, err := r.apiClient.UncachedAttributeCriteriaAPI.AddUncachedAttributeCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Uncached Attribute Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Uncached Attribute Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -514,7 +516,7 @@ func (r *uncachedAttributeCriteriaResource) CreateGroovyScriptedUncachedAttribut
 
 	addResponse, httpResp, err := r.apiClient.UncachedAttributeCriteriaAPI.AddUncachedAttributeCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Uncached Attribute Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Uncached Attribute Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -551,7 +553,7 @@ func (r *uncachedAttributeCriteriaResource) CreateSimpleUncachedAttributeCriteri
 
 	addResponse, httpResp, err := r.apiClient.UncachedAttributeCriteriaAPI.AddUncachedAttributeCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Uncached Attribute Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Uncached Attribute Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -586,7 +588,7 @@ func (r *uncachedAttributeCriteriaResource) CreateThirdPartyUncachedAttributeCri
 
 	addResponse, httpResp, err := r.apiClient.UncachedAttributeCriteriaAPI.AddUncachedAttributeCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Uncached Attribute Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Uncached Attribute Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -639,6 +641,8 @@ func (r *uncachedAttributeCriteriaResource) Create(ctx context.Context, req reso
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Uncached Attribute Criteria", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -663,7 +667,7 @@ func (r *defaultUncachedAttributeCriteriaResource) Create(ctx context.Context, r
 	readResponse, httpResp, err := r.apiClient.UncachedAttributeCriteriaAPI.GetUncachedAttributeCriteria(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Uncached Attribute Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Uncached Attribute Criteria", err, httpResp)
 		return
 	}
 
@@ -698,7 +702,7 @@ func (r *defaultUncachedAttributeCriteriaResource) Create(ctx context.Context, r
 
 		updateResponse, httpResp, err := r.apiClient.UncachedAttributeCriteriaAPI.UpdateUncachedAttributeCriteriaExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Uncached Attribute Criteria", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Uncached Attribute Criteria", err, httpResp)
 			return
 		}
 
@@ -721,6 +725,8 @@ func (r *defaultUncachedAttributeCriteriaResource) Create(ctx context.Context, r
 		if updateResponse.ThirdPartyUncachedAttributeCriteriaResponse != nil {
 			readThirdPartyUncachedAttributeCriteriaResponse(ctx, updateResponse.ThirdPartyUncachedAttributeCriteriaResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Uncached Attribute Criteria", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -823,7 +829,7 @@ func updateUncachedAttributeCriteria(ctx context.Context, req resource.UpdateReq
 
 		updateResponse, httpResp, err := apiClient.UncachedAttributeCriteriaAPI.UpdateUncachedAttributeCriteriaExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Uncached Attribute Criteria", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Uncached Attribute Criteria", err, httpResp)
 			return
 		}
 
@@ -846,6 +852,8 @@ func updateUncachedAttributeCriteria(ctx context.Context, req resource.UpdateReq
 		if updateResponse.ThirdPartyUncachedAttributeCriteriaResponse != nil {
 			readThirdPartyUncachedAttributeCriteriaResponse(ctx, updateResponse.ThirdPartyUncachedAttributeCriteriaResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Uncached Attribute Criteria", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -893,3 +901,16 @@ func importUncachedAttributeCriteria(ctx context.Context, req resource.ImportSta
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *uncachedAttributeCriteriaResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_uncached_attribute_criteria"),
+	}
+}
+
+func (r *defaultUncachedAttributeCriteriaResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_uncached_attribute_criteria"),
+	}
+}
@@ -49,29 +49,21 @@ func (r *uncachedEntryCriteriaListDataSource) Configure(_ context.Context, req d
 }
 
 type uncachedEntryCriteriaListDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *uncachedEntryCriteriaListDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Uncached Entry Criteria objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Uncached Entry Criteria objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewUncachedEntryCriteriaDataSource(), "Uncached Entry Criteria")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *uncachedEntryCriteriaListDataSource) Read(ctx context.Context, req data
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewUncachedEntryCriteriaDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.UncachedEntryCriteriaAPI.ListUncachedEntryCriteria(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.UncachedEntryCriteriaAPI.ListUncachedEntryCriteriaExecute(listRequest)
@@ -106,37 +104,35 @@ func (r *uncachedEntryCriteriaListDataSource) Read(ctx context.Context, req data
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object uncachedEntryCriteriaDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.DefaultUncachedEntryCriteriaResponse != nil {
-			attributes["id"] = types.StringValue(response.DefaultUncachedEntryCriteriaResponse.Id)
-			attributes["type"] = types.StringValue("default")
+			readDefaultUncachedEntryCriteriaResponseDataSource(ctx, response.DefaultUncachedEntryCriteriaResponse, &object, &resp.Diagnostics)
 		}
 		if response.LastAccessTimeUncachedEntryCriteriaResponse != nil {
-			attributes["id"] = types.StringValue(response.LastAccessTimeUncachedEntryCriteriaResponse.Id)
-			attributes["type"] = types.StringValue("last-access-time")
+			readLastAccessTimeUncachedEntryCriteriaResponseDataSource(ctx, response.LastAccessTimeUncachedEntryCriteriaResponse, &object, &resp.Diagnostics)
 		}
 		if response.FilterBasedUncachedEntryCriteriaResponse != nil {
-			attributes["id"] = types.StringValue(response.FilterBasedUncachedEntryCriteriaResponse.Id)
-			attributes["type"] = types.StringValue("filter-based")
+			readFilterBasedUncachedEntryCriteriaResponseDataSource(ctx, response.FilterBasedUncachedEntryCriteriaResponse, &object, &resp.Diagnostics)
 		}
 		if response.GroovyScriptedUncachedEntryCriteriaResponse != nil {
-			attributes["id"] = types.StringValue(response.GroovyScriptedUncachedEntryCriteriaResponse.Id)
-			attributes["type"] = types.StringValue("groovy-scripted")
+			readGroovyScriptedUncachedEntryCriteriaResponseDataSource(ctx, response.GroovyScriptedUncachedEntryCriteriaResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyUncachedEntryCriteriaResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyUncachedEntryCriteriaResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyUncachedEntryCriteriaResponseDataSource(ctx, response.ThirdPartyUncachedEntryCriteriaResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
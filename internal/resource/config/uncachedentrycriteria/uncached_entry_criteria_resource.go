@@ -28,9 +28,11 @@ var (
 	_ resource.Resource                = &uncachedEntryCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &uncachedEntryCriteriaResource{}
 	_ resource.ResourceWithImportState = &uncachedEntryCriteriaResource{}
+	_ resource.ResourceWithMoveState   = &uncachedEntryCriteriaResource{}
 	_ resource.Resource                = &defaultUncachedEntryCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &defaultUncachedEntryCriteriaResource{}
 	_ resource.ResourceWithImportState = &defaultUncachedEntryCriteriaResource{}
+	_ resource.ResourceWithMoveState   = &defaultUncachedEntryCriteriaResource{}
 )
 
 // Create a Uncached Entry Criteria resource
@@ -482,7 +484,7 @@ func (r *uncachedEntryCriteriaResource) CreateDefaultUncachedEntryCriteria(ctx c
 
 	addResponse, httpResp, err := r.apiClient.UncachedEntryCriteriaAPI.AddUncachedEntryCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Uncached Entry Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Uncached Entry Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -517,7 +519,7 @@ func (r *uncachedEntryCriteriaResource) CreateLastAccessTimeUncachedEntryCriteri
 
 	addResponse, httpResp, err := r.apiClient.UncachedEntryCriteriaAPI.AddUncachedEntryCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Uncached Entry Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Uncached Entry Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -552,7 +554,7 @@ func (r *uncachedEntryCriteriaResource) CreateFilterBasedUncachedEntryCriteria(c
 
 	addResponse, httpResp, err := r.apiClient.UncachedEntryCriteriaAPI.AddUncachedEntryCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Uncached Entry Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Uncached Entry Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -587,7 +589,7 @@ func (r *uncachedEntryCriteriaResource) CreateGroovyScriptedUncachedEntryCriteri
 
 	addResponse, httpResp, err := r.apiClient.UncachedEntryCriteriaAPI.AddUncachedEntryCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Uncached Entry Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Uncached Entry Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -622,7 +624,7 @@ func (r *uncachedEntryCriteriaResource) CreateThirdPartyUncachedEntryCriteria(ct
 
 	addResponse, httpResp, err := r.apiClient.UncachedEntryCriteriaAPI.AddUncachedEntryCriteriaExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Uncached Entry Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Uncached Entry Criteria", err, httpResp)
 		return nil, err
 	}
 
@@ -681,6 +683,8 @@ func (r *uncachedEntryCriteriaResource) Create(ctx context.Context, req resource
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Uncached Entry Criteria", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -705,7 +709,7 @@ func (r *defaultUncachedEntryCriteriaResource) Create(ctx context.Context, req r
 	readResponse, httpResp, err := r.apiClient.UncachedEntryCriteriaAPI.GetUncachedEntryCriteria(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Uncached Entry Criteria", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Uncached Entry Criteria", err, httpResp)
 		return
 	}
 
@@ -743,7 +747,7 @@ func (r *defaultUncachedEntryCriteriaResource) Create(ctx context.Context, req r
 
 		updateResponse, httpResp, err := r.apiClient.UncachedEntryCriteriaAPI.UpdateUncachedEntryCriteriaExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Uncached Entry Criteria", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Uncached Entry Criteria", err, httpResp)
 			return
 		}
 
@@ -769,6 +773,8 @@ func (r *defaultUncachedEntryCriteriaResource) Create(ctx context.Context, req r
 		if updateResponse.ThirdPartyUncachedEntryCriteriaResponse != nil {
 			readThirdPartyUncachedEntryCriteriaResponse(ctx, updateResponse.ThirdPartyUncachedEntryCriteriaResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Uncached Entry Criteria", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.populateAllComputedStringAttributes()
@@ -874,7 +880,7 @@ func updateUncachedEntryCriteria(ctx context.Context, req resource.UpdateRequest
 
 		updateResponse, httpResp, err := apiClient.UncachedEntryCriteriaAPI.UpdateUncachedEntryCriteriaExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Uncached Entry Criteria", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Uncached Entry Criteria", err, httpResp)
 			return
 		}
 
@@ -900,6 +906,8 @@ func updateUncachedEntryCriteria(ctx context.Context, req resource.UpdateRequest
 		if updateResponse.ThirdPartyUncachedEntryCriteriaResponse != nil {
 			readThirdPartyUncachedEntryCriteriaResponse(ctx, updateResponse.ThirdPartyUncachedEntryCriteriaResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Uncached Entry Criteria", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -947,3 +955,16 @@ func importUncachedEntryCriteria(ctx context.Context, req resource.ImportStateRe
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *uncachedEntryCriteriaResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_uncached_entry_criteria"),
+	}
+}
+
+func (r *defaultUncachedEntryCriteriaResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_uncached_entry_criteria"),
+	}
+}
@@ -5,6 +5,7 @@ package vaultauthenticationmethod
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -27,9 +28,11 @@ var (
 	_ resource.Resource                = &vaultAuthenticationMethodResource{}
 	_ resource.ResourceWithConfigure   = &vaultAuthenticationMethodResource{}
 	_ resource.ResourceWithImportState = &vaultAuthenticationMethodResource{}
+	_ resource.ResourceWithMoveState   = &vaultAuthenticationMethodResource{}
 	_ resource.Resource                = &defaultVaultAuthenticationMethodResource{}
 	_ resource.ResourceWithConfigure   = &defaultVaultAuthenticationMethodResource{}
 	_ resource.ResourceWithImportState = &defaultVaultAuthenticationMethodResource{}
+	_ resource.ResourceWithMoveState   = &defaultVaultAuthenticationMethodResource{}
 )
 
 // Create a Vault Authentication Method resource
@@ -84,18 +87,24 @@ func (r *defaultVaultAuthenticationMethodResource) Configure(_ context.Context,
 }
 
 type vaultAuthenticationMethodResourceModel struct {
-	Id                 types.String `tfsdk:"id"`
-	Name               types.String `tfsdk:"name"`
-	Notifications      types.Set    `tfsdk:"notifications"`
-	RequiredActions    types.Set    `tfsdk:"required_actions"`
-	Type               types.String `tfsdk:"type"`
-	Username           types.String `tfsdk:"username"`
-	Password           types.String `tfsdk:"password"`
-	VaultRoleID        types.String `tfsdk:"vault_role_id"`
-	VaultSecretID      types.String `tfsdk:"vault_secret_id"`
-	LoginMechanismName types.String `tfsdk:"login_mechanism_name"`
-	VaultAccessToken   types.String `tfsdk:"vault_access_token"`
-	Description        types.String `tfsdk:"description"`
+	Id                        types.String `tfsdk:"id"`
+	Name                      types.String `tfsdk:"name"`
+	Notifications             types.Set    `tfsdk:"notifications"`
+	RequiredActions           types.Set    `tfsdk:"required_actions"`
+	Type                      types.String `tfsdk:"type"`
+	Username                  types.String `tfsdk:"username"`
+	Password                  types.String `tfsdk:"password"`
+	PasswordWo                types.String `tfsdk:"password_wo"`
+	PasswordWoVersion         types.Int64  `tfsdk:"password_wo_version"`
+	VaultRoleID               types.String `tfsdk:"vault_role_id"`
+	VaultSecretID             types.String `tfsdk:"vault_secret_id"`
+	VaultSecretIDWo           types.String `tfsdk:"vault_secret_id_wo"`
+	VaultSecretIDWoVersion    types.Int64  `tfsdk:"vault_secret_id_wo_version"`
+	LoginMechanismName        types.String `tfsdk:"login_mechanism_name"`
+	VaultAccessToken          types.String `tfsdk:"vault_access_token"`
+	VaultAccessTokenWo        types.String `tfsdk:"vault_access_token_wo"`
+	VaultAccessTokenWoVersion types.Int64  `tfsdk:"vault_access_token_wo_version"`
+	Description               types.String `tfsdk:"description"`
 }
 
 // GetSchema defines the schema for the resource.
@@ -168,6 +177,9 @@ func vaultAuthenticationMethodSchema(ctx context.Context, req resource.SchemaReq
 		// Add any default properties and set optional properties to computed where necessary
 		config.SetAttributesToOptionalAndComputedAndRemoveDefaults(&schemaDef, []string{"type"})
 	}
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "password")
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "vault_secret_id")
+	config.AddWriteOnlyAttributeSchema(&schemaDef, "vault_access_token")
 	config.AddCommonResourceSchema(&schemaDef, true)
 	resp.Schema = schemaDef
 }
@@ -213,6 +225,11 @@ func configValidatorsVaultAuthenticationMethod() []resource.ConfigValidator {
 			path.MatchRoot("type"),
 			[]string{"static-token"},
 		),
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("vault_access_token_wo"),
+			path.MatchRoot("type"),
+			[]string{"static-token"},
+		),
 		configvalidators.ImpliesOtherAttributeOneOfString(
 			path.MatchRoot("vault_role_id"),
 			path.MatchRoot("type"),
@@ -223,6 +240,11 @@ func configValidatorsVaultAuthenticationMethod() []resource.ConfigValidator {
 			path.MatchRoot("type"),
 			[]string{"app-role"},
 		),
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("vault_secret_id_wo"),
+			path.MatchRoot("type"),
+			[]string{"app-role"},
+		),
 		configvalidators.ImpliesOtherAttributeOneOfString(
 			path.MatchRoot("login_mechanism_name"),
 			path.MatchRoot("type"),
@@ -238,20 +260,44 @@ func configValidatorsVaultAuthenticationMethod() []resource.ConfigValidator {
 			path.MatchRoot("type"),
 			[]string{"user-pass"},
 		),
-		configvalidators.ValueImpliesAttributeRequired(
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("password_wo"),
+			path.MatchRoot("type"),
+			[]string{"user-pass"},
+		),
+		configvalidators.ImpliesOtherValidator(
 			path.MatchRoot("type"),
-			"static-token",
-			[]path.Expression{path.MatchRoot("vault_access_token")},
+			[]string{"static-token"},
+			resourcevalidator.ExactlyOneOf(
+				path.MatchRoot("vault_access_token"),
+				path.MatchRoot("vault_access_token_wo"),
+			),
 		),
 		configvalidators.ValueImpliesAttributeRequired(
 			path.MatchRoot("type"),
 			"app-role",
-			[]path.Expression{path.MatchRoot("vault_role_id"), path.MatchRoot("vault_secret_id")},
+			[]path.Expression{path.MatchRoot("vault_role_id")},
+		),
+		configvalidators.ImpliesOtherValidator(
+			path.MatchRoot("type"),
+			[]string{"app-role"},
+			resourcevalidator.ExactlyOneOf(
+				path.MatchRoot("vault_secret_id"),
+				path.MatchRoot("vault_secret_id_wo"),
+			),
 		),
 		configvalidators.ValueImpliesAttributeRequired(
 			path.MatchRoot("type"),
 			"user-pass",
-			[]path.Expression{path.MatchRoot("username"), path.MatchRoot("password")},
+			[]path.Expression{path.MatchRoot("username")},
+		),
+		configvalidators.ImpliesOtherValidator(
+			path.MatchRoot("type"),
+			[]string{"user-pass"},
+			resourcevalidator.ExactlyOneOf(
+				path.MatchRoot("password"),
+				path.MatchRoot("password_wo"),
+			),
 		),
 	}
 }
@@ -360,12 +406,21 @@ func (state *vaultAuthenticationMethodResourceModel) setStateValuesNotReturnedBy
 	if !expectedValues.VaultAccessToken.IsUnknown() {
 		state.VaultAccessToken = expectedValues.VaultAccessToken
 	}
+	if !expectedValues.VaultAccessTokenWoVersion.IsUnknown() {
+		state.VaultAccessTokenWoVersion = expectedValues.VaultAccessTokenWoVersion
+	}
 	if !expectedValues.VaultSecretID.IsUnknown() {
 		state.VaultSecretID = expectedValues.VaultSecretID
 	}
+	if !expectedValues.VaultSecretIDWoVersion.IsUnknown() {
+		state.VaultSecretIDWoVersion = expectedValues.VaultSecretIDWoVersion
+	}
 	if !expectedValues.Password.IsUnknown() {
 		state.Password = expectedValues.Password
 	}
+	if !expectedValues.PasswordWoVersion.IsUnknown() {
+		state.PasswordWoVersion = expectedValues.PasswordWoVersion
+	}
 }
 
 // Create any update operations necessary to make the state match the plan
@@ -373,18 +428,25 @@ func createVaultAuthenticationMethodOperations(plan vaultAuthenticationMethodRes
 	var ops []client.Operation
 	operations.AddStringOperationIfNecessary(&ops, plan.Username, state.Username, "username")
 	operations.AddStringOperationIfNecessary(&ops, plan.Password, state.Password, "password")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.PasswordWo, plan.PasswordWoVersion, state.PasswordWoVersion, "password")
 	operations.AddStringOperationIfNecessary(&ops, plan.VaultRoleID, state.VaultRoleID, "vault-role-id")
 	operations.AddStringOperationIfNecessary(&ops, plan.VaultSecretID, state.VaultSecretID, "vault-secret-id")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.VaultSecretIDWo, plan.VaultSecretIDWoVersion, state.VaultSecretIDWoVersion, "vault-secret-id")
 	operations.AddStringOperationIfNecessary(&ops, plan.LoginMechanismName, state.LoginMechanismName, "login-mechanism-name")
 	operations.AddStringOperationIfNecessary(&ops, plan.VaultAccessToken, state.VaultAccessToken, "vault-access-token")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.VaultAccessTokenWo, plan.VaultAccessTokenWoVersion, state.VaultAccessTokenWoVersion, "vault-access-token")
 	operations.AddStringOperationIfNecessary(&ops, plan.Description, state.Description, "description")
 	return ops
 }
 
 // Create a static-token vault-authentication-method
 func (r *vaultAuthenticationMethodResource) CreateStaticTokenVaultAuthenticationMethod(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse, plan vaultAuthenticationMethodResourceModel) (*vaultAuthenticationMethodResourceModel, error) {
+	vaultAccessToken := plan.VaultAccessToken.ValueString()
+	if internaltypes.IsNonEmptyString(plan.VaultAccessTokenWo) {
+		vaultAccessToken = plan.VaultAccessTokenWo.ValueString()
+	}
 	addRequest := client.NewAddStaticTokenVaultAuthenticationMethodRequest([]client.EnumstaticTokenVaultAuthenticationMethodSchemaUrn{client.ENUMSTATICTOKENVAULTAUTHENTICATIONMETHODSCHEMAURN_URNPINGIDENTITYSCHEMASCONFIGURATION2_0VAULT_AUTHENTICATION_METHODSTATIC_TOKEN},
-		plan.VaultAccessToken.ValueString(),
+		vaultAccessToken,
 		plan.Name.ValueString())
 	addOptionalStaticTokenVaultAuthenticationMethodFields(ctx, addRequest, plan)
 	// Log request JSON
@@ -399,7 +461,7 @@ func (r *vaultAuthenticationMethodResource) CreateStaticTokenVaultAuthentication
 
 	addResponse, httpResp, err := r.apiClient.VaultAuthenticationMethodAPI.AddVaultAuthenticationMethodExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Vault Authentication Method", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Vault Authentication Method", err, httpResp)
 		return nil, err
 	}
 
@@ -417,9 +479,13 @@ func (r *vaultAuthenticationMethodResource) CreateStaticTokenVaultAuthentication
 
 // Create a app-role vault-authentication-method
 func (r *vaultAuthenticationMethodResource) CreateAppRoleVaultAuthenticationMethod(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse, plan vaultAuthenticationMethodResourceModel) (*vaultAuthenticationMethodResourceModel, error) {
+	vaultSecretID := plan.VaultSecretID.ValueString()
+	if internaltypes.IsNonEmptyString(plan.VaultSecretIDWo) {
+		vaultSecretID = plan.VaultSecretIDWo.ValueString()
+	}
 	addRequest := client.NewAddAppRoleVaultAuthenticationMethodRequest([]client.EnumappRoleVaultAuthenticationMethodSchemaUrn{client.ENUMAPPROLEVAULTAUTHENTICATIONMETHODSCHEMAURN_URNPINGIDENTITYSCHEMASCONFIGURATION2_0VAULT_AUTHENTICATION_METHODAPP_ROLE},
 		plan.VaultRoleID.ValueString(),
-		plan.VaultSecretID.ValueString(),
+		vaultSecretID,
 		plan.Name.ValueString())
 	addOptionalAppRoleVaultAuthenticationMethodFields(ctx, addRequest, plan)
 	// Log request JSON
@@ -434,7 +500,7 @@ func (r *vaultAuthenticationMethodResource) CreateAppRoleVaultAuthenticationMeth
 
 	addResponse, httpResp, err := r.apiClient.VaultAuthenticationMethodAPI.AddVaultAuthenticationMethodExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Vault Authentication Method", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Vault Authentication Method", err, httpResp)
 		return nil, err
 	}
 
@@ -452,9 +518,13 @@ func (r *vaultAuthenticationMethodResource) CreateAppRoleVaultAuthenticationMeth
 
 // Create a user-pass vault-authentication-method
 func (r *vaultAuthenticationMethodResource) CreateUserPassVaultAuthenticationMethod(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse, plan vaultAuthenticationMethodResourceModel) (*vaultAuthenticationMethodResourceModel, error) {
+	password := plan.Password.ValueString()
+	if internaltypes.IsNonEmptyString(plan.PasswordWo) {
+		password = plan.PasswordWo.ValueString()
+	}
 	addRequest := client.NewAddUserPassVaultAuthenticationMethodRequest([]client.EnumuserPassVaultAuthenticationMethodSchemaUrn{client.ENUMUSERPASSVAULTAUTHENTICATIONMETHODSCHEMAURN_URNPINGIDENTITYSCHEMASCONFIGURATION2_0VAULT_AUTHENTICATION_METHODUSER_PASS},
 		plan.Username.ValueString(),
-		plan.Password.ValueString(),
+		password,
 		plan.Name.ValueString())
 	addOptionalUserPassVaultAuthenticationMethodFields(ctx, addRequest, plan)
 	// Log request JSON
@@ -469,7 +539,7 @@ func (r *vaultAuthenticationMethodResource) CreateUserPassVaultAuthenticationMet
 
 	addResponse, httpResp, err := r.apiClient.VaultAuthenticationMethodAPI.AddVaultAuthenticationMethodExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Vault Authentication Method", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Vault Authentication Method", err, httpResp)
 		return nil, err
 	}
 
@@ -494,6 +564,13 @@ func (r *vaultAuthenticationMethodResource) Create(ctx context.Context, req reso
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("vault_secret_id_wo"), &plan.VaultSecretIDWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("vault_access_token_wo"), &plan.VaultAccessTokenWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	var state *vaultAuthenticationMethodResourceModel
 	var err error
@@ -518,6 +595,8 @@ func (r *vaultAuthenticationMethodResource) Create(ctx context.Context, req reso
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Vault Authentication Method", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -538,11 +617,18 @@ func (r *defaultVaultAuthenticationMethodResource) Create(ctx context.Context, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("vault_secret_id_wo"), &plan.VaultSecretIDWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("vault_access_token_wo"), &plan.VaultAccessTokenWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	readResponse, httpResp, err := r.apiClient.VaultAuthenticationMethodAPI.GetVaultAuthenticationMethod(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Vault Authentication Method", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Vault Authentication Method", err, httpResp)
 		return
 	}
 
@@ -574,7 +660,7 @@ func (r *defaultVaultAuthenticationMethodResource) Create(ctx context.Context, r
 
 		updateResponse, httpResp, err := r.apiClient.VaultAuthenticationMethodAPI.UpdateVaultAuthenticationMethodExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Vault Authentication Method", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Vault Authentication Method", err, httpResp)
 			return
 		}
 
@@ -594,6 +680,8 @@ func (r *defaultVaultAuthenticationMethodResource) Create(ctx context.Context, r
 		if updateResponse.UserPassVaultAuthenticationMethodResponse != nil {
 			readUserPassVaultAuthenticationMethodResponse(ctx, updateResponse.UserPassVaultAuthenticationMethodResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Vault Authentication Method", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -678,6 +766,13 @@ func updateVaultAuthenticationMethod(ctx context.Context, req resource.UpdateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("vault_secret_id_wo"), &plan.VaultSecretIDWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("vault_access_token_wo"), &plan.VaultAccessTokenWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Get the current state to see how any attributes are changing
 	var state vaultAuthenticationMethodResourceModel
@@ -694,7 +789,7 @@ func updateVaultAuthenticationMethod(ctx context.Context, req resource.UpdateReq
 
 		updateResponse, httpResp, err := apiClient.VaultAuthenticationMethodAPI.UpdateVaultAuthenticationMethodExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Vault Authentication Method", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Vault Authentication Method", err, httpResp)
 			return
 		}
 
@@ -714,6 +809,8 @@ func updateVaultAuthenticationMethod(ctx context.Context, req resource.UpdateReq
 		if updateResponse.UserPassVaultAuthenticationMethodResponse != nil {
 			readUserPassVaultAuthenticationMethodResponse(ctx, updateResponse.UserPassVaultAuthenticationMethodResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Vault Authentication Method", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -762,3 +859,16 @@ func importVaultAuthenticationMethod(ctx context.Context, req resource.ImportSta
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *vaultAuthenticationMethodResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_vault_authentication_method"),
+	}
+}
+
+func (r *defaultVaultAuthenticationMethodResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_vault_authentication_method"),
+	}
+}
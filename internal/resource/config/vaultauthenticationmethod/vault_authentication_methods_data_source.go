@@ -49,29 +49,21 @@ func (r *vaultAuthenticationMethodsDataSource) Configure(_ context.Context, req
 }
 
 type vaultAuthenticationMethodsDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *vaultAuthenticationMethodsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Vault Authentication Method objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Vault Authentication Method objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewVaultAuthenticationMethodDataSource(), "Vault Authentication Method")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *vaultAuthenticationMethodsDataSource) Read(ctx context.Context, req dat
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewVaultAuthenticationMethodDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.VaultAuthenticationMethodAPI.ListVaultAuthenticationMethods(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.VaultAuthenticationMethodAPI.ListVaultAuthenticationMethodsExecute(listRequest)
@@ -106,29 +104,29 @@ func (r *vaultAuthenticationMethodsDataSource) Read(ctx context.Context, req dat
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object vaultAuthenticationMethodDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.AppRoleVaultAuthenticationMethodResponse != nil {
-			attributes["id"] = types.StringValue(response.AppRoleVaultAuthenticationMethodResponse.Id)
-			attributes["type"] = types.StringValue("app-role")
+			readAppRoleVaultAuthenticationMethodResponseDataSource(ctx, response.AppRoleVaultAuthenticationMethodResponse, &object, &resp.Diagnostics)
 		}
 		if response.UserPassVaultAuthenticationMethodResponse != nil {
-			attributes["id"] = types.StringValue(response.UserPassVaultAuthenticationMethodResponse.Id)
-			attributes["type"] = types.StringValue("user-pass")
+			readUserPassVaultAuthenticationMethodResponseDataSource(ctx, response.UserPassVaultAuthenticationMethodResponse, &object, &resp.Diagnostics)
 		}
 		if response.StaticTokenVaultAuthenticationMethodResponse != nil {
-			attributes["id"] = types.StringValue(response.StaticTokenVaultAuthenticationMethodResponse.Id)
-			attributes["type"] = types.StringValue("static-token")
+			readStaticTokenVaultAuthenticationMethodResponseDataSource(ctx, response.StaticTokenVaultAuthenticationMethodResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
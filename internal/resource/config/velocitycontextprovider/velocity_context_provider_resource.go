@@ -31,9 +31,11 @@ var (
 	_ resource.Resource                = &velocityContextProviderResource{}
 	_ resource.ResourceWithConfigure   = &velocityContextProviderResource{}
 	_ resource.ResourceWithImportState = &velocityContextProviderResource{}
+	_ resource.ResourceWithMoveState   = &velocityContextProviderResource{}
 	_ resource.Resource                = &defaultVelocityContextProviderResource{}
 	_ resource.ResourceWithConfigure   = &defaultVelocityContextProviderResource{}
 	_ resource.ResourceWithImportState = &defaultVelocityContextProviderResource{}
+	_ resource.ResourceWithMoveState   = &defaultVelocityContextProviderResource{}
 )
 
 // Create a Velocity Context Provider resource
@@ -532,7 +534,7 @@ func (r *velocityContextProviderResource) CreateVelocityToolsVelocityContextProv
 
 	addResponse, httpResp, err := r.apiClient.VelocityContextProviderAPI.AddVelocityContextProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Velocity Context Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Velocity Context Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -570,7 +572,7 @@ func (r *velocityContextProviderResource) CreateThirdPartyVelocityContextProvide
 
 	addResponse, httpResp, err := r.apiClient.VelocityContextProviderAPI.AddVelocityContextProviderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Velocity Context Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Velocity Context Provider", err, httpResp)
 		return nil, err
 	}
 
@@ -613,6 +615,8 @@ func (r *velocityContextProviderResource) Create(ctx context.Context, req resour
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Velocity Context Provider", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -637,7 +641,7 @@ func (r *defaultVelocityContextProviderResource) Create(ctx context.Context, req
 	readResponse, httpResp, err := r.apiClient.VelocityContextProviderAPI.GetVelocityContextProvider(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString(), plan.HttpServletExtensionName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Velocity Context Provider", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Velocity Context Provider", err, httpResp)
 		return
 	}
 
@@ -669,7 +673,7 @@ func (r *defaultVelocityContextProviderResource) Create(ctx context.Context, req
 
 		updateResponse, httpResp, err := r.apiClient.VelocityContextProviderAPI.UpdateVelocityContextProviderExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Velocity Context Provider", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Velocity Context Provider", err, httpResp)
 			return
 		}
 
@@ -689,6 +693,8 @@ func (r *defaultVelocityContextProviderResource) Create(ctx context.Context, req
 		if updateResponse.ThirdPartyVelocityContextProviderResponse != nil {
 			readThirdPartyVelocityContextProviderResponse(ctx, updateResponse.ThirdPartyVelocityContextProviderResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Velocity Context Provider", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -789,7 +795,7 @@ func updateVelocityContextProvider(ctx context.Context, req resource.UpdateReque
 
 		updateResponse, httpResp, err := apiClient.VelocityContextProviderAPI.UpdateVelocityContextProviderExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Velocity Context Provider", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Velocity Context Provider", err, httpResp)
 			return
 		}
 
@@ -809,6 +815,8 @@ func updateVelocityContextProvider(ctx context.Context, req resource.UpdateReque
 		if updateResponse.ThirdPartyVelocityContextProviderResponse != nil {
 			readThirdPartyVelocityContextProviderResponse(ctx, updateResponse.ThirdPartyVelocityContextProviderResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, providerConfig, "Velocity Context Provider", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -863,3 +871,16 @@ func importVelocityContextProvider(ctx context.Context, req resource.ImportState
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("http_servlet_extension_name"), split[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), split[1])...)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *velocityContextProviderResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_velocity_context_provider"),
+	}
+}
+
+func (r *defaultVelocityContextProviderResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_velocity_context_provider"),
+	}
+}
@@ -50,9 +50,11 @@ func (r *velocityContextProvidersDataSource) Configure(_ context.Context, req da
 
 type velocityContextProvidersDataSourceModel struct {
 	Id                       types.String `tfsdk:"id"`
-	Filter                   types.String `tfsdk:"filter"`
 	Objects                  types.Set    `tfsdk:"objects"`
+	ObjectsById              types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes        types.Bool   `tfsdk:"include_attributes"`
 	HttpServletExtensionName types.String `tfsdk:"http_servlet_extension_name"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
@@ -64,19 +66,10 @@ func (r *velocityContextProvidersDataSource) Schema(ctx context.Context, req dat
 				Description: "Name of the parent HTTP Servlet Extension",
 				Required:    true,
 			},
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Velocity Context Provider objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
 		},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewVelocityContextProviderDataSource(), "Velocity Context Provider")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -91,9 +84,15 @@ func (r *velocityContextProvidersDataSource) Read(ctx context.Context, req datas
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewVelocityContextProviderDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.VelocityContextProviderAPI.ListVelocityContextProviders(config.ProviderBasicAuthContext(ctx, r.providerConfig), state.HttpServletExtensionName.ValueString())
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.VelocityContextProviderAPI.ListVelocityContextProvidersExecute(listRequest)
@@ -111,29 +110,30 @@ func (r *velocityContextProvidersDataSource) Read(ctx context.Context, req datas
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object velocityContextProviderDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.VelocityToolsVelocityContextProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.VelocityToolsVelocityContextProviderResponse.Id)
-			attributes["type"] = types.StringValue("velocity-tools")
+			readVelocityToolsVelocityContextProviderResponseDataSource(ctx, response.VelocityToolsVelocityContextProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.CustomVelocityContextProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.CustomVelocityContextProviderResponse.Id)
-			attributes["type"] = types.StringValue("custom")
+			readCustomVelocityContextProviderResponseDataSource(ctx, response.CustomVelocityContextProviderResponse, &object, &resp.Diagnostics)
 		}
 		if response.ThirdPartyVelocityContextProviderResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyVelocityContextProviderResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyVelocityContextProviderResponseDataSource(ctx, response.ThirdPartyVelocityContextProviderResponse, &object, &resp.Diagnostics)
+		}
+		object.HttpServletExtensionName = state.HttpServletExtensionName
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
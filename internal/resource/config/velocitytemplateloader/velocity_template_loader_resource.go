@@ -30,9 +30,11 @@ var (
 	_ resource.Resource                = &velocityTemplateLoaderResource{}
 	_ resource.ResourceWithConfigure   = &velocityTemplateLoaderResource{}
 	_ resource.ResourceWithImportState = &velocityTemplateLoaderResource{}
+	_ resource.ResourceWithMoveState   = &velocityTemplateLoaderResource{}
 	_ resource.Resource                = &defaultVelocityTemplateLoaderResource{}
 	_ resource.ResourceWithConfigure   = &defaultVelocityTemplateLoaderResource{}
 	_ resource.ResourceWithImportState = &defaultVelocityTemplateLoaderResource{}
+	_ resource.ResourceWithMoveState   = &defaultVelocityTemplateLoaderResource{}
 )
 
 // Create a Velocity Template Loader resource
@@ -264,7 +266,7 @@ func (r *velocityTemplateLoaderResource) CreateVelocityTemplateLoader(ctx contex
 
 	addResponse, httpResp, err := r.apiClient.VelocityTemplateLoaderAPI.AddVelocityTemplateLoaderExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Velocity Template Loader", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Velocity Template Loader", err, httpResp)
 		return nil, err
 	}
 
@@ -297,6 +299,8 @@ func (r *velocityTemplateLoaderResource) Create(ctx context.Context, req resourc
 
 	// Populate Computed attribute values
 	state.setStateValuesNotReturnedByAPI(&plan)
+	config.HandleRequiredActions(ctx, r.providerConfig, "Velocity Template Loader", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -321,7 +325,7 @@ func (r *defaultVelocityTemplateLoaderResource) Create(ctx context.Context, req
 	readResponse, httpResp, err := r.apiClient.VelocityTemplateLoaderAPI.GetVelocityTemplateLoader(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString(), plan.HttpServletExtensionName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Velocity Template Loader", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Velocity Template Loader", err, httpResp)
 		return
 	}
 
@@ -345,7 +349,7 @@ func (r *defaultVelocityTemplateLoaderResource) Create(ctx context.Context, req
 
 		updateResponse, httpResp, err := r.apiClient.VelocityTemplateLoaderAPI.UpdateVelocityTemplateLoaderExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Velocity Template Loader", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Velocity Template Loader", err, httpResp)
 			return
 		}
 
@@ -357,6 +361,8 @@ func (r *defaultVelocityTemplateLoaderResource) Create(ctx context.Context, req
 
 		// Read the response
 		readVelocityTemplateLoaderResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Velocity Template Loader", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -449,7 +455,7 @@ func updateVelocityTemplateLoader(ctx context.Context, req resource.UpdateReques
 
 		updateResponse, httpResp, err := apiClient.VelocityTemplateLoaderAPI.UpdateVelocityTemplateLoaderExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Velocity Template Loader", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Velocity Template Loader", err, httpResp)
 			return
 		}
 
@@ -461,6 +467,8 @@ func updateVelocityTemplateLoader(ctx context.Context, req resource.UpdateReques
 
 		// Read the response
 		readVelocityTemplateLoaderResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, providerConfig, "Velocity Template Loader", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -515,3 +523,16 @@ func importVelocityTemplateLoader(ctx context.Context, req resource.ImportStateR
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("http_servlet_extension_name"), split[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), split[1])...)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *velocityTemplateLoaderResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_velocity_template_loader"),
+	}
+}
+
+func (r *defaultVelocityTemplateLoaderResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_velocity_template_loader"),
+	}
+}
@@ -30,9 +30,11 @@ var (
 	_ resource.Resource                = &virtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &virtualAttributeResource{}
 	_ resource.ResourceWithImportState = &virtualAttributeResource{}
+	_ resource.ResourceWithMoveState   = &virtualAttributeResource{}
 	_ resource.Resource                = &defaultVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &defaultVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &defaultVirtualAttributeResource{}
+	_ resource.ResourceWithMoveState   = &defaultVirtualAttributeResource{}
 )
 
 // Create a Virtual Attribute resource
@@ -3292,7 +3294,7 @@ func (r *virtualAttributeResource) CreateMirrorVirtualAttribute(ctx context.Cont
 
 	addResponse, httpResp, err := r.apiClient.VirtualAttributeAPI.AddVirtualAttributeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
 		return nil, err
 	}
 
@@ -3334,7 +3336,7 @@ func (r *virtualAttributeResource) CreateConstructedVirtualAttribute(ctx context
 
 	addResponse, httpResp, err := r.apiClient.VirtualAttributeAPI.AddVirtualAttributeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
 		return nil, err
 	}
 
@@ -3372,7 +3374,7 @@ func (r *virtualAttributeResource) CreateIsMemberOfVirtualAttribute(ctx context.
 
 	addResponse, httpResp, err := r.apiClient.VirtualAttributeAPI.AddVirtualAttributeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
 		return nil, err
 	}
 
@@ -3418,7 +3420,7 @@ func (r *virtualAttributeResource) CreateReverseDnJoinVirtualAttribute(ctx conte
 
 	addResponse, httpResp, err := r.apiClient.VirtualAttributeAPI.AddVirtualAttributeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
 		return nil, err
 	}
 
@@ -3460,7 +3462,7 @@ func (r *virtualAttributeResource) CreateIdentifyReferencesVirtualAttribute(ctx
 
 	addResponse, httpResp, err := r.apiClient.VirtualAttributeAPI.AddVirtualAttributeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
 		return nil, err
 	}
 
@@ -3502,7 +3504,7 @@ func (r *virtualAttributeResource) CreateUserDefinedVirtualAttribute(ctx context
 
 	addResponse, httpResp, err := r.apiClient.VirtualAttributeAPI.AddVirtualAttributeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
 		return nil, err
 	}
 
@@ -3540,7 +3542,7 @@ func (r *virtualAttributeResource) CreateEntryDnVirtualAttribute(ctx context.Con
 
 	addResponse, httpResp, err := r.apiClient.VirtualAttributeAPI.AddVirtualAttributeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
 		return nil, err
 	}
 
@@ -3587,7 +3589,7 @@ func (r *virtualAttributeResource) CreateEqualityJoinVirtualAttribute(ctx contex
 
 	addResponse, httpResp, err := r.apiClient.VirtualAttributeAPI.AddVirtualAttributeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
 		return nil, err
 	}
 
@@ -3627,7 +3629,7 @@ func (r *virtualAttributeResource) CreateGroovyScriptedVirtualAttribute(ctx cont
 
 	addResponse, httpResp, err := r.apiClient.VirtualAttributeAPI.AddVirtualAttributeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
 		return nil, err
 	}
 
@@ -3666,7 +3668,7 @@ func (r *virtualAttributeResource) CreateMemberVirtualAttribute(ctx context.Cont
 
 	addResponse, httpResp, err := r.apiClient.VirtualAttributeAPI.AddVirtualAttributeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
 		return nil, err
 	}
 
@@ -3704,7 +3706,7 @@ func (r *virtualAttributeResource) CreatePasswordPolicyStateJsonVirtualAttribute
 
 	addResponse, httpResp, err := r.apiClient.VirtualAttributeAPI.AddVirtualAttributeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
 		return nil, err
 	}
 
@@ -3750,7 +3752,7 @@ func (r *virtualAttributeResource) CreateDnJoinVirtualAttribute(ctx context.Cont
 
 	addResponse, httpResp, err := r.apiClient.VirtualAttributeAPI.AddVirtualAttributeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
 		return nil, err
 	}
 
@@ -3790,7 +3792,7 @@ func (r *virtualAttributeResource) CreateThirdPartyVirtualAttribute(ctx context.
 
 	addResponse, httpResp, err := r.apiClient.VirtualAttributeAPI.AddVirtualAttributeExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Virtual Attribute", err, httpResp)
 		return nil, err
 	}
 
@@ -3897,6 +3899,8 @@ func (r *virtualAttributeResource) Create(ctx context.Context, req resource.Crea
 		}
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Virtual Attribute", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -3921,7 +3925,7 @@ func (r *defaultVirtualAttributeResource) Create(ctx context.Context, req resour
 	readResponse, httpResp, err := r.apiClient.VirtualAttributeAPI.GetVirtualAttribute(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Virtual Attribute", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Virtual Attribute", err, httpResp)
 		return
 	}
 
@@ -4013,7 +4017,7 @@ func (r *defaultVirtualAttributeResource) Create(ctx context.Context, req resour
 
 		updateResponse, httpResp, err := r.apiClient.VirtualAttributeAPI.UpdateVirtualAttributeExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Virtual Attribute", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Virtual Attribute", err, httpResp)
 			return
 		}
 
@@ -4093,6 +4097,8 @@ func (r *defaultVirtualAttributeResource) Create(ctx context.Context, req resour
 		if updateResponse.ThirdPartyVirtualAttributeResponse != nil {
 			readThirdPartyVirtualAttributeResponseDefault(ctx, updateResponse.ThirdPartyVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Virtual Attribute", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -4260,7 +4266,7 @@ func (r *virtualAttributeResource) Update(ctx context.Context, req resource.Upda
 
 		updateResponse, httpResp, err := r.apiClient.VirtualAttributeAPI.UpdateVirtualAttributeExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Virtual Attribute", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Virtual Attribute", err, httpResp)
 			return
 		}
 
@@ -4310,6 +4316,8 @@ func (r *virtualAttributeResource) Update(ctx context.Context, req resource.Upda
 		if updateResponse.ThirdPartyVirtualAttributeResponse != nil {
 			readThirdPartyVirtualAttributeResponse(ctx, updateResponse.ThirdPartyVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Virtual Attribute", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -4345,7 +4353,7 @@ func (r *defaultVirtualAttributeResource) Update(ctx context.Context, req resour
 
 		updateResponse, httpResp, err := r.apiClient.VirtualAttributeAPI.UpdateVirtualAttributeExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Virtual Attribute", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Virtual Attribute", err, httpResp)
 			return
 		}
 
@@ -4425,6 +4433,8 @@ func (r *defaultVirtualAttributeResource) Update(ctx context.Context, req resour
 		if updateResponse.ThirdPartyVirtualAttributeResponse != nil {
 			readThirdPartyVirtualAttributeResponseDefault(ctx, updateResponse.ThirdPartyVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Virtual Attribute", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -4472,3 +4482,16 @@ func importVirtualAttribute(ctx context.Context, req resource.ImportStateRequest
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *virtualAttributeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_virtual_attribute"),
+	}
+}
+
+func (r *defaultVirtualAttributeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_virtual_attribute"),
+	}
+}
@@ -49,29 +49,21 @@ func (r *virtualAttributesDataSource) Configure(_ context.Context, req datasourc
 }
 
 type virtualAttributesDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Filter  types.String `tfsdk:"filter"`
-	Objects types.Set    `tfsdk:"objects"`
+	Id                types.String `tfsdk:"id"`
+	Objects           types.Set    `tfsdk:"objects"`
+	ObjectsById       types.Map    `tfsdk:"objects_by_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	config.DataSourceFiltersModel
 }
 
 // GetSchema defines the schema for the datasource.
 func (r *virtualAttributesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	schemaDef := schema.Schema{
 		Description: "Lists Virtual Attribute objects in the server configuration.",
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				Description: "SCIM filter used when searching the configuration.",
-				Optional:    true,
-			},
-			"objects": schema.SetAttribute{
-				Description: "Virtual Attribute objects found in the configuration",
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				ElementType: internaltypes.ObjectsObjectType(),
-			},
-		},
+		Attributes:  map[string]schema.Attribute{},
 	}
+	config.AddFiltersDataSourceSchema(&schemaDef)
+	config.AddObjectsDataSourceSchema(ctx, &schemaDef, NewVirtualAttributeDataSource(), "Virtual Attribute")
 	config.AddCommonDataSourceSchema(&schemaDef, false)
 	resp.Schema = schemaDef
 }
@@ -86,9 +78,15 @@ func (r *virtualAttributesDataSource) Read(ctx context.Context, req datasource.R
 		return
 	}
 
+	objectType := config.DataSourceObjectType(ctx, NewVirtualAttributeDataSource())
+	state.ValidateAttributeEquals(objectType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listRequest := r.apiClient.VirtualAttributeAPI.ListVirtualAttributes(config.ProviderBasicAuthContext(ctx, r.providerConfig))
-	if internaltypes.IsDefined(state.Filter) {
-		listRequest = listRequest.Filter(state.Filter.ValueString())
+	if filter := state.ScimFilter(objectType); filter != "" {
+		listRequest = listRequest.Filter(filter)
 	}
 
 	readResponse, httpResp, err := r.apiClient.VirtualAttributeAPI.ListVirtualAttributesExecute(listRequest)
@@ -106,129 +104,109 @@ func (r *virtualAttributesDataSource) Read(ctx context.Context, req datasource.R
 	// Read the response into the state
 	objects := []attr.Value{}
 	for _, response := range readResponse.Resources {
-		attributes := map[string]attr.Value{}
+		var object virtualAttributeDataSourceModel
+		config.NewDataSourceObjectModel(ctx, objectType, &object, &resp.Diagnostics)
 		if response.MirrorVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.MirrorVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("mirror")
+			readMirrorVirtualAttributeResponseDataSource(ctx, response.MirrorVirtualAttributeResponse, &object, &resp.Diagnostics)
 		}
 		if response.EntryChecksumVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.EntryChecksumVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("entry-checksum")
+			readEntryChecksumVirtualAttributeResponseDataSource(ctx, response.EntryChecksumVirtualAttributeResponse, &object, &resp.Diagnostics)
 		}
 		if response.IsMemberOfVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.IsMemberOfVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("is-member-of")
+			readIsMemberOfVirtualAttributeResponseDataSource(ctx, response.IsMemberOfVirtualAttributeResponse, &object, &resp.Diagnostics)
 		}
 		if response.ReverseDnJoinVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.ReverseDnJoinVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("reverse-dn-join")
+			readReverseDnJoinVirtualAttributeResponseDataSource(ctx, response.ReverseDnJoinVirtualAttributeResponse, &object, &resp.Diagnostics)
 		}
 		if response.IdentifyReferencesVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.IdentifyReferencesVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("identify-references")
+			readIdentifyReferencesVirtualAttributeResponseDataSource(ctx, response.IdentifyReferencesVirtualAttributeResponse, &object, &resp.Diagnostics)
 		}
 		if response.UserDefinedVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.UserDefinedVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("user-defined")
+			readUserDefinedVirtualAttributeResponseDataSource(ctx, response.UserDefinedVirtualAttributeResponse, &object, &resp.Diagnostics)
 		}
 		if response.ShortUniqueIdVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.ShortUniqueIdVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("short-unique-id")
+			readShortUniqueIdVirtualAttributeResponseDataSource(ctx, response.ShortUniqueIdVirtualAttributeResponse, &object, &resp.Diagnostics)
 		}
 		if response.ExpandTimestampVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.ExpandTimestampVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("expand-timestamp")
+			object.Id = types.StringValue(response.ExpandTimestampVirtualAttributeResponse.Id)
+			object.Type = types.StringValue("expand-timestamp")
 		}
 		if response.InstanceNameVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.InstanceNameVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("instance-name")
+			readInstanceNameVirtualAttributeResponseDataSource(ctx, response.InstanceNameVirtualAttributeResponse, &object, &resp.Diagnostics)
 		}
 		if response.MemberVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.MemberVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("member")
+			readMemberVirtualAttributeResponseDataSource(ctx, response.MemberVirtualAttributeResponse, &object, &resp.Diagnostics)
 		}
 		if response.PasswordPolicyStateJsonVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.PasswordPolicyStateJsonVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("password-policy-state-json")
+			readPasswordPolicyStateJsonVirtualAttributeResponseDataSource(ctx, response.PasswordPolicyStateJsonVirtualAttributeResponse, &object, &resp.Diagnostics)
 		}
 		if response.SubschemaSubentryVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.SubschemaSubentryVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("subschema-subentry")
+			readSubschemaSubentryVirtualAttributeResponseDataSource(ctx, response.SubschemaSubentryVirtualAttributeResponse, &object, &resp.Diagnostics)
 		}
 		if response.DnJoinVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.DnJoinVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("dn-join")
+			readDnJoinVirtualAttributeResponseDataSource(ctx, response.DnJoinVirtualAttributeResponse, &object, &resp.Diagnostics)
 		}
 		if response.LargeAttributeVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.LargeAttributeVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("large-attribute")
+			object.Id = types.StringValue(response.LargeAttributeVirtualAttributeResponse.Id)
+			object.Type = types.StringValue("large-attribute")
 		}
 		if response.ThirdPartyVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.ThirdPartyVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("third-party")
+			readThirdPartyVirtualAttributeResponseDataSource(ctx, response.ThirdPartyVirtualAttributeResponse, &object, &resp.Diagnostics)
 		}
 		if response.MemberOfServerGroupVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.MemberOfServerGroupVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("member-of-server-group")
+			readMemberOfServerGroupVirtualAttributeResponseDataSource(ctx, response.MemberOfServerGroupVirtualAttributeResponse, &object, &resp.Diagnostics)
 		}
 		if response.ConstructedVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.ConstructedVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("constructed")
+			readConstructedVirtualAttributeResponseDataSource(ctx, response.ConstructedVirtualAttributeResponse, &object, &resp.Diagnostics)
 		}
 		if response.CustomVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.CustomVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("custom")
+			readCustomVirtualAttributeResponseDataSource(ctx, response.CustomVirtualAttributeResponse, &object, &resp.Diagnostics)
 		}
 		if response.FileBasedVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.FileBasedVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("file-based")
+			object.Id = types.StringValue(response.FileBasedVirtualAttributeResponse.Id)
+			object.Type = types.StringValue("file-based")
 		}
 		if response.NumSubordinatesVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.NumSubordinatesVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("num-subordinates")
+			readNumSubordinatesVirtualAttributeResponseDataSource(ctx, response.NumSubordinatesVirtualAttributeResponse, &object, &resp.Diagnostics)
 		}
 		if response.CurrentTimeVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.CurrentTimeVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("current-time")
+			readCurrentTimeVirtualAttributeResponseDataSource(ctx, response.CurrentTimeVirtualAttributeResponse, &object, &resp.Diagnostics)
 		}
 		if response.EntryUuidVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.EntryUuidVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("entry-uuid")
+			object.Id = types.StringValue(response.EntryUuidVirtualAttributeResponse.Id)
+			object.Type = types.StringValue("entry-uuid")
 		}
 		if response.EntryDnVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.EntryDnVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("entry-dn")
+			readEntryDnVirtualAttributeResponseDataSource(ctx, response.EntryDnVirtualAttributeResponse, &object, &resp.Diagnostics)
 		}
 		if response.HasSubordinatesVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.HasSubordinatesVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("has-subordinates")
+			readHasSubordinatesVirtualAttributeResponseDataSource(ctx, response.HasSubordinatesVirtualAttributeResponse, &object, &resp.Diagnostics)
 		}
 		if response.ConfigModelVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.ConfigModelVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("config-model")
+			object.Id = types.StringValue(response.ConfigModelVirtualAttributeResponse.Id)
+			object.Type = types.StringValue("config-model")
 		}
 		if response.EqualityJoinVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.EqualityJoinVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("equality-join")
+			readEqualityJoinVirtualAttributeResponseDataSource(ctx, response.EqualityJoinVirtualAttributeResponse, &object, &resp.Diagnostics)
 		}
 		if response.GroovyScriptedVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.GroovyScriptedVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("groovy-scripted")
+			readGroovyScriptedVirtualAttributeResponseDataSource(ctx, response.GroovyScriptedVirtualAttributeResponse, &object, &resp.Diagnostics)
 		}
 		if response.ReplicationStateDetailVirtualAttributeResponse != nil {
-			attributes["id"] = types.StringValue(response.ReplicationStateDetailVirtualAttributeResponse.Id)
-			attributes["type"] = types.StringValue("replication-state-detail")
+			readReplicationStateDetailVirtualAttributeResponseDataSource(ctx, response.ReplicationStateDetailVirtualAttributeResponse, &object, &resp.Diagnostics)
+		}
+		if !state.Matches(ctx, objectType, object, &resp.Diagnostics) {
+			continue
 		}
-		obj, diags := types.ObjectValue(internaltypes.ObjectsAttrTypes(), attributes)
-		resp.Diagnostics.Append(diags...)
-		objects = append(objects, obj)
+		objects = append(objects, config.DataSourceObjectValue(ctx, objectType, object, state.IncludeAttributes.ValueBool(), &resp.Diagnostics))
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Objects, diags = types.SetValue(internaltypes.ObjectsObjectType(), objects)
+	state.Objects, diags = types.SetValue(objectType, objects)
 	resp.Diagnostics.Append(diags...)
+	state.ObjectsById = config.DataSourceObjectsById(objectType, objects, &resp.Diagnostics)
 	state.Id = types.StringValue("id")
 
 	// Set refreshed state
@@ -29,9 +29,11 @@ var (
 	_ resource.Resource                = &webApplicationExtensionResource{}
 	_ resource.ResourceWithConfigure   = &webApplicationExtensionResource{}
 	_ resource.ResourceWithImportState = &webApplicationExtensionResource{}
+	_ resource.ResourceWithMoveState   = &webApplicationExtensionResource{}
 	_ resource.Resource                = &defaultWebApplicationExtensionResource{}
 	_ resource.ResourceWithConfigure   = &defaultWebApplicationExtensionResource{}
 	_ resource.ResourceWithImportState = &defaultWebApplicationExtensionResource{}
+	_ resource.ResourceWithMoveState   = &defaultWebApplicationExtensionResource{}
 )
 
 // Create a Web Application Extension resource
@@ -109,6 +111,8 @@ type defaultWebApplicationExtensionResourceModel struct {
 	SsoEnabled                          types.Bool   `tfsdk:"sso_enabled"`
 	OidcClientID                        types.String `tfsdk:"oidc_client_id"`
 	OidcClientSecret                    types.String `tfsdk:"oidc_client_secret"`
+	OidcClientSecretWo                  types.String `tfsdk:"oidc_client_secret_wo"`
+	OidcClientSecretWoVersion           types.Int64  `tfsdk:"oidc_client_secret_wo_version"`
 	OidcClientSecretPassphraseProvider  types.String `tfsdk:"oidc_client_secret_passphrase_provider"`
 	OidcIssuerURL                       types.String `tfsdk:"oidc_issuer_url"`
 	OidcTrustStoreFile                  types.String `tfsdk:"oidc_trust_store_file"`
@@ -258,6 +262,9 @@ func webApplicationExtensionSchema(ctx context.Context, req resource.SchemaReque
 		}
 		config.SetAttributesToOptionalAndComputedAndRemoveDefaults(&schemaDef, []string{"type"})
 	}
+	if isDefault {
+		config.AddWriteOnlyAttributeSchema(&schemaDef, "oidc_client_secret")
+	}
 	config.AddCommonResourceSchema(&schemaDef, true)
 	resp.Schema = schemaDef
 }
@@ -300,6 +307,7 @@ func configValidatorsWebApplicationExtension() []resource.ConfigValidator {
 			[]string{"console"},
 			resourcevalidator.Conflicting(
 				path.MatchRoot("oidc_client_secret"),
+				path.MatchRoot("oidc_client_secret_wo"),
 				path.MatchRoot("oidc_client_secret_passphrase_provider"),
 			),
 		),
@@ -334,6 +342,11 @@ func (r defaultWebApplicationExtensionResource) ConfigValidators(ctx context.Con
 			path.MatchRoot("type"),
 			[]string{"console"},
 		),
+		configvalidators.ImpliesOtherAttributeOneOfString(
+			path.MatchRoot("oidc_client_secret_wo"),
+			path.MatchRoot("type"),
+			[]string{"console"},
+		),
 		configvalidators.ImpliesOtherAttributeOneOfString(
 			path.MatchRoot("oidc_client_secret_passphrase_provider"),
 			path.MatchRoot("type"),
@@ -506,6 +519,9 @@ func (state *defaultWebApplicationExtensionResourceModel) setStateValuesNotRetur
 	if !expectedValues.OidcClientSecret.IsUnknown() {
 		state.OidcClientSecret = expectedValues.OidcClientSecret
 	}
+	if !expectedValues.OidcClientSecretWoVersion.IsUnknown() {
+		state.OidcClientSecretWoVersion = expectedValues.OidcClientSecretWoVersion
+	}
 }
 
 // Create any update operations necessary to make the state match the plan
@@ -527,6 +543,7 @@ func createWebApplicationExtensionOperationsDefault(plan defaultWebApplicationEx
 	operations.AddBoolOperationIfNecessary(&ops, plan.SsoEnabled, state.SsoEnabled, "sso-enabled")
 	operations.AddStringOperationIfNecessary(&ops, plan.OidcClientID, state.OidcClientID, "oidc-client-id")
 	operations.AddStringOperationIfNecessary(&ops, plan.OidcClientSecret, state.OidcClientSecret, "oidc-client-secret")
+	operations.AddWriteOnlyStringOperationIfNecessary(&ops, plan.OidcClientSecretWo, plan.OidcClientSecretWoVersion, state.OidcClientSecretWoVersion, "oidc-client-secret")
 	operations.AddStringOperationIfNecessary(&ops, plan.OidcClientSecretPassphraseProvider, state.OidcClientSecretPassphraseProvider, "oidc-client-secret-passphrase-provider")
 	operations.AddStringOperationIfNecessary(&ops, plan.OidcIssuerURL, state.OidcIssuerURL, "oidc-issuer-url")
 	operations.AddStringOperationIfNecessary(&ops, plan.OidcTrustStoreFile, state.OidcTrustStoreFile, "oidc-trust-store-file")
@@ -572,7 +589,7 @@ func (r *webApplicationExtensionResource) CreateGenericWebApplicationExtension(c
 
 	addResponse, httpResp, err := r.apiClient.WebApplicationExtensionAPI.AddWebApplicationExtensionExecute(apiAddRequest)
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Web Application Extension", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the Web Application Extension", err, httpResp)
 		return nil, err
 	}
 
@@ -603,6 +620,8 @@ func (r *webApplicationExtensionResource) Create(ctx context.Context, req resour
 		return
 	}
 
+	config.HandleRequiredActions(ctx, r.providerConfig, "Web Application Extension", state.Id, state.RequiredActions, &resp.Diagnostics)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *state)
 	resp.Diagnostics.Append(diags...)
@@ -623,11 +642,16 @@ func (r *defaultWebApplicationExtensionResource) Create(ctx context.Context, req
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("oidc_client_secret_wo"), &plan.OidcClientSecretWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	readResponse, httpResp, err := r.apiClient.WebApplicationExtensionAPI.GetWebApplicationExtension(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Name.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Web Application Extension", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Web Application Extension", err, httpResp)
 		return
 	}
 
@@ -656,7 +680,7 @@ func (r *defaultWebApplicationExtensionResource) Create(ctx context.Context, req
 
 		updateResponse, httpResp, err := r.apiClient.WebApplicationExtensionAPI.UpdateWebApplicationExtensionExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Web Application Extension", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Web Application Extension", err, httpResp)
 			return
 		}
 
@@ -673,6 +697,8 @@ func (r *defaultWebApplicationExtensionResource) Create(ctx context.Context, req
 		if updateResponse.GenericWebApplicationExtensionResponse != nil {
 			readGenericWebApplicationExtensionResponseDefault(ctx, updateResponse.GenericWebApplicationExtensionResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Web Application Extension", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	state.setStateValuesNotReturnedByAPI(&plan)
@@ -778,7 +804,7 @@ func (r *webApplicationExtensionResource) Update(ctx context.Context, req resour
 
 		updateResponse, httpResp, err := r.apiClient.WebApplicationExtensionAPI.UpdateWebApplicationExtensionExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Web Application Extension", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Web Application Extension", err, httpResp)
 			return
 		}
 
@@ -792,6 +818,8 @@ func (r *webApplicationExtensionResource) Update(ctx context.Context, req resour
 		if updateResponse.GenericWebApplicationExtensionResponse != nil {
 			readGenericWebApplicationExtensionResponse(ctx, updateResponse.GenericWebApplicationExtensionResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Web Application Extension", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -811,6 +839,11 @@ func (r *defaultWebApplicationExtensionResource) Update(ctx context.Context, req
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Write-only values are only available in the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("oidc_client_secret_wo"), &plan.OidcClientSecretWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Get the current state to see how any attributes are changing
 	var state defaultWebApplicationExtensionResourceModel
@@ -827,7 +860,7 @@ func (r *defaultWebApplicationExtensionResource) Update(ctx context.Context, req
 
 		updateResponse, httpResp, err := r.apiClient.WebApplicationExtensionAPI.UpdateWebApplicationExtensionExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Web Application Extension", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Web Application Extension", err, httpResp)
 			return
 		}
 
@@ -844,6 +877,8 @@ func (r *defaultWebApplicationExtensionResource) Update(ctx context.Context, req
 		if updateResponse.GenericWebApplicationExtensionResponse != nil {
 			readGenericWebApplicationExtensionResponseDefault(ctx, updateResponse.GenericWebApplicationExtensionResponse, &state, &plan, &resp.Diagnostics)
 		}
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Web Application Extension", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -891,3 +926,16 @@ func importWebApplicationExtension(ctx context.Context, req resource.ImportState
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// Allow moving state between the resource and its default counterpart, since both manage the same config object
+func (r *webApplicationExtensionResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_default_web_application_extension"),
+	}
+}
+
+func (r *defaultWebApplicationExtensionResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		config.StateMoverFromResourceType("pingdirectory_web_application_extension"),
+	}
+}
@@ -257,7 +257,7 @@ func (r *workQueueResource) Create(ctx context.Context, req resource.CreateReque
 	readResponse, httpResp, err := r.apiClient.WorkQueueAPI.GetWorkQueue(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig)).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Work Queue", err, httpResp)
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Work Queue", err, httpResp)
 		return
 	}
 
@@ -281,7 +281,7 @@ func (r *workQueueResource) Create(ctx context.Context, req resource.CreateReque
 
 		updateResponse, httpResp, err := r.apiClient.WorkQueueAPI.UpdateWorkQueueExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Work Queue", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Work Queue", err, httpResp)
 			return
 		}
 
@@ -293,6 +293,8 @@ func (r *workQueueResource) Create(ctx context.Context, req resource.CreateReque
 
 		// Read the response
 		readHighThroughputWorkQueueResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Work Queue", state.Id, state.RequiredActions, &resp.Diagnostics)
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -358,7 +360,7 @@ func (r *workQueueResource) Update(ctx context.Context, req resource.UpdateReque
 
 		updateResponse, httpResp, err := r.apiClient.WorkQueueAPI.UpdateWorkQueueExecute(updateRequest)
 		if err != nil {
-			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Work Queue", err, httpResp)
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the Work Queue", err, httpResp)
 			return
 		}
 
@@ -370,6 +372,8 @@ func (r *workQueueResource) Update(ctx context.Context, req resource.UpdateReque
 
 		// Read the response
 		readHighThroughputWorkQueueResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+
+		config.HandleRequiredActions(ctx, r.providerConfig, "Work Queue", state.Id, state.RequiredActions, &resp.Diagnostics)
 	} else {
 		tflog.Warn(ctx, "No configuration API operations created for update")
 	}
@@ -0,0 +1,365 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package alarm
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/directory"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Base DNs of the alarm and alert backends
+const (
+	alarmsBaseDN = "cn=alarms"
+	alertsBaseDN = "cn=alerts"
+)
+
+// Number of recent alerts returned when max_alerts isn't set
+const defaultMaxAlerts = 100
+
+// Severities of alarms, from least to most severe
+var alarmSeverities = []string{"normal", "indeterminate", "warning", "minor", "major", "critical"}
+
+// Severities of alerts, from least to most severe
+var alertSeverities = []string{"info", "warning", "error", "fatal"}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &alarmsDataSource{}
+	_ datasource.DataSourceWithConfigure = &alarmsDataSource{}
+)
+
+// Create an Alarms data source
+func NewAlarmsDataSource() datasource.DataSource {
+	return &alarmsDataSource{}
+}
+
+// alarmsDataSource is the datasource implementation.
+type alarmsDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the data source type name.
+func (r *alarmsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alarms"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *alarmsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+type alarmsDataSourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Severities      types.Set    `tfsdk:"severities"`
+	AlertSeverities types.Set    `tfsdk:"alert_severities"`
+	MaxAlerts       types.Int64  `tfsdk:"max_alerts"`
+	Alarms          types.List   `tfsdk:"alarms"`
+	Alerts          types.List   `tfsdk:"alerts"`
+	HighestSeverity types.String `tfsdk:"highest_severity"`
+}
+
+// Attribute types of each object in the alarms attribute
+var alarmAttrTypes = map[string]attr.Type{
+	"id":            types.StringType,
+	"condition":     types.StringType,
+	"severity":      types.StringType,
+	"gauge":         types.StringType,
+	"resource":      types.StringType,
+	"resource_type": types.StringType,
+	"details":       types.StringType,
+	"since":         types.StringType,
+}
+
+// Attribute types of each object in the alerts attribute
+var alertAttrTypes = map[string]attr.Type{
+	"id":        types.StringType,
+	"type":      types.StringType,
+	"severity":  types.StringType,
+	"message":   types.StringType,
+	"generator": types.StringType,
+	"time":      types.StringType,
+}
+
+// GetSchema defines the schema for the datasource.
+func (r *alarmsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	schemaDef := schema.Schema{
+		Description: "Lists the current alarms from the alarm backend and the recent alerts from the alert backend, read through the Directory REST API.",
+		Attributes: map[string]schema.Attribute{
+			"severities": schema.SetAttribute{
+				Description: "Only include alarms with these severities. Supported values are `" + strings.Join(alarmSeverities, "`, `") + "`. Defaults to every severity except `normal`, so that cleared alarms are left out.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOfCaseInsensitive(alarmSeverities...)),
+				},
+			},
+			"alert_severities": schema.SetAttribute{
+				Description: "Only include alerts with these severities. Supported values are `" + strings.Join(alertSeverities, "`, `") + "`. Defaults to every severity.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOfCaseInsensitive(alertSeverities...)),
+				},
+			},
+			"max_alerts": schema.Int64Attribute{
+				Description: "Maximum number of recent alerts to include. Defaults to 100. Set to 0 to leave out alerts.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"alarms": schema.ListNestedAttribute{
+				Description: "The matching alarms, from most to least severe.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the alarm.",
+							Computed:    true,
+						},
+						"condition": schema.StringAttribute{
+							Description: "The condition that raised the alarm.",
+							Computed:    true,
+						},
+						"severity": schema.StringAttribute{
+							Description: "The current severity of the alarm.",
+							Computed:    true,
+						},
+						"gauge": schema.StringAttribute{
+							Description: "The name of the gauge that raised the alarm, if any.",
+							Computed:    true,
+						},
+						"resource": schema.StringAttribute{
+							Description: "The specific resource that the alarm applies to, such as a backend or disk.",
+							Computed:    true,
+						},
+						"resource_type": schema.StringAttribute{
+							Description: "The type of the resource that the alarm applies to.",
+							Computed:    true,
+						},
+						"details": schema.StringAttribute{
+							Description: "Details about the alarm.",
+							Computed:    true,
+						},
+						"since": schema.StringAttribute{
+							Description: "When the alarm entered its current severity, in RFC 3339 format.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"alerts": schema.ListNestedAttribute{
+				Description: "The matching recent alerts, newest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the alert.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the alert, such as `server-started`.",
+							Computed:    true,
+						},
+						"severity": schema.StringAttribute{
+							Description: "The severity of the alert.",
+							Computed:    true,
+						},
+						"message": schema.StringAttribute{
+							Description: "The alert message.",
+							Computed:    true,
+						},
+						"generator": schema.StringAttribute{
+							Description: "The server component that generated the alert.",
+							Computed:    true,
+						},
+						"time": schema.StringAttribute{
+							Description: "When the alert was generated, in RFC 3339 format.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"highest_severity": schema.StringAttribute{
+				Description: "The highest severity of the matching alarms, or `normal` if there are none.",
+				Computed:    true,
+			},
+		},
+	}
+	config.AddCommonDataSourceSchema(&schemaDef, false)
+	resp.Schema = schemaDef
+}
+
+// Get an LDAP filter matching entries with an object class and any of the given values of an attribute
+func severityFilter(objectClass, attributeName string, severities []string) string {
+	filter := "(objectClass=" + objectClass + ")"
+	if len(severities) == 0 {
+		return filter
+	}
+	var severityFilters strings.Builder
+	for _, severity := range severities {
+		severityFilters.WriteString("(" + attributeName + "=" + directory.EscapeFilterValue(strings.ToLower(severity)) + ")")
+	}
+	return "(&" + filter + "(|" + severityFilters.String() + "))"
+}
+
+// Get the rank of a severity, with higher ranks being more severe
+func severityRank(severities []string, severity string) int {
+	for i, s := range severities {
+		if strings.EqualFold(s, severity) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Convert an LDAP generalized time, such as 20250102030405.678Z, to RFC 3339 format
+func rfc3339Time(generalizedTime string) types.String {
+	if generalizedTime == "" {
+		return types.StringNull()
+	}
+	for _, layout := range []string{"20060102150405.999Z0700", "20060102150405Z0700"} {
+		if parsed, err := time.Parse(layout, generalizedTime); err == nil {
+			return types.StringValue(parsed.UTC().Format(time.RFC3339))
+		}
+	}
+	return types.StringValue(generalizedTime)
+}
+
+// Get an optional string attribute value from an entry
+func entryValue(entry directory.Entry, attributeName string) types.String {
+	if value := entry.Value(attributeName); value != "" {
+		return types.StringValue(value)
+	}
+	return types.StringNull()
+}
+
+// Read the alarms into the model struct
+func readAlarms(entries []directory.Entry, state *alarmsDataSourceModel, diagnostics *diag.Diagnostics) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return severityRank(alarmSeverities, entries[i].Value("ds-alarm-severity")) > severityRank(alarmSeverities, entries[j].Value("ds-alarm-severity"))
+	})
+	highestSeverity := alarmSeverities[0]
+	alarms := make([]attr.Value, 0, len(entries))
+	for _, entry := range entries {
+		severity := entry.Value("ds-alarm-severity")
+		if severityRank(alarmSeverities, severity) > severityRank(alarmSeverities, highestSeverity) {
+			highestSeverity = strings.ToLower(severity)
+		}
+		since := entry.Value("ds-alarm-severity-start-time")
+		if since == "" {
+			since = entry.Value("ds-alarm-start-time")
+		}
+		alarm, diags := types.ObjectValue(alarmAttrTypes, map[string]attr.Value{
+			"id":            entryValue(entry, "ds-alarm-id"),
+			"condition":     entryValue(entry, "ds-alarm-condition"),
+			"severity":      entryValue(entry, "ds-alarm-severity"),
+			"gauge":         entryValue(entry, "ds-alarm-gauge-name"),
+			"resource":      entryValue(entry, "ds-alarm-specific-resource"),
+			"resource_type": entryValue(entry, "ds-alarm-specific-resource-type"),
+			"details":       entryValue(entry, "ds-alarm-details"),
+			"since":         rfc3339Time(since),
+		})
+		diagnostics.Append(diags...)
+		alarms = append(alarms, alarm)
+	}
+	var diags diag.Diagnostics
+	state.Alarms, diags = types.ListValue(types.ObjectType{AttrTypes: alarmAttrTypes}, alarms)
+	diagnostics.Append(diags...)
+	state.HighestSeverity = types.StringValue(highestSeverity)
+}
+
+// Read the most recent alerts into the model struct
+func readAlerts(entries []directory.Entry, maxAlerts int, state *alarmsDataSourceModel, diagnostics *diag.Diagnostics) {
+	// Generalized times in the same time zone sort chronologically
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Value("ds-alert-time") > entries[j].Value("ds-alert-time")
+	})
+	if len(entries) > maxAlerts {
+		entries = entries[:maxAlerts]
+	}
+	alerts := make([]attr.Value, 0, len(entries))
+	for _, entry := range entries {
+		alert, diags := types.ObjectValue(alertAttrTypes, map[string]attr.Value{
+			"id":        entryValue(entry, "ds-alert-id"),
+			"type":      entryValue(entry, "ds-alert-type"),
+			"severity":  entryValue(entry, "ds-alert-severity"),
+			"message":   entryValue(entry, "ds-alert-message"),
+			"generator": entryValue(entry, "ds-alert-generator"),
+			"time":      rfc3339Time(entry.Value("ds-alert-time")),
+		})
+		diagnostics.Append(diags...)
+		alerts = append(alerts, alert)
+	}
+	var diags diag.Diagnostics
+	state.Alerts, diags = types.ListValue(types.ObjectType{AttrTypes: alertAttrTypes}, alerts)
+	diagnostics.Append(diags...)
+}
+
+// Read resource information
+func (r *alarmsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current state
+	var state alarmsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	severities := internaltypes.GetStringSetValues(ctx, state.Severities, &resp.Diagnostics)
+	if len(severities) == 0 {
+		// Leave out cleared alarms by default
+		severities = alarmSeverities[1:]
+	}
+	alarmEntries, httpResp, err := directory.SearchEntries(ctx, r.providerConfig, r.apiClient, alarmsBaseDN, directory.ScopeSingleLevel,
+		severityFilter("ds-admin-alarm", "ds-alarm-severity", severities), nil)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while searching for Alarms", err, httpResp)
+		return
+	}
+	// Read the response into the state
+	readAlarms(alarmEntries, &state, &resp.Diagnostics)
+
+	maxAlerts := defaultMaxAlerts
+	if internaltypes.IsDefined(state.MaxAlerts) {
+		maxAlerts = int(state.MaxAlerts.ValueInt64())
+	}
+	var alertEntries []directory.Entry
+	if maxAlerts > 0 {
+		alertEntries, httpResp, err = directory.SearchEntries(ctx, r.providerConfig, r.apiClient, alertsBaseDN, directory.ScopeSingleLevel,
+			severityFilter("ds-admin-alert", "ds-alert-severity", internaltypes.GetStringSetValues(ctx, state.AlertSeverities, &resp.Diagnostics)), nil)
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while searching for Alerts", err, httpResp)
+			return
+		}
+	}
+	readAlerts(alertEntries, maxAlerts, &state, &resp.Diagnostics)
+	state.Id = types.StringValue("id")
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,360 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package directory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Base path of the Directory REST API
+const basePath = "/directory/v1"
+
+// Name of the DN field in Directory REST API entries
+const dnKey = "_dn"
+
+// Search scopes supported by the Directory REST API
+const (
+	ScopeBaseObject   = "baseObject"
+	ScopeSingleLevel  = "singleLevel"
+	ScopeSubordinate  = "subordinateSubtree"
+	ScopeWholeSubtree = "wholeSubtree"
+)
+
+// An LDAP entry read through the Directory REST API
+type Entry struct {
+	DN string
+	// Values of each attribute, as strings. JSON attribute values are encoded as JSON.
+	Attributes map[string][]string
+}
+
+// Get the object classes of the entry
+func (e Entry) ObjectClasses() []string {
+	return e.Values("objectClass")
+}
+
+// Get the values of an attribute. Attribute names are matched case-insensitively, as in LDAP.
+func (e Entry) Values(attributeName string) []string {
+	for name, values := range e.Attributes {
+		if strings.EqualFold(name, attributeName) {
+			return values
+		}
+	}
+	return nil
+}
+
+// Get the first value of an attribute, or an empty string if the attribute has no values
+func (e Entry) Value(attributeName string) string {
+	values := e.Values(attributeName)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// Get the Directory REST API path for an entry
+func entryPath(dn string) string {
+	return basePath + "/" + url.PathEscape(dn)
+}
+
+// Convert an entry from Directory REST API JSON
+func parseEntry(object map[string]any) Entry {
+	entry := Entry{Attributes: map[string][]string{}}
+	for name, value := range object {
+		if name == dnKey {
+			entry.DN, _ = value.(string)
+			continue
+		}
+		// Skip other metadata fields, such as _links
+		if strings.HasPrefix(name, "_") {
+			continue
+		}
+		entry.Attributes[name] = valueStrings(value)
+	}
+	return entry
+}
+
+// Get the string form of each value of an attribute
+func valueStrings(value any) []string {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return []string{v}
+	case []any:
+		var values []string
+		for _, element := range v {
+			values = append(values, valueStrings(element)...)
+		}
+		return values
+	case map[string]any:
+		valueJson, _ := json.Marshal(v)
+		return []string{string(valueJson)}
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
+
+// Send a request to the Directory REST API and parse the JSON response
+func sendRequest(ctx context.Context, providerConfig internaltypes.ProviderConfiguration, apiClient *client.APIClient, method, path string, body any) (map[string]any, *http.Response, error) {
+	return parseResponse(config.SendRawRequest(ctx, providerConfig, apiClient, method, path, body))
+}
+
+// Send a request like sendRequest, without logging the request body, which contains sensitive values
+func sendSensitiveRequest(ctx context.Context, providerConfig internaltypes.ProviderConfiguration, apiClient *client.APIClient, method, path string, body any) (map[string]any, *http.Response, error) {
+	return parseResponse(config.SendSensitiveRawRequest(ctx, providerConfig, apiClient, method, path, body))
+}
+
+// Parse the JSON response of a Directory REST API request
+func parseResponse(responseBody []byte, httpResp *http.Response, err error) (map[string]any, *http.Response, error) {
+	if err != nil {
+		return nil, httpResp, err
+	}
+	var response map[string]any
+	if len(responseBody) > 0 {
+		err = json.Unmarshal(responseBody, &response)
+	}
+	return response, httpResp, err
+}
+
+// Read an entry. If attributes is not empty, only those attributes are returned.
+func GetEntry(ctx context.Context, providerConfig internaltypes.ProviderConfiguration, apiClient *client.APIClient, dn string, attributes []string) (*Entry, *http.Response, error) {
+	path := entryPath(dn)
+	if len(attributes) > 0 {
+		path += "?" + url.Values{"includeAttributes": {strings.Join(attributes, ",")}}.Encode()
+	}
+	response, httpResp, err := sendRequest(ctx, providerConfig, apiClient, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, httpResp, err
+	}
+	entry := parseEntry(response)
+	return &entry, httpResp, nil
+}
+
+// Search for entries below a base DN. If attributes is not empty, only those attributes are returned.
+func SearchEntries(ctx context.Context, providerConfig internaltypes.ProviderConfiguration, apiClient *client.APIClient, baseDN, scope, filter string, attributes []string) ([]Entry, *http.Response, error) {
+	query := url.Values{
+		"searchScope": {scope},
+		"filter":      {filter},
+	}
+	if len(attributes) > 0 {
+		query.Set("includeAttributes", strings.Join(attributes, ","))
+	}
+	response, httpResp, err := sendRequest(ctx, providerConfig, apiClient, http.MethodGet, entryPath(baseDN)+"/subtree?"+query.Encode(), nil)
+	if err != nil {
+		return nil, httpResp, err
+	}
+
+	// Search results are returned in the "entries" array of the "_embedded" object
+	embedded, _ := response["_embedded"].(map[string]any)
+	rawEntries, _ := embedded["entries"].([]any)
+	entries := make([]Entry, 0, len(rawEntries))
+	for _, rawEntry := range rawEntries {
+		if object, ok := rawEntry.(map[string]any); ok {
+			entries = append(entries, parseEntry(object))
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].DN < entries[j].DN
+	})
+	return entries, httpResp, nil
+}
+
+// Get the attribute maps for an entry: the first value of each attribute, and all values of each attribute
+func EntryAttributeMaps(entry Entry, diagnostics *diag.Diagnostics) (types.Map, types.Map) {
+	firstValues := map[string]attr.Value{}
+	allValues := map[string]attr.Value{}
+	for name, values := range entry.Attributes {
+		if len(values) > 0 {
+			firstValues[name] = types.StringValue(values[0])
+		}
+		allValues[name] = internaltypes.GetStringList(values)
+	}
+	firstValuesMap, diags := types.MapValue(types.StringType, firstValues)
+	diagnostics.Append(diags...)
+	allValuesMap, diags := types.MapValue(types.ListType{ElemType: types.StringType}, allValues)
+	diagnostics.Append(diags...)
+	return firstValuesMap, allValuesMap
+}
+
+// Escape a value for use in an LDAP filter, as described in RFC 4515
+func EscapeFilterValue(value string) string {
+	var escaped strings.Builder
+	for i := 0; i < len(value); i++ {
+		switch c := value[i]; c {
+		case '*', '(', ')', '\\', 0:
+			fmt.Fprintf(&escaped, "\\%02x", c)
+		default:
+			escaped.WriteByte(c)
+		}
+	}
+	return escaped.String()
+}
+
+// Escape a value for use in a DN, as described in RFC 4514
+func EscapeDNValue(value string) string {
+	var escaped strings.Builder
+	for i, r := range value {
+		switch {
+		case strings.ContainsRune(",+\"\\<>;=", r),
+			(r == '#' || r == ' ') && i == 0,
+			r == ' ' && i == len(value)-1:
+			escaped.WriteRune('\\')
+			escaped.WriteRune(r)
+		default:
+			escaped.WriteRune(r)
+		}
+	}
+	return escaped.String()
+}
+
+// Modification types supported by the Directory REST API
+const (
+	ModificationAdd    = "add"
+	ModificationRemove = "remove"
+	ModificationSet    = "set"
+)
+
+// A modification of one attribute of an entry. A remove modification without values removes the attribute.
+type Modification struct {
+	AttributeName    string   `json:"attributeName"`
+	ModificationType string   `json:"modificationType"`
+	Values           []string `json:"values,omitempty"`
+}
+
+// Create an entry. If sensitive is true, the request body is not logged, since it contains sensitive values.
+func AddEntry(ctx context.Context, providerConfig internaltypes.ProviderConfiguration, apiClient *client.APIClient, entry Entry, sensitive bool) (*Entry, *http.Response, error) {
+	body := map[string]any{dnKey: entry.DN}
+	for name, values := range entry.Attributes {
+		body[name] = values
+	}
+	send := sendRequest
+	if sensitive {
+		send = sendSensitiveRequest
+	}
+	response, httpResp, err := send(ctx, providerConfig, apiClient, http.MethodPost, basePath, body)
+	if err != nil {
+		return nil, httpResp, err
+	}
+	created := parseEntry(response)
+	return &created, httpResp, nil
+}
+
+// Apply modifications to an entry. The modifications are applied atomically. If sensitive is true, the request
+// body is not logged, since the modifications contain sensitive values.
+func ModifyEntry(ctx context.Context, providerConfig internaltypes.ProviderConfiguration, apiClient *client.APIClient, dn string, modifications []Modification, sensitive bool) (*Entry, *http.Response, error) {
+	body := map[string]any{"modifications": modifications}
+	send := sendRequest
+	if sensitive {
+		send = sendSensitiveRequest
+	}
+	response, httpResp, err := send(ctx, providerConfig, apiClient, http.MethodPatch, entryPath(dn), body)
+	if err != nil {
+		return nil, httpResp, err
+	}
+	modified := parseEntry(response)
+	return &modified, httpResp, nil
+}
+
+// Delete an entry
+func DeleteEntry(ctx context.Context, providerConfig internaltypes.ProviderConfiguration, apiClient *client.APIClient, dn string) (*http.Response, error) {
+	_, httpResp, err := sendRequest(ctx, providerConfig, apiClient, http.MethodDelete, entryPath(dn), nil)
+	return httpResp, err
+}
+
+// Log the modifications of an entry update
+func LogModifications(ctx context.Context, modifications []Modification) {
+	if len(modifications) == 0 {
+		return
+	}
+
+	tflog.Debug(ctx, "Update using the following modifications:")
+	for _, modification := range modifications {
+		modificationJson, err := json.Marshal(modification)
+		if err == nil {
+			tflog.Debug(ctx, "Update modification: "+string(modificationJson))
+		}
+	}
+}
+
+// Determine whether two lists hold the same attribute values, ignoring their order
+func SameValues(values, otherValues []string) bool {
+	if len(values) != len(otherValues) {
+		return false
+	}
+	counts := map[string]int{}
+	for _, value := range values {
+		counts[value]++
+	}
+	for _, value := range otherValues {
+		if counts[value] == 0 {
+			return false
+		}
+		counts[value]--
+	}
+	return true
+}
+
+// Lowercase an ASCII letter. Other bytes are kept, so that multi-byte characters stay intact.
+func lowerASCII(c byte) byte {
+	if c >= 'A' && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
+
+// Normalize a DN for comparison: ASCII letters are lowercased, and whitespace around the separators of RDNs and
+// attribute values is removed. Escaped characters are kept as they are.
+func normalizeDN(dn string) string {
+	var normalized strings.Builder
+	pendingSpaces := 0
+	afterSeparator := true
+	for i := 0; i < len(dn); i++ {
+		c := dn[i]
+		switch {
+		case c == '\\' && i+1 < len(dn):
+			// Escaped characters, including escaped spaces, are kept
+			normalized.WriteString(strings.Repeat(" ", pendingSpaces))
+			normalized.WriteByte(c)
+			normalized.WriteByte(lowerASCII(dn[i+1]))
+			pendingSpaces = 0
+			afterSeparator = false
+			i++
+		case c == ' ':
+			if !afterSeparator {
+				pendingSpaces++
+			}
+		case c == ',' || c == '+' || c == '=' || c == ';':
+			if c == ';' {
+				c = ','
+			}
+			normalized.WriteByte(c)
+			pendingSpaces = 0
+			afterSeparator = true
+		default:
+			normalized.WriteString(strings.Repeat(" ", pendingSpaces))
+			normalized.WriteByte(lowerASCII(c))
+			pendingSpaces = 0
+			afterSeparator = false
+		}
+	}
+	return normalized.String()
+}
+
+// Determine whether two DNs are equal, ignoring case and whitespace around separators
+func EqualDNs(dn, otherDN string) bool {
+	return normalizeDN(dn) == normalizeDN(otherDN)
+}
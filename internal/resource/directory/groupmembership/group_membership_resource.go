@@ -0,0 +1,446 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package groupmembership
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/directory"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Attributes holding the members of static groups
+const (
+	memberAttribute       = "member"
+	uniqueMemberAttribute = "uniqueMember"
+)
+
+// Private state key recording the planned members that the group already had before this resource managed them
+const preexistingMembersPrivateStateKey = "preexisting_members"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &groupMembershipResource{}
+	_ resource.ResourceWithConfigure   = &groupMembershipResource{}
+	_ resource.ResourceWithImportState = &groupMembershipResource{}
+)
+
+// Create a Group Membership resource
+func NewGroupMembershipResource() resource.Resource {
+	return &groupMembershipResource{}
+}
+
+// groupMembershipResource is the resource implementation.
+type groupMembershipResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the resource type name.
+func (r *groupMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_membership"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *groupMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+type groupMembershipResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	GroupDn         types.String `tfsdk:"group_dn"`
+	MemberAttribute types.String `tfsdk:"member_attribute"`
+	Members         types.Set    `tfsdk:"members"`
+	Authoritative   types.Bool   `tfsdk:"authoritative"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *groupMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the members of an existing static group entry through the Directory REST API. Members are added and removed with incremental modifications, so other members of the group are kept unless `authoritative` is true. Unless `authoritative` is true, members in `members` that the group already had are never removed by this resource. Destroying this resource removes the members it added from the group, and leaves the group entry in place. Groups with the `groupOfNames` or `groupOfUniqueNames` object class require at least one member, so the last member of such a group is kept with a warning.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The DN of the group.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_dn": schema.StringAttribute{
+				Description: "The DN of the static group entry, such as `cn=Admins,ou=Groups,dc=example,dc=com`.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"member_attribute": schema.StringAttribute{
+				Description: "The attribute holding the members of the group: `member` for groups with the `groupOfNames` or `groupOfEntries` object class, or `uniqueMember` for groups with the `groupOfUniqueNames` object class. Defaults to `member`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(memberAttribute),
+				Validators: []validator.String{
+					stringvalidator.OneOf(memberAttribute, uniqueMemberAttribute),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"members": schema.SetAttribute{
+				Description: "DNs of the members managed by this resource. DNs are compared case-insensitively and ignoring whitespace around separators, as the server does for most DNs.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"authoritative": schema.BoolAttribute{
+				Description: "Whether this resource manages all members of the group. When true, members added outside of this resource are removed during the next apply. When false, only the members in `members` are added and removed, so several resources and other tools can manage members of the same group. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// Find a DN in a list of DNs
+func findDN(dns []string, dn string) (string, bool) {
+	for _, candidate := range dns {
+		if directory.EqualDNs(candidate, dn) {
+			return candidate, true
+		}
+	}
+	return "", false
+}
+
+// Get the group entry with the values of the member attribute. The member attribute is chosen from the values of the
+// group when it isn't known yet, which is the case when the group membership is imported.
+func (r *groupMembershipResource) getGroup(ctx context.Context, model *groupMembershipResourceModel) (*directory.Entry, *http.Response, error) {
+	attributes := []string{"objectClass", model.MemberAttribute.ValueString()}
+	if !internaltypes.IsDefined(model.MemberAttribute) {
+		attributes = []string{"objectClass", memberAttribute, uniqueMemberAttribute}
+	}
+	entry, httpResp, err := directory.GetEntry(ctx, r.providerConfig, r.apiClient, model.GroupDn.ValueString(), attributes)
+	if err != nil {
+		return nil, httpResp, err
+	}
+	if !internaltypes.IsDefined(model.MemberAttribute) {
+		model.MemberAttribute = types.StringValue(memberAttribute)
+		if len(entry.Values(memberAttribute)) == 0 && len(entry.Values(uniqueMemberAttribute)) > 0 {
+			model.MemberAttribute = types.StringValue(uniqueMemberAttribute)
+		}
+	}
+	return entry, httpResp, nil
+}
+
+// Check whether the schema requires the group to have at least one member. The groupOfNames and groupOfUniqueNames
+// object classes list their member attribute as MUST, while groupOfEntries allows groups without members.
+func requiresMember(group *directory.Entry) bool {
+	for _, objectClass := range group.ObjectClasses() {
+		if strings.EqualFold(objectClass, "groupOfNames") || strings.EqualFold(objectClass, "groupOfUniqueNames") {
+			return true
+		}
+	}
+	return false
+}
+
+// Read the planned members that the group already had before this resource managed them
+func readPreexistingMembers(ctx context.Context, private config.PrivateState, diagnostics *diag.Diagnostics) []string {
+	data, diags := private.GetKey(ctx, preexistingMembersPrivateStateKey)
+	diagnostics.Append(diags...)
+	if len(data) == 0 {
+		return nil
+	}
+	var members []string
+	err := json.Unmarshal(data, &members)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to read pre-existing group members from private state: "+err.Error())
+		return nil
+	}
+	return members
+}
+
+// Record the planned members that the group already had before this resource managed them
+func recordPreexistingMembers(ctx context.Context, private config.PrivateState, members []string, diagnostics *diag.Diagnostics) {
+	if len(members) == 0 {
+		diagnostics.Append(private.SetKey(ctx, preexistingMembersPrivateStateKey, nil)...)
+		return
+	}
+	data, err := json.Marshal(members)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to write pre-existing group members to private state: "+err.Error())
+		return
+	}
+	diagnostics.Append(private.SetKey(ctx, preexistingMembersPrivateStateKey, data)...)
+}
+
+// Get the planned members that the group already had before this resource managed them. Members that were already
+// managed keep their previous status, and newly planned members are pre-existing if the group has them already. In
+// authoritative mode the resource owns every member, so no members are pre-existing.
+func preexistingMembers(ctx context.Context, currentValues []string, plan, state *groupMembershipResourceModel, previousPreexisting []string, diagnostics *diag.Diagnostics) []string {
+	if plan.Authoritative.ValueBool() {
+		return nil
+	}
+	var plannedMembers, previousMembers []string
+	diagnostics.Append(plan.Members.ElementsAs(ctx, &plannedMembers, false)...)
+	if state != nil {
+		diagnostics.Append(state.Members.ElementsAs(ctx, &previousMembers, false)...)
+	}
+
+	var members []string
+	for _, member := range plannedMembers {
+		if _, managed := findDN(previousMembers, member); managed {
+			if _, preexisting := findDN(previousPreexisting, member); preexisting {
+				members = append(members, member)
+			}
+		} else if _, found := findDN(currentValues, member); found {
+			members = append(members, member)
+		}
+	}
+	return members
+}
+
+// Read the members of the group into the model. In authoritative mode all members are read, and otherwise only the
+// configured members are read. The configured spelling of each DN is kept.
+func readGroupMembers(ctx context.Context, values []string, state *groupMembershipResourceModel, diagnostics *diag.Diagnostics) {
+	var configuredMembers []string
+	if internaltypes.IsDefined(state.Members) {
+		diagnostics.Append(state.Members.ElementsAs(ctx, &configuredMembers, false)...)
+	}
+	members := []string{}
+	for _, value := range values {
+		configuredMember, configured := findDN(configuredMembers, value)
+		if configured {
+			members = append(members, configuredMember)
+		} else if state.Authoritative.ValueBool() {
+			members = append(members, value)
+		}
+	}
+	state.Members = internaltypes.GetStringSet(members)
+}
+
+// Get the modifications that give the group its planned members. The planned members that the group doesn't have are
+// added. Values that were previously managed and are no longer planned are removed, unless the group already had them
+// before they were managed. In authoritative mode, all other values that aren't planned are removed as well. Values
+// are compared with the current values of the group rather than the state, so that members changed outside of
+// Terraform don't make the modifications fail.
+func memberModifications(ctx context.Context, currentValues []string, plan, state *groupMembershipResourceModel, preexisting []string, diagnostics *diag.Diagnostics) []directory.Modification {
+	var plannedMembers, previousMembers []string
+	if plan != nil {
+		diagnostics.Append(plan.Members.ElementsAs(ctx, &plannedMembers, false)...)
+	}
+	if state != nil {
+		diagnostics.Append(state.Members.ElementsAs(ctx, &previousMembers, false)...)
+	}
+	authoritative := plan != nil && plan.Authoritative.ValueBool()
+
+	var addValues, removeValues []string
+	for _, member := range plannedMembers {
+		if _, found := findDN(currentValues, member); !found {
+			addValues = append(addValues, member)
+		}
+	}
+	for _, value := range currentValues {
+		if _, planned := findDN(plannedMembers, value); planned {
+			continue
+		}
+		if authoritative {
+			removeValues = append(removeValues, value)
+		} else if _, managed := findDN(previousMembers, value); managed {
+			if _, found := findDN(preexisting, value); !found {
+				removeValues = append(removeValues, value)
+			}
+		}
+	}
+
+	// The modifications are applied atomically, so members can be replaced even when the group requires a member
+	var modifications []directory.Modification
+	model := plan
+	if model == nil {
+		model = state
+	}
+	memberAttributeName := model.MemberAttribute.ValueString()
+	if len(removeValues) > 0 {
+		modifications = append(modifications, directory.Modification{
+			AttributeName:    memberAttributeName,
+			ModificationType: directory.ModificationRemove,
+			Values:           removeValues,
+		})
+	}
+	if len(addValues) > 0 {
+		modifications = append(modifications, directory.Modification{
+			AttributeName:    memberAttributeName,
+			ModificationType: directory.ModificationAdd,
+			Values:           addValues,
+		})
+	}
+	return modifications
+}
+
+// Apply modifications to the group
+func (r *groupMembershipResource) modifyGroup(ctx context.Context, groupDN string, modifications []directory.Modification, errorSummary string, diagnostics *diag.Diagnostics) bool {
+	if len(modifications) == 0 {
+		tflog.Warn(ctx, "No Directory REST API modifications created for the group")
+		return true
+	}
+	// Log modifications
+	directory.LogModifications(ctx, modifications)
+
+	_, httpResp, err := directory.ModifyEntry(ctx, r.providerConfig, r.apiClient, groupDN, modifications, false)
+	if err != nil {
+		config.ReportHttpError(ctx, diagnostics, errorSummary, err, httpResp)
+		return false
+	}
+	return true
+}
+
+// Create a new resource
+func (r *groupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan groupMembershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, httpResp, err := r.getGroup(ctx, &plan)
+	if err != nil {
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Group", err, httpResp)
+		return
+	}
+	currentValues := group.Values(plan.MemberAttribute.ValueString())
+	modifications := memberModifications(ctx, currentValues, &plan, nil, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || !r.modifyGroup(ctx, plan.GroupDn.ValueString(), modifications, "An error occurred while adding the Group Members", &resp.Diagnostics) {
+		return
+	}
+	recordPreexistingMembers(ctx, resp.Private, preexistingMembers(ctx, currentValues, &plan, nil, nil, &resp.Diagnostics), &resp.Diagnostics)
+
+	plan.Id = plan.GroupDn
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information
+func (r *groupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state groupMembershipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, httpResp, err := r.getGroup(ctx, &state)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.ReportHttpErrorAsWarning(ctx, &resp.Diagnostics, "An error occurred while getting the Group", err, httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Group", err, httpResp)
+		}
+		return
+	}
+
+	// Read the response into the state
+	readGroupMembers(ctx, group.Values(state.MemberAttribute.ValueString()), &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update a resource
+func (r *groupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan groupMembershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the current state to see which members were managed
+	var state groupMembershipResourceModel
+	req.State.Get(ctx, &state)
+	group, httpResp, err := r.getGroup(ctx, &plan)
+	if err != nil {
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while getting the Group", err, httpResp)
+		return
+	}
+	currentValues := group.Values(plan.MemberAttribute.ValueString())
+	previousPreexisting := readPreexistingMembers(ctx, req.Private, &resp.Diagnostics)
+	modifications := memberModifications(ctx, currentValues, &plan, &state, previousPreexisting, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || !r.modifyGroup(ctx, plan.GroupDn.ValueString(), modifications, "An error occurred while updating the Group Members", &resp.Diagnostics) {
+		return
+	}
+	recordPreexistingMembers(ctx, resp.Private, preexistingMembers(ctx, currentValues, &plan, &state, previousPreexisting, &resp.Diagnostics), &resp.Diagnostics)
+
+	plan.Id = state.Id
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *groupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state groupMembershipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, httpResp, err := r.getGroup(ctx, &state)
+	if err != nil {
+		// Nothing to remove when the group no longer exists
+		if httpResp == nil || httpResp.StatusCode != 404 {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Group", err, httpResp)
+		}
+		return
+	}
+	currentValues := group.Values(state.MemberAttribute.ValueString())
+	modifications := memberModifications(ctx, currentValues, nil, &state, readPreexistingMembers(ctx, req.Private, &resp.Diagnostics), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Removing every member would violate the schema of groups that require a member, so the last member is kept
+	if len(modifications) > 0 && len(modifications[0].Values) == len(currentValues) && requiresMember(group) {
+		removeValues := modifications[0].Values
+		kept := removeValues[len(removeValues)-1]
+		resp.Diagnostics.AddWarning("Group member kept",
+			"The group '"+state.GroupDn.ValueString()+"' requires at least one value of "+state.MemberAttribute.ValueString()+", so the member '"+kept+"' was kept in the group. Delete the group entry to remove it.")
+		modifications[0].Values = removeValues[:len(removeValues)-1]
+		if len(modifications[0].Values) == 0 {
+			return
+		}
+	}
+	r.modifyGroup(ctx, state.GroupDn.ValueString(), modifications, "An error occurred while removing the Group Members", &resp.Diagnostics)
+}
+
+func (r *groupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by group DN. All members of the group are imported, so the imported membership is authoritative.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_dn"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("authoritative"), true)...)
+}
@@ -0,0 +1,527 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package ldapentry
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/directory"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Name of the attribute holding the object classes of an entry, which is managed with object_classes
+const objectClassAttribute = "objectClass"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &ldapEntryResource{}
+	_ resource.ResourceWithConfigure      = &ldapEntryResource{}
+	_ resource.ResourceWithImportState    = &ldapEntryResource{}
+	_ resource.ResourceWithValidateConfig = &ldapEntryResource{}
+)
+
+// Create an LDAP Entry resource
+func NewLdapEntryResource() resource.Resource {
+	return &ldapEntryResource{}
+}
+
+// ldapEntryResource is the resource implementation.
+type ldapEntryResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the resource type name.
+func (r *ldapEntryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ldap_entry"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ldapEntryResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+type ldapEntryResourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	Dn                  types.String `tfsdk:"dn"`
+	ObjectClasses       types.Set    `tfsdk:"object_classes"`
+	Attributes          types.Map    `tfsdk:"attributes"`
+	AttributeValues     types.Map    `tfsdk:"attribute_values"`
+	SensitiveWo         types.Map    `tfsdk:"sensitive_attributes_wo"`
+	SensitiveWoVersion  types.Int64  `tfsdk:"sensitive_attributes_wo_version"`
+	ManagedAttributes   types.Set    `tfsdk:"managed_attributes"`
+	IgnoreServerChanges types.Set    `tfsdk:"ignore_server_changes"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *ldapEntryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an LDAP entry through the Directory REST API, such as a base entry, a service account or a static group. Only the configured attributes and the attributes listed in `managed_attributes` are managed. Other attributes of the entry, including operational attributes, are left unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The DN of the entry.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dn": schema.StringAttribute{
+				Description: "The DN of the entry, such as `ou=People,dc=example,dc=com`. Changing the DN replaces the entry.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"object_classes": schema.SetAttribute{
+				Description: "Object classes of the entry, such as `top` and `organizationalUnit`.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"attributes": schema.MapAttribute{
+				Description: "Single-valued attributes of the entry, such as `{ ou = \"People\" }`. Values of attributes with JSON syntax are JSON strings, for example from `jsonencode`.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"attribute_values": schema.MapAttribute{
+				Description: "Multi-valued attributes of the entry, such as `{ mail = [\"jdoe@example.com\", \"john.doe@example.com\"] }`. An attribute can be set in `attributes`, `attribute_values` or `sensitive_attributes_wo`, but only in one of them.",
+				Optional:    true,
+				ElementType: types.SetType{ElemType: types.StringType},
+				Validators: []validator.Map{
+					mapvalidator.ValueSetsAre(setvalidator.SizeAtLeast(1)),
+				},
+			},
+			"sensitive_attributes_wo": schema.MapAttribute{
+				Description: "Single-valued attributes of the entry holding secrets, such as `{ userPassword = var.password }`. These values are written to the entry but are never stored in the Terraform plan or state, and are never read back from the server. Change `sensitive_attributes_wo_version` to write new values. Removing an attribute from this map leaves its value on the entry. Requires Terraform 1.11 or later.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.AlsoRequires(path.MatchRoot("sensitive_attributes_wo_version")),
+				},
+			},
+			"sensitive_attributes_wo_version": schema.Int64Attribute{
+				Description: "Version of the `sensitive_attributes_wo` values. The write-only values are only written to the entry when this version changes.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("sensitive_attributes_wo")),
+				},
+			},
+			"managed_attributes": schema.SetAttribute{
+				Description: "Attributes that are managed by this resource even when they aren't configured. Values added to these attributes outside of Terraform are removed during the next apply.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"ignore_server_changes": schema.SetAttribute{
+				Description: "Configured attributes whose values read back from the server are not compared with the configured values, such as attributes that the server normalizes or encodes. The configured values are still written when they change. Secrets such as `userPassword` belong in `sensitive_attributes_wo` instead, which is never read back.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Validate that each attribute is configured in only one place
+func (r *ldapEntryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model ldapEntryResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuredNames := map[string]string{}
+	for _, attributeName := range []string{"attributes", "attribute_values", "sensitive_attributes_wo"} {
+		attributeMap := model.Attributes
+		if attributeName == "attribute_values" {
+			attributeMap = model.AttributeValues
+		} else if attributeName == "sensitive_attributes_wo" {
+			attributeMap = model.SensitiveWo
+		}
+		if !internaltypes.IsDefined(attributeMap) {
+			continue
+		}
+		for name := range attributeMap.Elements() {
+			if strings.EqualFold(name, objectClassAttribute) {
+				resp.Diagnostics.AddAttributeError(path.Root(attributeName).AtMapKey(name), "Invalid attribute name",
+					"The object classes of the entry are set with object_classes.")
+			} else if otherAttributeName, ok := configuredNames[strings.ToLower(name)]; ok {
+				resp.Diagnostics.AddAttributeError(path.Root(attributeName).AtMapKey(name), "Duplicate attribute name",
+					"The attribute '"+name+"' is already set in "+otherAttributeName+". LDAP attribute names are case-insensitive.")
+			}
+			configuredNames[strings.ToLower(name)] = attributeName
+		}
+	}
+	for _, attributeName := range []string{"managed_attributes", "ignore_server_changes"} {
+		names := model.ManagedAttributes
+		if attributeName == "ignore_server_changes" {
+			names = model.IgnoreServerChanges
+		}
+		for _, name := range stringValues(names) {
+			if strings.EqualFold(name, objectClassAttribute) {
+				resp.Diagnostics.AddAttributeError(path.Root(attributeName), "Invalid attribute name",
+					"The object classes of the entry are always managed with object_classes.")
+			} else if configuredNames[strings.ToLower(name)] == "sensitive_attributes_wo" {
+				resp.Diagnostics.AddAttributeError(path.Root(attributeName), "Invalid attribute name",
+					"The attribute '"+name+"' is set in sensitive_attributes_wo, whose values are never read back from the server.")
+			}
+		}
+	}
+}
+
+// Get the known values of a set of strings
+func stringValues(set types.Set) []string {
+	var values []string
+	for _, element := range set.Elements() {
+		if value, ok := element.(types.String); ok && internaltypes.IsDefined(value) {
+			values = append(values, value.ValueString())
+		}
+	}
+	return values
+}
+
+// Find an attribute name in a map of attribute values. LDAP attribute names are case-insensitive.
+func findName(values map[string][]string, attributeName string) (string, bool) {
+	for name := range values {
+		if strings.EqualFold(name, attributeName) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// Determine whether a list of attribute names contains a name
+func containsName(names []string, attributeName string) bool {
+	for _, name := range names {
+		if strings.EqualFold(name, attributeName) {
+			return true
+		}
+	}
+	return false
+}
+
+// Get the sorted names of a map of attribute values
+func sortedNames(values map[string][]string) []string {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Get the configured values of each attribute, from both attributes and attribute_values
+func (model *ldapEntryResourceModel) attributeValues(ctx context.Context, diagnostics *diag.Diagnostics) map[string][]string {
+	values := map[string][]string{}
+	if internaltypes.IsDefined(model.Attributes) {
+		for name, value := range model.Attributes.Elements() {
+			if stringValue, ok := value.(types.String); ok && !stringValue.IsNull() {
+				values[name] = []string{stringValue.ValueString()}
+			}
+		}
+	}
+	if internaltypes.IsDefined(model.AttributeValues) {
+		for name, value := range model.AttributeValues.Elements() {
+			if setValue, ok := value.(types.Set); ok && !setValue.IsNull() {
+				var setValues []string
+				diagnostics.Append(setValue.ElementsAs(ctx, &setValues, false)...)
+				values[name] = setValues
+			}
+		}
+	}
+	return values
+}
+
+// Get the write-only sensitive attribute values from the config, since they are never included in the plan or state
+func sensitiveAttributeValues(ctx context.Context, config tfsdk.Config, diagnostics *diag.Diagnostics) map[string][]string {
+	var sensitiveValues types.Map
+	diagnostics.Append(config.GetAttribute(ctx, path.Root("sensitive_attributes_wo"), &sensitiveValues)...)
+	values := map[string][]string{}
+	for name, value := range sensitiveValues.Elements() {
+		if stringValue, ok := value.(types.String); ok && !stringValue.IsNull() {
+			values[name] = []string{stringValue.ValueString()}
+		}
+	}
+	return values
+}
+
+// Get the attributes to read for the entry. All user attributes are read when the entry is imported.
+func (model *ldapEntryResourceModel) readAttributeNames(ctx context.Context, diagnostics *diag.Diagnostics) []string {
+	if model.ObjectClasses.IsNull() {
+		return nil
+	}
+	ignoreServerChanges := stringValues(model.IgnoreServerChanges)
+	names := []string{objectClassAttribute}
+	for _, name := range sortedNames(model.attributeValues(ctx, diagnostics)) {
+		if !containsName(ignoreServerChanges, name) {
+			names = append(names, name)
+		}
+	}
+	return append(names, stringValues(model.ManagedAttributes)...)
+}
+
+// Get a map attribute value, keeping it null when it was null and there are no values
+func mapValue(elementType attr.Type, elements map[string]attr.Value, wasNull bool, diagnostics *diag.Diagnostics) types.Map {
+	if len(elements) == 0 && wasNull {
+		return types.MapNull(elementType)
+	}
+	mapValue, diags := types.MapValue(elementType, elements)
+	diagnostics.Append(diags...)
+	return mapValue
+}
+
+// Read an entry into the model. Only the managed attributes are read, except when the entry is imported, in which
+// case the object classes are null and all user attributes of the entry are read.
+func readLdapEntry(ctx context.Context, entry directory.Entry, state *ldapEntryResourceModel, diagnostics *diag.Diagnostics) {
+	imported := state.ObjectClasses.IsNull()
+
+	// Keep the configured case of object class names, since they are case-insensitive
+	configuredObjectClasses := stringValues(state.ObjectClasses)
+	objectClasses := entry.ObjectClasses()
+	for i, objectClass := range objectClasses {
+		for _, configuredObjectClass := range configuredObjectClasses {
+			if strings.EqualFold(objectClass, configuredObjectClass) {
+				objectClasses[i] = configuredObjectClass
+			}
+		}
+	}
+	state.ObjectClasses = internaltypes.GetStringSet(objectClasses)
+
+	// Attributes with a single value are kept in attributes when they were configured there, and any other values
+	// are read into attribute_values, so that a changed number of values shows up in the plan
+	attributes := map[string]attr.Value{}
+	attributeValues := map[string]attr.Value{}
+	addValues := func(name string, values []string, singleValued bool) {
+		if len(values) == 0 {
+			return
+		}
+		if singleValued && len(values) == 1 {
+			attributes[name] = types.StringValue(values[0])
+		} else {
+			attributeValues[name] = internaltypes.GetStringSet(values)
+		}
+	}
+	if imported {
+		for name, values := range entry.Attributes {
+			if !strings.EqualFold(name, objectClassAttribute) {
+				addValues(name, values, true)
+			}
+		}
+	} else {
+		configuredValues := state.attributeValues(ctx, diagnostics)
+		ignoreServerChanges := stringValues(state.IgnoreServerChanges)
+		singleValuedAttributes := state.Attributes.Elements()
+		for name, values := range configuredValues {
+			_, singleValued := singleValuedAttributes[name]
+			if containsName(ignoreServerChanges, name) {
+				addValues(name, values, singleValued)
+			} else {
+				addValues(name, entry.Values(name), singleValued)
+			}
+		}
+		for _, name := range stringValues(state.ManagedAttributes) {
+			if _, configured := findName(configuredValues, name); !configured {
+				addValues(name, entry.Values(name), false)
+			}
+		}
+	}
+	state.Attributes = mapValue(types.StringType, attributes, state.Attributes.IsNull(), diagnostics)
+	state.AttributeValues = mapValue(types.SetType{ElemType: types.StringType}, attributeValues, state.AttributeValues.IsNull(), diagnostics)
+}
+
+// Get the modifications that change the entry from the state to the plan. Attributes that are no longer configured
+// are removed from the entry.
+func entryModifications(ctx context.Context, plan, state *ldapEntryResourceModel, diagnostics *diag.Diagnostics) []directory.Modification {
+	var modifications []directory.Modification
+	planObjectClasses := stringValues(plan.ObjectClasses)
+	if !directory.SameValues(planObjectClasses, stringValues(state.ObjectClasses)) {
+		modifications = append(modifications, directory.Modification{
+			AttributeName:    objectClassAttribute,
+			ModificationType: directory.ModificationSet,
+			Values:           planObjectClasses,
+		})
+	}
+
+	planValues := plan.attributeValues(ctx, diagnostics)
+	stateValues := state.attributeValues(ctx, diagnostics)
+	for _, name := range sortedNames(planValues) {
+		stateName, found := findName(stateValues, name)
+		if found && directory.SameValues(planValues[name], stateValues[stateName]) {
+			continue
+		}
+		modifications = append(modifications, directory.Modification{
+			AttributeName:    name,
+			ModificationType: directory.ModificationSet,
+			Values:           planValues[name],
+		})
+	}
+	for _, name := range sortedNames(stateValues) {
+		if _, found := findName(planValues, name); !found {
+			modifications = append(modifications, directory.Modification{
+				AttributeName:    name,
+				ModificationType: directory.ModificationRemove,
+			})
+		}
+	}
+	return modifications
+}
+
+// Create a new resource
+func (r *ldapEntryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan ldapEntryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entry := directory.Entry{
+		DN:         plan.Dn.ValueString(),
+		Attributes: plan.attributeValues(ctx, &resp.Diagnostics),
+	}
+	entry.Attributes[objectClassAttribute] = stringValues(plan.ObjectClasses)
+	sensitiveValues := sensitiveAttributeValues(ctx, req.Config, &resp.Diagnostics)
+	for name, values := range sensitiveValues {
+		entry.Attributes[name] = values
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	_, httpResp, err := directory.AddEntry(ctx, r.providerConfig, r.apiClient, entry, len(sensitiveValues) > 0)
+	if err != nil {
+		config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while creating the LDAP Entry", err, httpResp)
+		return
+	}
+
+	// Differences between the configured and returned values are reported on the next refresh
+	plan.Id = plan.Dn
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information
+func (r *ldapEntryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state ldapEntryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entry, httpResp, err := directory.GetEntry(ctx, r.providerConfig, r.apiClient, state.Dn.ValueString(), state.readAttributeNames(ctx, &resp.Diagnostics))
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.ReportHttpErrorAsWarning(ctx, &resp.Diagnostics, "An error occurred while getting the LDAP Entry", err, httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the LDAP Entry", err, httpResp)
+		}
+		return
+	}
+
+	// Read the response into the state
+	readLdapEntry(ctx, *entry, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update a resource
+func (r *ldapEntryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan ldapEntryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the current state to see how any attributes are changing
+	var state ldapEntryResourceModel
+	req.State.Get(ctx, &state)
+	modifications := entryModifications(ctx, &plan, &state, &resp.Diagnostics)
+	// The write-only sensitive attributes are only written when their version changes
+	var sensitiveValues map[string][]string
+	if !plan.SensitiveWoVersion.Equal(state.SensitiveWoVersion) {
+		sensitiveValues = sensitiveAttributeValues(ctx, req.Config, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(modifications) > 0 || len(sensitiveValues) > 0 {
+		// Log modifications before adding the sensitive values. The request body isn't logged when it includes them.
+		directory.LogModifications(ctx, modifications)
+		for _, name := range sortedNames(sensitiveValues) {
+			modifications = append(modifications, directory.Modification{
+				AttributeName:    name,
+				ModificationType: directory.ModificationSet,
+				Values:           sensitiveValues[name],
+			})
+		}
+
+		_, httpResp, err := directory.ModifyEntry(ctx, r.providerConfig, r.apiClient, plan.Dn.ValueString(), modifications, len(sensitiveValues) > 0)
+		if err != nil {
+			config.ReportHttpErrorForSchema(ctx, req.Plan.Schema, &resp.Diagnostics, "An error occurred while updating the LDAP Entry", err, httpResp)
+			return
+		}
+	} else {
+		tflog.Warn(ctx, "No Directory REST API modifications created for update")
+	}
+
+	// Differences between the configured and returned values are reported on the next refresh
+	plan.Id = state.Id
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ldapEntryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state ldapEntryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := directory.DeleteEntry(ctx, r.providerConfig, r.apiClient, state.Dn.ValueString())
+	if err != nil && (httpResp == nil || httpResp.StatusCode != 404) {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting the LDAP Entry", err, httpResp)
+		return
+	}
+}
+
+func (r *ldapEntryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by DN. The object classes are left null, so that all user attributes of the entry are read.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dn"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,214 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package ldapschema
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/directory"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &schemaAttributeTypeDataSource{}
+	_ datasource.DataSourceWithConfigure = &schemaAttributeTypeDataSource{}
+)
+
+// Create a Schema Attribute Type data source
+func NewSchemaAttributeTypeDataSource() datasource.DataSource {
+	return &schemaAttributeTypeDataSource{}
+}
+
+// schemaAttributeTypeDataSource is the datasource implementation.
+type schemaAttributeTypeDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the data source type name.
+func (r *schemaAttributeTypeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_attribute_type"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *schemaAttributeTypeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+type schemaAttributeTypeDataSourceModel struct {
+	Id                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Oid                   types.String `tfsdk:"oid"`
+	Names                 types.List   `tfsdk:"names"`
+	Description           types.String `tfsdk:"description"`
+	SuperiorType          types.String `tfsdk:"superior_type"`
+	Syntax                types.String `tfsdk:"syntax"`
+	EqualityMatchingRule  types.String `tfsdk:"equality_matching_rule"`
+	OrderingMatchingRule  types.String `tfsdk:"ordering_matching_rule"`
+	SubstringMatchingRule types.String `tfsdk:"substring_matching_rule"`
+	SingleValue           types.Bool   `tfsdk:"single_value"`
+	Collective            types.Bool   `tfsdk:"collective"`
+	NoUserModification    types.Bool   `tfsdk:"no_user_modification"`
+	Usage                 types.String `tfsdk:"usage"`
+	Obsolete              types.Bool   `tfsdk:"obsolete"`
+	Definition            types.String `tfsdk:"definition"`
+}
+
+// GetSchema defines the schema for the datasource.
+func (r *schemaAttributeTypeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	schemaDef := schema.Schema{
+		Description: "Describes an attribute type defined in the LDAP schema, read from cn=schema through the Directory REST API.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name or OID of the attribute type. Names are matched case-insensitively.",
+				Required:    true,
+			},
+			"oid": schema.StringAttribute{
+				Description: "The OID of the attribute type.",
+				Computed:    true,
+			},
+			"names": schema.ListAttribute{
+				Description: "All names of the attribute type.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the attribute type.",
+				Computed:    true,
+			},
+			"superior_type": schema.StringAttribute{
+				Description: "The attribute type that this attribute type inherits from, if any.",
+				Computed:    true,
+			},
+			"syntax": schema.StringAttribute{
+				Description: "The OID of the attribute syntax, including any syntax inherited from the superior type.",
+				Computed:    true,
+			},
+			"equality_matching_rule": schema.StringAttribute{
+				Description: "The equality matching rule, including any rule inherited from the superior type.",
+				Computed:    true,
+			},
+			"ordering_matching_rule": schema.StringAttribute{
+				Description: "The ordering matching rule, including any rule inherited from the superior type.",
+				Computed:    true,
+			},
+			"substring_matching_rule": schema.StringAttribute{
+				Description: "The substring matching rule, including any rule inherited from the superior type.",
+				Computed:    true,
+			},
+			"single_value": schema.BoolAttribute{
+				Description: "Whether the attribute type allows only one value.",
+				Computed:    true,
+			},
+			"collective": schema.BoolAttribute{
+				Description: "Whether the attribute type is collective.",
+				Computed:    true,
+			},
+			"no_user_modification": schema.BoolAttribute{
+				Description: "Whether the attribute type can't be modified by clients.",
+				Computed:    true,
+			},
+			"usage": schema.StringAttribute{
+				Description: "The usage of the attribute type, such as `userApplications` or `directoryOperation`.",
+				Computed:    true,
+			},
+			"obsolete": schema.BoolAttribute{
+				Description: "Whether the attribute type is marked as obsolete.",
+				Computed:    true,
+			},
+			"definition": schema.StringAttribute{
+				Description: "The full definition of the attribute type, as read from cn=schema.",
+				Computed:    true,
+			},
+		},
+	}
+	config.AddCommonDataSourceSchema(&schemaDef, false)
+	resp.Schema = schemaDef
+}
+
+// Get a value of an attribute type, inheriting it from the superior types when it isn't set directly
+func inheritedAttributeTypeValue(definitions []schemaDefinition, definition *schemaDefinition, keyword string) string {
+	// Limit the depth in case of circular definitions
+	for depth := 0; definition != nil && depth < len(definitions); depth++ {
+		if value := definition.Value(keyword); value != "" {
+			return value
+		}
+		superior := definition.Value("SUP")
+		if superior == "" {
+			break
+		}
+		definition = findSchemaDefinition(definitions, superior)
+	}
+	return ""
+}
+
+// Read an attribute type definition into the model struct
+func readAttributeType(definitions []schemaDefinition, definition *schemaDefinition, state *schemaAttributeTypeDataSourceModel) {
+	state.Id = types.StringValue(definition.OID)
+	state.Oid = types.StringValue(definition.OID)
+	state.Names = internaltypes.GetStringList(definition.Names)
+	state.Description = optionalString(definition.Value("DESC"))
+	state.SuperiorType = optionalString(definition.Value("SUP"))
+	// Leave out any minimum upper bound, such as the {256} in 1.3.6.1.4.1.1466.115.121.1.15{256}
+	syntax, _, _ := strings.Cut(inheritedAttributeTypeValue(definitions, definition, "SYNTAX"), "{")
+	state.Syntax = optionalString(syntax)
+	state.EqualityMatchingRule = optionalString(inheritedAttributeTypeValue(definitions, definition, "EQUALITY"))
+	state.OrderingMatchingRule = optionalString(inheritedAttributeTypeValue(definitions, definition, "ORDERING"))
+	state.SubstringMatchingRule = optionalString(inheritedAttributeTypeValue(definitions, definition, "SUBSTR"))
+	state.SingleValue = types.BoolValue(definition.Has("SINGLE-VALUE"))
+	state.Collective = types.BoolValue(definition.Has("COLLECTIVE"))
+	state.NoUserModification = types.BoolValue(definition.Has("NO-USER-MODIFICATION"))
+	usage := definition.Value("USAGE")
+	if usage == "" {
+		usage = "userApplications"
+	}
+	state.Usage = types.StringValue(usage)
+	state.Obsolete = types.BoolValue(definition.Has("OBSOLETE"))
+	state.Definition = types.StringValue(definition.Raw)
+}
+
+// Read resource information
+func (r *schemaAttributeTypeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current state
+	var state schemaAttributeTypeDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entry, httpResp, err := directory.GetEntry(ctx, r.providerConfig, r.apiClient, schemaDN, []string{attributeTypesAttribute})
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Schema Attribute Types", err, httpResp)
+		return
+	}
+	definitions, definition, err := lookupSchemaDefinition(ctx, entry.Values(attributeTypesAttribute), state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to parse the Schema Attribute Type", err.Error())
+		return
+	}
+	if definition == nil {
+		resp.Diagnostics.AddError("Schema Attribute Type not found", "No attribute type named \""+state.Name.ValueString()+"\" is defined in the schema")
+		return
+	}
+
+	// Read the response into the state
+	readAttributeType(definitions, definition, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
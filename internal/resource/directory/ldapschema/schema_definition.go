@@ -0,0 +1,214 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package ldapschema
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DN of the subschema subentry
+const schemaDN = "cn=schema"
+
+// Names of the subschema subentry attributes holding each kind of schema element
+const (
+	attributeTypesAttribute = "attributeTypes"
+	objectClassesAttribute  = "objectClasses"
+)
+
+// Keywords of schema element definitions that don't take a value, as described in RFC 4512
+var flagKeywords = map[string]bool{
+	"OBSOLETE":             true,
+	"SINGLE-VALUE":         true,
+	"COLLECTIVE":           true,
+	"NO-USER-MODIFICATION": true,
+	"ABSTRACT":             true,
+	"STRUCTURAL":           true,
+	"AUXILIARY":            true,
+}
+
+// An attribute type or object class definition from the subschema subentry
+type schemaDefinition struct {
+	OID   string
+	Names []string
+	// Values of each keyword in the definition. Flag keywords have no values.
+	Fields map[string][]string
+	// The definition as read from the server
+	Raw string
+}
+
+// Check whether the definition includes a keyword
+func (d schemaDefinition) Has(keyword string) bool {
+	_, ok := d.Fields[keyword]
+	return ok
+}
+
+// Get the first value of a keyword, or an empty string if the definition doesn't include it
+func (d schemaDefinition) Value(keyword string) string {
+	if values := d.Fields[keyword]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// Check whether the definition has the given OID or name. Names are matched case-insensitively.
+func (d schemaDefinition) Matches(nameOrOID string) bool {
+	if d.OID == nameOrOID {
+		return true
+	}
+	for _, name := range d.Names {
+		if strings.EqualFold(name, nameOrOID) {
+			return true
+		}
+	}
+	return false
+}
+
+// Split a definition into parentheses, quoted strings, and other tokens
+func tokenizeDefinition(definition string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(definition); {
+		switch c := definition[i]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(' || c == ')' || c == '$':
+			tokens = append(tokens, string(c))
+			i++
+		case c == '\'':
+			end := strings.IndexByte(definition[i+1:], '\'')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated quoted string at position %d", i)
+			}
+			// Keep the opening quote so quoted strings can be told apart from keywords
+			tokens = append(tokens, definition[i:i+end+1])
+			i += end + 2
+		default:
+			start := i
+			for i < len(definition) && !strings.ContainsRune(" \t\n\r()$'", rune(definition[i])) {
+				i++
+			}
+			tokens = append(tokens, definition[start:i])
+		}
+	}
+	return tokens, nil
+}
+
+// Get the value of a token, removing the opening quote from quoted strings
+func tokenValue(token string) string {
+	return strings.TrimPrefix(token, "'")
+}
+
+// Parse an attribute type or object class definition, as described in RFC 4512
+func parseSchemaDefinition(definition string) (*schemaDefinition, error) {
+	tokens, err := tokenizeDefinition(definition)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) < 3 || tokens[0] != "(" || tokens[len(tokens)-1] != ")" {
+		return nil, fmt.Errorf("definition must be enclosed in parentheses")
+	}
+	result := &schemaDefinition{
+		OID:    tokens[1],
+		Fields: map[string][]string{},
+		Raw:    definition,
+	}
+	tokens = tokens[2 : len(tokens)-1]
+	for i := 0; i < len(tokens); i++ {
+		keyword := strings.ToUpper(tokens[i])
+		if flagKeywords[keyword] {
+			result.Fields[keyword] = nil
+			continue
+		}
+		i++
+		if i >= len(tokens) {
+			return nil, fmt.Errorf("missing value for %s", keyword)
+		}
+		var values []string
+		if tokens[i] == "(" {
+			for i++; i < len(tokens) && tokens[i] != ")"; i++ {
+				if tokens[i] != "$" {
+					values = append(values, tokenValue(tokens[i]))
+				}
+			}
+			if i >= len(tokens) {
+				return nil, fmt.Errorf("unterminated list for %s", keyword)
+			}
+		} else {
+			values = []string{tokenValue(tokens[i])}
+		}
+		result.Fields[keyword] = values
+	}
+	result.Names = result.Fields["NAME"]
+	return result, nil
+}
+
+// A definition of the subschema subentry that couldn't be parsed
+type unparsedSchemaDefinition struct {
+	definition string
+	err        error
+}
+
+// Parse all definitions of an attribute of the subschema subentry. Definitions that can't be parsed are returned
+// separately, so that a single definition the parser doesn't understand doesn't prevent reading the others.
+func parseSchemaDefinitions(definitions []string) ([]schemaDefinition, []unparsedSchemaDefinition) {
+	result := make([]schemaDefinition, 0, len(definitions))
+	var unparsed []unparsedSchemaDefinition
+	for _, definition := range definitions {
+		parsed, err := parseSchemaDefinition(definition)
+		if err != nil {
+			unparsed = append(unparsed, unparsedSchemaDefinition{definition: definition, err: err})
+			continue
+		}
+		result = append(result, *parsed)
+	}
+	return result, unparsed
+}
+
+// Check whether a definition that couldn't be parsed mentions a name or OID, such as "( 1.2.3 NAME 'example' ...)"
+func (u unparsedSchemaDefinition) mentions(nameOrOID string) bool {
+	for _, token := range strings.FieldsFunc(u.definition, func(r rune) bool {
+		return r == ' ' || r == '\t' || r == '\n' || r == '(' || r == ')' || r == '\''
+	}) {
+		if strings.EqualFold(token, nameOrOID) {
+			return true
+		}
+	}
+	return false
+}
+
+// Parse the definitions of an attribute of the subschema subentry and find a definition by name or OID. Definitions
+// that can't be parsed are skipped with a warning, and an error is returned only if the definition being looked up
+// is one of them. The definition is nil when it isn't defined in the schema.
+func lookupSchemaDefinition(ctx context.Context, values []string, nameOrOID string) ([]schemaDefinition, *schemaDefinition, error) {
+	definitions, unparsed := parseSchemaDefinitions(values)
+	definition := findSchemaDefinition(definitions, nameOrOID)
+	for _, u := range unparsed {
+		if definition == nil && u.mentions(nameOrOID) {
+			return nil, nil, fmt.Errorf("failed to parse schema definition %q: %w", u.definition, u.err)
+		}
+		tflog.Warn(ctx, fmt.Sprintf("Skipping schema definition %q that could not be parsed: %s", u.definition, u.err.Error()))
+	}
+	return definitions, definition, nil
+}
+
+// Find a definition by name or OID
+func findSchemaDefinition(definitions []schemaDefinition, nameOrOID string) *schemaDefinition {
+	for i := range definitions {
+		if definitions[i].Matches(nameOrOID) {
+			return &definitions[i]
+		}
+	}
+	return nil
+}
+
+// Get a string value, using null for an empty string
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
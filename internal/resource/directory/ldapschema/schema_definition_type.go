@@ -0,0 +1,82 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package ldapschema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = definitionType{}
+	_ basetypes.StringValuableWithSemanticEquals = definitionValue{}
+)
+
+// String type for schema definitions. Definitions that only differ in whitespace, the order of keywords, or the
+// order of lists of oids are semantically equal, so that reformatting a definition doesn't cause changes.
+type definitionType struct {
+	basetypes.StringType
+}
+
+func (t definitionType) Equal(o attr.Type) bool {
+	other, ok := o.(definitionType)
+	return ok && t.StringType.Equal(other.StringType)
+}
+
+func (t definitionType) String() string {
+	return "ldapschema.definitionType"
+}
+
+func (t definitionType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return definitionValue{StringValue: in}, nil
+}
+
+func (t definitionType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return definitionValue{StringValue: stringValue}, nil
+}
+
+func (t definitionType) ValueType(_ context.Context) attr.Value {
+	return definitionValue{}
+}
+
+// A schema definition value
+type definitionValue struct {
+	basetypes.StringValue
+}
+
+func (v definitionValue) Equal(o attr.Value) bool {
+	other, ok := o.(definitionValue)
+	return ok && v.StringValue.Equal(other.StringValue)
+}
+
+func (v definitionValue) Type(_ context.Context) attr.Type {
+	return definitionType{}
+}
+
+// Compare the parsed definitions
+func (v definitionValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	newValue, ok := newValuable.(definitionValue)
+	if !ok {
+		return false, nil
+	}
+	return definitionsEquivalent(v.ValueString(), newValue.ValueString()), nil
+}
+
+// Get a definition value from a string
+func newDefinitionValue(definition string) definitionValue {
+	return definitionValue{StringValue: basetypes.NewStringValue(definition)}
+}
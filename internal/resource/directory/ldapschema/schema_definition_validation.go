@@ -0,0 +1,321 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package ldapschema
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Forms of the values of schema definition keywords, as described in RFC 4512
+type valueForm int
+
+const (
+	// A keyword without a value, such as SINGLE-VALUE
+	formFlag valueForm = iota
+	// One quoted descr, or a parenthesized list of them
+	formQdescrs
+	// One quoted string
+	formQdstring
+	// One quoted string, or a parenthesized list of them
+	formQdstrings
+	// One descr or numeric OID
+	formOid
+	// One oid, or a parenthesized list of them separated by $
+	formOids
+	// A numeric OID with an optional minimum upper bound, such as 1.3.6.1.4.1.1466.115.121.1.15{256}
+	formNoidlen
+	// One of the attribute type usages
+	formUsage
+)
+
+var (
+	numericOIDRegex = regexp.MustCompile(`^(0|[1-9][0-9]*)(\.(0|[1-9][0-9]*))+$`)
+	descrRegex      = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9-]*$`)
+	noidlenRegex    = regexp.MustCompile(`^(0|[1-9][0-9]*)(\.(0|[1-9][0-9]*))+(\{[0-9]+\})?$`)
+	extensionRegex  = regexp.MustCompile(`^X-[A-Za-z_-]+$`)
+)
+
+// Usages of attribute types
+var attributeTypeUsages = map[string]bool{
+	"userApplications":     true,
+	"directoryOperation":   true,
+	"distributedOperation": true,
+	"dSAOperation":         true,
+}
+
+// Keywords of attribute type definitions, as described in RFC 4512 section 4.1.2
+var attributeTypeKeywords = map[string]valueForm{
+	"NAME":                 formQdescrs,
+	"DESC":                 formQdstring,
+	"OBSOLETE":             formFlag,
+	"SUP":                  formOid,
+	"EQUALITY":             formOid,
+	"ORDERING":             formOid,
+	"SUBSTR":               formOid,
+	"SYNTAX":               formNoidlen,
+	"SINGLE-VALUE":         formFlag,
+	"COLLECTIVE":           formFlag,
+	"NO-USER-MODIFICATION": formFlag,
+	"USAGE":                formUsage,
+}
+
+// Keywords of object class definitions, as described in RFC 4512 section 4.1.1
+var objectClassKeywords = map[string]valueForm{
+	"NAME":       formQdescrs,
+	"DESC":       formQdstring,
+	"OBSOLETE":   formFlag,
+	"SUP":        formOids,
+	"ABSTRACT":   formFlag,
+	"STRUCTURAL": formFlag,
+	"AUXILIARY":  formFlag,
+	"MUST":       formOids,
+	"MAY":        formOids,
+}
+
+// Check that a token is a quoted string
+func quoted(token string) bool {
+	return strings.HasPrefix(token, "'")
+}
+
+// Validate a single value of a keyword
+func validateValue(keyword, token string, form valueForm) error {
+	value := tokenValue(token)
+	// Show quoted strings with both quotes in errors
+	display := token
+	if quoted(token) {
+		display += "'"
+	}
+	switch form {
+	case formQdescrs:
+		if !quoted(token) || !descrRegex.MatchString(value) {
+			return fmt.Errorf("%s must be a quoted name starting with a letter and containing only letters, digits and hyphens, got %s", keyword, display)
+		}
+	case formQdstring, formQdstrings:
+		if !quoted(token) || value == "" {
+			return fmt.Errorf("%s must be a non-empty quoted string, got %s", keyword, display)
+		}
+	case formOid, formOids:
+		if quoted(token) || (!descrRegex.MatchString(value) && !numericOIDRegex.MatchString(value)) {
+			return fmt.Errorf("%s must be a name or a numeric OID, got %s", keyword, display)
+		}
+	case formNoidlen:
+		if quoted(token) || !noidlenRegex.MatchString(value) {
+			return fmt.Errorf("%s must be a numeric OID with an optional length such as {256}, got %s", keyword, display)
+		}
+	case formUsage:
+		if quoted(token) || !attributeTypeUsages[value] {
+			return fmt.Errorf("USAGE must be one of userApplications, directoryOperation, distributedOperation or dSAOperation, got %s", display)
+		}
+	}
+	return nil
+}
+
+// Validate the value of a keyword starting at tokens[i], and return the index of the token after the value
+func validateKeywordValue(keyword string, tokens []string, i int, form valueForm) (int, error) {
+	if form == formFlag {
+		return i, nil
+	}
+	if i >= len(tokens) {
+		return i, fmt.Errorf("missing value for %s", keyword)
+	}
+	if tokens[i] != "(" {
+		if tokens[i] == ")" || tokens[i] == "$" {
+			return i, fmt.Errorf("missing value for %s", keyword)
+		}
+		return i + 1, validateValue(keyword, tokens[i], form)
+	}
+	if form != formQdescrs && form != formQdstrings && form != formOids {
+		return i, fmt.Errorf("%s doesn't take a list of values", keyword)
+	}
+	// Lists of oids are separated by $, while lists of quoted strings are separated by whitespace
+	expectValue := true
+	count := 0
+	for i++; i < len(tokens) && tokens[i] != ")"; i++ {
+		if tokens[i] == "$" {
+			if form != formOids || expectValue {
+				return i, fmt.Errorf("unexpected $ in the value of %s", keyword)
+			}
+			expectValue = true
+			continue
+		}
+		if !expectValue {
+			return i, fmt.Errorf("values of %s must be separated by $", keyword)
+		}
+		if err := validateValue(keyword, tokens[i], form); err != nil {
+			return i, err
+		}
+		count++
+		expectValue = form != formOids
+	}
+	if i >= len(tokens) {
+		return i, fmt.Errorf("unterminated list for %s", keyword)
+	}
+	if count == 0 || (form == formOids && expectValue) {
+		return i, fmt.Errorf("incomplete list of values for %s", keyword)
+	}
+	return i + 1, nil
+}
+
+// Validate a schema definition against the RFC 4512 grammar for the given keywords. Keywords may be in any order.
+func validateSchemaDefinition(definition string, keywords map[string]valueForm) error {
+	tokens, err := tokenizeDefinition(definition)
+	if err != nil {
+		return err
+	}
+	if len(tokens) < 3 || tokens[0] != "(" || tokens[len(tokens)-1] != ")" {
+		return errors.New("definition must be enclosed in parentheses")
+	}
+	if !numericOIDRegex.MatchString(tokens[1]) {
+		return fmt.Errorf("definition must start with a numeric OID, got %s", tokens[1])
+	}
+	seen := map[string]bool{}
+	tokens = tokens[2 : len(tokens)-1]
+	for i := 0; i < len(tokens); {
+		keyword := strings.ToUpper(tokens[i])
+		form, known := keywords[keyword]
+		if !known {
+			if !extensionRegex.MatchString(tokens[i]) {
+				return fmt.Errorf("unexpected %s, expected one of the keywords of the definition or an extension starting with X-", tokens[i])
+			}
+			form = formQdstrings
+		}
+		if seen[keyword] {
+			return fmt.Errorf("%s appears more than once", keyword)
+		}
+		seen[keyword] = true
+		i, err = validateKeywordValue(keyword, tokens, i+1, form)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate an attribute type definition, including the rules of RFC 4512 that go beyond the grammar
+func validateAttributeTypeDefinition(definition string) error {
+	if err := validateSchemaDefinition(definition, attributeTypeKeywords); err != nil {
+		return err
+	}
+	parsed, err := parseSchemaDefinition(definition)
+	if err != nil {
+		return err
+	}
+	if !parsed.Has("SUP") && !parsed.Has("SYNTAX") {
+		return errors.New("an attribute type must have SUP or SYNTAX")
+	}
+	usage := parsed.Value("USAGE")
+	if parsed.Has("COLLECTIVE") && usage != "" && usage != "userApplications" {
+		return errors.New("a COLLECTIVE attribute type must have the userApplications usage")
+	}
+	if parsed.Has("NO-USER-MODIFICATION") && (usage == "" || usage == "userApplications") {
+		return errors.New("a NO-USER-MODIFICATION attribute type must have an operational usage")
+	}
+	return nil
+}
+
+// Validate an object class definition, including the rules of RFC 4512 that go beyond the grammar
+func validateObjectClassDefinition(definition string) error {
+	if err := validateSchemaDefinition(definition, objectClassKeywords); err != nil {
+		return err
+	}
+	parsed, err := parseSchemaDefinition(definition)
+	if err != nil {
+		return err
+	}
+	kinds := 0
+	for _, kind := range []string{"ABSTRACT", "STRUCTURAL", "AUXILIARY"} {
+		if parsed.Has(kind) {
+			kinds++
+		}
+	}
+	if kinds > 1 {
+		return errors.New("an object class can only be one of ABSTRACT, STRUCTURAL and AUXILIARY")
+	}
+	return nil
+}
+
+// Schema definition extensions that the server adds to definitions, which are only compared when both definitions
+// include them
+var serverExtensions = map[string]bool{
+	"X-SCHEMA-FILE": true,
+}
+
+// Compare the values of a keyword of two definitions
+func keywordValuesEqual(keyword string, values, otherValues []string) bool {
+	if len(values) != len(otherValues) {
+		return false
+	}
+	switch {
+	case keyword == "DESC" || strings.HasPrefix(keyword, "X-"):
+		// Strings are compared exactly
+		for i := range values {
+			if values[i] != otherValues[i] {
+				return false
+			}
+		}
+	case keyword == "NAME":
+		// The first name is the primary name, so the order matters
+		for i := range values {
+			if !strings.EqualFold(values[i], otherValues[i]) {
+				return false
+			}
+		}
+	default:
+		// Lists of oids are sets
+		for _, value := range values {
+			found := false
+			for _, otherValue := range otherValues {
+				if strings.EqualFold(value, otherValue) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Determine whether two definitions are equivalent. Keywords may be in any order, and names, OIDs and lists of oids
+// are compared case-insensitively.
+func (d schemaDefinition) Equivalent(other schemaDefinition) bool {
+	if !strings.EqualFold(d.OID, other.OID) {
+		return false
+	}
+	for keyword, values := range d.Fields {
+		otherValues, found := other.Fields[keyword]
+		if !found {
+			if serverExtensions[keyword] {
+				continue
+			}
+			return false
+		}
+		if !keywordValuesEqual(keyword, values, otherValues) {
+			return false
+		}
+	}
+	for keyword := range other.Fields {
+		if _, found := d.Fields[keyword]; !found && !serverExtensions[keyword] {
+			return false
+		}
+	}
+	return true
+}
+
+// Determine whether two definition strings are equivalent. Definitions that can't be parsed are never equivalent.
+func definitionsEquivalent(definition, otherDefinition string) bool {
+	parsed, err := parseSchemaDefinition(definition)
+	if err != nil {
+		return false
+	}
+	otherParsed, err := parseSchemaDefinition(otherDefinition)
+	if err != nil {
+		return false
+	}
+	return parsed.Equivalent(*otherParsed)
+}
@@ -0,0 +1,332 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package ldapschema
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/directory"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &schemaElementResource{}
+	_ resource.ResourceWithConfigure   = &schemaElementResource{}
+	_ resource.ResourceWithModifyPlan  = &schemaElementResource{}
+	_ resource.ResourceWithImportState = &schemaElementResource{}
+)
+
+// A kind of schema element that can be managed in the subschema subentry
+type schemaElementKind struct {
+	typeName string
+	label    string
+	// Name of the kind of schema element used in descriptions
+	description string
+	// Attribute of the subschema subentry holding the definitions
+	attribute         string
+	exampleDefinition string
+	validate          func(definition string) error
+}
+
+var attributeTypeKind = schemaElementKind{
+	typeName:          "_schema_attribute_type",
+	label:             "Schema Attribute Type",
+	description:       "attribute type",
+	attribute:         attributeTypesAttribute,
+	exampleDefinition: "( 1.3.6.1.4.1.32473.1.1.1 NAME 'exampleEmployeeCode' DESC 'Example employee code' EQUALITY caseIgnoreMatch SYNTAX 1.3.6.1.4.1.1466.115.121.1.15 SINGLE-VALUE )",
+	validate:          validateAttributeTypeDefinition,
+}
+
+var objectClassKind = schemaElementKind{
+	typeName:          "_schema_object_class",
+	label:             "Schema Object Class",
+	description:       "object class",
+	attribute:         objectClassesAttribute,
+	exampleDefinition: "( 1.3.6.1.4.1.32473.1.2.1 NAME 'exampleEmployee' SUP top AUXILIARY MAY exampleEmployeeCode )",
+	validate:          validateObjectClassDefinition,
+}
+
+// Create a Schema Attribute Type resource
+func NewSchemaAttributeTypeResource() resource.Resource {
+	return &schemaElementResource{kind: attributeTypeKind}
+}
+
+// Create a Schema Object Class resource
+func NewSchemaObjectClassResource() resource.Resource {
+	return &schemaElementResource{kind: objectClassKind}
+}
+
+// schemaElementResource is the resource implementation.
+type schemaElementResource struct {
+	kind           schemaElementKind
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the resource type name.
+func (r *schemaElementResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.kind.typeName
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *schemaElementResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+type schemaElementResourceModel struct {
+	Id         types.String    `tfsdk:"id"`
+	Definition definitionValue `tfsdk:"definition"`
+	Oid        types.String    `tfsdk:"oid"`
+	Names      types.List      `tfsdk:"names"`
+}
+
+// Validator checking definitions against the RFC 4512 grammar
+type definitionValidator struct {
+	validate func(definition string) error
+}
+
+func (v definitionValidator) Description(_ context.Context) string {
+	return "value must be a schema definition as described in RFC 4512"
+}
+
+func (v definitionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v definitionValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := v.validate(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid schema definition", err.Error())
+	}
+}
+
+// GetSchema defines the schema for the resource.
+func (r *schemaElementResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a custom " + r.kind.description + " in the LDAP schema, added to cn=schema through the Directory REST API.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The OID of the " + r.kind.description + ".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"definition": schema.StringAttribute{
+				Description: "The definition of the " + r.kind.description + " as described in RFC 4512, such as `" + r.kind.exampleDefinition + "`. The definition is validated before it is sent to the server. Differences in whitespace, in the order of keywords or in the order of lists of OIDs are not treated as changes. Changing the OID replaces the " + r.kind.description + ".",
+				Required:    true,
+				CustomType:  definitionType{},
+				Validators: []validator.String{
+					definitionValidator{validate: r.kind.validate},
+				},
+			},
+			"oid": schema.StringAttribute{
+				Description: "The OID of the " + r.kind.description + ".",
+				Computed:    true,
+			},
+			"names": schema.ListAttribute{
+				Description: "All names of the " + r.kind.description + ".",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Set the values parsed from the definition in the plan, and replace the schema element when the OID changes
+func (r *schemaElementResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan schemaElementResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Definition.IsUnknown() {
+		return
+	}
+	definition, err := parseSchemaDefinition(plan.Definition.ValueString())
+	if err != nil {
+		// Invalid definitions are reported by the validator
+		return
+	}
+	plan.Id = types.StringValue(definition.OID)
+	plan.Oid = types.StringValue(definition.OID)
+	plan.Names = internaltypes.GetStringList(definition.Names)
+	if !req.State.Raw.IsNull() {
+		var state schemaElementResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if !strings.EqualFold(state.Oid.ValueString(), definition.OID) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("definition"))
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Read a definition into the model struct. The configured definition is kept when the definition read from the
+// server is equivalent, since the server may format it differently and add extensions such as X-SCHEMA-FILE.
+func readSchemaElement(definition *schemaDefinition, state *schemaElementResourceModel) {
+	state.Id = types.StringValue(definition.OID)
+	state.Oid = types.StringValue(definition.OID)
+	state.Names = internaltypes.GetStringList(definition.Names)
+	if state.Definition.IsNull() || !definitionsEquivalent(state.Definition.ValueString(), definition.Raw) {
+		state.Definition = newDefinitionValue(definition.Raw)
+	}
+}
+
+// Apply modifications to the definitions in the subschema subentry
+func (r *schemaElementResource) modifySchema(ctx context.Context, modifications []directory.Modification, errorSummary string, diagnostics *diag.Diagnostics) bool {
+	// Log modifications
+	directory.LogModifications(ctx, modifications)
+
+	_, httpResp, err := directory.ModifyEntry(ctx, r.providerConfig, r.apiClient, schemaDN, modifications, false)
+	if err != nil {
+		config.ReportHttpError(ctx, diagnostics, errorSummary, err, httpResp)
+		return false
+	}
+	return true
+}
+
+// Create a new resource
+func (r *schemaElementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan schemaElementResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modifications := []directory.Modification{
+		{
+			AttributeName:    r.kind.attribute,
+			ModificationType: directory.ModificationAdd,
+			Values:           []string{plan.Definition.ValueString()},
+		},
+	}
+	if !r.modifySchema(ctx, modifications, "An error occurred while adding the "+r.kind.label, &resp.Diagnostics) {
+		return
+	}
+
+	// Differences between the configured and returned definitions are reported on the next refresh
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information
+func (r *schemaElementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state schemaElementResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entry, httpResp, err := directory.GetEntry(ctx, r.providerConfig, r.apiClient, schemaDN, []string{r.kind.attribute})
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the "+r.kind.label+"s", err, httpResp)
+		return
+	}
+	// Imported schema elements are found by the name or OID used as the import ID
+	nameOrOID := state.Id.ValueString()
+	if internaltypes.IsDefined(state.Oid) {
+		nameOrOID = state.Oid.ValueString()
+	}
+	_, definition, err := lookupSchemaDefinition(ctx, entry.Values(r.kind.attribute), nameOrOID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to parse the "+r.kind.label, err.Error())
+		return
+	}
+	if definition == nil {
+		tflog.Warn(ctx, "The "+r.kind.description+" \""+nameOrOID+"\" is no longer defined in the schema, removing it from the state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Read the response into the state
+	readSchemaElement(definition, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update a resource
+func (r *schemaElementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan schemaElementResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the current state to replace the current definition. Definitions are matched by OID, so the current
+	// definition is removed even if the server formats it differently.
+	var state schemaElementResourceModel
+	req.State.Get(ctx, &state)
+	modifications := []directory.Modification{
+		{
+			AttributeName:    r.kind.attribute,
+			ModificationType: directory.ModificationRemove,
+			Values:           []string{state.Definition.ValueString()},
+		},
+		{
+			AttributeName:    r.kind.attribute,
+			ModificationType: directory.ModificationAdd,
+			Values:           []string{plan.Definition.ValueString()},
+		},
+	}
+	if !r.modifySchema(ctx, modifications, "An error occurred while updating the "+r.kind.label, &resp.Diagnostics) {
+		return
+	}
+
+	// Differences between the configured and returned definitions are reported on the next refresh
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *schemaElementResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state schemaElementResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modifications := []directory.Modification{
+		{
+			AttributeName:    r.kind.attribute,
+			ModificationType: directory.ModificationRemove,
+			Values:           []string{state.Definition.ValueString()},
+		},
+	}
+	r.modifySchema(ctx, modifications, "An error occurred while removing the "+r.kind.label, &resp.Diagnostics)
+}
+
+func (r *schemaElementResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by name or OID. The definition is read from the schema.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,197 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package ldapschema
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/directory"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &schemaObjectClassDataSource{}
+	_ datasource.DataSourceWithConfigure = &schemaObjectClassDataSource{}
+)
+
+// Create a Schema Object Class data source
+func NewSchemaObjectClassDataSource() datasource.DataSource {
+	return &schemaObjectClassDataSource{}
+}
+
+// schemaObjectClassDataSource is the datasource implementation.
+type schemaObjectClassDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the data source type name.
+func (r *schemaObjectClassDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_object_class"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *schemaObjectClassDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+type schemaObjectClassDataSourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Oid                types.String `tfsdk:"oid"`
+	Names              types.List   `tfsdk:"names"`
+	Description        types.String `tfsdk:"description"`
+	SuperiorClasses    types.List   `tfsdk:"superior_classes"`
+	Kind               types.String `tfsdk:"kind"`
+	RequiredAttributes types.Set    `tfsdk:"required_attributes"`
+	OptionalAttributes types.Set    `tfsdk:"optional_attributes"`
+	Obsolete           types.Bool   `tfsdk:"obsolete"`
+	Definition         types.String `tfsdk:"definition"`
+}
+
+// GetSchema defines the schema for the datasource.
+func (r *schemaObjectClassDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	schemaDef := schema.Schema{
+		Description: "Describes an object class defined in the LDAP schema, read from cn=schema through the Directory REST API.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name or OID of the object class. Names are matched case-insensitively.",
+				Required:    true,
+			},
+			"oid": schema.StringAttribute{
+				Description: "The OID of the object class.",
+				Computed:    true,
+			},
+			"names": schema.ListAttribute{
+				Description: "All names of the object class.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the object class.",
+				Computed:    true,
+			},
+			"superior_classes": schema.ListAttribute{
+				Description: "The object classes that this object class directly inherits from.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"kind": schema.StringAttribute{
+				Description: "The kind of the object class: `STRUCTURAL`, `AUXILIARY`, or `ABSTRACT`.",
+				Computed:    true,
+			},
+			"required_attributes": schema.SetAttribute{
+				Description: "Attributes that entries with this object class must contain, including those inherited from superior classes.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"optional_attributes": schema.SetAttribute{
+				Description: "Attributes that entries with this object class may contain, including those inherited from superior classes.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"obsolete": schema.BoolAttribute{
+				Description: "Whether the object class is marked as obsolete.",
+				Computed:    true,
+			},
+			"definition": schema.StringAttribute{
+				Description: "The full definition of the object class, as read from cn=schema.",
+				Computed:    true,
+			},
+		},
+	}
+	config.AddCommonDataSourceSchema(&schemaDef, false)
+	resp.Schema = schemaDef
+}
+
+// Get the values of a keyword of an object class and all of its superior classes, without duplicates
+func inheritedObjectClassValues(definitions []schemaDefinition, definition *schemaDefinition, keyword string) []string {
+	values := []string{}
+	seenValues := map[string]bool{}
+	seenClasses := map[string]bool{}
+	pending := []*schemaDefinition{definition}
+	for len(pending) > 0 {
+		current := pending[0]
+		pending = pending[1:]
+		if current == nil || seenClasses[current.OID] {
+			continue
+		}
+		seenClasses[current.OID] = true
+		for _, value := range current.Fields[keyword] {
+			if !seenValues[value] {
+				seenValues[value] = true
+				values = append(values, value)
+			}
+		}
+		for _, superior := range current.Fields["SUP"] {
+			pending = append(pending, findSchemaDefinition(definitions, superior))
+		}
+	}
+	return values
+}
+
+// Read an object class definition into the model struct
+func readObjectClass(definitions []schemaDefinition, definition *schemaDefinition, state *schemaObjectClassDataSourceModel) {
+	state.Id = types.StringValue(definition.OID)
+	state.Oid = types.StringValue(definition.OID)
+	state.Names = internaltypes.GetStringList(definition.Names)
+	state.Description = optionalString(definition.Value("DESC"))
+	state.SuperiorClasses = internaltypes.GetStringList(definition.Fields["SUP"])
+	// Object classes are structural unless marked otherwise
+	kind := "STRUCTURAL"
+	for _, k := range []string{"ABSTRACT", "AUXILIARY"} {
+		if definition.Has(k) {
+			kind = k
+		}
+	}
+	state.Kind = types.StringValue(kind)
+	state.RequiredAttributes = internaltypes.GetStringSet(inheritedObjectClassValues(definitions, definition, "MUST"))
+	state.OptionalAttributes = internaltypes.GetStringSet(inheritedObjectClassValues(definitions, definition, "MAY"))
+	state.Obsolete = types.BoolValue(definition.Has("OBSOLETE"))
+	state.Definition = types.StringValue(definition.Raw)
+}
+
+// Read resource information
+func (r *schemaObjectClassDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current state
+	var state schemaObjectClassDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entry, httpResp, err := directory.GetEntry(ctx, r.providerConfig, r.apiClient, schemaDN, []string{objectClassesAttribute})
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Schema Object Classes", err, httpResp)
+		return
+	}
+	definitions, definition, err := lookupSchemaDefinition(ctx, entry.Values(objectClassesAttribute), state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to parse the Schema Object Class", err.Error())
+		return
+	}
+	if definition == nil {
+		resp.Diagnostics.AddError("Schema Object Class not found", "No object class named \""+state.Name.ValueString()+"\" is defined in the schema")
+		return
+	}
+
+	// Read the response into the state
+	readObjectClass(definitions, definition, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
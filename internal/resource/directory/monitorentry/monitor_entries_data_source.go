@@ -0,0 +1,179 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package monitorentry
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/directory"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Filter matching every monitor entry
+const defaultMonitorEntryFilter = "(objectClass=ds-monitor-entry)"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &monitorEntriesDataSource{}
+	_ datasource.DataSourceWithConfigure = &monitorEntriesDataSource{}
+)
+
+// Create a Monitor Entries data source
+func NewMonitorEntriesDataSource() datasource.DataSource {
+	return &monitorEntriesDataSource{}
+}
+
+// monitorEntriesDataSource is the datasource implementation.
+type monitorEntriesDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the data source type name.
+func (r *monitorEntriesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_entries"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *monitorEntriesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+type monitorEntriesDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Filter      types.String `tfsdk:"filter"`
+	ObjectClass types.String `tfsdk:"object_class"`
+	Dns         types.Set    `tfsdk:"dns"`
+	Entries     types.List   `tfsdk:"entries"`
+}
+
+// Attribute types of each object in the entries attribute
+var monitorEntryAttrTypes = map[string]attr.Type{
+	"dn":               types.StringType,
+	"name":             types.StringType,
+	"object_classes":   types.SetType{ElemType: types.StringType},
+	"attributes":       types.MapType{ElemType: types.StringType},
+	"attribute_values": types.MapType{ElemType: types.ListType{ElemType: types.StringType}},
+}
+
+// GetSchema defines the schema for the datasource.
+func (r *monitorEntriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	schemaDef := schema.Schema{
+		Description: "Lists monitor entries from the cn=monitor backend, read through the Directory REST API.",
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				Description: "LDAP filter used to select monitor entries, such as `(objectClass=ds-backend-monitor-entry)`. Defaults to `" + defaultMonitorEntryFilter + "`, which matches every monitor entry.",
+				Optional:    true,
+			},
+			"object_class": schema.StringAttribute{
+				Description: "Only include monitor entries with this object class, such as `ds-backend-monitor-entry`. Combined with `filter` when both are set.",
+				Optional:    true,
+			},
+			"dns": schema.SetAttribute{
+				Description: "DNs of the matching monitor entries.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"entries": schema.ListNestedAttribute{
+				Description: "The matching monitor entries, sorted by DN.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"dn": schema.StringAttribute{
+							Description: "The DN of the monitor entry.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The cn of the monitor entry.",
+							Computed:    true,
+						},
+						"object_classes": schema.SetAttribute{
+							Description: "Object classes of the monitor entry.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"attributes": schema.MapAttribute{
+							Description: "The first value of each attribute of the monitor entry. Numeric values can be converted with `tonumber`.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"attribute_values": schema.MapAttribute{
+							Description: "All values of each attribute of the monitor entry.",
+							Computed:    true,
+							ElementType: types.ListType{ElemType: types.StringType},
+						},
+					},
+				},
+			},
+		},
+	}
+	config.AddCommonDataSourceSchema(&schemaDef, false)
+	resp.Schema = schemaDef
+}
+
+// Get the LDAP filter for the search
+func (m monitorEntriesDataSourceModel) searchFilter() string {
+	filter := defaultMonitorEntryFilter
+	if internaltypes.IsDefined(m.Filter) && m.Filter.ValueString() != "" {
+		filter = m.Filter.ValueString()
+	}
+	if internaltypes.IsDefined(m.ObjectClass) && m.ObjectClass.ValueString() != "" {
+		filter = "(&" + filter + "(objectClass=" + directory.EscapeFilterValue(m.ObjectClass.ValueString()) + "))"
+	}
+	return filter
+}
+
+// Read resource information
+func (r *monitorEntriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current state
+	var state monitorEntriesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := state.searchFilter()
+	entries, httpResp, err := directory.SearchEntries(ctx, r.providerConfig, r.apiClient, monitorBaseDN, directory.ScopeWholeSubtree, filter, nil)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while searching for Monitor Entries", err, httpResp)
+		return
+	}
+
+	// Read the response into the state
+	dns := make([]string, 0, len(entries))
+	objects := make([]attr.Value, 0, len(entries))
+	for _, entry := range entries {
+		dns = append(dns, entry.DN)
+		attributes, attributeValues := directory.EntryAttributeMaps(entry, &resp.Diagnostics)
+		object, diags := types.ObjectValue(monitorEntryAttrTypes, map[string]attr.Value{
+			"dn":               types.StringValue(entry.DN),
+			"name":             types.StringValue(entry.Value("cn")),
+			"object_classes":   internaltypes.GetStringSet(entry.ObjectClasses()),
+			"attributes":       attributes,
+			"attribute_values": attributeValues,
+		})
+		resp.Diagnostics.Append(diags...)
+		objects = append(objects, object)
+	}
+	state.Id = types.StringValue("id")
+	state.Dns = internaltypes.GetStringSet(dns)
+	state.Entries, diags = types.ListValue(types.ObjectType{AttrTypes: monitorEntryAttrTypes}, objects)
+	resp.Diagnostics.Append(diags...)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
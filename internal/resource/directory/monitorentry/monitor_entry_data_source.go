@@ -0,0 +1,139 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package monitorentry
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/directory"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Base DN of the monitor backend
+const monitorBaseDN = "cn=monitor"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &monitorEntryDataSource{}
+	_ datasource.DataSourceWithConfigure = &monitorEntryDataSource{}
+)
+
+// Create a Monitor Entry data source
+func NewMonitorEntryDataSource() datasource.DataSource {
+	return &monitorEntryDataSource{}
+}
+
+// monitorEntryDataSource is the datasource implementation.
+type monitorEntryDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the data source type name.
+func (r *monitorEntryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_entry"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *monitorEntryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+type monitorEntryDataSourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Dn              types.String `tfsdk:"dn"`
+	ObjectClasses   types.Set    `tfsdk:"object_classes"`
+	Attributes      types.Map    `tfsdk:"attributes"`
+	AttributeValues types.Map    `tfsdk:"attribute_values"`
+}
+
+// GetSchema defines the schema for the datasource.
+func (r *monitorEntryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	schemaDef := schema.Schema{
+		Description: "Describes a monitor entry from the cn=monitor backend, read through the Directory REST API.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The cn of a monitor entry directly below cn=monitor, such as `userRoot Backend` or `Work Queue`. Exactly one of `name` and `dn` must be set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("dn")),
+				},
+			},
+			"dn": schema.StringAttribute{
+				Description: "The DN of the monitor entry, such as `cn=userRoot Backend,cn=monitor`. Exactly one of `name` and `dn` must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"object_classes": schema.SetAttribute{
+				Description: "Object classes of the monitor entry, such as `ds-backend-monitor-entry`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"attributes": schema.MapAttribute{
+				Description: "The first value of each attribute of the monitor entry. Numeric values can be converted with `tonumber`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"attribute_values": schema.MapAttribute{
+				Description: "All values of each attribute of the monitor entry.",
+				Computed:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+			},
+		},
+	}
+	config.AddCommonDataSourceSchema(&schemaDef, false)
+	resp.Schema = schemaDef
+}
+
+// Get the DN of a monitor entry directly below cn=monitor
+func monitorEntryDN(name string) string {
+	return "cn=" + directory.EscapeDNValue(name) + "," + monitorBaseDN
+}
+
+// Read resource information
+func (r *monitorEntryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current state
+	var state monitorEntryDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dn := state.Dn.ValueString()
+	if internaltypes.IsDefined(state.Name) {
+		dn = monitorEntryDN(state.Name.ValueString())
+	}
+	entry, httpResp, err := directory.GetEntry(ctx, r.providerConfig, r.apiClient, dn, nil)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Monitor Entry", err, httpResp)
+		return
+	}
+
+	// Read the response into the state
+	state.Id = types.StringValue(entry.DN)
+	state.Dn = types.StringValue(entry.DN)
+	state.Name = types.StringValue(entry.Value("cn"))
+	state.ObjectClasses = internaltypes.GetStringSet(entry.ObjectClasses())
+	state.Attributes, state.AttributeValues = directory.EntryAttributeMaps(*entry, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,162 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package prometheusmetrics
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Suffixes of the samples of histogram and summary metric families
+var familySampleSuffixes = []string{"_bucket", "_sum", "_count"}
+
+// A metric family parsed from the Prometheus text exposition format
+type metricFamily struct {
+	Name    string
+	Type    string
+	Help    string
+	Samples []metricSample
+}
+
+// A sample of a metric family
+type metricSample struct {
+	Name   string
+	Labels map[string]string
+	// The sample value. NaN and infinite values can't be represented in Terraform, so they are nil.
+	Value *float64
+}
+
+// Parse metrics in the Prometheus text exposition format. Families are returned sorted by name, with
+// samples in the order they were exposed.
+func parseExpositionFormat(text string) ([]metricFamily, error) {
+	families := map[string]*metricFamily{}
+	getFamily := func(name string) *metricFamily {
+		if families[name] == nil {
+			families[name] = &metricFamily{Name: name, Type: "untyped"}
+		}
+		return families[name]
+	}
+
+	for lineNumber, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			keyword, rest, _ := strings.Cut(strings.TrimSpace(line[1:]), " ")
+			name, text, _ := strings.Cut(strings.TrimSpace(rest), " ")
+			switch {
+			case name == "":
+			case keyword == "HELP":
+				getFamily(name).Help = unescapeHelp(strings.TrimSpace(text))
+			case keyword == "TYPE" && strings.TrimSpace(text) != "":
+				getFamily(name).Type = strings.TrimSpace(text)
+			}
+			// Other comments are ignored
+			continue
+		}
+
+		sample, err := parseSample(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber+1, err)
+		}
+		familyName := sample.Name
+		if families[familyName] == nil {
+			// Histogram and summary samples belong to the family without the suffix
+			for _, suffix := range familySampleSuffixes {
+				if base := strings.TrimSuffix(sample.Name, suffix); base != sample.Name && families[base] != nil {
+					familyName = base
+					break
+				}
+			}
+		}
+		family := getFamily(familyName)
+		family.Samples = append(family.Samples, sample)
+	}
+
+	result := make([]metricFamily, 0, len(families))
+	for _, family := range families {
+		result = append(result, *family)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// Parse a sample line, such as: name{label="value"} 1.5 1700000000000
+func parseSample(line string) (metricSample, error) {
+	sample := metricSample{Labels: map[string]string{}}
+	nameEnd := strings.IndexAny(line, "{ \t")
+	if nameEnd <= 0 {
+		return sample, fmt.Errorf("missing value for metric %q", line)
+	}
+	sample.Name = line[:nameEnd]
+	rest := line[nameEnd:]
+
+	if strings.HasPrefix(rest, "{") {
+		var err error
+		rest, err = parseLabels(rest[1:], sample.Labels)
+		if err != nil {
+			return sample, fmt.Errorf("metric %s: %w", sample.Name, err)
+		}
+	}
+
+	// The value may be followed by a timestamp, which is ignored
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return sample, fmt.Errorf("missing value for metric %s", sample.Name)
+	}
+	value, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return sample, fmt.Errorf("invalid value %q for metric %s", fields[0], sample.Name)
+	}
+	if !math.IsNaN(value) && !math.IsInf(value, 0) {
+		sample.Value = &value
+	}
+	return sample, nil
+}
+
+// Parse the labels of a sample after the opening brace, returning the rest of the line after the closing brace
+func parseLabels(text string, labels map[string]string) (string, error) {
+	for {
+		text = strings.TrimLeft(text, " \t,")
+		if strings.HasPrefix(text, "}") {
+			return text[1:], nil
+		}
+		nameEnd := strings.IndexByte(text, '=')
+		if nameEnd <= 0 {
+			return "", fmt.Errorf("invalid labels")
+		}
+		name := strings.TrimSpace(text[:nameEnd])
+		text = strings.TrimLeft(text[nameEnd+1:], " \t")
+		if !strings.HasPrefix(text, "\"") {
+			return "", fmt.Errorf("value of label %s must be quoted", name)
+		}
+		var value strings.Builder
+		i := 1
+		for ; i < len(text) && text[i] != '"'; i++ {
+			if text[i] == '\\' && i+1 < len(text) {
+				i++
+				if text[i] == 'n' {
+					value.WriteByte('\n')
+					continue
+				}
+			}
+			value.WriteByte(text[i])
+		}
+		if i >= len(text) {
+			return "", fmt.Errorf("unterminated value of label %s", name)
+		}
+		labels[name] = value.String()
+		text = text[i+1:]
+	}
+}
+
+// Unescape the text of a HELP line, where backslashes and line feeds are escaped
+func unescapeHelp(help string) string {
+	return strings.NewReplacer(`\\`, `\`, `\n`, "\n").Replace(help)
+}
@@ -0,0 +1,232 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package prometheusmetrics
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Path of the metrics endpoint of the default Prometheus Monitoring HTTP Servlet Extension
+const defaultMetricsPath = "/metrics"
+
+// Content type of the Prometheus text exposition format
+const expositionFormatContentType = "text/plain; version=0.0.4"
+
+// Matches absolute URL paths
+var pathRegex = regexp.MustCompile(`^/[^?#]*$`)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &prometheusMetricsDataSource{}
+	_ datasource.DataSourceWithConfigure = &prometheusMetricsDataSource{}
+)
+
+// Create a Prometheus Metrics data source
+func NewPrometheusMetricsDataSource() datasource.DataSource {
+	return &prometheusMetricsDataSource{}
+}
+
+// prometheusMetricsDataSource is the datasource implementation.
+type prometheusMetricsDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the data source type name.
+func (r *prometheusMetricsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_prometheus_metrics"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *prometheusMetricsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+type prometheusMetricsDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Path        types.String `tfsdk:"path"`
+	NamePrefix  types.String `tfsdk:"name_prefix"`
+	MetricNames types.Set    `tfsdk:"metric_names"`
+	Families    types.List   `tfsdk:"families"`
+}
+
+// Attribute types of each object in the samples attribute of a metric family
+var sampleAttrTypes = map[string]attr.Type{
+	"name":   types.StringType,
+	"labels": types.MapType{ElemType: types.StringType},
+	"value":  types.Float64Type,
+}
+
+// Attribute types of each object in the families attribute
+var familyAttrTypes = map[string]attr.Type{
+	"name":    types.StringType,
+	"type":    types.StringType,
+	"help":    types.StringType,
+	"samples": types.ListType{ElemType: types.ObjectType{AttrTypes: sampleAttrTypes}},
+}
+
+// GetSchema defines the schema for the datasource.
+func (r *prometheusMetricsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	schemaDef := schema.Schema{
+		Description: "Scrapes the metrics endpoint of a Prometheus Monitoring HTTP Servlet Extension over the provider's HTTPS connection and parses the published metrics.",
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				Description: "Path of the metrics endpoint, as configured in the `base_context_path` of the Prometheus Monitoring HTTP Servlet Extension. Defaults to `" + defaultMetricsPath + "`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(pathRegex, "must be an absolute path such as \"/metrics\""),
+				},
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "Only include metric families whose names start with this prefix.",
+				Optional:    true,
+			},
+			"metric_names": schema.SetAttribute{
+				Description: "Names of the metric families being published.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"families": schema.ListNestedAttribute{
+				Description: "The metric families being published, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the metric family.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the metric family, such as `gauge`, `counter`, `histogram`, `summary`, or `untyped`.",
+							Computed:    true,
+						},
+						"help": schema.StringAttribute{
+							Description: "The help text of the metric family.",
+							Computed:    true,
+						},
+						"samples": schema.ListNestedAttribute{
+							Description: "The samples of the metric family, in the order they were published.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Description: "The name of the sample, which includes any suffix such as `_count` for histograms and summaries.",
+										Computed:    true,
+									},
+									"labels": schema.MapAttribute{
+										Description: "The labels of the sample.",
+										Computed:    true,
+										ElementType: types.StringType,
+									},
+									"value": schema.Float64Attribute{
+										Description: "The value of the sample. Null for NaN and infinite values, which can't be represented in Terraform.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	config.AddCommonDataSourceSchema(&schemaDef, false)
+	resp.Schema = schemaDef
+}
+
+// Get the object value for a metric family
+func familyValue(family metricFamily, diagnostics *diag.Diagnostics) attr.Value {
+	samples := make([]attr.Value, 0, len(family.Samples))
+	for _, sample := range family.Samples {
+		labels := make(map[string]attr.Value, len(sample.Labels))
+		for name, value := range sample.Labels {
+			labels[name] = types.StringValue(value)
+		}
+		labelsMap, diags := types.MapValue(types.StringType, labels)
+		diagnostics.Append(diags...)
+		value, diags := types.ObjectValue(sampleAttrTypes, map[string]attr.Value{
+			"name":   types.StringValue(sample.Name),
+			"labels": labelsMap,
+			"value":  types.Float64PointerValue(sample.Value),
+		})
+		diagnostics.Append(diags...)
+		samples = append(samples, value)
+	}
+	samplesList, diags := types.ListValue(types.ObjectType{AttrTypes: sampleAttrTypes}, samples)
+	diagnostics.Append(diags...)
+	help := types.StringNull()
+	if family.Help != "" {
+		help = types.StringValue(family.Help)
+	}
+	value, diags := types.ObjectValue(familyAttrTypes, map[string]attr.Value{
+		"name":    types.StringValue(family.Name),
+		"type":    types.StringValue(family.Type),
+		"help":    help,
+		"samples": samplesList,
+	})
+	diagnostics.Append(diags...)
+	return value
+}
+
+// Read resource information
+func (r *prometheusMetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current state
+	var state prometheusMetricsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metricsPath := defaultMetricsPath
+	if internaltypes.IsDefined(state.Path) {
+		metricsPath = state.Path.ValueString()
+	}
+	responseBody, httpResp, err := config.SendRawGetRequest(ctx, r.providerConfig, r.apiClient, metricsPath, expositionFormatContentType)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while scraping the Prometheus Metrics", err, httpResp)
+		return
+	}
+	families, err := parseExpositionFormat(string(responseBody))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to parse the Prometheus Metrics", err.Error())
+		return
+	}
+
+	// Read the response into the state
+	names := []string{}
+	values := []attr.Value{}
+	for _, family := range families {
+		if !strings.HasPrefix(family.Name, state.NamePrefix.ValueString()) {
+			continue
+		}
+		names = append(names, family.Name)
+		values = append(values, familyValue(family, &resp.Diagnostics))
+	}
+	state.Id = types.StringValue(metricsPath)
+	state.MetricNames = internaltypes.GetStringSet(names)
+	state.Families, diags = types.ListValue(types.ObjectType{AttrTypes: familyAttrTypes}, values)
+	resp.Diagnostics.Append(diags...)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
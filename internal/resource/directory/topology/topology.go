@@ -0,0 +1,118 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package topology
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/directory"
+)
+
+// Filter matching the replication summary monitor entries, one for each replicated base DN
+const replicationSummaryFilter = "(cn=Replication Summary*)"
+
+// Matches the key="value" pairs in the values of replication summary attributes
+var summaryPairRegex = regexp.MustCompile(`([A-Za-z0-9-]+)="([^"]*)"`)
+
+// Properties of a server instance read from the Configuration API
+type serverInstance struct {
+	ServerInstanceName     string   `json:"serverInstanceName"`
+	Schemas                []string `json:"schemas"`
+	ClusterName            string   `json:"clusterName"`
+	ServerInstanceLocation *string  `json:"serverInstanceLocation"`
+	Hostname               *string  `json:"hostname"`
+	LdapPort               *int64   `json:"ldapPort"`
+	LdapsPort              *int64   `json:"ldapsPort"`
+	ReplicationPort        *int64   `json:"replicationPort"`
+	ReplicationSetName     *string  `json:"replicationSetName"`
+	ServerVersion          *string  `json:"serverVersion"`
+	MemberOfServerGroup    []string `json:"memberOfServerGroup"`
+}
+
+// Get the type of the server instance from its schemas, such as "directory-server-instance"
+func (s serverInstance) instanceType() string {
+	for _, schema := range s.Schemas {
+		if strings.HasPrefix(schema, "urn:pingidentity:schemas:configuration:2.0:") {
+			return schema[strings.LastIndex(schema, ":")+1:]
+		}
+	}
+	return ""
+}
+
+// Check whether the server instance takes part in replication. Only directory server instances with a replication
+// port replicate, so reachability through replication can't be judged for other server instances.
+func (s serverInstance) replicates() bool {
+	return s.instanceType() == "directory-server-instance" && s.ReplicationPort != nil
+}
+
+// Check whether an LDAP server address, such as "ds1.example.com:636", refers to this server instance
+func (s serverInstance) hasAddress(address string) bool {
+	host, port, found := strings.Cut(address, ":")
+	if !found || s.Hostname == nil || !strings.EqualFold(host, *s.Hostname) {
+		return false
+	}
+	for _, instancePort := range []*int64{s.LdapPort, s.LdapsPort} {
+		if instancePort != nil && strconv.FormatInt(*instancePort, 10) == port {
+			return true
+		}
+	}
+	return false
+}
+
+// The status of a replica of a base DN, from a replication summary monitor entry
+type replicaStatus struct {
+	BaseDN       string
+	ReplicaID    string
+	LdapServer   string
+	ConnectedTo  string
+	GenerationID string
+	Backlog      *int64
+}
+
+// Parse a replica value of a replication summary monitor entry, such as
+// replica-id="1234" ldap-server="ds1.example.com:636" connected-to="5678" generation-id="..." replication-backlog="0"
+func parseReplicaStatus(baseDN, value string) replicaStatus {
+	fields := map[string]string{}
+	for _, match := range summaryPairRegex.FindAllStringSubmatch(value, -1) {
+		fields[strings.ToLower(match[1])] = match[2]
+	}
+	status := replicaStatus{
+		BaseDN:       baseDN,
+		ReplicaID:    fields["replica-id"],
+		LdapServer:   fields["ldap-server"],
+		ConnectedTo:  fields["connected-to"],
+		GenerationID: fields["generation-id"],
+	}
+	if backlog, err := strconv.ParseInt(fields["replication-backlog"], 10, 64); err == nil {
+		status.Backlog = &backlog
+	}
+	return status
+}
+
+// Get the status of every replica from the replication summary monitor entries
+func replicaStatuses(entries []directory.Entry) []replicaStatus {
+	var statuses []replicaStatus
+	for _, entry := range entries {
+		baseDN := entry.Value("base-dn")
+		for _, value := range entry.Values("replica") {
+			statuses = append(statuses, parseReplicaStatus(baseDN, value))
+		}
+	}
+	return statuses
+}
+
+// Check whether a replica is connected to a replication server
+func (r replicaStatus) connected() bool {
+	return r.ConnectedTo != "" && !strings.EqualFold(r.ConnectedTo, "none")
+}
+
+// Get a string value, using null for an empty string
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
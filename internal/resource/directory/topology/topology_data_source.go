@@ -0,0 +1,421 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package topology
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/directory"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Name of the synchronization provider used for replication when none is configured
+const defaultSynchronizationProviderName = "Multimaster Synchronization"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &topologyDataSource{}
+	_ datasource.DataSourceWithConfigure = &topologyDataSource{}
+)
+
+// Create a Topology data source
+func NewTopologyDataSource() datasource.DataSource {
+	return &topologyDataSource{}
+}
+
+// topologyDataSource is the datasource implementation.
+type topologyDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the data source type name.
+func (r *topologyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_topology"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *topologyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+type topologyDataSourceModel struct {
+	Id                          types.String `tfsdk:"id"`
+	SynchronizationProviderName types.String `tfsdk:"synchronization_provider_name"`
+	LocalInstanceName           types.String `tfsdk:"local_instance_name"`
+	ServerInstances             types.List   `tfsdk:"server_instances"`
+	ReplicationDomains          types.List   `tfsdk:"replication_domains"`
+	Degraded                    types.Bool   `tfsdk:"degraded"`
+	DegradedReasons             types.List   `tfsdk:"degraded_reasons"`
+}
+
+// Attribute types of each object in the replicas attribute of a server instance
+var replicaAttrTypes = map[string]attr.Type{
+	"base_dn":       types.StringType,
+	"replica_id":    types.StringType,
+	"connected_to":  types.StringType,
+	"generation_id": types.StringType,
+	"backlog":       types.Int64Type,
+}
+
+// Attribute types of each object in the server_instances attribute
+var serverInstanceAttrTypes = map[string]attr.Type{
+	"name":                 types.StringType,
+	"type":                 types.StringType,
+	"cluster_name":         types.StringType,
+	"location":             types.StringType,
+	"hostname":             types.StringType,
+	"ldap_port":            types.Int64Type,
+	"ldaps_port":           types.Int64Type,
+	"replication_port":     types.Int64Type,
+	"replication_set_name": types.StringType,
+	"server_version":       types.StringType,
+	"server_groups":        types.SetType{ElemType: types.StringType},
+	"local":                types.BoolType,
+	"reachable":            types.BoolType,
+	"replicas":             types.ListType{ElemType: types.ObjectType{AttrTypes: replicaAttrTypes}},
+}
+
+// Attribute types of each object in the replication_domains attribute
+var replicationDomainAttrTypes = map[string]attr.Type{
+	"name":      types.StringType,
+	"base_dn":   types.StringType,
+	"server_id": types.Int64Type,
+}
+
+// GetSchema defines the schema for the datasource.
+func (r *topologyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	schemaDef := schema.Schema{
+		Description: "Describes the topology of the server: every server instance with its location, server groups, and replication status, along with the replication domains of this server. Status is read from the replication summary monitor entries through the Directory REST API.",
+		Attributes: map[string]schema.Attribute{
+			"synchronization_provider_name": schema.StringAttribute{
+				Description: "Name of the synchronization provider whose replication domains are listed. Defaults to `" + defaultSynchronizationProviderName + "`.",
+				Optional:    true,
+			},
+			"local_instance_name": schema.StringAttribute{
+				Description: "The name of the server instance that the provider is connected to.",
+				Computed:    true,
+			},
+			"server_instances": schema.ListNestedAttribute{
+				Description: "Every server instance in the topology, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the server instance.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the server instance, such as `directory-server-instance` or `proxy-server-instance`.",
+							Computed:    true,
+						},
+						"cluster_name": schema.StringAttribute{
+							Description: "The name of the cluster of the server instance.",
+							Computed:    true,
+						},
+						"location": schema.StringAttribute{
+							Description: "The location of the server instance.",
+							Computed:    true,
+						},
+						"hostname": schema.StringAttribute{
+							Description: "The name of the host where the server instance is installed.",
+							Computed:    true,
+						},
+						"ldap_port": schema.Int64Attribute{
+							Description: "The LDAP port of the server instance.",
+							Computed:    true,
+						},
+						"ldaps_port": schema.Int64Attribute{
+							Description: "The LDAPS port of the server instance.",
+							Computed:    true,
+						},
+						"replication_port": schema.Int64Attribute{
+							Description: "The replication port of the server instance.",
+							Computed:    true,
+						},
+						"replication_set_name": schema.StringAttribute{
+							Description: "The name of the replication set of the server instance.",
+							Computed:    true,
+						},
+						"server_version": schema.StringAttribute{
+							Description: "The product version of the server instance.",
+							Computed:    true,
+						},
+						"server_groups": schema.SetAttribute{
+							Description: "The server groups that the server instance is a member of.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"local": schema.BoolAttribute{
+							Description: "Whether this is the server instance that the provider is connected to.",
+							Computed:    true,
+						},
+						"reachable": schema.BoolAttribute{
+							Description: "Whether the server instance is reachable through replication. Null when replication isn't configured, or for server instances that don't replicate, such as proxy server instances and directory server instances without a `replication_port`, since reachability can't be determined.",
+							Computed:    true,
+						},
+						"replicas": schema.ListNestedAttribute{
+							Description: "The replication status of each base DN replicated by the server instance.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"base_dn": schema.StringAttribute{
+										Description: "The replicated base DN.",
+										Computed:    true,
+									},
+									"replica_id": schema.StringAttribute{
+										Description: "The replica ID of the server instance for the base DN.",
+										Computed:    true,
+									},
+									"connected_to": schema.StringAttribute{
+										Description: "The replication server that the replica is connected to.",
+										Computed:    true,
+									},
+									"generation_id": schema.StringAttribute{
+										Description: "The generation ID of the replicated data. Replicas with different generation IDs don't replicate with each other.",
+										Computed:    true,
+									},
+									"backlog": schema.Int64Attribute{
+										Description: "The number of changes that the replica hasn't yet applied.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"replication_domains": schema.ListNestedAttribute{
+				Description: "The replication domains configured on the server that the provider is connected to, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the replication domain.",
+							Computed:    true,
+						},
+						"base_dn": schema.StringAttribute{
+							Description: "The base DN of the replicated data.",
+							Computed:    true,
+						},
+						"server_id": schema.Int64Attribute{
+							Description: "The unique identifier of this server within the replication domain.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"degraded": schema.BoolAttribute{
+				Description: "Whether the topology is degraded: a replicating directory server instance is unreachable, a replica has a backlog, or the replicas of a base DN have different generation IDs.",
+				Computed:    true,
+			},
+			"degraded_reasons": schema.ListAttribute{
+				Description: "Why the topology is degraded. Empty when the topology isn't degraded.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+	config.AddCommonDataSourceSchema(&schemaDef, false)
+	resp.Schema = schemaDef
+}
+
+// Read every server instance through the Configuration API
+func (r *topologyDataSource) readServerInstances(ctx context.Context, diagnostics *diag.Diagnostics) []serverInstance {
+	responseBody, httpResp, err := config.SendRawRequest(ctx, r.providerConfig, r.apiClient, http.MethodGet, "/config/server-instances", nil)
+	if err != nil {
+		config.ReportHttpError(ctx, diagnostics, "An error occurred while listing the Server Instance objects", err, httpResp)
+		return nil
+	}
+	var response struct {
+		Resources []serverInstance `json:"Resources"`
+	}
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		diagnostics.AddError("Failed to parse the Server Instance objects", err.Error())
+		return nil
+	}
+	sort.Slice(response.Resources, func(i, j int) bool {
+		return response.Resources[i].ServerInstanceName < response.Resources[j].ServerInstanceName
+	})
+	return response.Resources
+}
+
+// Read the replication domains of the synchronization provider
+func (r *topologyDataSource) readReplicationDomains(ctx context.Context, synchronizationProviderName string, diagnostics *diag.Diagnostics) types.List {
+	domainType := types.ObjectType{AttrTypes: replicationDomainAttrTypes}
+	readResponse, httpResp, err := r.apiClient.ReplicationDomainAPI.ListReplicationDomains(config.ProviderBasicAuthContext(ctx, r.providerConfig), synchronizationProviderName).Execute()
+	if err != nil {
+		// Servers without replication may not have the synchronization provider
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return types.ListValueMust(domainType, []attr.Value{})
+		}
+		config.ReportHttpError(ctx, diagnostics, "An error occurred while listing the Replication Domain objects", err, httpResp)
+		return types.ListNull(domainType)
+	}
+	domains := readResponse.Resources
+	sort.Slice(domains, func(i, j int) bool {
+		return domains[i].Id < domains[j].Id
+	})
+	values := make([]attr.Value, 0, len(domains))
+	for _, domain := range domains {
+		value, diags := types.ObjectValue(replicationDomainAttrTypes, map[string]attr.Value{
+			"name":      types.StringValue(domain.Id),
+			"base_dn":   types.StringValue(domain.BaseDN),
+			"server_id": types.Int64Value(domain.ServerID),
+		})
+		diagnostics.Append(diags...)
+		values = append(values, value)
+	}
+	list, diags := types.ListValue(domainType, values)
+	diagnostics.Append(diags...)
+	return list
+}
+
+// Get the object value for a server instance, along with the reasons that it is degraded
+func serverInstanceValue(instance serverInstance, localInstanceName string, statuses []replicaStatus, diagnostics *diag.Diagnostics) (attr.Value, []string) {
+	var degradedReasons []string
+	local := instance.ServerInstanceName == localInstanceName
+	replicas := []attr.Value{}
+	connected := false
+	for _, status := range statuses {
+		if !instance.hasAddress(status.LdapServer) {
+			continue
+		}
+		connected = connected || status.connected()
+		if status.Backlog != nil && *status.Backlog > 0 {
+			degradedReasons = append(degradedReasons, fmt.Sprintf("Server instance %s has a replication backlog of %d changes for %s", instance.ServerInstanceName, *status.Backlog, status.BaseDN))
+		}
+		replica, diags := types.ObjectValue(replicaAttrTypes, map[string]attr.Value{
+			"base_dn":       types.StringValue(status.BaseDN),
+			"replica_id":    optionalString(status.ReplicaID),
+			"connected_to":  optionalString(status.ConnectedTo),
+			"generation_id": optionalString(status.GenerationID),
+			"backlog":       internaltypes.Int64TypeOrNil(status.Backlog),
+		})
+		diagnostics.Append(diags...)
+		replicas = append(replicas, replica)
+	}
+
+	// Reachability is left null for server instances that don't replicate
+	reachable := types.BoolNull()
+	if local && instance.replicates() {
+		reachable = types.BoolValue(true)
+	} else if instance.replicates() && len(statuses) > 0 {
+		reachable = types.BoolValue(connected)
+		if !connected {
+			degradedReasons = append(degradedReasons, fmt.Sprintf("Server instance %s is not reachable through replication", instance.ServerInstanceName))
+		}
+	}
+
+	replicaList, diags := types.ListValue(types.ObjectType{AttrTypes: replicaAttrTypes}, replicas)
+	diagnostics.Append(diags...)
+	value, diags := types.ObjectValue(serverInstanceAttrTypes, map[string]attr.Value{
+		"name":                 types.StringValue(instance.ServerInstanceName),
+		"type":                 types.StringValue(instance.instanceType()),
+		"cluster_name":         types.StringValue(instance.ClusterName),
+		"location":             internaltypes.StringTypeOrNil(instance.ServerInstanceLocation, false),
+		"hostname":             internaltypes.StringTypeOrNil(instance.Hostname, false),
+		"ldap_port":            internaltypes.Int64TypeOrNil(instance.LdapPort),
+		"ldaps_port":           internaltypes.Int64TypeOrNil(instance.LdapsPort),
+		"replication_port":     internaltypes.Int64TypeOrNil(instance.ReplicationPort),
+		"replication_set_name": internaltypes.StringTypeOrNil(instance.ReplicationSetName, false),
+		"server_version":       internaltypes.StringTypeOrNil(instance.ServerVersion, false),
+		"server_groups":        internaltypes.GetStringSet(instance.MemberOfServerGroup),
+		"local":                types.BoolValue(local),
+		"reachable":            reachable,
+		"replicas":             replicaList,
+	})
+	diagnostics.Append(diags...)
+	return value, degradedReasons
+}
+
+// Get the reasons that the replicas of each base DN are degraded because their generation IDs differ
+func generationIDReasons(statuses []replicaStatus) []string {
+	generationIDs := map[string]map[string]bool{}
+	for _, status := range statuses {
+		if status.GenerationID == "" {
+			continue
+		}
+		if generationIDs[status.BaseDN] == nil {
+			generationIDs[status.BaseDN] = map[string]bool{}
+		}
+		generationIDs[status.BaseDN][status.GenerationID] = true
+	}
+	var reasons []string
+	for baseDN, ids := range generationIDs {
+		if len(ids) > 1 {
+			reasons = append(reasons, fmt.Sprintf("Replicas of %s have %d different generation IDs", baseDN, len(ids)))
+		}
+	}
+	sort.Strings(reasons)
+	return reasons
+}
+
+// Read resource information
+func (r *topologyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current state
+	var state topologyDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	globalConfiguration, httpResp, err := r.apiClient.GlobalConfigurationAPI.GetGlobalConfiguration(config.ProviderBasicAuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Global Configuration", err, httpResp)
+		return
+	}
+	instances := r.readServerInstances(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	synchronizationProviderName := defaultSynchronizationProviderName
+	if internaltypes.IsDefined(state.SynchronizationProviderName) {
+		synchronizationProviderName = state.SynchronizationProviderName.ValueString()
+	}
+	state.ReplicationDomains = r.readReplicationDomains(ctx, synchronizationProviderName, &resp.Diagnostics)
+	summaryEntries, httpResp, err := directory.SearchEntries(ctx, r.providerConfig, r.apiClient, "cn=monitor", directory.ScopeSingleLevel, replicationSummaryFilter, nil)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while searching for the replication summary Monitor Entries", err, httpResp)
+		return
+	}
+	statuses := replicaStatuses(summaryEntries)
+
+	// Read the response into the state
+	var degradedReasons []string
+	instanceValues := make([]attr.Value, 0, len(instances))
+	for _, instance := range instances {
+		value, reasons := serverInstanceValue(instance, globalConfiguration.InstanceName, statuses, &resp.Diagnostics)
+		instanceValues = append(instanceValues, value)
+		degradedReasons = append(degradedReasons, reasons...)
+	}
+	degradedReasons = append(degradedReasons, generationIDReasons(statuses)...)
+	state.Id = types.StringValue(globalConfiguration.InstanceName)
+	state.LocalInstanceName = types.StringValue(globalConfiguration.InstanceName)
+	state.ServerInstances, diags = types.ListValue(types.ObjectType{AttrTypes: serverInstanceAttrTypes}, instanceValues)
+	resp.Diagnostics.Append(diags...)
+	state.Degraded = types.BoolValue(len(degradedReasons) > 0)
+	state.DegradedReasons = internaltypes.GetStringList(degradedReasons)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
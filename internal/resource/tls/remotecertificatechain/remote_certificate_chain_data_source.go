@@ -0,0 +1,239 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package remotecertificatechain
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+)
+
+// Number of seconds to wait for the connection and TLS handshake when no timeout is configured
+const defaultTimeoutSeconds = 10
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &remoteCertificateChainDataSource{}
+)
+
+// Create a Remote Certificate Chain data source
+func NewRemoteCertificateChainDataSource() datasource.DataSource {
+	return &remoteCertificateChainDataSource{}
+}
+
+// remoteCertificateChainDataSource is the datasource implementation. It connects to the remote server directly from
+// the provider, so it doesn't need the PingDirectory API client.
+type remoteCertificateChainDataSource struct{}
+
+// Metadata returns the data source type name.
+func (r *remoteCertificateChainDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_certificate_chain"
+}
+
+type remoteCertificateChainDataSourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	Host             types.String `tfsdk:"host"`
+	Port             types.Int64  `tfsdk:"port"`
+	StartTLS         types.Bool   `tfsdk:"start_tls"`
+	ServerName       types.String `tfsdk:"server_name"`
+	TimeoutSeconds   types.Int64  `tfsdk:"timeout_seconds"`
+	CertificateChain types.String `tfsdk:"certificate_chain"`
+	LastCertificate  types.String `tfsdk:"last_certificate"`
+	Certificates     types.List   `tfsdk:"certificates"`
+	ChainComplete    types.Bool   `tfsdk:"chain_complete"`
+}
+
+// Get attrtype map for each certificate presented by the remote server
+func getCertificateAttrTypes() map[string]attr.Type {
+	attrTypes := map[string]attr.Type{
+		"pem": types.StringType,
+	}
+	for name, attrType := range config.GetCertificateDetailsObjectType().AttrTypes {
+		attrTypes[name] = attrType
+	}
+	return attrTypes
+}
+
+// GetSchema defines the schema for the datasource.
+func (r *remoteCertificateChainDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	certificateAttributes := config.GetCertificateDetailsDataSourceAttributes()
+	certificateAttributes["pem"] = schema.StringAttribute{
+		Description: "The PEM-encoded certificate.",
+		Computed:    true,
+	}
+	schemaDef := schema.Schema{
+		Description: "Connects to a remote server from the machine running Terraform and returns the certificate chain it presents during the TLS handshake. The presented certificates are not validated, so verify the returned fingerprints before trusting them.",
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				Description: "The host name or IP address of the remote server.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Description: "The port of the remote server.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"start_tls": schema.BoolAttribute{
+				Description: "Set to true to send an LDAP StartTLS extended request before the TLS handshake, for LDAP servers that don't accept TLS connections directly on the port.",
+				Optional:    true,
+			},
+			"server_name": schema.StringAttribute{
+				Description: "The server name to send in the TLS server name indication extension. Defaults to `host`.",
+				Optional:    true,
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Description: "The number of seconds to wait for the connection and TLS handshake. Defaults to 10.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"certificate_chain": schema.StringAttribute{
+				Description: "The PEM-encoded certificates presented by the remote server, starting with the server's own certificate.",
+				Computed:    true,
+			},
+			"last_certificate": schema.StringAttribute{
+				Description: "The PEM-encoded last certificate presented by the remote server. This is the self-signed root certificate when `chain_complete` is true. Otherwise it is usually an intermediate CA certificate, and the root CA certificate that issued it must be trusted as well.",
+				Computed:    true,
+			},
+			"certificates": schema.ListNestedAttribute{
+				Description: "Details of each certificate presented by the remote server, in the order they were presented.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: certificateAttributes,
+				},
+			},
+			"chain_complete": schema.BoolAttribute{
+				Description: "Whether each presented certificate is signed by the next one, ending with a self-signed root certificate. Servers often leave out the root certificate, in which case this is false.",
+				Computed:    true,
+			},
+		},
+	}
+	config.AddCommonDataSourceSchema(&schemaDef, false)
+	resp.Schema = schemaDef
+}
+
+// Connect to the remote server and return the certificates it presents during the TLS handshake
+func fetchCertificateChain(ctx context.Context, host string, port int64, serverName string, useStartTLS bool, timeout time.Duration) ([]*x509.Certificate, error) {
+	dialer := &net.Dialer{Timeout: timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(host, strconv.FormatInt(port, 10)))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	err = conn.SetDeadline(time.Now().Add(timeout))
+	if err != nil {
+		return nil, err
+	}
+
+	if useStartTLS {
+		err = startTLS(conn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The presented certificates are returned as-is rather than validated, since the point is to retrieve
+	// certificates that aren't trusted yet
+	//#nosec G402
+	tlsConn := tls.Client(conn, &tls.Config{
+		ServerName:         serverName,
+		InsecureSkipVerify: true,
+	})
+	err = tlsConn.HandshakeContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tlsConn.ConnectionState().PeerCertificates, nil
+}
+
+// Encode a certificate as PEM
+func encodeCertificate(cert *x509.Certificate) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+}
+
+// Read the presented certificates into the state
+func readCertificateChain(certs []*x509.Certificate, state *remoteCertificateChainDataSourceModel, diagnostics *diag.Diagnostics) {
+	var chain strings.Builder
+	var certificates []attr.Value
+	for _, cert := range certs {
+		certPem := encodeCertificate(cert)
+		chain.WriteString(certPem)
+
+		details, diags := config.CertificateDetailsObject(cert)
+		diagnostics.Append(diags...)
+		attrValues := details.Attributes()
+		attrValues["pem"] = types.StringValue(certPem)
+		certificate, diags := types.ObjectValue(getCertificateAttrTypes(), attrValues)
+		diagnostics.Append(diags...)
+		certificates = append(certificates, certificate)
+	}
+
+	state.CertificateChain = types.StringValue(chain.String())
+	state.LastCertificate = types.StringValue(encodeCertificate(certs[len(certs)-1]))
+	var diags diag.Diagnostics
+	state.Certificates, diags = types.ListValue(types.ObjectType{AttrTypes: getCertificateAttrTypes()}, certificates)
+	diagnostics.Append(diags...)
+	state.ChainComplete = types.BoolValue(config.CertificateChainComplete(certs))
+}
+
+// Read resource information
+func (r *remoteCertificateChainDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current state
+	var state remoteCertificateChainDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverName := state.Host.ValueString()
+	if !state.ServerName.IsNull() {
+		serverName = state.ServerName.ValueString()
+	}
+	timeout := time.Duration(defaultTimeoutSeconds) * time.Second
+	if !state.TimeoutSeconds.IsNull() {
+		timeout = time.Duration(state.TimeoutSeconds.ValueInt64()) * time.Second
+	}
+	address := net.JoinHostPort(state.Host.ValueString(), strconv.FormatInt(state.Port.ValueInt64(), 10))
+
+	tflog.Debug(ctx, "Retrieving the certificate chain presented by "+address)
+	certs, err := fetchCertificateChain(ctx, state.Host.ValueString(), state.Port.ValueInt64(), serverName, state.StartTLS.ValueBool(), timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("An error occurred while retrieving the certificate chain from "+address, err.Error())
+		return
+	}
+	if len(certs) == 0 {
+		resp.Diagnostics.AddError("An error occurred while retrieving the certificate chain from "+address, "The server did not present any certificates.")
+		return
+	}
+
+	// Read the response into the state
+	state.Id = types.StringValue(address)
+	readCertificateChain(certs, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
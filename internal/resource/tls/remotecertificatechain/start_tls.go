@@ -0,0 +1,159 @@
+// Copyright © 2025 Ping Identity Corporation
+
+package remotecertificatechain
+
+import (
+	"bufio"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+)
+
+// OID of the LDAP StartTLS extended operation, from RFC 4511
+const startTLSRequestOID = "1.3.6.1.4.1.1466.20037"
+
+// BER tags used in the LDAP messages exchanged for StartTLS
+const (
+	berTagSequence         = 0x30
+	berTagEnumerated       = 0x0a
+	berTagExtendedResponse = 0x78
+	extendedRequestTag     = 23
+	requestNameTag         = 0
+)
+
+// Maximum size of the StartTLS response that will be read, to avoid reading an unbounded amount of data from a
+// server that isn't speaking LDAP
+const maxStartTLSResponseLength = 64 * 1024
+
+// Send an LDAP StartTLS extended request on the connection and wait for a successful response, so that a TLS
+// handshake can be done on the same connection
+func startTLS(conn net.Conn) error {
+	requestName, err := asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: requestNameTag, Bytes: []byte(startTLSRequestOID)})
+	if err != nil {
+		return err
+	}
+	request, err := asn1.Marshal(struct {
+		MessageID int
+		Operation asn1.RawValue
+	}{1, asn1.RawValue{Class: asn1.ClassApplication, Tag: extendedRequestTag, IsCompound: true, Bytes: requestName}})
+	if err != nil {
+		return err
+	}
+	_, err = conn.Write(request)
+	if err != nil {
+		return err
+	}
+
+	// The response is parsed by hand rather than with encoding/asn1, since LDAP servers are allowed to use BER
+	// encodings that aren't valid DER, such as non-minimal lengths
+	tag, message, err := readBERElement(bufio.NewReader(conn))
+	if err != nil {
+		return fmt.Errorf("failed to read the StartTLS response: %w", err)
+	}
+	if tag != berTagSequence {
+		return errors.New("the StartTLS response is not an LDAP message")
+	}
+	// Skip the message ID
+	_, _, message, err = parseBERElement(message)
+	if err != nil {
+		return fmt.Errorf("failed to parse the StartTLS response: %w", err)
+	}
+	tag, response, _, err := parseBERElement(message)
+	if err != nil {
+		return fmt.Errorf("failed to parse the StartTLS response: %w", err)
+	}
+	if tag != berTagExtendedResponse {
+		return fmt.Errorf("unexpected LDAP operation in the StartTLS response with tag 0x%02x", tag)
+	}
+	// The response starts with the result code, matched DN, and diagnostic message
+	tag, resultCode, response, err := parseBERElement(response)
+	if err != nil || tag != berTagEnumerated || len(resultCode) == 0 {
+		return errors.New("failed to parse the StartTLS result code")
+	}
+	code := 0
+	for _, b := range resultCode {
+		code = code<<8 | int(b)
+	}
+	if code != 0 {
+		var diagnosticMessage []byte
+		_, _, response, err = parseBERElement(response)
+		if err == nil {
+			_, diagnosticMessage, _, _ = parseBERElement(response)
+		}
+		return fmt.Errorf("the server rejected the StartTLS request with result code %d: %s", code, string(diagnosticMessage))
+	}
+	return nil
+}
+
+// Parse the length of a BER element from the bytes following its tag, returning the length and the number of bytes used
+func parseBERLength(data []byte) (int, int, error) {
+	if len(data) == 0 {
+		return 0, 0, io.ErrUnexpectedEOF
+	}
+	if data[0]&0x80 == 0 {
+		return int(data[0]), 1, nil
+	}
+	numLengthBytes := int(data[0] & 0x7f)
+	if numLengthBytes == 0 || numLengthBytes > 4 {
+		return 0, 0, errors.New("unsupported BER length encoding")
+	}
+	if len(data) < 1+numLengthBytes {
+		return 0, 0, io.ErrUnexpectedEOF
+	}
+	length := 0
+	for _, b := range data[1 : 1+numLengthBytes] {
+		length = length<<8 | int(b)
+	}
+	return length, 1 + numLengthBytes, nil
+}
+
+// Parse a single BER element, returning its tag, its content, and the remaining bytes after it
+func parseBERElement(data []byte) (byte, []byte, []byte, error) {
+	if len(data) < 2 {
+		return 0, nil, nil, io.ErrUnexpectedEOF
+	}
+	length, lengthSize, err := parseBERLength(data[1:])
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	start := 1 + lengthSize
+	if length > len(data)-start {
+		return 0, nil, nil, io.ErrUnexpectedEOF
+	}
+	return data[0], data[start : start+length], data[start+length:], nil
+}
+
+// Read a single BER element from the reader, returning its tag and content
+func readBERElement(reader *bufio.Reader) (byte, []byte, error) {
+	tag, err := reader.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	lengthHeader, err := reader.Peek(1)
+	if err != nil {
+		return 0, nil, err
+	}
+	lengthBytes := make([]byte, 1+int(lengthHeader[0]&0x7f))
+	if lengthHeader[0]&0x80 == 0 {
+		lengthBytes = lengthBytes[:1]
+	}
+	_, err = io.ReadFull(reader, lengthBytes)
+	if err != nil {
+		return 0, nil, err
+	}
+	length, _, err := parseBERLength(lengthBytes)
+	if err != nil {
+		return 0, nil, err
+	}
+	if length > maxStartTLSResponseLength {
+		return 0, nil, fmt.Errorf("response length %d is too large", length)
+	}
+	content := make([]byte, length)
+	_, err = io.ReadFull(reader, content)
+	if err != nil {
+		return 0, nil, err
+	}
+	return tag, content, nil
+}
@@ -3,9 +3,11 @@
 package types
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -24,6 +26,25 @@ func GetStringSet(values []string) types.Set {
 	return set
 }
 
+// Get the values of a types.Set of strings as a slice. A null or unknown set has no values.
+func GetStringSetValues(ctx context.Context, set types.Set, diagnostics *diag.Diagnostics) []string {
+	var values []string
+	if IsDefined(set) {
+		diagnostics.Append(set.ElementsAs(ctx, &values, false)...)
+	}
+	return values
+}
+
+// Get a types.List from a slice of strings, preserving the order of the values
+func GetStringList(values []string) types.List {
+	listValues := make([]attr.Value, len(values))
+	for i := 0; i < len(values); i++ {
+		listValues[i] = types.StringValue(values[i])
+	}
+	list, _ := types.ListValue(types.StringType, listValues)
+	return list
+}
+
 // Get a types.Set from a slice of int64
 func GetInt64Set(values []int64) types.Set {
 	setValues := make([]attr.Value, len(values))
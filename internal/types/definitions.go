@@ -3,15 +3,27 @@
 package types
 
 import (
+	"sync"
+
 	client "github.com/pingidentity/pingdirectory-go-client/v10300/configurationapi"
 )
 
+// Supported values for the provider required_actions_policy attribute
+const (
+	RequiredActionsPolicyWarn    = "warn"
+	RequiredActionsPolicyError   = "error"
+	RequiredActionsPolicyCollect = "collect"
+)
+
 // Configuration used by the provider and resources
 type ProviderConfiguration struct {
-	HttpsHost      string
-	Username       string
-	Password       string
-	ProductVersion string
+	HttpsHost             string
+	Username              string
+	Password              string
+	ProductVersion        string
+	RequiredActionsPolicy string
+	// Shared by all resources of a configured provider when the policy is "collect"
+	RequiredActions *RequiredActionsCollector
 }
 
 // Configuration passed to resources
@@ -19,3 +31,39 @@ type ResourceConfiguration struct {
 	ProviderConfig ProviderConfiguration
 	ApiClient      *client.APIClient
 }
+
+// Required actions collected during an apply, grouped by resource
+type RequiredActionsCollector struct {
+	mutex     sync.Mutex
+	resources []string
+	actions   map[string][]string
+}
+
+func NewRequiredActionsCollector() *RequiredActionsCollector {
+	return &RequiredActionsCollector{
+		actions: map[string][]string{},
+	}
+}
+
+// Add required actions for a resource
+func (c *RequiredActionsCollector) Add(resource string, actions []string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if _, ok := c.actions[resource]; !ok {
+		c.resources = append(c.resources, resource)
+	}
+	c.actions[resource] = append(c.actions[resource], actions...)
+}
+
+// Get the collected required actions in the order the resources were applied
+func (c *RequiredActionsCollector) Resources() ([]string, map[string][]string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	resources := make([]string, len(c.resources))
+	copy(resources, c.resources)
+	actions := make(map[string][]string, len(c.actions))
+	for resource, resourceActions := range c.actions {
+		actions[resource] = append([]string{}, resourceActions...)
+	}
+	return resources, actions
+}
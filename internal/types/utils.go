@@ -5,6 +5,7 @@ package types
 import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -96,3 +97,13 @@ func EmptySet(elementType attr.Type) types.Set {
 	result, _ := types.SetValue(elementType, []attr.Value{})
 	return result
 }
+
+func EmptyListDefault(elementType attr.Type) defaults.List {
+	empty := EmptyList(elementType)
+	return listdefault.StaticValue(empty)
+}
+
+func EmptyList(elementType attr.Type) types.List {
+	result, _ := types.ListValue(elementType, []attr.Value{})
+	return result
+}